@@ -0,0 +1,276 @@
+package videoUpscaler
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	fmt "fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/janction/videoUpscaler/db"
+	"github.com/janction/videoUpscaler/ipfs"
+	"github.com/janction/videoUpscaler/videoUpscalerLogger"
+	"github.com/janction/videoUpscaler/vm"
+)
+
+// FinalVideoFilename is the name of the final video while it is assembled
+const FinalVideoFilename = "final.mkv"
+
+// IsReadyForAssembly returns true once every thread of a completed task has its frames on IPFS
+func (t VideoUpscalerTask) IsReadyForAssembly() bool {
+	if !t.Completed || t.Expired || len(t.Threads) == 0 {
+		return false
+	}
+	for _, thread := range t.Threads {
+		if thread.Solution == nil || thread.Solution.Dir == "" {
+			return false
+		}
+	}
+	return true
+}
+
+// GetAssembler returns the worker designated to assemble the final video: the one that proposed the solution of the first thread
+func (t VideoUpscalerTask) GetAssembler() string {
+	for _, thread := range t.Threads {
+		if thread.Solution != nil && thread.Solution.ProposedBy != "" {
+			return thread.Solution.ProposedBy
+		}
+	}
+	return ""
+}
+
+// GetAssemblyVerifier returns the worker that verifies the final video: the first proposer of a solution other than
+// the assembler, or else the first validator. Empty if the task was processed by the assembler alone
+func (t VideoUpscalerTask) GetAssemblyVerifier() string {
+	assembler := t.GetAssembler()
+	for _, thread := range t.Threads {
+		if thread.Solution != nil && thread.Solution.ProposedBy != "" && thread.Solution.ProposedBy != assembler {
+			return thread.Solution.ProposedBy
+		}
+	}
+	for _, thread := range t.Threads {
+		for _, validation := range thread.Validations {
+			if validation.Validator != assembler {
+				return validation.Validator
+			}
+		}
+	}
+	return ""
+}
+
+// GetAssemblyAttempt returns the amount of final videos rejected so far, which identifies the current assembly
+func (t VideoUpscalerTask) GetAssemblyAttempt() int32 {
+	if t.FinalVideo == nil {
+		return 0
+	}
+	return t.FinalVideo.Rejections
+}
+
+// IsFinalVideoPending returns true if the task is waiting for the assembler to submit the final video
+func (t VideoUpscalerTask) IsFinalVideoPending() bool {
+	return t.IsReadyForAssembly() && (t.FinalVideo == nil || t.FinalVideo.Cid == "")
+}
+
+// IsFinalVideoUnverified returns true if the final video was submitted and is waiting for the verifier
+func (t VideoUpscalerTask) IsFinalVideoUnverified() bool {
+	return t.FinalVideo != nil && t.FinalVideo.Cid != "" && !t.FinalVideo.Verified
+}
+
+// SubmitFinalVideo records the final video sent by the assembler, or the verification sent by the verifier.
+// The video is verified when the verifier sends the same cid and hash, and rejected otherwise so it is assembled again
+func (t *VideoUpscalerTask) SubmitFinalVideo(creator, cid, hash string, height int64) error {
+	if !t.IsReadyForAssembly() {
+		return fmt.Errorf("task %s is not ready to be assembled", t.TaskId)
+	}
+
+	assembler, verifier := t.GetAssembler(), t.GetAssemblyVerifier()
+	switch {
+	case creator == assembler && t.IsFinalVideoPending():
+		if cid == "" {
+			return errors.New("cid of the final video is empty")
+		}
+		if err := validateContainerHash(hash); err != nil {
+			return err
+		}
+		t.FinalVideo = &FinalVideo{AssembledBy: creator, Cid: cid, Hash: hash, Height: height, Rejections: t.GetAssemblyAttempt()}
+		// nobody else processed the task, so there is no one to verify it
+		t.FinalVideo.Verified = verifier == ""
+	case creator == verifier && t.IsFinalVideoUnverified():
+		if cid == t.FinalVideo.Cid && hash == t.FinalVideo.Hash {
+			t.FinalVideo.Verified = true
+			t.FinalVideo.VerifiedBy = creator
+		} else {
+			videoUpscalerLogger.Logger.Info("Final video %s of task %s rejected by %s", t.FinalVideo.Cid, t.TaskId, creator)
+			t.FinalVideo = &FinalVideo{Rejections: t.FinalVideo.Rejections + 1}
+		}
+	default:
+		return fmt.Errorf("worker %s can't submit the final video of task %s", creator, t.TaskId)
+	}
+	return nil
+}
+
+// AssembleFinalVideo downloads the input and the frames of every thread, encodes them into the final video and
+// submits it. Run by the assembler once the task is ready
+func (t VideoUpscalerTask) AssembleFinalVideo(ctx context.Context, workerAddress, rootPath string, db *db.DB) error {
+	attempt := t.GetAssemblyAttempt()
+	db.UpdateAssembly(t.TaskId, attempt, true, false)
+
+	fail := func(err error) error {
+		videoUpscalerLogger.Logger.Error("unable to assemble final video of task %s: %s", t.TaskId, err.Error())
+		db.UpdateAssembly(t.TaskId, attempt, false, false)
+		return err
+	}
+
+	output, hash, err := t.assemble(ctx, filepath.Join(rootPath, "assemblies", t.TaskId))
+	if err != nil {
+		return fail(err)
+	}
+	cid, err := ipfs.UploadFile(output)
+	if err != nil {
+		return fail(err)
+	}
+
+	if err := submitFinalVideo(workerAddress, t.TaskId, cid, hash); err != nil {
+		return fail(err)
+	}
+	videoUpscalerLogger.Logger.Info("Final video %s of task %s submitted", cid, t.TaskId)
+	return nil
+}
+
+// assemble downloads the input and the frames of every thread into workPath and encodes them into the final video.
+// Returns the path and the container hash of the video. Encoding is bitexact, so every node assembles the same bytes
+func (t VideoUpscalerTask) assemble(ctx context.Context, workPath string) (string, string, error) {
+	framesPath := filepath.Join(workPath, "frames")
+	output := filepath.Join(workPath, FinalVideoFilename)
+	// we start from scratch, frames of a previous attempt might be incomplete
+	os.RemoveAll(workPath)
+
+	ipfs.EnsureIPFSRunning()
+	job := vm.AssembleJob{FramesPath: framesPath, Format: t.GetFrameFormat(), StartFrame: int64(t.StartFrame), EndFrame: int64(t.EndFrame), Output: output}
+	// image sequences have no audio, so only video inputs are downloaded
+	if t.InputType == InputType_INPUT_TYPE_VIDEO {
+		if err := ipfs.IPFSGet(t.Cid, workPath); err != nil {
+			return "", "", err
+		}
+		job.Input = filepath.Join(workPath, t.Cid)
+	}
+	if err := t.collectFrames(workPath, framesPath); err != nil {
+		return "", "", err
+	}
+
+	if err := vm.AssembleVideo(ctx, job); err != nil {
+		return "", "", err
+	}
+
+	hash, err := calculateContainerHash(output)
+	if err != nil {
+		return "", "", err
+	}
+	return output, hash, nil
+}
+
+// collectFrames downloads the output of every thread and moves the frames into framesPath, making sure no frame of the task is missing
+func (t VideoUpscalerTask) collectFrames(workPath, framesPath string) error {
+	manifest := t.GetOutputManifest()
+	if !manifest.IsComplete() {
+		return fmt.Errorf("output of task %s is not complete. missing frames: %v, duplicate frames: %v, invalid entries: %v", t.TaskId, manifest.MissingFrames, manifest.DuplicateFrames, len(manifest.InvalidEntries))
+	}
+	if err := os.MkdirAll(framesPath, 0o755); err != nil {
+		return err
+	}
+
+	downloaded := make(map[string]bool)
+	next := int64(t.StartFrame)
+	for _, entry := range manifest.Entries {
+		if entry.Frame != next {
+			return fmt.Errorf("frame %v is missing from the output of task %s", next, t.TaskId)
+		}
+		next++
+
+		if !downloaded[entry.Dir] {
+			// the content of a directory is extracted into the output path
+			if err := ipfs.IPFSGet(entry.Dir, filepath.Join(workPath, entry.Dir)); err != nil {
+				return err
+			}
+			downloaded[entry.Dir] = true
+		}
+		if err := os.Rename(filepath.Join(workPath, entry.Dir, entry.Filename), filepath.Join(framesPath, t.GetFrameFormat().FrameFilename(entry.Frame))); err != nil {
+			return err
+		}
+	}
+	if next != int64(t.EndFrame)+1 {
+		return fmt.Errorf("frames %v to %v are missing from the output of task %s", next, t.EndFrame, t.TaskId)
+	}
+	return nil
+}
+
+// VerifyFinalVideo assembles the final video again from the input and the frames of every thread, without
+// looking at the submitted one, and checks it has every frame of the task. The cid and hash of this assembly are
+// sent back to the chain, which only verifies the final video if they match the submitted ones. Run by the verifier
+func (t VideoUpscalerTask) VerifyFinalVideo(ctx context.Context, workerAddress, rootPath string, db *db.DB) error {
+	attempt := t.GetAssemblyAttempt()
+	db.UpdateAssembly(t.TaskId, attempt, false, true)
+
+	fail := func(err error) error {
+		// we try again on next block
+		videoUpscalerLogger.Logger.Error("unable to verify final video of task %s: %s", t.TaskId, err.Error())
+		db.UpdateAssembly(t.TaskId, attempt, false, false)
+		return err
+	}
+
+	video, hash, err := t.assemble(ctx, filepath.Join(rootPath, "assemblies", t.TaskId, "verification"))
+	if err != nil {
+		return fail(err)
+	}
+
+	frames, err := vm.CountVideoFrames(ctx, video)
+	if err != nil || frames != t.GetFrameAmount() {
+		return fail(fmt.Errorf("final video has %v frames, expected %v", frames, t.GetFrameAmount()))
+	}
+
+	// the same bytes get the same cid, so the submitted cid is also checked
+	cid, err := ipfs.UploadFile(video)
+	if err != nil {
+		return fail(err)
+	}
+	if cid != t.FinalVideo.Cid || hash != t.FinalVideo.Hash {
+		videoUpscalerLogger.Logger.Info("Final video %s of task %s doesn't match our assembly %s with hash %s", t.FinalVideo.Cid, t.TaskId, cid, hash)
+	}
+
+	if err := submitFinalVideo(workerAddress, t.TaskId, cid, hash); err != nil {
+		return fail(err)
+	}
+	return nil
+}
+
+func submitFinalVideo(address, taskId, cid, hash string) error {
+	args := []string{"tx", "videoUpscaler", "submit-final-video", taskId, cid, hash, "--yes", "--from", address}
+	return ExecuteCli(args)
+}
+
+// calculateContainerHash returns the sha256 of the bytes of the file
+func calculateContainerHash(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hasher := sha256.New()
+	if _, err := io.Copy(hasher, file); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hasher.Sum(nil)), nil
+}
+
+// validateContainerHash makes sure the hash is a hex encoded sha256
+func validateContainerHash(hash string) error {
+	decoded, err := hex.DecodeString(hash)
+	if err != nil || len(decoded) != sha256.Size {
+		return fmt.Errorf("invalid hash %s of the final video", hash)
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package videoUpscaler
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testContainerHash = "3a7bd3e2360a3d29eea436fcfb7e44c735d117c42d1c1835420b6b9942dd4f1b"
+
+func assembledTask() VideoUpscalerTask {
+	return VideoUpscalerTask{
+		TaskId:    "1",
+		Completed: true,
+		Threads: []*VideoUpscalerThread{
+			{ThreadId: "10", Solution: &VideoUpscalerThread_Solution{ProposedBy: "alice", Dir: "QmDir10"}, Validations: []*VideoUpscalerThread_Validation{{Validator: "bob"}}},
+			{ThreadId: "11", Solution: &VideoUpscalerThread_Solution{ProposedBy: "carol", Dir: "QmDir11"}},
+		},
+	}
+}
+
+func TestFinalVideoWorkers(t *testing.T) {
+	task := assembledTask()
+	assert.True(t, task.IsReadyForAssembly())
+	assert.Equal(t, "alice", task.GetAssembler())
+	// proposers are preferred over validators
+	assert.Equal(t, "carol", task.GetAssemblyVerifier())
+
+	task.Threads[1].Solution.ProposedBy = "alice"
+	assert.Equal(t, "bob", task.GetAssemblyVerifier())
+
+	task.Threads[0].Validations = nil
+	assert.Empty(t, task.GetAssemblyVerifier())
+
+	// a thread without its frames on IPFS can't be assembled
+	task.Threads[1].Solution.Dir = ""
+	assert.False(t, task.IsReadyForAssembly())
+}
+
+func TestSubmitFinalVideo(t *testing.T) {
+	task := assembledTask()
+
+	// only the assembler submits the video, with a valid hash
+	assert.Error(t, task.SubmitFinalVideo("carol", "QmVideo", testContainerHash, 5))
+	assert.Error(t, task.SubmitFinalVideo("alice", "QmVideo", "abc", 5))
+	require.NoError(t, task.SubmitFinalVideo("alice", "QmVideo", testContainerHash, 5))
+	assert.True(t, task.IsFinalVideoUnverified())
+	assert.Error(t, task.SubmitFinalVideo("alice", "QmVideo", testContainerHash, 6))
+
+	// a different hash from the verifier rejects the video, so it is assembled again
+	require.NoError(t, task.SubmitFinalVideo("carol", "QmVideo", "", 6))
+	assert.True(t, task.IsFinalVideoPending())
+	assert.Equal(t, int32(1), task.GetAssemblyAttempt())
+
+	require.NoError(t, task.SubmitFinalVideo("alice", "QmVideo2", testContainerHash, 7))
+	require.NoError(t, task.SubmitFinalVideo("carol", "QmVideo2", testContainerHash, 8))
+	assert.True(t, task.FinalVideo.Verified)
+	assert.Equal(t, "carol", task.FinalVideo.VerifiedBy)
+	assert.Equal(t, int32(1), task.FinalVideo.Rejections)
+	assert.Error(t, task.SubmitFinalVideo("carol", "QmVideo2", testContainerHash, 9))
+}
+
+func TestSubmitFinalVideo_WithoutVerifier(t *testing.T) {
+	task := assembledTask()
+	task.Threads[0].Validations = nil
+	task.Threads[1].Solution.ProposedBy = "alice"
+
+	require.NoError(t, task.SubmitFinalVideo("alice", "QmVideo", testContainerHash, 5))
+	assert.True(t, task.FinalVideo.Verified)
+}
+
+func TestCalculateContainerHash(t *testing.T) {
+	path := filepath.Join(t.TempDir(), FinalVideoFilename)
+	require.NoError(t, os.WriteFile(path, []byte("hello world"), 0o644))
+
+	hash, err := calculateContainerHash(path)
+	require.NoError(t, err)
+	assert.Equal(t, "b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9", hash)
+	assert.NoError(t, validateContainerHash(hash))
+}
@@ -0,0 +1,237 @@
+package videoUpscaler
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	fmt "fmt"
+	"image"
+	"math/bits"
+	"os"
+
+	videoUpscalerCrypto "github.com/janction/videoUpscaler/crypto"
+)
+
+const (
+	// FingerprintTiles is the amount of tiles of a frame fingerprint, the most a distance can be
+	FingerprintTiles = fingerprintGrid * fingerprintGrid
+	// the frame is split in a fingerprintGrid by fingerprintGrid grid of tiles
+	fingerprintGrid = 8
+	// the fingerprint has the width and height of the frame, then the brightness and the detail of each tile
+	fingerprintHeader = 8
+	fingerprintSize   = fingerprintHeader + 2*FingerprintTiles
+	// brightness, out of 255, and detail, in sixteenths of octave, a tile can differ by and still match
+	fingerprintLumaTolerance   = 8
+	fingerprintDetailTolerance = 8
+	// detail below this gradient, in 16 bit luma, is mostly noise of the render and barely changes the fingerprint
+	fingerprintDetailFloor = 1 << 10
+)
+
+// fingerprintImage returns the perceptual fingerprint of an image at its resolution, hex encoded. It has the size of
+// the image and, for each tile of a grid, its average brightness and its detail: the average difference of brightness
+// between neighbour pixels, in a logarithmic scale. Detail is what an upscaler adds, so a frame resized with a cheap
+// filter, or rendered at a lower resolution, loses detail in most tiles even if it looks the same.
+// Only integer arithmetic is used, so every node gets the same fingerprint for the same pixels
+func fingerprintImage(img image.Image) string {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+
+	var sums, details, counts [FingerprintTiles]uint64
+	row, previous := make([]uint64, width), make([]uint64, width)
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			row[x] = luma(img, bounds.Min.X+x, bounds.Min.Y+y)
+		}
+		tileRow := y * fingerprintGrid / height
+		for x := 0; x < width; x++ {
+			tile := tileRow*fingerprintGrid + x*fingerprintGrid/width
+			sums[tile] += row[x]
+			counts[tile]++
+			if x > 0 {
+				details[tile] += absDiff(row[x], row[x-1])
+			}
+			if y > 0 {
+				details[tile] += absDiff(row[x], previous[x])
+			}
+		}
+		row, previous = previous, row
+	}
+
+	fingerprint := make([]byte, fingerprintSize)
+	binary.BigEndian.PutUint32(fingerprint[0:], uint32(width))
+	binary.BigEndian.PutUint32(fingerprint[4:], uint32(height))
+	for tile := 0; tile < FingerprintTiles; tile++ {
+		if counts[tile] == 0 {
+			continue
+		}
+		fingerprint[fingerprintHeader+2*tile] = byte(sums[tile] / counts[tile] >> 8)
+		fingerprint[fingerprintHeader+2*tile+1] = byte(logScale(details[tile]/counts[tile]+fingerprintDetailFloor) - logScale(fingerprintDetailFloor))
+	}
+	return hex.EncodeToString(fingerprint)
+}
+
+// logScale returns the base 2 logarithm of v in sixteenths, interpolating linearly between powers of two
+func logScale(v uint64) int {
+	n := bits.Len64(v)
+	if n == 0 {
+		return 0
+	}
+	return (n-1)*16 + int(v<<4>>(n-1)&0xF)
+}
+
+func absDiff(a, b uint64) uint64 {
+	if a > b {
+		return a - b
+	}
+	return b - a
+}
+
+// luma returns the 16 bit brightness of a pixel, with the weights of color.GrayModel
+func luma(img image.Image, x, y int) uint64 {
+	var r, g, b uint32
+	if rgba64, ok := img.(image.RGBA64Image); ok {
+		// avoids allocating a color for each pixel
+		c := rgba64.RGBA64At(x, y)
+		r, g, b = uint32(c.R), uint32(c.G), uint32(c.B)
+	} else {
+		r, g, b, _ = img.At(x, y).RGBA()
+	}
+	return uint64((19595*r + 38470*g + 7471*b + 1<<15) >> 16)
+}
+
+// calculateImageFingerprint returns the perceptual fingerprint of the image stored at filePath
+func calculateImageFingerprint(filePath string) (string, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	img, _, err := image.Decode(file)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode image: %w", err)
+	}
+	return fingerprintImage(img), nil
+}
+
+// FingerprintDistance returns the amount of tiles that differ between two fingerprints. Fingerprints of frames
+// of different sizes are further away than any tolerance
+func FingerprintDistance(a, b string) (int, error) {
+	first, err := decodeFingerprint(a)
+	if err != nil {
+		return 0, err
+	}
+	second, err := decodeFingerprint(b)
+	if err != nil {
+		return 0, err
+	}
+	if !bytes.Equal(first[:fingerprintHeader], second[:fingerprintHeader]) {
+		return FingerprintTiles + 1, nil
+	}
+	distance := 0
+	for tile := 0; tile < FingerprintTiles; tile++ {
+		i := fingerprintHeader + 2*tile
+		if absDiff(uint64(first[i]), uint64(second[i])) > fingerprintLumaTolerance || absDiff(uint64(first[i+1]), uint64(second[i+1])) > fingerprintDetailTolerance {
+			distance++
+		}
+	}
+	return distance, nil
+}
+
+// ValidateFingerprint makes sure the fingerprint is a hex encoded fingerprint of a frame
+func ValidateFingerprint(fingerprint string) error {
+	_, err := decodeFingerprint(fingerprint)
+	return err
+}
+
+func decodeFingerprint(fingerprint string) ([]byte, error) {
+	decoded, err := hex.DecodeString(fingerprint)
+	if err != nil || len(decoded) != fingerprintSize {
+		return nil, fmt.Errorf("invalid fingerprint %s", fingerprint)
+	}
+	return decoded, nil
+}
+
+// IsFingerprintValidated returns if the frames of the thread are validated by fingerprint instead of by pixel hash
+func (t VideoUpscalerThread) IsFingerprintValidated() bool {
+	return t.Options.GetValidation() == ValidationMode_VALIDATION_MODE_FINGERPRINT
+}
+
+// IsFingerprintMatch returns if the fingerprint of a validator is close enough to the fingerprint of a frame of the solution
+func (t VideoUpscalerThread) IsFingerprintMatch(solution, validation string) bool {
+	distance, err := FingerprintDistance(solution, validation)
+	return err == nil && distance <= int(t.Options.GetMaxFingerprintDistance())
+}
+
+// VerifyRevealedFingerprints makes sure the fingerprints revealed for the solution are the ones the proposer
+// signed when it proposed it, so they can't be adjusted to the fingerprints revealed by the validators
+func (t VideoUpscalerThread) VerifyRevealedFingerprints() error {
+	pk, err := videoUpscalerCrypto.DecodePublicKeyFromCLI(t.Solution.PublicKey)
+	if err != nil {
+		return err
+	}
+	for _, frame := range t.Solution.Frames {
+		if err := ValidateFingerprint(frame.Fingerprint); err != nil {
+			return fmt.Errorf("frame %s: %w", frame.Filename, err)
+		}
+		message, err := videoUpscalerCrypto.GenerateSignableMessage(frame.Fingerprint, t.Solution.ProposedBy)
+		if err != nil {
+			return err
+		}
+		sig, err := videoUpscalerCrypto.DecodeSignatureFromCLI(frame.Signature)
+		if err != nil {
+			return err
+		}
+		if !pk.VerifySignature(message, sig) {
+			return fmt.Errorf("fingerprint of frame %s doesn't match the proposed solution", frame.Filename)
+		}
+	}
+	return nil
+}
+
+// IsValidationRevealed returns true once the validator revealed the fingerprints of its validation
+func (v VideoUpscalerThread_Validation) IsValidationRevealed() bool {
+	return len(v.Frames) > 0 && v.Frames[0].Fingerprint != ""
+}
+
+// RevealValidatorFingerprints records the fingerprints of the frames the validator signed. Validators only sign their
+// fingerprints when they validate, and reveal them once the solution is revealed, so they can't copy the fingerprints
+// of other validators or of the proposer. Each fingerprint must be the one signed with the validation
+func (t *VideoUpscalerThread) RevealValidatorFingerprints(validator string, fingerprints map[string]string) error {
+	if !t.IsSolutionRevealed() {
+		return fmt.Errorf("solution of thread %s is not revealed yet", t.ThreadId)
+	}
+	validation := t.GetValidation(validator)
+	if validation == nil {
+		return fmt.Errorf("%s didn't validate thread %s", validator, t.ThreadId)
+	}
+	if validation.IsValidationRevealed() {
+		return fmt.Errorf("validation of %s is already revealed", validator)
+	}
+	pk, err := videoUpscalerCrypto.DecodePublicKeyFromCLI(validation.PublicKey)
+	if err != nil {
+		return err
+	}
+
+	for _, frame := range validation.Frames {
+		fingerprint := fingerprints[frame.Filename]
+		if err := ValidateFingerprint(fingerprint); err != nil {
+			return fmt.Errorf("frame %s: %w", frame.Filename, err)
+		}
+		message, err := videoUpscalerCrypto.GenerateSignableMessage(fingerprint, validator)
+		if err != nil {
+			return err
+		}
+		sig, err := videoUpscalerCrypto.DecodeSignatureFromCLI(frame.Signature)
+		if err != nil {
+			return err
+		}
+		if !pk.VerifySignature(message, sig) {
+			return fmt.Errorf("fingerprint of frame %s doesn't match the validation", frame.Filename)
+		}
+	}
+	for _, frame := range validation.Frames {
+		frame.Fingerprint = fingerprints[frame.Filename]
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+package videoUpscaler
+
+import (
+	"encoding/hex"
+	"image"
+	"image/color"
+	"image/draw"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	videoUpscalerCrypto "github.com/janction/videoUpscaler/crypto"
+	"github.com/janction/videoUpscaler/vm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	xdraw "golang.org/x/image/draw"
+)
+
+func TestFingerprintImage(t *testing.T) {
+	frame := vm.FakeFrame("QmInput", 1, 320, 180)
+	fingerprint := fingerprintImage(frame)
+	assert.NoError(t, ValidateFingerprint(fingerprint))
+
+	// the same pixels with 16 bits per channel
+	wide := image.NewRGBA64(frame.Bounds())
+	draw.Draw(wide, wide.Bounds(), frame, frame.Bounds().Min, draw.Src)
+	assert.Equal(t, fingerprint, fingerprintImage(wide))
+
+	// small differences of a render are within the default distance
+	noisy := image.NewRGBA(frame.Bounds())
+	copy(noisy.Pix, frame.Pix)
+	for x := 0; x < 320; x += 3 {
+		c := noisy.RGBAAt(x, x%180)
+		noisy.SetRGBA(x, x%180, color.RGBA{R: c.R ^ 1, G: c.G ^ 1, B: c.B, A: c.A})
+	}
+	distance, err := FingerprintDistance(fingerprint, fingerprintImage(noisy))
+	assert.NoError(t, err)
+	assert.LessOrEqual(t, distance, int(DefaultParams().MaxFingerprintDistance))
+
+	// another picture is far away
+	distance, err = FingerprintDistance(fingerprint, fingerprintImage(vm.FakeFrame("QmOther", 7, 320, 180)))
+	assert.NoError(t, err)
+	assert.Greater(t, distance, int(DefaultParams().MaxFingerprintDistance))
+
+	// the same picture at another resolution doesn't match any tolerance
+	distance, err = FingerprintDistance(fingerprint, fingerprintImage(vm.FakeFrame("QmInput", 1, 160, 90)))
+	assert.NoError(t, err)
+	assert.Greater(t, distance, FingerprintTiles)
+
+	_, err = FingerprintDistance(fingerprint, "abc")
+	assert.Error(t, err)
+}
+
+func TestFingerprintRejectsCheapResize(t *testing.T) {
+	// 1. Setup: the frame rendered by the upscaler, and the same frame rendered at half the resolution
+	// and resized to the output resolution with cheap filters
+	frame := vm.FakeFrame("QmInput", 1, 320, 180)
+	fingerprint := fingerprintImage(frame)
+	small := image.NewRGBA(image.Rect(0, 0, 160, 90))
+	xdraw.BiLinear.Scale(small, small.Bounds(), frame, frame.Bounds(), draw.Src, nil)
+
+	for name, filter := range map[string]xdraw.Interpolator{"nearest": xdraw.NearestNeighbor, "bilinear": xdraw.BiLinear, "catmull-rom": xdraw.CatmullRom} {
+		resized := image.NewRGBA(frame.Bounds())
+		filter.Scale(resized, resized.Bounds(), small, small.Bounds(), draw.Src, nil)
+
+		// 2. the resized frame has the size of the output, but not its detail
+		distance, err := FingerprintDistance(fingerprint, fingerprintImage(resized))
+		require.NoError(t, err)
+		assert.Greater(t, distance, int(DefaultParams().MaxFingerprintDistance), name)
+	}
+}
+
+// signFingerprint signs the fingerprint for the worker like the propose and validate commands do
+func signFingerprint(t *testing.T, key *secp256k1.PrivKey, fingerprint, worker string) string {
+	message, err := videoUpscalerCrypto.GenerateSignableMessage(fingerprint, worker)
+	require.NoError(t, err)
+	signature, err := key.Sign(message)
+	require.NoError(t, err)
+	return videoUpscalerCrypto.EncodeSignatureForCLI(signature)
+}
+
+func TestEvaluateVerificationsByFingerprint(t *testing.T) {
+	fingerprint := fingerprintImage(vm.FakeFrame("QmInput", 1, 320, 180))
+	// two tiles away
+	decoded, err := hex.DecodeString(fingerprint)
+	require.NoError(t, err)
+	decoded[fingerprintHeader] += 2 * fingerprintLumaTolerance
+	decoded[fingerprintHeader+3] += 2 * fingerprintDetailTolerance
+	near := hex.EncodeToString(decoded)
+	far := fingerprintImage(vm.FakeFrame("QmOther", 7, 320, 180))
+
+	proposer, validator1, validator2, validator3 := secp256k1.GenPrivKey(), secp256k1.GenPrivKey(), secp256k1.GenPrivKey(), secp256k1.GenPrivKey()
+	frame := &VideoUpscalerThread_Frame{Filename: "frame_000001.png", Hash: "hash", Fingerprint: fingerprint, Signature: signFingerprint(t, proposer, fingerprint, "proposer")}
+	thread := VideoUpscalerThread{
+		Workers: []string{"proposer", "validator1", "validator2", "validator3"},
+		Options: &UpscaleOptions{Validation: ValidationMode_VALIDATION_MODE_FINGERPRINT, MaxFingerprintDistance: 4},
+		Solution: &VideoUpscalerThread_Solution{ProposedBy: "proposer", PublicKey: videoUpscalerCrypto.EncodePublicKeyForCLI(proposer.PubKey()),
+			Frames: []*VideoUpscalerThread_Frame{frame}},
+		Validations: []*VideoUpscalerThread_Validation{
+			{Validator: "validator1", PublicKey: videoUpscalerCrypto.EncodePublicKeyForCLI(validator1.PubKey()), Frames: []*VideoUpscalerThread_Frame{
+				{Filename: "frame_000001.png", Signature: signFingerprint(t, validator1, near, "validator1")}}},
+			{Validator: "validator2", PublicKey: videoUpscalerCrypto.EncodePublicKeyForCLI(validator2.PubKey()), Frames: []*VideoUpscalerThread_Frame{
+				{Filename: "frame_000001.png", Signature: signFingerprint(t, validator2, far, "validator2")}}},
+			// validator3 didn't reveal its fingerprints
+			{Validator: "validator3", PublicKey: videoUpscalerCrypto.EncodePublicKeyForCLI(validator3.PubKey()), Frames: []*VideoUpscalerThread_Frame{
+				{Filename: "frame_000001.png", Signature: signFingerprint(t, validator3, fingerprint, "validator3")}}},
+		},
+	}
+
+	// the revealed fingerprint is the one signed with the proposal
+	assert.NoError(t, thread.VerifyRevealedFingerprints())
+
+	// validators can only reveal the fingerprints they signed
+	assert.Error(t, thread.RevealValidatorFingerprints("validator1", map[string]string{"frame_000001.png": fingerprint}))
+	assert.Error(t, thread.RevealValidatorFingerprints("validator1", map[string]string{}))
+	assert.Error(t, thread.RevealValidatorFingerprints("proposer", map[string]string{"frame_000001.png": fingerprint}))
+	require.NoError(t, thread.RevealValidatorFingerprints("validator1", map[string]string{"frame_000001.png": near}))
+	require.NoError(t, thread.RevealValidatorFingerprints("validator2", map[string]string{"frame_000001.png": far}))
+	assert.Error(t, thread.RevealValidatorFingerprints("validator1", map[string]string{"frame_000001.png": near}))
+
+	require.NoError(t, thread.EvaluateVerifications())
+	assert.Equal(t, int64(1), frame.ValidCount)
+	assert.Equal(t, int64(1), frame.InvalidCount)
+
+	// a fingerprint changed after seeing the validations is rejected
+	frame.Fingerprint = near
+	assert.Error(t, thread.VerifyRevealedFingerprints())
+}
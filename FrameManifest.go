@@ -0,0 +1,244 @@
+package videoUpscaler
+
+import (
+	"encoding/json"
+	fmt "fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/janction/videoUpscaler/ipfs"
+	"github.com/janction/videoUpscaler/videoUpscalerLogger"
+	"github.com/janction/videoUpscaler/vm"
+)
+
+// FrameManifestFilename is the file with the frame manifest of a thread, stored next to its output dir
+const FrameManifestFilename = "manifest.json"
+
+// calculateCID returns the IPFS CID of a frame. Replaced on tests so the ipfs cli is not needed
+var calculateCID = ipfs.CalculateCID
+
+// FrameManifestEntry describes an upscaled frame of a thread
+type FrameManifestEntry struct {
+	Frame    int64  `json:"frame"`
+	Filename string `json:"filename"`
+	Width    int32  `json:"width"`
+	Height   int32  `json:"height"`
+	Hash     string `json:"hash"`
+	// empty on partial manifests
+	Cid string `json:"cid,omitempty"`
+	// name of the input image of the frame, for image sequence inputs
+	Source string `json:"source,omitempty"`
+	// perceptual fingerprint, for threads validated by fingerprint
+	Fingerprint string `json:"fingerprint,omitempty"`
+}
+
+// FrameManifest maps each expected frame of a thread to its upscaled file. It is built once rendering
+// finishes, and it is the source of the hashes, cids and files we propose, verify, reveal and upload
+type FrameManifest struct {
+	ThreadId   string               `json:"thread_id"`
+	StartFrame int64                `json:"start_frame"`
+	EndFrame   int64                `json:"end_frame"`
+	Frames     []FrameManifestEntry `json:"frames"`
+}
+
+// GetFrameManifestPath returns where the frame manifest of the thread is stored
+func GetFrameManifestPath(rootPath, threadId string) string {
+	return filepath.Join(rootPath, "upscales", threadId, FrameManifestFilename)
+}
+
+// BuildFrameManifest reads the frames of the thread at outputPath, ordered by frame number. Files that are not
+// a frame of the thread are an error. Missing frames are an error too, unless partial is set, in which case only the
+// frames already rendered are included, without CIDs. Hidden files are temporary files of the renderer: they are
+// skipped on partial manifests, and removed as leftovers of an interrupted write otherwise
+func (t VideoUpscalerThread) BuildFrameManifest(outputPath string, partial bool) (FrameManifest, error) {
+	manifest := FrameManifest{ThreadId: t.ThreadId, StartFrame: t.StartFrame, EndFrame: t.EndFrame, Frames: []FrameManifestEntry{}}
+
+	entries, err := os.ReadDir(outputPath)
+	if err != nil {
+		return FrameManifest{}, err
+	}
+
+	format := t.GetFrameFormat()
+	found := make(map[int64]bool)
+	for _, entry := range entries {
+		name := entry.Name()
+		if strings.HasPrefix(name, ".") {
+			if !partial {
+				videoUpscalerLogger.Logger.Debug("Removing leftover file %s of thread %s", name, t.ThreadId)
+				os.RemoveAll(filepath.Join(outputPath, name))
+			}
+			continue
+		}
+
+		frame, err := GetFrameNumber(name)
+		if err != nil || entry.IsDir() || name != format.FrameFilename(frame) || frame < t.StartFrame || frame > t.EndFrame {
+			return FrameManifest{}, fmt.Errorf("unexpected file %s at %s", name, outputPath)
+		}
+		found[frame] = true
+	}
+
+	var missing []int64
+	for frame := t.StartFrame; frame <= t.EndFrame; frame++ {
+		if !found[frame] {
+			missing = append(missing, frame)
+			continue
+		}
+
+		filename := format.FrameFilename(frame)
+		framePath := filepath.Join(outputPath, filename)
+		hash, width, height, err := calculateImageInfo(framePath)
+		if err != nil {
+			if partial {
+				// the frame might still be written by the renderer
+				videoUpscalerLogger.Logger.Debug("Skipping frame %s of thread %s: %s", filename, t.ThreadId, err.Error())
+				continue
+			}
+			return FrameManifest{}, err
+		}
+
+		entry := FrameManifestEntry{Frame: frame, Filename: filename, Width: width, Height: height, Hash: hash}
+		if t.IsFingerprintValidated() {
+			entry.Fingerprint, err = calculateImageFingerprint(framePath)
+			if err != nil {
+				return FrameManifest{}, err
+			}
+		}
+		if !partial {
+			entry.Cid, err = calculateCID(framePath)
+			if err != nil {
+				return FrameManifest{}, err
+			}
+		}
+		manifest.Frames = append(manifest.Frames, entry)
+	}
+
+	if len(missing) > 0 && !partial {
+		return FrameManifest{}, fmt.Errorf("%v frames of thread %s are missing at %s: %v", len(missing), t.ThreadId, outputPath, missing)
+	}
+
+	return manifest, nil
+}
+
+// Validate makes sure the manifest is complete for the thread: every frame once, in order, with its hash and cid
+func (m FrameManifest) Validate(t VideoUpscalerThread) error {
+	if m.ThreadId != t.ThreadId || m.StartFrame != t.StartFrame || m.EndFrame != t.EndFrame {
+		return fmt.Errorf("manifest of thread %s frames %v to %v doesn't belong to thread %s frames %v to %v", m.ThreadId, m.StartFrame, m.EndFrame, t.ThreadId, t.StartFrame, t.EndFrame)
+	}
+	if len(m.Frames) != int(t.EndFrame-t.StartFrame)+1 {
+		return fmt.Errorf("manifest of thread %s has %v frames, expected %v", t.ThreadId, len(m.Frames), t.EndFrame-t.StartFrame+1)
+	}
+	format := t.GetFrameFormat()
+	for i, entry := range m.Frames {
+		frame := t.StartFrame + int64(i)
+		if entry.Frame != frame || entry.Filename != format.FrameFilename(frame) {
+			return fmt.Errorf("manifest of thread %s is missing frame %v", t.ThreadId, frame)
+		}
+		if entry.Hash == "" || entry.Cid == "" {
+			return fmt.Errorf("manifest of thread %s has no hash or cid for frame %v", t.ThreadId, frame)
+		}
+		if t.IsFingerprintValidated() && entry.Fingerprint == "" {
+			return fmt.Errorf("manifest of thread %s has no fingerprint for frame %v", t.ThreadId, frame)
+		}
+	}
+	return nil
+}
+
+// CheckOutput makes sure the files at outputPath are exactly the frames of the manifest
+func (m FrameManifest) CheckOutput(outputPath string) error {
+	entries, err := os.ReadDir(outputPath)
+	if err != nil {
+		return err
+	}
+
+	expected := make(map[string]bool)
+	for _, filename := range m.Filenames() {
+		expected[filename] = true
+	}
+	for _, entry := range entries {
+		if !expected[entry.Name()] {
+			return fmt.Errorf("unexpected file %s at %s", entry.Name(), outputPath)
+		}
+		delete(expected, entry.Name())
+	}
+	for _, entry := range m.Frames {
+		if expected[entry.Filename] {
+			return fmt.Errorf("frame %s is missing at %s", entry.Filename, outputPath)
+		}
+	}
+	return nil
+}
+
+// SetSources records the name of the input image of each frame, so upscaled frames can be traced back to the sequence
+func (m *FrameManifest) SetSources(sequence vm.ImageSequence) error {
+	for i := range m.Frames {
+		source, err := sequence.File(m.Frames[i].Frame)
+		if err != nil {
+			return err
+		}
+		m.Frames[i].Source = source
+	}
+	return nil
+}
+
+// Filenames returns the files of the manifest, ordered by frame
+func (m FrameManifest) Filenames() []string {
+	filenames := make([]string, 0, len(m.Frames))
+	for _, entry := range m.Frames {
+		filenames = append(filenames, entry.Filename)
+	}
+	return filenames
+}
+
+// Hashes returns the pixel hash of each file of the manifest
+func (m FrameManifest) Hashes() map[string]string {
+	hashes := make(map[string]string)
+	for _, entry := range m.Frames {
+		hashes[entry.Filename] = entry.Hash
+	}
+	return hashes
+}
+
+// Fingerprints returns the perceptual fingerprint of each file of the manifest
+func (m FrameManifest) Fingerprints() map[string]string {
+	fingerprints := make(map[string]string)
+	for _, entry := range m.Frames {
+		fingerprints[entry.Filename] = entry.Fingerprint
+	}
+	return fingerprints
+}
+
+// Solution returns the frames of the manifest as revealed on chain
+func (m FrameManifest) Solution() map[string]VideoUpscalerThread_Frame {
+	solution := make(map[string]VideoUpscalerThread_Frame)
+	for _, entry := range m.Frames {
+		solution[entry.Filename] = VideoUpscalerThread_Frame{Filename: entry.Filename, Cid: entry.Cid, Hash: entry.Hash, Fingerprint: entry.Fingerprint}
+	}
+	return solution
+}
+
+// Write stores the manifest at path, replacing any previous one
+func (m FrameManifest) Write(path string) error {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, path)
+}
+
+// ReadFrameManifest loads the manifest stored at path
+func ReadFrameManifest(path string) (FrameManifest, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return FrameManifest{}, err
+	}
+	var manifest FrameManifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return FrameManifest{}, fmt.Errorf("invalid frame manifest %s: %w", path, err)
+	}
+	return manifest, nil
+}
@@ -0,0 +1,148 @@
+package videoUpscaler
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/janction/videoUpscaler/vm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeCID avoids calling the ipfs cli on tests
+func fakeCID(t *testing.T) {
+	original := calculateCID
+	calculateCID = func(path string) (string, error) {
+		return "Qm" + filepath.Base(path), nil
+	}
+	t.Cleanup(func() { calculateCID = original })
+}
+
+func writeTestFrames(t *testing.T, dir string, frames ...int) {
+	require.NoError(t, os.MkdirAll(dir, 0o755))
+	for _, frame := range frames {
+		require.NoError(t, createTestImage(filepath.Join(dir, vm.FormatFrameFilename(frame))))
+	}
+}
+
+func TestBuildFrameManifest(t *testing.T) {
+	fakeCID(t)
+	dir := filepath.Join(t.TempDir(), "output")
+	writeTestFrames(t, dir, 10, 11, 12)
+	// leftover of an interrupted write
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".frame_000012.png.tmp"), []byte("partial"), 0o644))
+	thread := VideoUpscalerThread{ThreadId: "1", StartFrame: 10, EndFrame: 12}
+
+	manifest, err := thread.BuildFrameManifest(dir, false)
+	require.NoError(t, err)
+	assert.NoError(t, manifest.Validate(thread))
+	assert.NoError(t, manifest.CheckOutput(dir))
+	assert.Equal(t, []string{"frame_000010.png", "frame_000011.png", "frame_000012.png"}, manifest.Filenames())
+	assert.Equal(t, int32(100), manifest.Frames[0].Width)
+	assert.Equal(t, int32(100), manifest.Frames[0].Height)
+	assert.Equal(t, "Qmframe_000011.png", manifest.Solution()["frame_000011.png"].Cid)
+	assert.NoFileExists(t, filepath.Join(dir, ".frame_000012.png.tmp"))
+
+	// manifest is stored and loaded as is
+	path := filepath.Join(t.TempDir(), FrameManifestFilename)
+	require.NoError(t, manifest.Write(path))
+	stored, err := ReadFrameManifest(path)
+	require.NoError(t, err)
+	assert.Equal(t, manifest, stored)
+}
+
+func TestBuildFrameManifest_Fingerprints(t *testing.T) {
+	fakeCID(t)
+	dir := filepath.Join(t.TempDir(), "output")
+	writeTestFrames(t, dir, 0, 1)
+	thread := VideoUpscalerThread{ThreadId: "1", StartFrame: 0, EndFrame: 1}
+
+	// strict threads don't need fingerprints
+	manifest, err := thread.BuildFrameManifest(dir, false)
+	require.NoError(t, err)
+	assert.Empty(t, manifest.Frames[0].Fingerprint)
+
+	thread.Options = &UpscaleOptions{Validation: ValidationMode_VALIDATION_MODE_FINGERPRINT}
+	assert.ErrorContains(t, manifest.Validate(thread), "no fingerprint")
+
+	manifest, err = thread.BuildFrameManifest(dir, false)
+	require.NoError(t, err)
+	assert.NoError(t, manifest.Validate(thread))
+	assert.NoError(t, ValidateFingerprint(manifest.Fingerprints()["frame_000001.png"]))
+	assert.Equal(t, manifest.Frames[1].Fingerprint, manifest.Solution()["frame_000001.png"].Fingerprint)
+}
+
+func TestBuildFrameManifest_Gaps(t *testing.T) {
+	fakeCID(t)
+	dir := filepath.Join(t.TempDir(), "output")
+	writeTestFrames(t, dir, 0, 2)
+	thread := VideoUpscalerThread{ThreadId: "1", StartFrame: 0, EndFrame: 2}
+
+	_, err := thread.BuildFrameManifest(dir, false)
+	assert.ErrorContains(t, err, "1 frames of thread 1 are missing")
+
+	// partial manifests only have the rendered frames, without cids
+	manifest, err := thread.BuildFrameManifest(dir, true)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"frame_000000.png", "frame_000002.png"}, manifest.Filenames())
+	assert.Empty(t, manifest.Frames[0].Cid)
+	assert.Error(t, manifest.Validate(thread))
+}
+
+func TestBuildFrameManifest_ExtraFiles(t *testing.T) {
+	fakeCID(t)
+	thread := VideoUpscalerThread{ThreadId: "1", StartFrame: 0, EndFrame: 1}
+
+	tests := []string{"frame_000002.png", "frame_00000001.png", "notes.txt"}
+	for _, extra := range tests {
+		dir := filepath.Join(t.TempDir(), "output")
+		writeTestFrames(t, dir, 0, 1)
+		require.NoError(t, os.WriteFile(filepath.Join(dir, extra), []byte("extra"), 0o644))
+
+		_, err := thread.BuildFrameManifest(dir, false)
+		assert.ErrorContains(t, err, "unexpected file "+extra, extra)
+		_, err = thread.BuildFrameManifest(dir, true)
+		assert.ErrorContains(t, err, "unexpected file "+extra, extra)
+	}
+}
+
+func TestFrameManifestCheckOutput(t *testing.T) {
+	fakeCID(t)
+	dir := filepath.Join(t.TempDir(), "output")
+	writeTestFrames(t, dir, 0, 1)
+	thread := VideoUpscalerThread{ThreadId: "1", StartFrame: 0, EndFrame: 1}
+	manifest, err := thread.BuildFrameManifest(dir, false)
+	require.NoError(t, err)
+
+	require.NoError(t, os.Remove(filepath.Join(dir, "frame_000001.png")))
+	assert.ErrorContains(t, manifest.CheckOutput(dir), "frame_000001.png is missing")
+
+	writeTestFrames(t, dir, 1, 5)
+	assert.ErrorContains(t, manifest.CheckOutput(dir), "unexpected file frame_000005.png")
+
+	// manifest of another range is rejected
+	other := VideoUpscalerThread{ThreadId: "1", StartFrame: 0, EndFrame: 2}
+	assert.Error(t, manifest.Validate(other))
+}
+
+func TestFrameManifestSetSources(t *testing.T) {
+	fakeCID(t)
+	dir := filepath.Join(t.TempDir(), "output")
+	writeTestFrames(t, dir, 1, 2)
+	thread := VideoUpscalerThread{ThreadId: "1", StartFrame: 1, EndFrame: 2}
+	files := map[string]string{"shot.1000.exr": "Qm0", "shot.1001.exr": "Qm1", "shot.1002.exr": "Qm2", "notes.txt": "Qm3"}
+
+	sequence, err := thread.GetSequence(files)
+	require.NoError(t, err)
+	manifest, err := thread.BuildFrameManifest(dir, false)
+	require.NoError(t, err)
+	require.NoError(t, manifest.SetSources(sequence))
+	assert.Equal(t, "shot.1001.exr", manifest.Frames[0].Source)
+	assert.Equal(t, "shot.1002.exr", manifest.Frames[1].Source)
+
+	// threads past the end of the sequence can't be upscaled
+	thread.EndFrame = 3
+	_, err = thread.GetSequence(files)
+	assert.ErrorIs(t, err, errInvalidSequence)
+}
@@ -0,0 +1,74 @@
+package videoUpscaler
+
+import (
+	"errors"
+	fmt "fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/janction/videoUpscaler/db"
+	"github.com/janction/videoUpscaler/ipfs"
+	"github.com/janction/videoUpscaler/videoUpscalerLogger"
+	"github.com/janction/videoUpscaler/vm"
+)
+
+// errInvalidSequence is returned when the directory of an image sequence task can't be upscaled
+var errInvalidSequence = errors.New("invalid image sequence")
+
+// Validate makes sure the input type is known
+func (i InputType) Validate() error {
+	if _, found := InputType_name[int32(i)]; !found {
+		return fmt.Errorf("unknown input type %v", i)
+	}
+	return nil
+}
+
+// GetSequence returns the image sequence of the files of the cid directory, listed as filename to cid.
+// Frames of the thread past the end of the sequence are an error
+func (t VideoUpscalerThread) GetSequence(files map[string]string) (vm.ImageSequence, error) {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sequence, err := vm.ParseImageSequence(names)
+	if err != nil {
+		return vm.ImageSequence{}, fmt.Errorf("%w: %s", errInvalidSequence, err.Error())
+	}
+	if _, err := sequence.File(t.EndFrame); err != nil {
+		return vm.ImageSequence{}, fmt.Errorf("%w: %s", errInvalidSequence, err.Error())
+	}
+	return sequence, nil
+}
+
+// downloadSequence gets only the images with the frames of the thread from the cid directory, into path/cid named
+// with the FormatInputFilename of the sequence. Images already downloaded with the same content are not downloaded again.
+// Returns an error wrapping errInvalidSequence if the directory is not a sequence with the frames of the thread
+func (t VideoUpscalerThread) downloadSequence(cid string, path string, db *db.DB) (vm.ImageSequence, error) {
+	files, err := ipfs.ListDirectory(cid)
+	if err != nil {
+		return vm.ImageSequence{}, err
+	}
+	sequence, err := t.GetSequence(files)
+	if err != nil {
+		return vm.ImageSequence{}, err
+	}
+
+	downloaded := 0
+	for frame := t.StartFrame; frame <= t.EndFrame; frame++ {
+		name := sequence.Files[frame]
+		local := filepath.Join(path, cid, sequence.FormatInputFilename(frame))
+		if _, err := os.Stat(local); err == nil {
+			if localCid, err := calculateCID(local); err == nil && ipfs.IsSameContent(files[name], localCid) {
+				continue
+			}
+		}
+		if err := ipfs.IPFSGetFile(cid+"/"+name, local); err != nil {
+			db.AddLogEntry(t.ThreadId, fmt.Sprintf("Error getting image %s of frame %v. %s", name, frame, err.Error()), time.Now().Unix(), 2)
+			return vm.ImageSequence{}, err
+		}
+		downloaded++
+	}
+	videoUpscalerLogger.Logger.Info("Downloaded %v images of sequence %s for thread %s", downloaded, cid, t.ThreadId)
+	return sequence, nil
+}
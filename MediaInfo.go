@@ -0,0 +1,72 @@
+package videoUpscaler
+
+import (
+	"context"
+	fmt "fmt"
+
+	"github.com/janction/videoUpscaler/vm"
+)
+
+// ProbeMediaInfo probes the video file with ffprobe
+func ProbeMediaInfo(ctx context.Context, path string) (MediaInfo, error) {
+	media, err := vm.ProbeMedia(ctx, path)
+	if err != nil {
+		return MediaInfo{}, err
+	}
+	return NewMediaInfo(media), nil
+}
+
+// NewMediaInfo returns the media info of a probed file
+func NewMediaInfo(media vm.Media) MediaInfo {
+	info := MediaInfo{FrameCount: media.FrameCount, FrameRate: media.FrameRate, Width: media.Width, Height: media.Height, Codec: media.Codec, DurationMs: media.DurationMs}
+	for _, stream := range media.AudioStreams {
+		info.AudioStreams = append(info.AudioStreams, &MediaInfo_AudioStream{Index: stream.Index, Codec: stream.Codec, Channels: stream.Channels, SampleRate: stream.SampleRate, Language: stream.Language})
+	}
+	return info
+}
+
+// Validate makes sure the media info describes a video
+func (m MediaInfo) Validate() error {
+	if m.FrameCount < 1 {
+		return fmt.Errorf("frame count %v must be positive", m.FrameCount)
+	}
+	if m.Width < 1 || m.Height < 1 {
+		return fmt.Errorf("resolution %vx%v is invalid", m.Width, m.Height)
+	}
+	if _, err := vm.ParseFrameRate(m.FrameRate); err != nil {
+		return err
+	}
+	if m.Codec == "" {
+		return fmt.Errorf("codec is empty")
+	}
+	if m.DurationMs < 0 {
+		return fmt.Errorf("duration %v is invalid", m.DurationMs)
+	}
+	return nil
+}
+
+// ValidateFrameRange makes sure the frames of a task are within the video. Frames are counted from 0
+func (m MediaInfo) ValidateFrameRange(startFrame, endFrame int32) error {
+	if startFrame < 0 || int64(endFrame) >= m.FrameCount {
+		return fmt.Errorf("frames %v to %v are out of the %v frames of the video", startFrame, endFrame, m.FrameCount)
+	}
+	return nil
+}
+
+// CheckProbed compares the media info of the task with the input probed by the worker. The duration is not
+// compared, since it is rounded differently by each version of ffprobe
+func (m MediaInfo) CheckProbed(probed MediaInfo) error {
+	switch {
+	case m.FrameCount != probed.FrameCount:
+		return fmt.Errorf("input has %v frames, task expects %v", probed.FrameCount, m.FrameCount)
+	case m.Width != probed.Width || m.Height != probed.Height:
+		return fmt.Errorf("input resolution is %vx%v, task expects %vx%v", probed.Width, probed.Height, m.Width, m.Height)
+	case m.FrameRate != probed.FrameRate:
+		return fmt.Errorf("input frame rate is %s, task expects %s", probed.FrameRate, m.FrameRate)
+	case m.Codec != probed.Codec:
+		return fmt.Errorf("input codec is %s, task expects %s", probed.Codec, m.Codec)
+	case len(m.AudioStreams) != len(probed.AudioStreams):
+		return fmt.Errorf("input has %v audio streams, task expects %v", len(probed.AudioStreams), len(m.AudioStreams))
+	}
+	return nil
+}
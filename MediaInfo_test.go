@@ -0,0 +1,60 @@
+package videoUpscaler
+
+import (
+	"testing"
+
+	"github.com/janction/videoUpscaler/vm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestMediaInfo() MediaInfo {
+	return NewMediaInfo(vm.Media{FrameCount: 1440, FrameRate: "30000/1001", Width: 1920, Height: 1080, Codec: "h264", DurationMs: 48048, AudioStreams: []vm.AudioStream{{Index: 1, Codec: "aac", Channels: 2, SampleRate: 48000, Language: "eng"}}})
+}
+
+func TestMediaInfoValidate(t *testing.T) {
+	info := newTestMediaInfo()
+	require.NoError(t, info.Validate())
+	require.Len(t, info.AudioStreams, 1)
+
+	invalid := []func(*MediaInfo){
+		func(m *MediaInfo) { m.FrameCount = 0 },
+		func(m *MediaInfo) { m.Width = 0 },
+		func(m *MediaInfo) { m.FrameRate = "30/0" },
+		func(m *MediaInfo) { m.Codec = "" },
+		func(m *MediaInfo) { m.DurationMs = -1 },
+	}
+	for _, change := range invalid {
+		m := newTestMediaInfo()
+		change(&m)
+		assert.Error(t, m.Validate())
+	}
+}
+
+func TestMediaInfoValidateFrameRange(t *testing.T) {
+	info := newTestMediaInfo()
+	assert.NoError(t, info.ValidateFrameRange(0, 1439))
+	assert.Error(t, info.ValidateFrameRange(0, 1440))
+	assert.Error(t, info.ValidateFrameRange(-1, 100))
+}
+
+func TestMediaInfoCheckProbed(t *testing.T) {
+	info := newTestMediaInfo()
+
+	// the duration is not compared
+	probed := newTestMediaInfo()
+	probed.DurationMs = 48047
+	assert.NoError(t, info.CheckProbed(probed))
+
+	probed = newTestMediaInfo()
+	probed.FrameCount = 1439
+	assert.Error(t, info.CheckProbed(probed))
+
+	probed = newTestMediaInfo()
+	probed.Height = 720
+	assert.Error(t, info.CheckProbed(probed))
+
+	probed = newTestMediaInfo()
+	probed.AudioStreams = nil
+	assert.Error(t, info.CheckProbed(probed))
+}
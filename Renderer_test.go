@@ -0,0 +1,78 @@
+package videoUpscaler
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/janction/videoUpscaler/db"
+	"github.com/janction/videoUpscaler/vm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFakeRendererIsDeterministic(t *testing.T) {
+	database, err := db.Init(t.TempDir())
+	require.NoError(t, err)
+
+	renderer := vm.NewFakeRenderer()
+	frames := []int64{0, 1, 2, 3, 4}
+
+	var hashes [2][]string
+	for i := range hashes {
+		path := t.TempDir()
+		var rendered []int64
+		err := vm.Render(context.Background(), renderer, vm.RenderJob{ThreadId: "1", Cid: "cid", Path: path}, frames, 2, vm.DefaultRetryPolicy(), database, func(frameNumber int64) {
+			rendered = append(rendered, frameNumber)
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, frames, rendered)
+		assert.False(t, renderer.IsRunning(context.Background(), "1"))
+
+		for _, frame := range frames {
+			hash, err := CalculateFileHash(filepath.Join(path, "output", vm.FormatFrameFilename(int(frame))))
+			require.NoError(t, err)
+			hashes[i] = append(hashes[i], hash)
+		}
+	}
+	assert.Equal(t, hashes[0], hashes[1])
+	assert.NotEqual(t, hashes[0][0], hashes[0][1])
+}
+
+func TestPendingFramesWithFakeRenderer(t *testing.T) {
+	database, err := db.Init(t.TempDir())
+	require.NoError(t, err)
+
+	thread := VideoUpscalerThread{ThreadId: "1", StartFrame: 10, EndFrame: 14}
+	path := t.TempDir()
+	outputPath := filepath.Join(path, "output")
+
+	frames := thread.getPendingFrames(outputPath, database)
+	assert.Equal(t, []int64{10, 11, 12, 13, 14}, frames)
+
+	err = vm.Render(context.Background(), vm.NewFakeRenderer(), vm.RenderJob{ThreadId: thread.ThreadId, Cid: "cid", Path: path}, frames, 3, vm.DefaultRetryPolicy(), database, func(frameNumber int64) {
+		thread.checkpointFrame(outputPath, frameNumber, database)
+	})
+	require.NoError(t, err)
+	assert.Empty(t, thread.getPendingFrames(outputPath, database))
+
+	// a missing output is rendered again
+	require.NoError(t, os.Remove(filepath.Join(outputPath, vm.FormatFrameFilename(12))))
+	assert.Equal(t, []int64{12}, thread.getPendingFrames(outputPath, database))
+}
+
+func TestWorkInProgress(t *testing.T) {
+	assert.False(t, IsWorkInProgress("1"))
+	require.True(t, workInProgress.start("1"))
+	assert.True(t, IsWorkInProgress("1"))
+
+	// a second StartWork of the same thread doesn't run while the first one is going
+	assert.False(t, workInProgress.start("1"))
+
+	// once the work stops, it can be restarted whatever the renderer
+	workInProgress.done("1")
+	assert.False(t, IsWorkInProgress("1"))
+	assert.True(t, workInProgress.start("1"))
+	workInProgress.done("1")
+}
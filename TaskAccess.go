@@ -0,0 +1,31 @@
+package videoUpscaler
+
+import (
+	"fmt"
+	"slices"
+)
+
+// IsRestricted returns true if only some workers are allowed to process the task
+func (a TaskAccess) IsRestricted() bool {
+	return len(a.AllowedWorkers) > 0 || a.WorkerGroup != ""
+}
+
+// Allows returns nil if the worker can process the task. group is the worker group
+// referenced by the access, or nil if there is none
+func (a TaskAccess) Allows(worker string, group *WorkerGroup) error {
+	if slices.Contains(a.DeniedWorkers, worker) {
+		return fmt.Errorf("worker %s is denied", worker)
+	}
+
+	if !a.IsRestricted() {
+		return nil
+	}
+
+	if slices.Contains(a.AllowedWorkers, worker) {
+		return nil
+	}
+	if group != nil && slices.Contains(group.Members, worker) {
+		return nil
+	}
+	return fmt.Errorf("worker %s is not allowed", worker)
+}
@@ -0,0 +1,25 @@
+package videoUpscaler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTaskAccessAllows(t *testing.T) {
+	// open tasks accept anyone but denied workers
+	access := TaskAccess{DeniedWorkers: []string{"bad"}}
+	assert.NoError(t, access.Allows("worker", nil))
+	assert.Error(t, access.Allows("bad", nil))
+
+	access.AllowedWorkers = []string{"vetted"}
+	assert.NoError(t, access.Allows("vetted", nil))
+	assert.Error(t, access.Allows("worker", nil))
+
+	group := WorkerGroup{Name: "studio", Members: []string{"member", "bad"}}
+	access.WorkerGroup = group.Name
+	assert.NoError(t, access.Allows("member", &group))
+	assert.NoError(t, access.Allows("vetted", &group))
+	assert.Error(t, access.Allows("bad", &group))
+	assert.Error(t, access.Allows("worker", &group))
+}
@@ -0,0 +1,103 @@
+package videoUpscaler
+
+import (
+	"encoding/json"
+	"slices"
+	"sort"
+)
+
+// json representation of a manifest entry. We don't use the proto json tags
+// because frame 0 would be omitted
+type manifestEntryJSON struct {
+	Frame    int64  `json:"frame"`
+	Filename string `json:"filename"`
+	Cid      string `json:"cid"`
+	Hash     string `json:"hash"`
+	ThreadId string `json:"thread_id"`
+	Dir      string `json:"dir"`
+}
+
+type manifestJSON struct {
+	TaskId          string              `json:"task_id"`
+	Cid             string              `json:"cid"`
+	Completed       bool                `json:"completed"`
+	Frames          []manifestEntryJSON `json:"frames"`
+	MissingFrames   []int64             `json:"missing_frames"`
+	DuplicateFrames []int64             `json:"duplicate_frames"`
+	InvalidEntries  []invalidEntryJSON  `json:"invalid_entries"`
+}
+
+type invalidEntryJSON struct {
+	ThreadId string `json:"thread_id"`
+	Filename string `json:"filename"`
+}
+
+// GetOutputManifest collects the frames of every thread that is completed or has an accepted solution, ordered
+// by frame number. Proposed solutions are left out, their frames are not revealed yet. Files without a frame number
+// of the task and frames found in more than one thread are reported instead of failing, so a single bad solution doesn't break
+// the manifest of the task, as well as the frames of the task that have no entry yet
+func (t *VideoUpscalerTask) GetOutputManifest() TaskOutputManifest {
+	manifest := TaskOutputManifest{TaskId: t.TaskId, Cid: t.Cid, Completed: t.Completed}
+
+	found := make(map[int64]bool)
+	for _, thread := range t.Threads {
+		if thread.Solution == nil || !(thread.Completed || thread.Solution.Accepted) {
+			continue
+		}
+
+		for _, frame := range thread.Solution.Frames {
+			number, err := GetFrameNumber(frame.Filename)
+			if err != nil || number < int64(t.StartFrame) || number > int64(t.EndFrame) {
+				manifest.InvalidEntries = append(manifest.InvalidEntries, &TaskOutputManifest_InvalidEntry{ThreadId: thread.ThreadId, Filename: frame.Filename})
+				continue
+			}
+			if found[number] {
+				if !slices.Contains(manifest.DuplicateFrames, number) {
+					manifest.DuplicateFrames = append(manifest.DuplicateFrames, number)
+				}
+				continue
+			}
+			found[number] = true
+			entry := TaskOutputManifest_Entry{Frame: number, Filename: frame.Filename, Cid: frame.Cid, Hash: frame.Hash, ThreadId: thread.ThreadId, Dir: thread.Solution.Dir}
+			manifest.Entries = append(manifest.Entries, &entry)
+		}
+	}
+
+	sort.SliceStable(manifest.Entries, func(i, j int) bool {
+		return manifest.Entries[i].Frame < manifest.Entries[j].Frame
+	})
+	slices.Sort(manifest.DuplicateFrames)
+
+	for frame := int64(t.StartFrame); frame <= int64(t.EndFrame); frame++ {
+		if !found[frame] {
+			manifest.MissingFrames = append(manifest.MissingFrames, frame)
+		}
+	}
+
+	return manifest
+}
+
+// IsComplete returns true if the manifest has a single valid entry for every frame of the task
+func (m TaskOutputManifest) IsComplete() bool {
+	return len(m.MissingFrames) == 0 && len(m.DuplicateFrames) == 0 && len(m.InvalidEntries) == 0
+}
+
+// ToJSON encodes the manifest so it can be consumed by download tools
+func (m TaskOutputManifest) ToJSON() (string, error) {
+	result := manifestJSON{TaskId: m.TaskId, Cid: m.Cid, Completed: m.Completed, Frames: []manifestEntryJSON{},
+		MissingFrames: []int64{}, DuplicateFrames: []int64{}, InvalidEntries: []invalidEntryJSON{}}
+	for _, entry := range m.Entries {
+		result.Frames = append(result.Frames, manifestEntryJSON{Frame: entry.Frame, Filename: entry.Filename, Cid: entry.Cid, Hash: entry.Hash, ThreadId: entry.ThreadId, Dir: entry.Dir})
+	}
+	result.MissingFrames = append(result.MissingFrames, m.MissingFrames...)
+	result.DuplicateFrames = append(result.DuplicateFrames, m.DuplicateFrames...)
+	for _, entry := range m.InvalidEntries {
+		result.InvalidEntries = append(result.InvalidEntries, invalidEntryJSON{ThreadId: entry.ThreadId, Filename: entry.Filename})
+	}
+
+	data, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
@@ -0,0 +1,95 @@
+package videoUpscaler
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetFrameNumber(t *testing.T) {
+	number, err := GetFrameNumber("frame_000042.png")
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), number)
+
+	_, err = GetFrameNumber("output.png")
+	assert.Error(t, err)
+}
+
+func TestGetOutputManifest(t *testing.T) {
+	task := VideoUpscalerTask{
+		TaskId:    "1",
+		Cid:       "inputCid",
+		Completed: true,
+		EndFrame:  2,
+		Threads: []*VideoUpscalerThread{
+			{ThreadId: "10", Completed: true, Solution: &VideoUpscalerThread_Solution{Dir: "dir0", Frames: []*VideoUpscalerThread_Frame{
+				{Filename: "frame_000001.png", Cid: "cid1", Hash: "hash1"},
+				{Filename: "frame_000000.png", Cid: "cid0", Hash: "hash0"},
+			}}},
+			{ThreadId: "11"},
+			{ThreadId: "12", Completed: true, Solution: &VideoUpscalerThread_Solution{Dir: "dir2", Frames: []*VideoUpscalerThread_Frame{
+				{Filename: "frame_000002.png", Cid: "cid2", Hash: "hash2"},
+			}}},
+		},
+	}
+
+	manifest := task.GetOutputManifest()
+	assert.True(t, manifest.IsComplete())
+	require.Len(t, manifest.Entries, 3)
+	for i, entry := range manifest.Entries {
+		assert.Equal(t, int64(i), entry.Frame)
+	}
+	assert.Equal(t, "10", manifest.Entries[0].ThreadId)
+	assert.Equal(t, "dir2", manifest.Entries[2].Dir)
+
+	data, err := manifest.ToJSON()
+	require.NoError(t, err)
+
+	var decoded map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(data), &decoded))
+	frames := decoded["frames"].([]interface{})
+	assert.Len(t, frames, 3)
+	assert.Equal(t, float64(0), frames[0].(map[string]interface{})["frame"])
+}
+
+func TestGetOutputManifest_Incomplete(t *testing.T) {
+	task := VideoUpscalerTask{
+		TaskId:   "1",
+		EndFrame: 4,
+		Threads: []*VideoUpscalerThread{
+			{ThreadId: "1-0", Solution: &VideoUpscalerThread_Solution{Accepted: true, Frames: []*VideoUpscalerThread_Frame{
+				{Filename: "frame_000000.png", Cid: "cid0", Hash: "hash0"},
+				{Filename: "frame_000001.png", Cid: "cid1", Hash: "hash1"},
+			}}},
+			// only proposed, frames are not revealed yet
+			{ThreadId: "1-1", Solution: &VideoUpscalerThread_Solution{Frames: []*VideoUpscalerThread_Frame{
+				{Filename: "frame_000002.png"},
+			}}},
+			// a bad solution doesn't break the manifest
+			{ThreadId: "1-2", Completed: true, Solution: &VideoUpscalerThread_Solution{Dir: "dir", Frames: []*VideoUpscalerThread_Frame{
+				{Filename: "output.png", Cid: "cidX", Hash: "hashX"},
+				{Filename: "frame_000099.png", Cid: "cid99", Hash: "hash99"},
+				{Filename: "frame_000001.png", Cid: "cid1b", Hash: "hash1b"},
+				{Filename: "frame_000003.png", Cid: "cid3", Hash: "hash3"},
+			}}},
+		},
+	}
+
+	manifest := task.GetOutputManifest()
+	assert.False(t, manifest.IsComplete())
+	require.Len(t, manifest.Entries, 3)
+	assert.Equal(t, []int64{0, 1, 3}, []int64{manifest.Entries[0].Frame, manifest.Entries[1].Frame, manifest.Entries[2].Frame})
+	// the first thread with the frame keeps its entry
+	assert.Equal(t, "cid1", manifest.Entries[1].Cid)
+	assert.Equal(t, []int64{2, 4}, manifest.MissingFrames)
+	assert.Equal(t, []int64{1}, manifest.DuplicateFrames)
+	require.Len(t, manifest.InvalidEntries, 2)
+	assert.Equal(t, "1-2", manifest.InvalidEntries[0].ThreadId)
+	assert.Equal(t, "output.png", manifest.InvalidEntries[0].Filename)
+
+	data, err := manifest.ToJSON()
+	require.NoError(t, err)
+	assert.Contains(t, data, `"missing_frames": [`)
+}
@@ -0,0 +1,99 @@
+package videoUpscaler
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/janction/videoUpscaler/db"
+	"github.com/janction/videoUpscaler/videoUpscalerLogger"
+	"github.com/janction/videoUpscaler/vm"
+)
+
+// MaxFailureMessageLength is the longest message a failure report can have
+const MaxFailureMessageLength = 256
+
+var renderFailureReasons = map[vm.FailureReason]ThreadFailureReason{
+	vm.FailureInputDecode:    ThreadFailureReason_THREAD_FAILURE_REASON_INPUT_DECODE,
+	vm.FailureContainerStart: ThreadFailureReason_THREAD_FAILURE_REASON_CONTAINER_START,
+	vm.FailureTimeout:        ThreadFailureReason_THREAD_FAILURE_REASON_TIMEOUT,
+	vm.FailureMissingOutput:  ThreadFailureReason_THREAD_FAILURE_REASON_MISSING_OUTPUT,
+	vm.FailureResourceLimit:  ThreadFailureReason_THREAD_FAILURE_REASON_RESOURCE_LIMIT,
+	vm.FailureMediaMismatch:  ThreadFailureReason_THREAD_FAILURE_REASON_MEDIA_MISMATCH,
+}
+
+// NewThreadFailureReason returns the reason reported on chain for a render failure
+func NewThreadFailureReason(reason vm.FailureReason) ThreadFailureReason {
+	return renderFailureReasons[reason]
+}
+
+// Validate makes sure the reason is one of the known failures
+func (r ThreadFailureReason) Validate() error {
+	if _, found := ThreadFailureReason_name[int32(r)]; !found || r == ThreadFailureReason_THREAD_FAILURE_REASON_UNSPECIFIED {
+		return fmt.Errorf("failure reason %v is invalid", r)
+	}
+	return nil
+}
+
+// HasFailed returns true if the worker already gave up the thread
+func (t VideoUpscalerThread) HasFailed(worker string) bool {
+	return slices.ContainsFunc(t.Failures, func(f *VideoUpscalerThread_Failure) bool { return f.Worker == worker })
+}
+
+// ReportFailure gives up the thread after the frames couldn't be rendered, so another worker can take it
+func (t VideoUpscalerThread) ReportFailure(workerAddress string, reason vm.FailureReason, cause error, db *db.DB) error {
+	// the message is a single cli argument
+	message := strings.ReplaceAll(cause.Error(), "\n", " ")
+	if len(message) > MaxFailureMessageLength {
+		message = message[:MaxFailureMessageLength]
+	}
+
+	args := []string{"tx", "videoUpscaler", "report-thread-failure", t.TaskId, t.ThreadId, string(reason), message, "--yes", "--from", workerAddress}
+	if err := ExecuteCli(args); err != nil {
+		videoUpscalerLogger.Logger.Error("unable to report failure of thread %s: %s", t.ThreadId, err.Error())
+		return err
+	}
+
+	db.AddLogEntry(t.ThreadId, fmt.Sprintf("Thread %s given up: %s", t.ThreadId, reason), time.Now().Unix(), 2)
+	return nil
+}
+
+// CountMediaMismatchReports returns the amount of workers that reported the input doesn't match the media info of the task
+func (t VideoUpscalerTask) CountMediaMismatchReports() int {
+	var workers []string
+	for _, thread := range t.Threads {
+		for _, failure := range thread.Failures {
+			if failure.Reason == ThreadFailureReason_THREAD_FAILURE_REASON_MEDIA_MISMATCH && !slices.Contains(workers, failure.Worker) {
+				workers = append(workers, failure.Worker)
+			}
+		}
+	}
+	return len(workers)
+}
+
+// IsMediaMismatch returns true if enough workers reported the input doesn't match the media info of the task to refund it:
+// at least minReports, and more than half of the workers that took threads of the task, so a few workers can't cancel
+// a task the others are rendering
+func (t VideoUpscalerTask) IsMediaMismatch(minReports int64) bool {
+	reports := t.CountMediaMismatchReports()
+	return int64(reports) >= minReports && reports*2 > t.countWorkers()
+}
+
+// countWorkers returns the amount of workers that took threads of the task, including the ones that gave them up
+func (t VideoUpscalerTask) countWorkers() int {
+	var workers []string
+	for _, thread := range t.Threads {
+		for _, worker := range thread.Workers {
+			if !slices.Contains(workers, worker) {
+				workers = append(workers, worker)
+			}
+		}
+		for _, failure := range thread.Failures {
+			if !slices.Contains(workers, failure.Worker) {
+				workers = append(workers, failure.Worker)
+			}
+		}
+	}
+	return len(workers)
+}
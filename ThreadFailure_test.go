@@ -0,0 +1,73 @@
+package videoUpscaler
+
+import (
+	"testing"
+
+	"github.com/janction/videoUpscaler/vm"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestThreadFailureReason(t *testing.T) {
+	// every render failure has a reason on chain
+	for _, reason := range []vm.FailureReason{vm.FailureInputDecode, vm.FailureContainerStart, vm.FailureTimeout, vm.FailureMissingOutput, vm.FailureResourceLimit, vm.FailureMediaMismatch} {
+		assert.NoError(t, NewThreadFailureReason(reason).Validate())
+	}
+	assert.Error(t, ThreadFailureReason_THREAD_FAILURE_REASON_UNSPECIFIED.Validate())
+	assert.Error(t, ThreadFailureReason(99).Validate())
+}
+
+func TestThreadHasFailed(t *testing.T) {
+	thread := VideoUpscalerThread{Failures: []*VideoUpscalerThread_Failure{{Worker: "worker1", Reason: ThreadFailureReason_THREAD_FAILURE_REASON_TIMEOUT}}}
+	assert.True(t, thread.HasFailed("worker1"))
+	assert.False(t, thread.HasFailed("worker2"))
+}
+
+func TestCountMediaMismatchReports(t *testing.T) {
+	mismatch := ThreadFailureReason_THREAD_FAILURE_REASON_MEDIA_MISMATCH
+	task := VideoUpscalerTask{Threads: []*VideoUpscalerThread{
+		{Failures: []*VideoUpscalerThread_Failure{{Worker: "worker1", Reason: mismatch}, {Worker: "worker2", Reason: ThreadFailureReason_THREAD_FAILURE_REASON_TIMEOUT}}},
+		{Failures: []*VideoUpscalerThread_Failure{{Worker: "worker1", Reason: mismatch}}},
+	}}
+
+	// the same worker reporting on several threads counts once
+	assert.Equal(t, 1, task.CountMediaMismatchReports())
+
+	task.Threads[1].Failures = append(task.Threads[1].Failures, &VideoUpscalerThread_Failure{Worker: "worker3", Reason: mismatch})
+	assert.Equal(t, 2, task.CountMediaMismatchReports())
+}
+
+func TestIsMediaMismatch(t *testing.T) {
+	mismatch := ThreadFailureReason_THREAD_FAILURE_REASON_MEDIA_MISMATCH
+	task := VideoUpscalerTask{Threads: []*VideoUpscalerThread{
+		{Workers: []string{"worker3"}, Failures: []*VideoUpscalerThread_Failure{{Worker: "worker1", Reason: mismatch}}},
+		{Workers: []string{"worker4", "worker5"}, Failures: []*VideoUpscalerThread_Failure{{Worker: "worker2", Reason: mismatch}}},
+	}}
+	minReports := DefaultParams().MinMediaMismatchReports
+
+	// two workers can't cancel a task other workers are rendering
+	assert.False(t, task.IsMediaMismatch(2))
+
+	// more reports than the params require, but still not most of the workers of the task
+	task.Threads[0].Failures = append(task.Threads[0].Failures, &VideoUpscalerThread_Failure{Worker: "worker6", Reason: mismatch})
+	assert.False(t, task.IsMediaMismatch(minReports))
+
+	// most of the workers found the input is not the described one
+	task.Threads[1].Workers = []string{"worker5"}
+	task.Threads[1].Failures = append(task.Threads[1].Failures, &VideoUpscalerThread_Failure{Worker: "worker4", Reason: mismatch})
+	assert.True(t, task.IsMediaMismatch(minReports))
+	assert.False(t, task.IsMediaMismatch(minReports+2))
+}
+
+func TestMediaMismatchReportsRequired(t *testing.T) {
+	// params stored before min media mismatch reports existed use the default
+	params := DefaultParams()
+	params.MinMediaMismatchReports = 0
+	assert.NoError(t, params.Validate())
+	assert.Equal(t, int64(DefaultMinMediaMismatchReports), params.GetMediaMismatchReportsRequired())
+
+	params.MinMediaMismatchReports = 5
+	assert.Equal(t, int64(5), params.GetMediaMismatchReportsRequired())
+
+	params.MinMediaMismatchReports = -1
+	assert.Error(t, params.Validate())
+}
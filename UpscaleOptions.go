@@ -0,0 +1,122 @@
+package videoUpscaler
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/janction/videoUpscaler/vm"
+)
+
+const (
+	// DefaultScale is the upscale ratio of tasks that don't set one
+	DefaultScale = 2
+	// MaxScale is the highest upscale ratio a task can request
+	MaxScale = 8
+)
+
+var modelRegexp = regexp.MustCompile(`^[a-z0-9][a-z0-9_.-]{0,63}$`)
+
+// outputFormats maps the output formats of a task to the formats of the renderers
+var outputFormats = map[OutputFormat]vm.OutputFormat{
+	OutputFormat_OUTPUT_FORMAT_PNG:           vm.FormatPNG,
+	OutputFormat_OUTPUT_FORMAT_WEBP_LOSSLESS: vm.FormatWebP,
+	OutputFormat_OUTPUT_FORMAT_TIFF_16:       vm.FormatTIFF16,
+}
+
+// NewUpscaleOptions merges the scale field and the required backend of a task with its options. Zero scales
+// default to DefaultScale and empty backends to DefaultBackend. A value set in both places must match
+func NewUpscaleOptions(scale int32, backend string, options *UpscaleOptions) (UpscaleOptions, error) {
+	result := UpscaleOptions{}
+	if options != nil {
+		result = *options
+	}
+
+	if scale != 0 && result.Scale != 0 && scale != result.Scale {
+		return result, fmt.Errorf("scale %v doesn't match options scale %v", scale, result.Scale)
+	}
+	if result.Scale == 0 {
+		result.Scale = scale
+	}
+	if result.Scale == 0 {
+		result.Scale = DefaultScale
+	}
+
+	if backend != "" && result.Backend != "" && backend != result.Backend {
+		return result, fmt.Errorf("backend %s doesn't match options backend %s", backend, result.Backend)
+	}
+	if result.Backend == "" {
+		result.Backend = backend
+	}
+	if result.Backend == "" {
+		result.Backend = DefaultBackend
+	}
+	return result, result.Validate()
+}
+
+// Validate makes sure the options are supported by the upscaler
+func (o UpscaleOptions) Validate() error {
+	if o.Scale < 1 || o.Scale > MaxScale {
+		return fmt.Errorf("scale %v must be between 1 and %v", o.Scale, MaxScale)
+	}
+	if o.NoiseLevel < 0 || o.NoiseLevel > 3 {
+		return fmt.Errorf("noise level %v must be between 0 and 3", o.NoiseLevel)
+	}
+	if !o.Denoise && o.NoiseLevel != 0 {
+		return fmt.Errorf("noise level requires denoise")
+	}
+	if o.Model != "" && !modelRegexp.MatchString(o.Model) {
+		return fmt.Errorf("model %s is invalid", o.Model)
+	}
+	if _, found := outputFormats[o.OutputFormat]; !found {
+		return fmt.Errorf("output format %v doesn't exist", o.OutputFormat)
+	}
+	if _, found := ValidationMode_name[int32(o.Validation)]; !found {
+		return fmt.Errorf("validation mode %v doesn't exist", o.Validation)
+	}
+	if o.MaxFingerprintDistance < 0 || o.MaxFingerprintDistance > FingerprintTiles {
+		return fmt.Errorf("max fingerprint distance %v must be between 0 and %v", o.MaxFingerprintDistance, FingerprintTiles)
+	}
+	if o.Validation != ValidationMode_VALIDATION_MODE_FINGERPRINT && o.MaxFingerprintDistance != 0 {
+		return fmt.Errorf("max fingerprint distance requires fingerprint validation")
+	}
+
+	// the backend must exist and support the rest of the options
+	renderOptions := o.renderOptions()
+	backend, err := renderOptions.GetBackend()
+	if err != nil {
+		return err
+	}
+	return backend.Validate(renderOptions)
+}
+
+func (o UpscaleOptions) renderOptions() vm.UpscaleOptions {
+	return vm.UpscaleOptions{Scale: o.Scale, Denoise: o.Denoise, NoiseLevel: o.NoiseLevel, Model: o.Model, Deterministic: o.Deterministic, Backend: o.Backend, Format: o.OutputFormat.renderFormat()}
+}
+
+// GetRenderOptions returns the options the renderer uses to upscale the thread. Threads created before
+// upscale options existed only have a scale
+func (t VideoUpscalerThread) GetRenderOptions() vm.UpscaleOptions {
+	options := UpscaleOptions{Scale: int32(t.Scale)}
+	if t.Options != nil {
+		options = *t.Options
+	}
+	return options.renderOptions()
+}
+
+// GetFrameFormat returns the format of the upscaled frames of the thread
+func (t VideoUpscalerThread) GetFrameFormat() vm.OutputFormat {
+	return t.GetRenderOptions().GetFormat()
+}
+
+// GetFrameFormat returns the format of the upscaled frames of the task
+func (t VideoUpscalerTask) GetFrameFormat() vm.OutputFormat {
+	return t.Options.GetOutputFormat().renderFormat()
+}
+
+// renderFormat returns the format the renderers write. Unknown formats are the default format
+func (f OutputFormat) renderFormat() vm.OutputFormat {
+	if format, found := outputFormats[f]; found {
+		return format
+	}
+	return vm.DefaultFormat
+}
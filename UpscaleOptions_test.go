@@ -0,0 +1,92 @@
+package videoUpscaler
+
+import (
+	"testing"
+
+	"github.com/janction/videoUpscaler/vm"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewUpscaleOptions(t *testing.T) {
+	// scale defaults to 2
+	options, err := NewUpscaleOptions(0, "", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(DefaultScale), options.Scale)
+	assert.Equal(t, DefaultBackend, options.Backend)
+
+	// the scale field is used when options don't set it
+	options, err = NewUpscaleOptions(4, "", &UpscaleOptions{Denoise: true, NoiseLevel: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, UpscaleOptions{Scale: 4, Denoise: true, NoiseLevel: 1, Backend: DefaultBackend}, options)
+
+	_, err = NewUpscaleOptions(4, "", &UpscaleOptions{Scale: 2})
+	assert.Error(t, err)
+	_, err = NewUpscaleOptions(16, "", nil)
+	assert.Error(t, err)
+	_, err = NewUpscaleOptions(2, "", &UpscaleOptions{NoiseLevel: 2})
+	assert.Error(t, err)
+	_, err = NewUpscaleOptions(2, "", &UpscaleOptions{Model: "../models"})
+	assert.Error(t, err)
+}
+
+func TestNewUpscaleOptionsBackend(t *testing.T) {
+	// the backend required by the task is used when options don't set it
+	options, err := NewUpscaleOptions(4, vm.BackendRealESRGAN, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, vm.BackendRealESRGAN, options.Backend)
+
+	_, err = NewUpscaleOptions(2, vm.BackendRealESRGAN, &UpscaleOptions{Backend: vm.BackendFFmpegBicubic})
+	assert.Error(t, err)
+	_, err = NewUpscaleOptions(2, "", &UpscaleOptions{Backend: "waifu3x"})
+	assert.Error(t, err)
+
+	// options must be supported by the backend
+	_, err = NewUpscaleOptions(8, vm.BackendRealESRGAN, nil)
+	assert.Error(t, err)
+	_, err = NewUpscaleOptions(2, "", &UpscaleOptions{Backend: vm.BackendFFmpegLanczos, Denoise: true, NoiseLevel: 1})
+	assert.Error(t, err)
+	_, err = NewUpscaleOptions(2, "", &UpscaleOptions{Backend: vm.BackendFFmpegLanczos, Model: "models_rgb"})
+	assert.Error(t, err)
+}
+
+func TestThreadsGetUpscaleOptions(t *testing.T) {
+	options := UpscaleOptions{Scale: 4, Model: "models_rgb", Deterministic: true, Backend: DefaultBackend}
+	task := VideoUpscalerTask{TaskId: "1", StartFrame: 0, EndFrame: 9, ThreadAmount: 2, Scale: 4, Options: &options}
+
+	for _, thread := range task.GenerateThreads(task.TaskId) {
+		assert.Equal(t, int64(4), thread.Scale)
+		assert.Equal(t, options, *thread.Options)
+		assert.Equal(t, vm.UpscaleOptions{Scale: 4, Model: "models_rgb", Deterministic: true, Backend: DefaultBackend, Format: vm.FormatPNG}, thread.GetRenderOptions())
+	}
+
+	// threads without options only have a scale
+	thread := VideoUpscalerThread{Scale: 4}
+	assert.Equal(t, vm.UpscaleOptions{Scale: 4, Format: vm.FormatPNG}, thread.GetRenderOptions())
+}
+
+func TestUpscaleOptionsOutputFormat(t *testing.T) {
+	options, err := NewUpscaleOptions(2, "", &UpscaleOptions{OutputFormat: OutputFormat_OUTPUT_FORMAT_TIFF_16})
+	assert.NoError(t, err)
+	thread := VideoUpscalerThread{Options: &options}
+	assert.Equal(t, vm.FormatTIFF16, thread.GetFrameFormat())
+	assert.Equal(t, "frame_000007.tiff", thread.GetFrameFormat().FrameFilename(7))
+
+	// tasks created before output formats existed are PNG
+	assert.Equal(t, vm.FormatPNG, VideoUpscalerTask{}.GetFrameFormat())
+
+	_, err = NewUpscaleOptions(2, "", &UpscaleOptions{OutputFormat: OutputFormat(9)})
+	assert.Error(t, err)
+}
+
+func TestUpscaleOptionsValidation(t *testing.T) {
+	_, err := NewUpscaleOptions(2, "", &UpscaleOptions{Validation: ValidationMode_VALIDATION_MODE_FINGERPRINT, MaxFingerprintDistance: 16})
+	assert.NoError(t, err)
+
+	// the distance only applies to fingerprint validation, and can't be over the size of the fingerprint
+	_, err = NewUpscaleOptions(2, "", &UpscaleOptions{MaxFingerprintDistance: 16})
+	assert.Error(t, err)
+	_, err = NewUpscaleOptions(2, "", &UpscaleOptions{Validation: ValidationMode_VALIDATION_MODE_FINGERPRINT, MaxFingerprintDistance: FingerprintTiles + 1})
+	assert.Error(t, err)
+	_, err = NewUpscaleOptions(2, "", &UpscaleOptions{Validation: ValidationMode(5)})
+	assert.Error(t, err)
+}
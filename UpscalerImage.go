@@ -0,0 +1,53 @@
+package videoUpscaler
+
+import (
+	"fmt"
+
+	"github.com/janction/videoUpscaler/vm"
+)
+
+// DefaultBackend is the upscaling backend of tasks that don't select one
+const DefaultBackend = vm.DefaultBackend
+
+// Validate makes sure the image is of a known backend and is pinned by digest
+func (i UpscalerImage) Validate() error {
+	if i.Backend == "" {
+		return fmt.Errorf("image %s has no backend", i.Image)
+	}
+	if _, found := vm.GetBackend(i.Backend); !found {
+		return fmt.Errorf("backend %s of image %s doesn't exist", i.Backend, i.Image)
+	}
+	_, err := vm.ImageDigest(i.Image)
+	return err
+}
+
+// Digest returns the digest the image is pinned to
+func (i UpscalerImage) Digest() string {
+	digest, _ := vm.ImageDigest(i.Image)
+	return digest
+}
+
+// GetUpscalerImage returns the image allowed to render tasks of the backend. An empty backend is the default one
+func (p Params) GetUpscalerImage(backend string) (UpscalerImage, bool) {
+	if backend == "" {
+		backend = DefaultBackend
+	}
+	for _, image := range p.UpscalerImages {
+		if image.Backend == backend {
+			return image, true
+		}
+	}
+	return UpscalerImage{}, false
+}
+
+// GetBackend returns the upscaling backend selected in the task options, the one required by the task,
+// or the default one
+func (t VideoUpscalerTask) GetBackend() string {
+	if t.Options != nil && t.Options.Backend != "" {
+		return t.Options.Backend
+	}
+	if t.Requirements == nil || t.Requirements.Backend == "" {
+		return DefaultBackend
+	}
+	return t.Requirements.Backend
+}
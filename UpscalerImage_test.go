@@ -0,0 +1,47 @@
+package videoUpscaler
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpscalerImagesParams(t *testing.T) {
+	pinned := "rodrigoa77/upscaler-cpu@sha256:" + strings.Repeat("a", 64)
+
+	params := DefaultParams()
+	assert.NoError(t, params.Validate())
+	_, found := params.GetUpscalerImage("")
+	assert.False(t, found)
+
+	params.UpscalerImages = []UpscalerImage{{Backend: DefaultBackend, Image: pinned}}
+	assert.NoError(t, params.Validate())
+	image, found := params.GetUpscalerImage("")
+	assert.True(t, found)
+	assert.Equal(t, "sha256:"+strings.Repeat("a", 64), image.Digest())
+
+	// only images pinned by digest are allowed
+	params.UpscalerImages = []UpscalerImage{{Backend: DefaultBackend, Image: "rodrigoa77/upscaler-cpu:latest"}}
+	assert.Error(t, params.Validate())
+
+	// images of unknown backends are rejected
+	params.UpscalerImages = []UpscalerImage{{Backend: "waifu3x", Image: pinned}}
+	assert.Error(t, params.Validate())
+
+	// a single image per backend
+	params.UpscalerImages = []UpscalerImage{{Backend: DefaultBackend, Image: pinned}, {Backend: DefaultBackend, Image: pinned}}
+	assert.Error(t, params.Validate())
+}
+
+func TestTaskBackend(t *testing.T) {
+	task := VideoUpscalerTask{}
+	assert.Equal(t, DefaultBackend, task.GetBackend())
+
+	task.Requirements = &TaskRequirements{Backend: "realesrgan"}
+	assert.Equal(t, "realesrgan", task.GetBackend())
+
+	// the backend selected in the options comes first
+	task.Options = &UpscaleOptions{Backend: "ffmpeg-lanczos"}
+	assert.Equal(t, "ffmpeg-lanczos", task.GetBackend())
+}
@@ -2,8 +2,10 @@ package videoUpscaler
 
 import (
 	"context"
+	"sort"
 	"strconv"
 
+	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/types"
 	"github.com/janction/videoUpscaler/db"
 )
@@ -17,19 +19,40 @@ func GetEmptyVideoUpscalerTaskList() []IndexedVideoUpscalerTask {
 }
 
 func (t *VideoUpscalerTask) GenerateThreads(taskId string) (res []*VideoUpscalerThread) {
+	// adaptive tasks get their threads cut as workers subscribe
+	if t.IsAdaptive() {
+		t.NextFrame = t.StartFrame
+		return res
+	}
+
 	// Split frames among the threads
 	frameRanges := splitFrames(int(t.StartFrame), int(t.EndFrame), int(t.ThreadAmount))
 
 	// Print the result
 
 	for i, r := range frameRanges {
-		thread := VideoUpscalerThread{ThreadId: t.TaskId + strconv.FormatInt(int64(i), 10), StartFrame: int64(r.StartFrame), EndFrame: int64(r.EndFrame), TaskId: taskId}
+		thread := VideoUpscalerThread{ThreadId: GetThreadId(t.TaskId, i), StartFrame: int64(r.StartFrame), EndFrame: int64(r.EndFrame), TaskId: taskId, Scale: int64(t.Scale), Options: t.threadOptions()}
 		res = append(res, &thread)
 	}
 
 	return res
 }
 
+// GetThreadId returns the id of the thread at the given index of a task. The separator keeps ids of different
+// tasks from colliding, like task 1 thread 10 and task 11 thread 0
+func GetThreadId(taskId string, index int) string {
+	return taskId + "-" + strconv.Itoa(index)
+}
+
+// threadOptions returns a copy of the upscale options of the task for a new thread
+func (t VideoUpscalerTask) threadOptions() *UpscaleOptions {
+	if t.Options == nil {
+		return nil
+	}
+	options := *t.Options
+	return &options
+}
+
 // SplitFrames divides the total frames into chunks based on the number of threads
 // FrameRange represents the range of frames assigned to a thread
 type frameRange struct {
@@ -71,15 +94,123 @@ func (t VideoUpscalerTask) SubscribeWorkerToTask(ctx context.Context, workerAddr
 		db.UpdateTask(taskId, threadId, false)
 		return err
 	}
+
+	// requests for a new adaptive thread can be sent again once this one is processed
+	if threadId == "" {
+		db.UpdateTask(taskId, threadId, false)
+	}
 	return nil
 }
 
-func (t *VideoUpscalerTask) GetWinnerReward() types.Coin {
-	amountThreads := len(t.Threads)
-	return types.NewCoin(t.Reward.Denom, t.Reward.Amount.QuoRaw(2).QuoRaw(int64(amountThreads)))
+// GetWinnerReward returns the half of the reward that corresponds to the frames of the thread
+func (t *VideoUpscalerTask) GetWinnerReward(thread *VideoUpscalerThread) types.Coin {
+	return types.NewCoin(t.Reward.Denom, t.getThreadReward(thread))
+}
+
+// GetValidatorsReward returns the half of the reward shared by the validators of the thread
+func (t *VideoUpscalerTask) GetValidatorsReward(thread *VideoUpscalerThread) types.Coin {
+	return types.NewCoin(t.Reward.Denom, t.getThreadReward(thread))
+}
+
+// threads can have different sizes, so the reward is proportional to the amount of frames
+func (t *VideoUpscalerTask) getThreadReward(thread *VideoUpscalerThread) math.Int {
+	threadFrames := thread.EndFrame - thread.StartFrame + 1
+	return t.GetTotalReward().Amount.QuoRaw(2).MulRaw(threadFrames).QuoRaw(t.GetFrameAmount())
+}
+
+// GetFrameAmount returns the amount of frames of the task
+func (t VideoUpscalerTask) GetFrameAmount() int64 {
+	return int64(t.EndFrame) - int64(t.StartFrame) + 1
+}
+
+// GetTotalReward returns the reward of the task plus the priority fee
+func (t VideoUpscalerTask) GetTotalReward() types.Coin {
+	if t.PriorityFee == nil || t.PriorityFee.IsNil() {
+		return *t.Reward
+	}
+	return t.Reward.Add(*t.PriorityFee)
+}
+
+// GetRewardPerFrame returns the effective reward workers get per frame, including the priority fee
+func (t VideoUpscalerTask) GetRewardPerFrame() math.LegacyDec {
+	return math.LegacyNewDecFromInt(t.GetTotalReward().Amount).QuoInt64(t.GetFrameAmount())
+}
+
+// IsExpiredAt returns true if the task has an expiry height and it was reached
+func (t VideoUpscalerTask) IsExpiredAt(height int64) bool {
+	return t.ExpiryHeight > 0 && height >= t.ExpiryHeight
 }
 
-func (t *VideoUpscalerTask) GetValidatorsReward() types.Coin {
-	amountThreads := len(t.Threads)
-	return types.NewCoin(t.Reward.Denom, t.Reward.Amount.QuoRaw(2).QuoRaw(int64(amountThreads)))
+// SortTasksByPriority sorts the tasks so workers pick first the ones with higher priority fee,
+// then higher reward per frame, and then the older ones
+func SortTasksByPriority(tasks []VideoUpscalerTask) {
+	sort.SliceStable(tasks, func(i, j int) bool {
+		feeI, feeJ := math.ZeroInt(), math.ZeroInt()
+		if tasks[i].PriorityFee != nil && !tasks[i].PriorityFee.IsNil() {
+			feeI = tasks[i].PriorityFee.Amount
+		}
+		if tasks[j].PriorityFee != nil && !tasks[j].PriorityFee.IsNil() {
+			feeJ = tasks[j].PriorityFee.Amount
+		}
+		if !feeI.Equal(feeJ) {
+			return feeI.GT(feeJ)
+		}
+
+		rewardI, rewardJ := tasks[i].GetRewardPerFrame(), tasks[j].GetRewardPerFrame()
+		if !rewardI.Equal(rewardJ) {
+			return rewardI.GT(rewardJ)
+		}
+		return false
+	})
+}
+
+// IsAdaptive returns true if threads are sized on the fly from the speed of workers
+func (t VideoUpscalerTask) IsAdaptive() bool {
+	return t.TargetThreadSeconds > 0
+}
+
+// HasPendingFrames returns true if an adaptive task still has frames not assigned to a thread
+func (t VideoUpscalerTask) HasPendingFrames() bool {
+	return t.IsAdaptive() && t.NextFrame <= t.EndFrame
+}
+
+// CutThread creates a new thread starting at the next pending frame, with enough frames
+// to last TargetThreadSeconds for a worker that renders a frame in frameSeconds
+func (t *VideoUpscalerTask) CutThread(frameSeconds int64) (int, *VideoUpscalerThread) {
+	if frameSeconds < 1 {
+		frameSeconds = 1
+	}
+	frames := t.TargetThreadSeconds / frameSeconds
+	if frames < 1 {
+		frames = 1
+	}
+
+	start := int64(t.NextFrame)
+	end := start + frames - 1
+	if end > int64(t.EndFrame) {
+		end = int64(t.EndFrame)
+	}
+
+	index := len(t.Threads)
+	thread := VideoUpscalerThread{ThreadId: GetThreadId(t.TaskId, index), TaskId: t.TaskId, StartFrame: start, EndFrame: end, Scale: int64(t.Scale), Options: t.threadOptions()}
+	t.Threads = append(t.Threads, &thread)
+	t.ThreadAmount = int32(len(t.Threads))
+	t.NextFrame = int32(end + 1)
+
+	return index, &thread
+}
+
+// GetAverageRenderSeconds returns the average seconds per frame of the completed threads
+func (t VideoUpscalerTask) GetAverageRenderSeconds() int64 {
+	var total, count int64
+	for _, thread := range t.Threads {
+		if thread.Completed && thread.AverageRenderSeconds > 0 {
+			total += thread.AverageRenderSeconds
+			count++
+		}
+	}
+	if count == 0 {
+		return 0
+	}
+	return total / count
 }
@@ -0,0 +1,99 @@
+package videoUpscaler
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCutThread(t *testing.T) {
+	task := VideoUpscalerTask{TaskId: "1", StartFrame: 0, EndFrame: 9, TargetThreadSeconds: 60}
+	threads := task.GenerateThreads(task.TaskId)
+	assert.Empty(t, threads)
+	assert.True(t, task.HasPendingFrames())
+
+	// 20 seconds per frame, 3 frames to fill a minute
+	index, thread := task.CutThread(20)
+	assert.Equal(t, 0, index)
+	assert.Equal(t, int64(0), thread.StartFrame)
+	assert.Equal(t, int64(2), thread.EndFrame)
+
+	// a slow worker still gets a frame
+	_, thread = task.CutThread(120)
+	assert.Equal(t, int64(3), thread.StartFrame)
+	assert.Equal(t, int64(3), thread.EndFrame)
+
+	// last thread is capped at the end of the task
+	index, thread = task.CutThread(1)
+	assert.Equal(t, 2, index)
+	assert.Equal(t, int64(4), thread.StartFrame)
+	assert.Equal(t, int64(9), thread.EndFrame)
+	assert.False(t, task.HasPendingFrames())
+	assert.Equal(t, int32(3), task.ThreadAmount)
+}
+
+func TestThreadIdsDontCollideAcrossTasks(t *testing.T) {
+	// task 1 thread 10 and task 11 thread 0 used to be both "110"
+	task1 := VideoUpscalerTask{TaskId: "1", StartFrame: 0, EndFrame: 10, ThreadAmount: 11}
+	task11 := VideoUpscalerTask{TaskId: "11", StartFrame: 0, EndFrame: 10, ThreadAmount: 1}
+	threads1 := task1.GenerateThreads(task1.TaskId)
+	threads11 := task11.GenerateThreads(task11.TaskId)
+	assert.Equal(t, "1-10", threads1[10].ThreadId)
+	assert.Equal(t, "11-0", threads11[0].ThreadId)
+	assert.NotEqual(t, threads1[10].ThreadId, threads11[0].ThreadId)
+
+	// adaptive threads are cut with the same ids
+	adaptive1 := VideoUpscalerTask{TaskId: "1", StartFrame: 0, EndFrame: 10, TargetThreadSeconds: 1}
+	adaptive11 := VideoUpscalerTask{TaskId: "11", StartFrame: 0, EndFrame: 10, TargetThreadSeconds: 1}
+	var thread *VideoUpscalerThread
+	for i := 0; i <= 10; i++ {
+		_, thread = adaptive1.CutThread(1)
+	}
+	_, thread11 := adaptive11.CutThread(1)
+	assert.Equal(t, "1-10", thread.ThreadId)
+	assert.Equal(t, "11-0", thread11.ThreadId)
+}
+
+func TestThreadRewardIsProportional(t *testing.T) {
+	reward := types.NewCoin("jct", math.NewInt(1000))
+	task := VideoUpscalerTask{StartFrame: 0, EndFrame: 9, Reward: &reward}
+
+	thread := VideoUpscalerThread{StartFrame: 0, EndFrame: 1}
+	assert.Equal(t, math.NewInt(100), task.GetWinnerReward(&thread).Amount)
+	assert.Equal(t, math.NewInt(100), task.GetValidatorsReward(&thread).Amount)
+
+	thread = VideoUpscalerThread{StartFrame: 2, EndFrame: 9}
+	assert.Equal(t, math.NewInt(400), task.GetWinnerReward(&thread).Amount)
+}
+
+func TestSortTasksByPriority(t *testing.T) {
+	reward := types.NewCoin("jct", math.NewInt(1000))
+	richReward := types.NewCoin("jct", math.NewInt(5000))
+	fee := types.NewCoin("jct", math.NewInt(10))
+
+	tasks := []VideoUpscalerTask{
+		{TaskId: "0", StartFrame: 0, EndFrame: 9, Reward: &reward},
+		{TaskId: "1", StartFrame: 0, EndFrame: 9, Reward: &richReward},
+		{TaskId: "2", StartFrame: 0, EndFrame: 99, Reward: &reward, PriorityFee: &fee},
+		{TaskId: "3", StartFrame: 0, EndFrame: 9, Reward: &reward},
+	}
+	SortTasksByPriority(tasks)
+
+	var ids []string
+	for _, task := range tasks {
+		ids = append(ids, task.TaskId)
+	}
+	assert.Equal(t, []string{"2", "1", "0", "3"}, ids)
+	assert.Equal(t, math.NewInt(1010), tasks[0].GetTotalReward().Amount)
+}
+
+func TestIsExpiredAt(t *testing.T) {
+	task := VideoUpscalerTask{}
+	assert.False(t, task.IsExpiredAt(100))
+
+	task.ExpiryHeight = 50
+	assert.False(t, task.IsExpiredAt(49))
+	assert.True(t, task.IsExpiredAt(50))
+}
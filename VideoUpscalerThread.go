@@ -2,12 +2,15 @@ package videoUpscaler
 
 import (
 	"context"
+	"errors"
 	fmt "fmt"
 	"os"
+	"os/exec"
 	"path"
 	"path/filepath"
 	"slices"
 	"strconv"
+	"sync"
 	"time"
 
 	"cosmossdk.io/math"
@@ -20,70 +23,155 @@ import (
 	"github.com/janction/videoUpscaler/vm"
 )
 
-func (t *VideoUpscalerThread) StartWork(ctx context.Context, worker string, cid string, path string, db *db.DB) error {
+// StartWork downloads the input and upscales the frames of the thread with the renderer, using the pinned image
+// when the renderer runs containers. With a batchSize over 1, ranges of consecutive frames are upscaled in a single renderer call.
+// Frames that fail are retried following the policy. If they still fail, the thread is given up with ReportFailure.
+// If the task has media info, the downloaded input is probed first and the thread is given up if it doesn't match.
+// Image sequence inputs only download the images of the thread
+func (t *VideoUpscalerThread) StartWork(ctx context.Context, worker string, cid string, inputType InputType, path string, image string, media *MediaInfo, renderer vm.Renderer, batchSize int, policy vm.RetryPolicy, db *db.DB) error {
 	// ctx := context.Background()
+	if !workInProgress.start(t.ThreadId) {
+		videoUpscalerLogger.Logger.Info("Work for thread %s is already going", t.ThreadId)
+		return nil
+	}
+	defer workInProgress.done(t.ThreadId)
 
 	if err := db.UpdateThread(t.ThreadId, false, false, true, false, false, false, false, false); err != nil {
 		videoUpscalerLogger.Logger.Error("Unable to update thread status, err: %s", err.Error())
 	}
 
-	isRunning := vm.IsContainerRunning(ctx, t.ThreadId)
+	isRunning := renderer.IsRunning(ctx, t.ThreadId)
 	if !isRunning {
 		// task is not running,
 
-		// we remove the container just in case it already exists.
-		vm.RemoveContainer(ctx, "upscaler-cpu"+t.ThreadId)
+		// we remove anything left by a previous run, just in case it already exists.
+		renderer.Cleanup(ctx, t.ThreadId)
+		// the manifest is built again once the frames are upscaled
+		manifestPath := filepath.Join(path, FrameManifestFilename)
+		os.Remove(manifestPath)
 
 		videoUpscalerLogger.Logger.Info("No solution for thread %s. Starting work", t.ThreadId)
 		// we don't have a solution, start working
 		started := time.Now().Unix()
 		ipfs.EnsureIPFSRunning()
-		db.AddLogEntry(t.ThreadId, fmt.Sprintf("Started downloading IPFS file %s...", cid), started, 0)
-		if err := db.UpdateThread(t.ThreadId, true, false, true, false, false, false, false, false); err != nil {
-			videoUpscalerLogger.Logger.Error("Unable to update thread status, err: %s", err.Error())
-		}
-		err := ipfs.IPFSGet(cid, path)
-		if err != nil {
+		var sequence *vm.ImageSequence
+		if inputType == InputType_INPUT_TYPE_IMAGE_SEQUENCE {
+			// only the images with the frames of the thread are downloaded
+			db.AddLogEntry(t.ThreadId, fmt.Sprintf("Started downloading images of sequence %s...", cid), started, 0)
 			if err := db.UpdateThread(t.ThreadId, true, false, true, false, false, false, false, false); err != nil {
 				videoUpscalerLogger.Logger.Error("Unable to update thread status, err: %s", err.Error())
 			}
-			db.AddLogEntry(t.ThreadId, fmt.Sprintf("Error getting IPFS file %s. %s", cid, err.Error()), started, 2)
-			videoUpscalerLogger.Logger.Error("Error getting cid %s", cid)
-			return err
+			downloaded, err := t.downloadSequence(cid, path, db)
+			if errors.Is(err, errInvalidSequence) {
+				db.UpdateThread(t.ThreadId, true, true, false, false, false, false, false, false)
+				db.AddLogEntry(t.ThreadId, fmt.Sprintf("Input %s is not a valid image sequence. %s", cid, err.Error()), time.Now().Unix(), 2)
+				return t.ReportFailure(worker, vm.FailureInputDecode, err, db)
+			}
+			if err != nil {
+				db.AddLogEntry(t.ThreadId, fmt.Sprintf("Error getting images of sequence %s. %s", cid, err.Error()), started, 2)
+				videoUpscalerLogger.Logger.Error("Error getting cid %s", cid)
+				return err
+			}
+			sequence = &downloaded
+			if err := db.UpdateThread(t.ThreadId, true, true, true, false, false, false, false, false); err != nil {
+				videoUpscalerLogger.Logger.Error("Unable to update thread status, err: %s", err.Error())
+			}
+			finish := time.Now().Unix()
+			db.AddLogEntry(t.ThreadId, fmt.Sprintf("Successfully downloaded images of sequence %s in %v seconds.", cid, finish-started), finish, 0)
+		} else if ipfs.IsDownloadComplete(cid, path) {
+			// input is already on disk from a previous run, no need to download it again
+			db.AddLogEntry(t.ThreadId, fmt.Sprintf("IPFS file %s already downloaded, skipping download.", cid), started, 0)
+			if err := db.UpdateThread(t.ThreadId, true, true, true, false, false, false, false, false); err != nil {
+				videoUpscalerLogger.Logger.Error("Unable to update thread status, err: %s", err.Error())
+			}
+		} else {
+			db.AddLogEntry(t.ThreadId, fmt.Sprintf("Started downloading IPFS file %s...", cid), started, 0)
+			if err := db.UpdateThread(t.ThreadId, true, false, true, false, false, false, false, false); err != nil {
+				videoUpscalerLogger.Logger.Error("Unable to update thread status, err: %s", err.Error())
+			}
+			err := ipfs.IPFSGet(cid, path)
+			if err != nil {
+				if err := db.UpdateThread(t.ThreadId, true, false, true, false, false, false, false, false); err != nil {
+					videoUpscalerLogger.Logger.Error("Unable to update thread status, err: %s", err.Error())
+				}
+				db.AddLogEntry(t.ThreadId, fmt.Sprintf("Error getting IPFS file %s. %s", cid, err.Error()), started, 2)
+				videoUpscalerLogger.Logger.Error("Error getting cid %s", cid)
+				return err
+			}
+			// download completed successfuly
+			if err := db.UpdateThread(t.ThreadId, true, true, true, false, false, false, false, false); err != nil {
+				videoUpscalerLogger.Logger.Error("Unable to update thread status, err: %s", err.Error())
+			}
+
+			finish := time.Now().Unix()
+			difference := time.Unix(finish, 0).Sub(time.Unix(started, 0))
+			db.AddLogEntry(t.ThreadId, fmt.Sprintf("Successfully downloaded IPFS file %s in %v seconds.", cid, int(difference.Seconds())), finish, 0)
 		}
-		// download completed successfuly
-		if err := db.UpdateThread(t.ThreadId, true, true, true, false, false, false, false, false); err != nil {
-			videoUpscalerLogger.Logger.Error("Unable to update thread status, err: %s", err.Error())
+
+		if media != nil {
+			if err := t.checkInput(ctx, filepath.Join(path, cid), *media); err != nil {
+				db.UpdateThread(t.ThreadId, true, true, false, false, false, false, false, false)
+				db.AddLogEntry(t.ThreadId, fmt.Sprintf("Input %s doesn't match the task. %s", cid, err.Error()), time.Now().Unix(), 2)
+				return t.ReportFailure(worker, vm.FailureMediaMismatch, err, db)
+			}
 		}
 
+		// we start upscaler, only with the frames we didn't render on previous runs
+		outputPath := filepath.Join(path, "output")
+		frames := t.getPendingFrames(outputPath, db)
+		if len(frames) < int(t.EndFrame)-int(t.StartFrame)+1 {
+			db.AddLogEntry(t.ThreadId, fmt.Sprintf("Resuming thread %s, %v frames left to upscale.", t.ThreadId, len(frames)), time.Now().Unix(), 0)
+		}
+		if t.IsReverse(worker) {
+			slices.Reverse(frames)
+		}
+		checkpoint := func(frameNumber int64) {
+			t.checkpointFrame(outputPath, frameNumber, db)
+		}
+		job := vm.RenderJob{TaskId: t.TaskId, ThreadId: t.ThreadId, Cid: cid, Path: path, Image: image, Options: t.GetRenderOptions(), Sequence: sequence}
+		if err := vm.Render(ctx, renderer, job, frames, batchSize, policy, db, checkpoint); err != nil {
+			db.UpdateThread(t.ThreadId, true, true, false, false, false, false, false, false)
+			var renderErr *vm.RenderError
+			if errors.As(err, &renderErr) {
+				videoUpscalerLogger.Logger.Error("Giving up thread %s: %s", t.ThreadId, err.Error())
+				return t.ReportFailure(worker, renderErr.Reason, renderErr, db)
+			}
+			return err
+		}
+
+		_, err := os.Stat(outputPath)
 		finish := time.Now().Unix()
 		difference := time.Unix(finish, 0).Sub(time.Unix(started, 0))
-		db.AddLogEntry(t.ThreadId, fmt.Sprintf("Successfully downloaded IPFS file %s in %v seconds.", cid, int(difference.Seconds())), finish, 0)
-
-		// we start upscaler
-		vm.RenderVideo(ctx, cid, t.StartFrame, t.EndFrame, t.ThreadId, path, t.IsReverse(worker), db)
-
-		rendersPath := filepath.Join(path, "output")
-		_, err = os.Stat(rendersPath)
-		finish = time.Now().Unix()
-		difference = time.Unix(finish, 0).Sub(time.Unix(started, 0))
 		if err != nil {
 			// output path was not created so no upscaler happened. we will start over
-			videoUpscalerLogger.Logger.Error("Unable to complete upscaler of task, retrying. No files at %s", rendersPath)
+			videoUpscalerLogger.Logger.Error("Unable to complete upscaler of task, retrying. No files at %s", outputPath)
 
 			db.UpdateThread(t.ThreadId, true, true, false, false, false, false, false, false)
 			return nil
 		}
-		files, _ := os.ReadDir(rendersPath)
-		if len(files) != int(t.EndFrame)-int(t.StartFrame)+1 {
+		manifest, err := t.BuildFrameManifest(outputPath, false)
+		if err != nil {
 			db.UpdateThread(t.ThreadId, true, true, false, false, false, false, false, false)
-			videoUpscalerLogger.Logger.Error("Not the amount we expected. retrying. Amount of files %v", len(files))
+			db.AddLogEntry(t.ThreadId, fmt.Sprintf("Output of thread %s is not complete, retrying. %s", t.ThreadId, err.Error()), finish, 2)
+			videoUpscalerLogger.Logger.Error("Output of thread %s is not complete, retrying. %s", t.ThreadId, err.Error())
 			return nil
 		}
+		if sequence != nil {
+			if err := manifest.SetSources(*sequence); err != nil {
+				db.UpdateThread(t.ThreadId, true, true, false, false, false, false, false, false)
+				videoUpscalerLogger.Logger.Error("Unable to map frames of thread %s to the sequence: %s", t.ThreadId, err.Error())
+				return err
+			}
+		}
+		if err := manifest.Write(manifestPath); err != nil {
+			db.UpdateThread(t.ThreadId, true, true, false, false, false, false, false, false)
+			videoUpscalerLogger.Logger.Error("Unable to write manifest of thread %s: %s", t.ThreadId, err.Error())
+			return err
+		}
 		db.UpdateThread(t.ThreadId, true, true, true, true, false, false, false, false)
 		db.AddLogEntry(t.ThreadId, fmt.Sprintf("Thread %s completed succesfully in %v seconds.", t.ThreadId, int(difference.Seconds())), finish, 1)
 	} else {
-		// Container is running, so we update worker status
+		// Renderer is running, so we update worker status
 		// if worker status is idle, we change it
 		videoUpscalerLogger.Logger.Info("Work for thread %s is already going", t.ThreadId)
 
@@ -92,24 +180,110 @@ func (t *VideoUpscalerThread) StartWork(ctx context.Context, worker string, cid
 	return nil
 }
 
-func (t VideoUpscalerThread) ProposeSolution(codec codec.Codec, alias, workerAddress string, rootPath string, db *db.DB) error {
-	db.UpdateThread(t.ThreadId, true, true, true, true, true, false, false, false)
+// workInProgress keeps the threads StartWork is running for on this node
+var workInProgress = threadsInProgress{ids: make(map[string]bool)}
 
-	output := path.Join(rootPath, "upscales", t.ThreadId, "output")
-	count := vm.CountFilesInDirectory(output)
+type threadsInProgress struct {
+	mu  sync.Mutex
+	ids map[string]bool
+}
 
-	if count != (int(t.EndFrame)-int(t.StartFrame))+1 {
-		videoUpscalerLogger.Logger.Error("not enought local frames to propose solution: %v", count)
-		db.UpdateThread(t.ThreadId, true, true, true, true, false, false, false, false)
-		return nil
+// start registers the thread, returning false if it was already in progress
+func (w *threadsInProgress) start(id string) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.ids[id] {
+		return false
 	}
+	w.ids[id] = true
+	return true
+}
+
+func (w *threadsInProgress) done(id string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	delete(w.ids, id)
+}
+
+// IsWorkInProgress returns true while this node is running StartWork for the thread. Work started on a previous
+// run of the node, or that returned with an error, is not in progress anymore
+func IsWorkInProgress(threadId string) bool {
+	workInProgress.mu.Lock()
+	defer workInProgress.mu.Unlock()
+	return workInProgress.ids[threadId]
+}
 
-	hashes, err := GenerateDirectoryFileHashes(output)
+// checkInput probes the downloaded input and compares it with the media info of the task. Workers without
+// ffprobe skip the check
+func (t *VideoUpscalerThread) checkInput(ctx context.Context, input string, media MediaInfo) error {
+	probed, err := ProbeMediaInfo(ctx, input)
+	if errors.Is(err, exec.ErrNotFound) {
+		videoUpscalerLogger.Logger.Error("Unable to check input of thread %s: %s", t.ThreadId, err.Error())
+		return nil
+	}
 	if err != nil {
-		videoUpscalerLogger.Logger.Error("Unable to calculate CIDs: %s", err.Error())
-		db.UpdateThread(t.ThreadId, true, true, true, true, false, false, false, false)
 		return err
 	}
+	return media.CheckProbed(probed)
+}
+
+// getPendingFrames returns the frames of the thread that still need to be upscaled. Checkpointed frames
+// are skipped only if the output file is still there with the same pixel hash
+func (t *VideoUpscalerThread) getPendingFrames(outputPath string, db *db.DB) []int64 {
+	rendered, err := db.GetRenderedFrames(t.ThreadId)
+	if err != nil {
+		videoUpscalerLogger.Logger.Error("Unable to read rendered frames of thread %s: %s", t.ThreadId, err.Error())
+		rendered = map[int64]string{}
+	}
+
+	var pending []int64
+	for frame := t.StartFrame; frame <= t.EndFrame; frame++ {
+		hash, found := rendered[frame]
+		if found {
+			current, err := CalculateFileHash(filepath.Join(outputPath, t.GetFrameFormat().FrameFilename(frame)))
+			if err == nil && current == hash {
+				continue
+			}
+			// output is missing or was modified, we render it again
+			videoUpscalerLogger.Logger.Info("Frame %v of thread %s doesn't match its checkpoint", frame, t.ThreadId)
+			db.DeleteRenderedFrame(t.ThreadId, frame)
+		}
+		pending = append(pending, frame)
+	}
+	return pending
+}
+
+// checkpointFrame records the pixel hash of a rendered frame, so it is not rendered again on restart
+func (t *VideoUpscalerThread) checkpointFrame(outputPath string, frameNumber int64, db *db.DB) {
+	hash, err := CalculateFileHash(filepath.Join(outputPath, t.GetFrameFormat().FrameFilename(frameNumber)))
+	if err != nil {
+		videoUpscalerLogger.Logger.Error("Unable to checkpoint frame %v of thread %s: %s", frameNumber, t.ThreadId, err.Error())
+		return
+	}
+	db.AddRenderedFrame(t.ThreadId, frameNumber, hash)
+}
+
+// ProposeSolution signs the hashes of the frame manifest and proposes them, with the digest of the image used
+func (t VideoUpscalerThread) ProposeSolution(codec codec.Codec, alias, workerAddress string, rootPath string, imageDigest string, db *db.DB) error {
+	db.UpdateThread(t.ThreadId, true, true, true, true, true, false, false, false)
+
+	output := path.Join(rootPath, "upscales", t.ThreadId, "output")
+	manifest, err := t.readFrameManifest(rootPath)
+	if err == nil {
+		err = manifest.CheckOutput(output)
+	}
+	if err != nil {
+		// frames are upscaled again and the manifest rebuilt
+		videoUpscalerLogger.Logger.Error("unable to propose solution of thread %s: %s", t.ThreadId, err.Error())
+		db.UpdateThread(t.ThreadId, true, true, false, false, false, false, false, false)
+		return nil
+	}
+
+	// with fingerprint validation we commit to the fingerprints, revealed later with the solution
+	hashes := manifest.Hashes()
+	if t.IsFingerprintValidated() {
+		hashes = manifest.Fingerprints()
+	}
 
 	pkey, err := videoUpscalerCrypto.ExtractPublicKey(rootPath, alias, codec)
 	if err != nil {
@@ -153,6 +327,9 @@ func (t VideoUpscalerThread) ProposeSolution(codec codec.Codec, alias, workerAdd
 	args = append(args, solution...)
 
 	// Append flags
+	if imageDigest != "" {
+		args = append(args, "--image-digest", imageDigest)
+	}
 	args = append(args, "--yes", "--from", workerAddress)
 	err = ExecuteCli(args)
 	if err != nil {
@@ -169,7 +346,17 @@ func (t VideoUpscalerThread) SubmitVerification(codec codec.Codec, alias, worker
 	// we will verify any file we already have rendered.
 	db.UpdateThread(t.ThreadId, true, true, true, true, true, true, false, false)
 	output := path.Join(rootPath, "upscales", t.ThreadId, "output")
-	files := vm.CountFilesInDirectory(output)
+	manifest, err := t.readFrameManifest(rootPath)
+	if err != nil {
+		// upscaling is not completed, we verify the frames rendered so far
+		manifest, err = t.BuildFrameManifest(output, true)
+	}
+	if err != nil {
+		videoUpscalerLogger.Logger.Error("unable to read frames at %s: %s", output, err.Error())
+		db.UpdateThread(t.ThreadId, true, true, true, true, true, false, false, false)
+		return nil
+	}
+	files := len(manifest.Frames)
 	if files == 0 {
 		videoUpscalerLogger.Logger.Error("found %v files in path %s", files, output)
 		db.UpdateThread(t.ThreadId, true, true, true, true, true, false, false, false)
@@ -188,12 +375,10 @@ func (t VideoUpscalerThread) SubmitVerification(codec codec.Codec, alias, worker
 		return nil
 	}
 	// we do have some work, lets compare it with the solution
-	myWork, err := GenerateDirectoryFileHashes(output)
-
-	if err != nil {
-		videoUpscalerLogger.Logger.Error("error getting hashes. Err: %s", err.Error())
-		db.UpdateThread(t.ThreadId, true, true, true, true, true, false, false, false)
-		return err
+	myWork := manifest.Hashes()
+	if t.IsFingerprintValidated() {
+		// fingerprints are signed instead of the hashes, and revealed once the solution is revealed
+		myWork = manifest.Fingerprints()
 	}
 
 	publicKey, err := videoUpscalerCrypto.GetPublicKey(rootPath, alias, codec)
@@ -256,8 +441,15 @@ func submitValidation(validator string, taskId, threadId, publicKey string, sign
 func (t VideoUpscalerThread) SubmitSolution(ctx context.Context, workerAddress, rootPath string, db *db.DB) error {
 	db.UpdateThread(t.ThreadId, true, true, true, true, true, true, true, true)
 
+	manifest, err := t.readFrameManifest(rootPath)
+	if err != nil {
+		db.UpdateThread(t.ThreadId, true, true, true, true, true, true, true, false)
+		videoUpscalerLogger.Logger.Error(err.Error())
+		return err
+	}
+
 	db.AddLogEntry(t.ThreadId, "Submiting solution to IPFS...", time.Now().Unix(), 0)
-	cid, err := ipfs.UploadSolution(ctx, rootPath, t.ThreadId)
+	cid, err := ipfs.UploadSolution(ctx, rootPath, t.ThreadId, manifest.Filenames())
 	if err != nil {
 		db.UpdateThread(t.ThreadId, true, true, true, true, true, true, true, false)
 		videoUpscalerLogger.Logger.Error(err.Error())
@@ -310,18 +502,26 @@ func (t VideoUpscalerThread) IsReverse(worker string) bool {
 	return false
 }
 
+// GetValidatorReward returns the share of the validators reward that corresponds to the frames the worker validated.
+// With fingerprint validation, only revealed validations are paid
 func (t *VideoUpscalerThread) GetValidatorReward(worker string, totalReward types.Coin) types.Coin {
 	var totalFiles int
 	for _, validation := range t.Validations {
-		totalFiles = totalFiles + int(len(validation.Frames))
+		if t.isValidationPaid(validation) {
+			totalFiles = totalFiles + int(len(validation.Frames))
+		}
 	}
 	for _, validation := range t.Validations {
-		if validation.Validator == worker {
+		if validation.Validator == worker && t.isValidationPaid(validation) {
 			amount := calculateValidatorPayment(int(len(validation.Frames)), totalFiles, totalReward.Amount)
-			return types.NewCoin("jct", amount)
+			return types.NewCoin(totalReward.Denom, amount)
 		}
 	}
-	return types.NewCoin("jct", math.NewInt(0))
+	return types.NewCoin(totalReward.Denom, math.NewInt(0))
+}
+
+func (t *VideoUpscalerThread) isValidationPaid(validation *VideoUpscalerThread_Validation) bool {
+	return !t.IsFingerprintValidated() || validation.IsValidationRevealed()
 }
 
 // Calculate the validator's reward proportionally using sdkmath.Int
@@ -334,28 +534,53 @@ func calculateValidatorPayment(filesValidated, totalFilesValidated int, totalVal
 	return totalValidatorReward.Mul(math.NewInt(int64(filesValidated))).Quo(math.NewInt(int64(totalFilesValidated)))
 }
 
-// Once validations are ready, we show blockchain the solution
-func (t *VideoUpscalerThread) RevealSolution(rootPath string, db *db.DB) error {
-	output := path.Join(rootPath, "upscales", t.ThreadId, "output")
-	cids, err := ipfs.CalculateCIDs(output)
+// RevealValidation shows blockchain the fingerprints of the frames this validator signed, once the solution is revealed.
+// Only used by tasks validated by fingerprint
+func (t VideoUpscalerThread) RevealValidation(workerAddress, rootPath string, db *db.DB) error {
+	db.UpdateValidationReveal(t.ThreadId, true)
+	validation := t.GetValidation(workerAddress)
+	if validation == nil {
+		return fmt.Errorf("%s didn't validate thread %s", workerAddress, t.ThreadId)
+	}
+
+	manifest, err := t.readFrameManifest(rootPath)
 	if err != nil {
-		videoUpscalerLogger.Logger.Error(err.Error())
+		// upscaling might not be completed, like when we validated
+		manifest, err = t.BuildFrameManifest(path.Join(rootPath, "upscales", t.ThreadId, "output"), true)
+	}
+	if err != nil {
+		videoUpscalerLogger.Logger.Error("unable to read frames of thread %s: %s", t.ThreadId, err.Error())
+		db.UpdateValidationReveal(t.ThreadId, false)
 		return err
 	}
 
-	solution := make(map[string]VideoUpscalerThread_Frame)
-	for filename, cid := range cids {
-		path := filepath.Join(output, filename)
-		hash, err := CalculateFileHash(path)
+	// only the frames we signed are revealed
+	fingerprints := manifest.Fingerprints()
+	revealed := make(map[string]string)
+	for _, frame := range validation.Frames {
+		revealed[frame.Filename] = fingerprints[frame.Filename]
+	}
 
-		if err != nil {
-			videoUpscalerLogger.Logger.Error(err.Error())
-			return err
-		}
+	args := []string{"tx", "videoUpscaler", "reveal-validation", t.TaskId, t.ThreadId}
+	args = append(args, MapToKeyValueFormat(revealed)...)
+	args = append(args, "--from", workerAddress, "--yes")
+	if err := ExecuteCli(args); err != nil {
+		videoUpscalerLogger.Logger.Error("error revealing validation: %s", err.Error())
+		db.UpdateValidationReveal(t.ThreadId, false)
+		return err
+	}
+	db.AddLogEntry(t.ThreadId, "Validation revealed", time.Now().Unix(), 0)
+	return nil
+}
 
-		frame := VideoUpscalerThread_Frame{Filename: filename, Cid: cid, Hash: hash}
-		solution[filename] = frame
+// Once validations are ready, we show blockchain the solution, with the cids and hashes of the frame manifest
+func (t *VideoUpscalerThread) RevealSolution(rootPath string, db *db.DB) error {
+	manifest, err := t.readFrameManifest(rootPath)
+	if err != nil {
+		videoUpscalerLogger.Logger.Error(err.Error())
+		return err
 	}
+	solution := manifest.Solution()
 
 	// Base arguments
 	args := []string{
@@ -378,7 +603,20 @@ func (t *VideoUpscalerThread) RevealSolution(rootPath string, db *db.DB) error {
 	return nil
 }
 
-// Evaluates if the verifications sent are valid
+// readFrameManifest loads the manifest written by StartWork and makes sure it is complete for the thread
+func (t VideoUpscalerThread) readFrameManifest(rootPath string) (FrameManifest, error) {
+	manifest, err := ReadFrameManifest(GetFrameManifestPath(rootPath, t.ThreadId))
+	if err != nil {
+		return FrameManifest{}, err
+	}
+	if err := manifest.Validate(t); err != nil {
+		return FrameManifest{}, err
+	}
+	return manifest, nil
+}
+
+// Evaluates if the verifications sent are valid. Strict validation requires the validator to have signed the hash of the
+// frame, fingerprint validation requires its revealed fingerprint to be within the max fingerprint distance of the frame
 func (t *VideoUpscalerThread) EvaluateVerifications() error {
 	for _, frame := range t.Solution.Frames {
 		for _, validation := range t.Validations {
@@ -389,6 +627,10 @@ func (t *VideoUpscalerThread) EvaluateVerifications() error {
 				videoUpscalerLogger.Logger.Debug("Solution Frame %s, not found at validation of validator %s ", frame.Filename, validation.Validator)
 				continue
 			}
+			if t.IsFingerprintValidated() && !validation.IsValidationRevealed() {
+				// the validator didn't reveal its fingerprints yet
+				continue
+			}
 
 			pk, err := videoUpscalerCrypto.DecodePublicKeyFromCLI(validation.PublicKey)
 			if err != nil {
@@ -396,7 +638,12 @@ func (t *VideoUpscalerThread) EvaluateVerifications() error {
 				return err
 			}
 
-			message, err := videoUpscalerCrypto.GenerateSignableMessage(frame.Hash, validation.Validator)
+			// with fingerprint validation, validators sign the fingerprint they publish instead of the hash
+			signed := frame.Hash
+			if t.IsFingerprintValidated() {
+				signed = validation.Frames[idx].Fingerprint
+			}
+			message, err := videoUpscalerCrypto.GenerateSignableMessage(signed, validation.Validator)
 			if err != nil {
 				videoUpscalerLogger.Logger.Error("unable to recreate original message %sto verify: %s", message, err.Error())
 				return err
@@ -408,6 +655,9 @@ func (t *VideoUpscalerThread) EvaluateVerifications() error {
 			}
 
 			valid := pk.VerifySignature(message, sig)
+			if valid && t.IsFingerprintValidated() {
+				valid = t.IsFingerprintMatch(frame.Fingerprint, validation.Frames[idx].Fingerprint)
+			}
 
 			if valid {
 				// verification passed
@@ -422,6 +672,21 @@ func (t *VideoUpscalerThread) EvaluateVerifications() error {
 	return nil
 }
 
+// IsSolutionRevealed returns true once the proposer revealed the frames of its solution
+func (t VideoUpscalerThread) IsSolutionRevealed() bool {
+	return t.Solution != nil && len(t.Solution.Frames) > 0 && t.Solution.Frames[0].Hash != ""
+}
+
+// GetValidation returns the validation sent by the validator, or nil if it didn't send one
+func (t VideoUpscalerThread) GetValidation(validator string) *VideoUpscalerThread_Validation {
+	for _, validation := range t.Validations {
+		if validation.Validator == validator {
+			return validation
+		}
+	}
+	return nil
+}
+
 // for those frames evaluated, if we have at least one that has more
 // invalid counts than valid ones, we rejected. Otherwise is accepted
 func (t *VideoUpscalerThread) IsSolutionAccepted() bool {
@@ -1,8 +1,11 @@
 package videoUpscaler
 
 import (
+	"encoding/json"
 	io "io"
 	"net/http"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -12,29 +15,104 @@ import (
 	"github.com/janction/videoUpscaler/videoUpscalerLogger"
 )
 
-func (w Worker) RegisterWorker(address string, stake types.Coin, db *db.DB) error {
+func (w Worker) RegisterWorker(address string, stake types.Coin, maxThreads int64, capabilities WorkerCapabilities, announceAddrs []string, db *db.DB) error {
 	time.Sleep(8 * time.Second) // Delay 8 seconds before registering
 	db.Addworker(address)
 	// Base arguments
 	args := []string{
 		"tx", "videoUpscaler", "add-worker",
 	}
-	ip, _ := getPublicIP()
-	ipfsId, _ := ipfs.GetIPFSPeerID()
+	ip, ipfsId, err := getNetworkDetails(announceAddrs)
+	if err != nil {
+		db.DeleteWorker(address)
+		return err
+	}
 	args = append(args, ip)
 	args = append(args, ipfsId)
 	args = append(args, stake.String())
+	args = append(args, "--max-threads")
+	args = append(args, strconv.FormatInt(maxThreads, 10))
+	if data, err := json.Marshal(capabilities); err == nil {
+		args = append(args, "--capabilities")
+		args = append(args, string(data))
+	}
+	for _, addr := range announceAddrs {
+		args = append(args, "--announce-addrs")
+		args = append(args, addr)
+	}
 	args = append(args, "--from")
 	args = append(args, address)
 	args = append(args, "--yes")
 
-	err := ExecuteCli(args)
+	err = ExecuteCli(args)
 	if err != nil {
 		db.DeleteWorker(address)
+		return err
 	}
 	return nil
 }
 
+// SyncNetwork sends the current network details of the worker to the chain when they changed,
+// like a new public ip, a new IPFS peer id or other announced addresses
+func (w Worker) SyncNetwork(announceAddrs []string) error {
+	ip, ipfsId, err := getNetworkDetails(announceAddrs)
+	if err != nil {
+		return err
+	}
+	if !w.NetworkChanged(ip, ipfsId, announceAddrs) {
+		return nil
+	}
+	videoUpscalerLogger.Logger.Info("Updating network of worker %s to %s %s %s", w.Address, ip, ipfsId, announceAddrs)
+	return w.updateNetwork(ip, ipfsId, announceAddrs)
+}
+
+// NetworkChanged returns if the given network details differ from the ones of the worker in the chain
+func (w Worker) NetworkChanged(ip, ipfsId string, announceAddrs []string) bool {
+	updated := Worker{Address: w.Address, IpfsId: ipfsId, PublicIp: ip, AnnounceAddrs: announceAddrs}
+	return w.GetNetworkKey() != updated.GetNetworkKey()
+}
+
+// updateNetwork sends the network details of the worker to the chain
+func (w Worker) updateNetwork(ip, ipfsId string, announceAddrs []string) error {
+	args := []string{"tx", "videoUpscaler", "update-worker", ip, ipfsId}
+	for _, addr := range announceAddrs {
+		args = append(args, "--announce-addrs")
+		args = append(args, addr)
+	}
+	args = append(args, "--from", w.Address, "--yes")
+	return ExecuteCli(args)
+}
+
+// getNetworkDetails returns the public ip and the IPFS peer id of this node.
+// The public ip is only looked up when there are no announced addresses
+func getNetworkDetails(announceAddrs []string) (string, string, error) {
+	ip := ""
+	if len(announceAddrs) == 0 {
+		var err error
+		ip, err = getPublicIP()
+		if err != nil {
+			return "", "", err
+		}
+	}
+
+	ipfsId, err := ipfs.GetIPFSPeerID()
+	if err != nil {
+		return "", "", err
+	}
+	return ip, ipfsId, nil
+}
+
+// GetNetworkKey identifies the network details of the worker, so nodes know when to connect again
+func (w Worker) GetNetworkKey() string {
+	return strings.Join(append([]string{w.Address, w.IpfsId, w.PublicIp}, w.AnnounceAddrs...), "|")
+}
+
+// SendHeartbeat lets the chain know this worker is still alive
+func (w Worker) SendHeartbeat(address string) error {
+	args := []string{"tx", "videoUpscaler", "worker-heartbeat", "--from", address, "--yes"}
+	return ExecuteCli(args)
+}
+
 // GetPublicIP fetches the public IP of the machine
 func getPublicIP() (string, error) {
 	resp, err := http.Get("https://api.ipify.org")
@@ -53,15 +131,72 @@ func getPublicIP() (string, error) {
 	return strings.TrimSpace(string(ip)), nil
 }
 
-func (w *Worker) DeclareWinner(payment types.Coin) {
-	w.CurrentTaskId = ""
-	w.CurrentThreadIndex = 0
+func (w *Worker) DeclareWinner(taskId, threadId string, payment types.Coin) {
+	w.ReleaseThread(taskId, threadId)
 	w.Reputation.Points = w.Reputation.Points + 1
 	w.Reputation.Solutions = w.Reputation.Solutions + 1
 	w.Reputation.Winnings = w.Reputation.Winnings.Add(payment)
 }
 
-func (w *Worker) ReleaseValidator() {
-	w.CurrentTaskId = ""
-	w.CurrentThreadIndex = 0
+func (w *Worker) DeclareValidator(taskId, threadId string, payment types.Coin) {
+	w.ReleaseThread(taskId, threadId)
+	w.Reputation.Points = w.Reputation.Points + 1
+	w.Reputation.Validations = w.Reputation.Validations + 1
+	w.Reputation.Winnings = w.Reputation.Winnings.Add(payment)
+}
+
+// EstimateFrameSeconds returns the average seconds the worker needed to render a frame, or 0 without history
+func (w Worker) EstimateFrameSeconds() int64 {
+	if w.Reputation == nil || len(w.Reputation.RenderDurations) == 0 {
+		return 0
+	}
+
+	var total int64
+	for _, duration := range w.Reputation.RenderDurations {
+		total += duration
+	}
+	return total / int64(len(w.Reputation.RenderDurations))
+}
+
+// ThreadCapacity returns the amount of threads the worker can work on concurrently
+func (w Worker) ThreadCapacity() int {
+	if w.MaxThreads < 1 {
+		return 1
+	}
+	return int(w.MaxThreads)
+}
+
+// HasCapacity returns true if the worker can subscribe to another thread
+func (w Worker) HasCapacity() bool {
+	return len(w.Assignments) < w.ThreadCapacity()
+}
+
+// IsWorkingOn returns true if the worker is assigned to the thread of the task
+func (w Worker) IsWorkingOn(taskId, threadId string) bool {
+	return w.GetAssignment(taskId, threadId) != nil
+}
+
+// GetAssignment returns the assignment of the worker to the thread, or nil
+func (w Worker) GetAssignment(taskId, threadId string) *Worker_Assignment {
+	for _, assignment := range w.Assignments {
+		if assignment.TaskId == taskId && assignment.ThreadId == threadId {
+			return assignment
+		}
+	}
+	return nil
+}
+
+// AssignThread adds a new thread for the worker to work on
+func (w *Worker) AssignThread(taskId string, threadIndex int, threadId string) {
+	if w.IsWorkingOn(taskId, threadId) {
+		return
+	}
+	w.Assignments = append(w.Assignments, &Worker_Assignment{TaskId: taskId, ThreadIndex: int32(threadIndex), ThreadId: threadId})
+}
+
+// ReleaseThread removes the thread from the worker assignments
+func (w *Worker) ReleaseThread(taskId, threadId string) {
+	w.Assignments = slices.DeleteFunc(w.Assignments, func(a *Worker_Assignment) bool {
+		return a.TaskId == taskId && a.ThreadId == threadId
+	})
 }
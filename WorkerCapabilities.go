@@ -0,0 +1,79 @@
+package videoUpscaler
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/janction/videoUpscaler/vm"
+)
+
+// Validate makes sure the capabilities don't have negative values
+func (c WorkerCapabilities) Validate() error {
+	if c.CpuCores < 0 || c.MemoryMb < 0 || c.DiskMb < 0 || c.MaxInputWidth < 0 || c.MaxInputHeight < 0 {
+		return fmt.Errorf("capabilities can't have negative values")
+	}
+	for _, scale := range c.ScaleFactors {
+		if scale < 1 {
+			return fmt.Errorf("scale factor %v is invalid", scale)
+		}
+	}
+	for _, backend := range c.Backends {
+		if _, found := vm.GetBackend(backend); !found {
+			return fmt.Errorf("backend %s doesn't exist", backend)
+		}
+	}
+	return nil
+}
+
+// Validate makes sure the requirements don't have negative values
+func (r TaskRequirements) Validate() error {
+	if r.MinCpuCores < 0 || r.MinMemoryMb < 0 || r.MinDiskMb < 0 || r.InputWidth < 0 || r.InputHeight < 0 {
+		return fmt.Errorf("requirements can't have negative values")
+	}
+	if _, found := vm.GetBackend(r.Backend); !found {
+		return fmt.Errorf("backend %s doesn't exist", r.Backend)
+	}
+	return nil
+}
+
+// CanProcess returns nil if the worker capabilities match the requirements of the task,
+// or an error with the first requirement not met.
+// Workers without capabilities can only process tasks without requirements.
+// Workers that don't declare backends only support the default one, and workers that don't declare a max input
+// resolution accept any resolution
+func (w Worker) CanProcess(task VideoUpscalerTask) error {
+	c := w.Capabilities
+	if c == nil {
+		c = &WorkerCapabilities{}
+	}
+
+	if len(c.ScaleFactors) > 0 && !slices.Contains(c.ScaleFactors, task.Scale) {
+		return fmt.Errorf("scale %v is not supported", task.Scale)
+	}
+	if backend := task.GetBackend(); !slices.Contains(c.Backends, backend) && (len(c.Backends) > 0 || backend != DefaultBackend) {
+		return fmt.Errorf("backend %s is not supported", backend)
+	}
+
+	r := task.Requirements
+	if r == nil {
+		return nil
+	}
+
+	if c.CpuCores < r.MinCpuCores {
+		return fmt.Errorf("%v cpu cores required, worker has %v", r.MinCpuCores, c.CpuCores)
+	}
+	if c.MemoryMb < r.MinMemoryMb {
+		return fmt.Errorf("%v MB of memory required, worker has %v", r.MinMemoryMb, c.MemoryMb)
+	}
+	if c.DiskMb < r.MinDiskMb {
+		return fmt.Errorf("%v MB of disk required, worker has %v", r.MinDiskMb, c.DiskMb)
+	}
+	if r.Model != "" && !slices.Contains(c.Models, r.Model) {
+		return fmt.Errorf("model %s is not supported", r.Model)
+	}
+	// a max resolution of 0 means the worker didn't declare it
+	if (c.MaxInputWidth > 0 && r.InputWidth > c.MaxInputWidth) || (c.MaxInputHeight > 0 && r.InputHeight > c.MaxInputHeight) {
+		return fmt.Errorf("input resolution %vx%v is over the max of %vx%v", r.InputWidth, r.InputHeight, c.MaxInputWidth, c.MaxInputHeight)
+	}
+	return nil
+}
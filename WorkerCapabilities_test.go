@@ -0,0 +1,75 @@
+package videoUpscaler
+
+import (
+	"testing"
+
+	"github.com/janction/videoUpscaler/vm"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCanProcess(t *testing.T) {
+	task := VideoUpscalerTask{Scale: 2}
+
+	// workers without capabilities can only process tasks without requirements
+	worker := Worker{}
+	assert.NoError(t, worker.CanProcess(task))
+
+	task.Requirements = &TaskRequirements{MinCpuCores: 4, Backend: "realesrgan", InputWidth: 1920, InputHeight: 1080}
+	assert.Error(t, worker.CanProcess(task))
+
+	worker.Capabilities = &WorkerCapabilities{CpuCores: 8, ScaleFactors: []int32{2, 4}, Backends: []string{"realesrgan"}, MaxInputWidth: 3840, MaxInputHeight: 2160}
+	assert.NoError(t, worker.CanProcess(task))
+
+	task.Scale = 3
+	assert.Error(t, worker.CanProcess(task))
+
+	task.Scale = 4
+	task.Requirements.Model = "x4plus"
+	assert.Error(t, worker.CanProcess(task))
+
+	task.Requirements.Model = ""
+	task.Requirements.InputWidth = 7680
+	assert.Error(t, worker.CanProcess(task))
+}
+
+func TestCanProcessWithoutMaxResolution(t *testing.T) {
+	task := VideoUpscalerTask{Scale: 2, Requirements: &TaskRequirements{MinCpuCores: 4, InputWidth: 1920, InputHeight: 1080}}
+
+	// workers that don't declare a max resolution accept any input
+	worker := Worker{Capabilities: &WorkerCapabilities{CpuCores: 8}}
+	assert.NoError(t, worker.CanProcess(task))
+
+	// each dimension is only checked when declared
+	worker.Capabilities.MaxInputWidth = 1280
+	assert.Error(t, worker.CanProcess(task))
+
+	worker.Capabilities.MaxInputWidth = 0
+	worker.Capabilities.MaxInputHeight = 2160
+	assert.NoError(t, worker.CanProcess(task))
+
+	worker.Capabilities.MaxInputHeight = 720
+	assert.Error(t, worker.CanProcess(task))
+}
+
+func TestCanProcessBackend(t *testing.T) {
+	task := VideoUpscalerTask{Scale: 2, Options: &UpscaleOptions{Backend: vm.BackendFFmpegLanczos}}
+
+	// workers that don't declare backends only support the default one
+	worker := Worker{}
+	assert.Error(t, worker.CanProcess(task))
+
+	worker.Capabilities = &WorkerCapabilities{Backends: []string{vm.BackendFFmpegLanczos}}
+	assert.NoError(t, worker.CanProcess(task))
+
+	task.Options.Backend = ""
+	assert.Error(t, worker.CanProcess(task))
+}
+
+func TestCapabilitiesValidate(t *testing.T) {
+	assert.NoError(t, WorkerCapabilities{CpuCores: 4, ScaleFactors: []int32{2}}.Validate())
+	assert.Error(t, WorkerCapabilities{MemoryMb: -1}.Validate())
+	assert.Error(t, WorkerCapabilities{ScaleFactors: []int32{0}}.Validate())
+	assert.Error(t, WorkerCapabilities{Backends: []string{"waifu3x"}}.Validate())
+	assert.Error(t, TaskRequirements{MinDiskMb: -1}.Validate())
+	assert.Error(t, TaskRequirements{Backend: "waifu3x"}.Validate())
+}
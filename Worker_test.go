@@ -0,0 +1,49 @@
+package videoUpscaler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkerAssignments(t *testing.T) {
+	worker := Worker{Address: "worker", MaxThreads: 2}
+	assert.True(t, worker.HasCapacity())
+
+	worker.AssignThread("1", 0, "10")
+	worker.AssignThread("1", 0, "10")
+	assert.Len(t, worker.Assignments, 1)
+	assert.True(t, worker.HasCapacity())
+
+	worker.AssignThread("2", 1, "21")
+	assert.False(t, worker.HasCapacity())
+	assert.True(t, worker.IsWorkingOn("2", "21"))
+	assert.Equal(t, int32(1), worker.GetAssignment("2", "21").ThreadIndex)
+
+	worker.ReleaseThread("1", "10")
+	assert.False(t, worker.IsWorkingOn("1", "10"))
+	assert.True(t, worker.HasCapacity())
+}
+
+func TestWorkerDefaultCapacity(t *testing.T) {
+	worker := Worker{Address: "worker"}
+	assert.Equal(t, 1, worker.ThreadCapacity())
+
+	worker.AssignThread("1", 0, "10")
+	assert.False(t, worker.HasCapacity())
+}
+
+func TestWorkerNetworkChanged(t *testing.T) {
+	announceAddrs := []string{"/dns4/worker.example.com/tcp/4001"}
+	worker := Worker{Address: "worker", IpfsId: "12D3KooW", PublicIp: "1.2.3.4", AnnounceAddrs: announceAddrs}
+	assert.False(t, worker.NetworkChanged("1.2.3.4", "12D3KooW", announceAddrs))
+
+	// a new public ip with the same announced addresses is sent again, and other nodes connect again
+	assert.True(t, worker.NetworkChanged("5.6.7.8", "12D3KooW", announceAddrs))
+	updated := worker
+	updated.PublicIp = "5.6.7.8"
+	assert.NotEqual(t, worker.GetNetworkKey(), updated.GetNetworkKey())
+
+	assert.True(t, worker.NetworkChanged("1.2.3.4", "12D3KooX", announceAddrs))
+	assert.True(t, worker.NetworkChanged("1.2.3.4", "12D3KooW", nil))
+}
@@ -23,6 +23,9 @@ const (
 	Query_GetVideoUpscalerLogs_FullMethodName         = "/janction.videoUpscaler.v1.Query/GetVideoUpscalerLogs"
 	Query_GetWorker_FullMethodName                    = "/janction.videoUpscaler.v1.Query/GetWorker"
 	Query_GetPendingVideoUpscalerTasks_FullMethodName = "/janction.videoUpscaler.v1.Query/GetPendingVideoUpscalerTasks"
+	Query_GetTaskOutputManifest_FullMethodName        = "/janction.videoUpscaler.v1.Query/GetTaskOutputManifest"
+	Query_GetModuleAccounting_FullMethodName          = "/janction.videoUpscaler.v1.Query/GetModuleAccounting"
+	Query_GetWorkerGroup_FullMethodName               = "/janction.videoUpscaler.v1.Query/GetWorkerGroup"
 )
 
 // QueryClient is the client API for Query service.
@@ -34,6 +37,12 @@ type QueryClient interface {
 	GetVideoUpscalerLogs(ctx context.Context, in *QueryGetVideoUpscalerLogsRequest, opts ...grpc.CallOption) (*QueryGetVideoUpscalerLogsResponse, error)
 	GetWorker(ctx context.Context, in *QueryGetWorkerRequest, opts ...grpc.CallOption) (*QueryGetWorkerResponse, error)
 	GetPendingVideoUpscalerTasks(ctx context.Context, in *QueryGetPendingVideoUpscalerTaskRequest, opts ...grpc.CallOption) (*QueryGetPendingVideoUpscalerTaskResponse, error)
+	// GetTaskOutputManifest returns the ordered list of upscaled frames of a task
+	GetTaskOutputManifest(ctx context.Context, in *QueryGetTaskOutputManifestRequest, opts ...grpc.CallOption) (*QueryGetTaskOutputManifestResponse, error)
+	// GetModuleAccounting returns the escrowed rewards, worker stakes and payouts of the module
+	GetModuleAccounting(ctx context.Context, in *QueryGetModuleAccountingRequest, opts ...grpc.CallOption) (*QueryGetModuleAccountingResponse, error)
+	// GetWorkerGroup returns the members of a worker group
+	GetWorkerGroup(ctx context.Context, in *QueryGetWorkerGroupRequest, opts ...grpc.CallOption) (*QueryGetWorkerGroupResponse, error)
 }
 
 type queryClient struct {
@@ -80,6 +89,33 @@ func (c *queryClient) GetPendingVideoUpscalerTasks(ctx context.Context, in *Quer
 	return out, nil
 }
 
+func (c *queryClient) GetTaskOutputManifest(ctx context.Context, in *QueryGetTaskOutputManifestRequest, opts ...grpc.CallOption) (*QueryGetTaskOutputManifestResponse, error) {
+	out := new(QueryGetTaskOutputManifestResponse)
+	err := c.cc.Invoke(ctx, Query_GetTaskOutputManifest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetModuleAccounting(ctx context.Context, in *QueryGetModuleAccountingRequest, opts ...grpc.CallOption) (*QueryGetModuleAccountingResponse, error) {
+	out := new(QueryGetModuleAccountingResponse)
+	err := c.cc.Invoke(ctx, Query_GetModuleAccounting_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetWorkerGroup(ctx context.Context, in *QueryGetWorkerGroupRequest, opts ...grpc.CallOption) (*QueryGetWorkerGroupResponse, error) {
+	out := new(QueryGetWorkerGroupResponse)
+	err := c.cc.Invoke(ctx, Query_GetWorkerGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -89,6 +125,12 @@ type QueryServer interface {
 	GetVideoUpscalerLogs(context.Context, *QueryGetVideoUpscalerLogsRequest) (*QueryGetVideoUpscalerLogsResponse, error)
 	GetWorker(context.Context, *QueryGetWorkerRequest) (*QueryGetWorkerResponse, error)
 	GetPendingVideoUpscalerTasks(context.Context, *QueryGetPendingVideoUpscalerTaskRequest) (*QueryGetPendingVideoUpscalerTaskResponse, error)
+	// GetTaskOutputManifest returns the ordered list of upscaled frames of a task
+	GetTaskOutputManifest(context.Context, *QueryGetTaskOutputManifestRequest) (*QueryGetTaskOutputManifestResponse, error)
+	// GetModuleAccounting returns the escrowed rewards, worker stakes and payouts of the module
+	GetModuleAccounting(context.Context, *QueryGetModuleAccountingRequest) (*QueryGetModuleAccountingResponse, error)
+	// GetWorkerGroup returns the members of a worker group
+	GetWorkerGroup(context.Context, *QueryGetWorkerGroupRequest) (*QueryGetWorkerGroupResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -108,6 +150,15 @@ func (UnimplementedQueryServer) GetWorker(context.Context, *QueryGetWorkerReques
 func (UnimplementedQueryServer) GetPendingVideoUpscalerTasks(context.Context, *QueryGetPendingVideoUpscalerTaskRequest) (*QueryGetPendingVideoUpscalerTaskResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPendingVideoUpscalerTasks not implemented")
 }
+func (UnimplementedQueryServer) GetTaskOutputManifest(context.Context, *QueryGetTaskOutputManifestRequest) (*QueryGetTaskOutputManifestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTaskOutputManifest not implemented")
+}
+func (UnimplementedQueryServer) GetModuleAccounting(context.Context, *QueryGetModuleAccountingRequest) (*QueryGetModuleAccountingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetModuleAccounting not implemented")
+}
+func (UnimplementedQueryServer) GetWorkerGroup(context.Context, *QueryGetWorkerGroupRequest) (*QueryGetWorkerGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkerGroup not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -193,6 +244,60 @@ func _Query_GetPendingVideoUpscalerTasks_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetTaskOutputManifest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetTaskOutputManifestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetTaskOutputManifest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetTaskOutputManifest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTaskOutputManifest(ctx, req.(*QueryGetTaskOutputManifestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetModuleAccounting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetModuleAccountingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetModuleAccounting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetModuleAccounting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetModuleAccounting(ctx, req.(*QueryGetModuleAccountingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetWorkerGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetWorkerGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetWorkerGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetWorkerGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetWorkerGroup(ctx, req.(*QueryGetWorkerGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -216,6 +321,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPendingVideoUpscalerTasks",
 			Handler:    _Query_GetPendingVideoUpscalerTasks_Handler,
 		},
+		{
+			MethodName: "GetTaskOutputManifest",
+			Handler:    _Query_GetTaskOutputManifest_Handler,
+		},
+		{
+			MethodName: "GetModuleAccounting",
+			Handler:    _Query_GetModuleAccounting_Handler,
+		},
+		{
+			MethodName: "GetWorkerGroup",
+			Handler:    _Query_GetWorkerGroup_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "janction/videoUpscaler/v1/query.proto",
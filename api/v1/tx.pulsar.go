@@ -18,14 +18,22 @@ import (
 )
 
 var (
-	md_MsgCreateVideoUpscalerTask            protoreflect.MessageDescriptor
-	fd_MsgCreateVideoUpscalerTask_creator    protoreflect.FieldDescriptor
-	fd_MsgCreateVideoUpscalerTask_cid        protoreflect.FieldDescriptor
-	fd_MsgCreateVideoUpscalerTask_startFrame protoreflect.FieldDescriptor
-	fd_MsgCreateVideoUpscalerTask_endFrame   protoreflect.FieldDescriptor
-	fd_MsgCreateVideoUpscalerTask_threads    protoreflect.FieldDescriptor
-	fd_MsgCreateVideoUpscalerTask_scale      protoreflect.FieldDescriptor
-	fd_MsgCreateVideoUpscalerTask_reward     protoreflect.FieldDescriptor
+	md_MsgCreateVideoUpscalerTask                       protoreflect.MessageDescriptor
+	fd_MsgCreateVideoUpscalerTask_creator               protoreflect.FieldDescriptor
+	fd_MsgCreateVideoUpscalerTask_cid                   protoreflect.FieldDescriptor
+	fd_MsgCreateVideoUpscalerTask_startFrame            protoreflect.FieldDescriptor
+	fd_MsgCreateVideoUpscalerTask_endFrame              protoreflect.FieldDescriptor
+	fd_MsgCreateVideoUpscalerTask_threads               protoreflect.FieldDescriptor
+	fd_MsgCreateVideoUpscalerTask_scale                 protoreflect.FieldDescriptor
+	fd_MsgCreateVideoUpscalerTask_reward                protoreflect.FieldDescriptor
+	fd_MsgCreateVideoUpscalerTask_target_thread_seconds protoreflect.FieldDescriptor
+	fd_MsgCreateVideoUpscalerTask_requirements          protoreflect.FieldDescriptor
+	fd_MsgCreateVideoUpscalerTask_access                protoreflect.FieldDescriptor
+	fd_MsgCreateVideoUpscalerTask_expiry_height         protoreflect.FieldDescriptor
+	fd_MsgCreateVideoUpscalerTask_priority_fee          protoreflect.FieldDescriptor
+	fd_MsgCreateVideoUpscalerTask_options               protoreflect.FieldDescriptor
+	fd_MsgCreateVideoUpscalerTask_media_info            protoreflect.FieldDescriptor
+	fd_MsgCreateVideoUpscalerTask_input_type            protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -38,6 +46,14 @@ func init() {
 	fd_MsgCreateVideoUpscalerTask_threads = md_MsgCreateVideoUpscalerTask.Fields().ByName("threads")
 	fd_MsgCreateVideoUpscalerTask_scale = md_MsgCreateVideoUpscalerTask.Fields().ByName("scale")
 	fd_MsgCreateVideoUpscalerTask_reward = md_MsgCreateVideoUpscalerTask.Fields().ByName("reward")
+	fd_MsgCreateVideoUpscalerTask_target_thread_seconds = md_MsgCreateVideoUpscalerTask.Fields().ByName("target_thread_seconds")
+	fd_MsgCreateVideoUpscalerTask_requirements = md_MsgCreateVideoUpscalerTask.Fields().ByName("requirements")
+	fd_MsgCreateVideoUpscalerTask_access = md_MsgCreateVideoUpscalerTask.Fields().ByName("access")
+	fd_MsgCreateVideoUpscalerTask_expiry_height = md_MsgCreateVideoUpscalerTask.Fields().ByName("expiry_height")
+	fd_MsgCreateVideoUpscalerTask_priority_fee = md_MsgCreateVideoUpscalerTask.Fields().ByName("priority_fee")
+	fd_MsgCreateVideoUpscalerTask_options = md_MsgCreateVideoUpscalerTask.Fields().ByName("options")
+	fd_MsgCreateVideoUpscalerTask_media_info = md_MsgCreateVideoUpscalerTask.Fields().ByName("media_info")
+	fd_MsgCreateVideoUpscalerTask_input_type = md_MsgCreateVideoUpscalerTask.Fields().ByName("input_type")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgCreateVideoUpscalerTask)(nil)
@@ -147,6 +163,54 @@ func (x *fastReflection_MsgCreateVideoUpscalerTask) Range(f func(protoreflect.Fi
 			return
 		}
 	}
+	if x.TargetThreadSeconds != int64(0) {
+		value := protoreflect.ValueOfInt64(x.TargetThreadSeconds)
+		if !f(fd_MsgCreateVideoUpscalerTask_target_thread_seconds, value) {
+			return
+		}
+	}
+	if x.Requirements != nil {
+		value := protoreflect.ValueOfMessage(x.Requirements.ProtoReflect())
+		if !f(fd_MsgCreateVideoUpscalerTask_requirements, value) {
+			return
+		}
+	}
+	if x.Access != nil {
+		value := protoreflect.ValueOfMessage(x.Access.ProtoReflect())
+		if !f(fd_MsgCreateVideoUpscalerTask_access, value) {
+			return
+		}
+	}
+	if x.ExpiryHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ExpiryHeight)
+		if !f(fd_MsgCreateVideoUpscalerTask_expiry_height, value) {
+			return
+		}
+	}
+	if x.PriorityFee != nil {
+		value := protoreflect.ValueOfMessage(x.PriorityFee.ProtoReflect())
+		if !f(fd_MsgCreateVideoUpscalerTask_priority_fee, value) {
+			return
+		}
+	}
+	if x.Options != nil {
+		value := protoreflect.ValueOfMessage(x.Options.ProtoReflect())
+		if !f(fd_MsgCreateVideoUpscalerTask_options, value) {
+			return
+		}
+	}
+	if x.MediaInfo != nil {
+		value := protoreflect.ValueOfMessage(x.MediaInfo.ProtoReflect())
+		if !f(fd_MsgCreateVideoUpscalerTask_media_info, value) {
+			return
+		}
+	}
+	if x.InputType != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.InputType))
+		if !f(fd_MsgCreateVideoUpscalerTask_input_type, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -176,6 +240,22 @@ func (x *fastReflection_MsgCreateVideoUpscalerTask) Has(fd protoreflect.FieldDes
 		return x.Scale != int32(0)
 	case "janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask.reward":
 		return x.Reward != nil
+	case "janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask.target_thread_seconds":
+		return x.TargetThreadSeconds != int64(0)
+	case "janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask.requirements":
+		return x.Requirements != nil
+	case "janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask.access":
+		return x.Access != nil
+	case "janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask.expiry_height":
+		return x.ExpiryHeight != int64(0)
+	case "janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask.priority_fee":
+		return x.PriorityFee != nil
+	case "janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask.options":
+		return x.Options != nil
+	case "janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask.media_info":
+		return x.MediaInfo != nil
+	case "janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask.input_type":
+		return x.InputType != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask"))
@@ -206,6 +286,22 @@ func (x *fastReflection_MsgCreateVideoUpscalerTask) Clear(fd protoreflect.FieldD
 		x.Scale = int32(0)
 	case "janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask.reward":
 		x.Reward = nil
+	case "janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask.target_thread_seconds":
+		x.TargetThreadSeconds = int64(0)
+	case "janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask.requirements":
+		x.Requirements = nil
+	case "janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask.access":
+		x.Access = nil
+	case "janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask.expiry_height":
+		x.ExpiryHeight = int64(0)
+	case "janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask.priority_fee":
+		x.PriorityFee = nil
+	case "janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask.options":
+		x.Options = nil
+	case "janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask.media_info":
+		x.MediaInfo = nil
+	case "janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask.input_type":
+		x.InputType = 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask"))
@@ -243,6 +339,30 @@ func (x *fastReflection_MsgCreateVideoUpscalerTask) Get(descriptor protoreflect.
 	case "janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask.reward":
 		value := x.Reward
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask.target_thread_seconds":
+		value := x.TargetThreadSeconds
+		return protoreflect.ValueOfInt64(value)
+	case "janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask.requirements":
+		value := x.Requirements
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask.access":
+		value := x.Access
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask.expiry_height":
+		value := x.ExpiryHeight
+		return protoreflect.ValueOfInt64(value)
+	case "janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask.priority_fee":
+		value := x.PriorityFee
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask.options":
+		value := x.Options
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask.media_info":
+		value := x.MediaInfo
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask.input_type":
+		value := x.InputType
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask"))
@@ -277,6 +397,22 @@ func (x *fastReflection_MsgCreateVideoUpscalerTask) Set(fd protoreflect.FieldDes
 		x.Scale = int32(value.Int())
 	case "janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask.reward":
 		x.Reward = value.Message().Interface().(*v1beta1.Coin)
+	case "janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask.target_thread_seconds":
+		x.TargetThreadSeconds = value.Int()
+	case "janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask.requirements":
+		x.Requirements = value.Message().Interface().(*TaskRequirements)
+	case "janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask.access":
+		x.Access = value.Message().Interface().(*TaskAccess)
+	case "janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask.expiry_height":
+		x.ExpiryHeight = value.Int()
+	case "janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask.priority_fee":
+		x.PriorityFee = value.Message().Interface().(*v1beta1.Coin)
+	case "janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask.options":
+		x.Options = value.Message().Interface().(*UpscaleOptions)
+	case "janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask.media_info":
+		x.MediaInfo = value.Message().Interface().(*MediaInfo)
+	case "janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask.input_type":
+		x.InputType = (InputType)(value.Enum())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask"))
@@ -302,6 +438,31 @@ func (x *fastReflection_MsgCreateVideoUpscalerTask) Mutable(fd protoreflect.Fiel
 			x.Reward = new(v1beta1.Coin)
 		}
 		return protoreflect.ValueOfMessage(x.Reward.ProtoReflect())
+	case "janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask.requirements":
+		if x.Requirements == nil {
+			x.Requirements = new(TaskRequirements)
+		}
+		return protoreflect.ValueOfMessage(x.Requirements.ProtoReflect())
+	case "janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask.access":
+		if x.Access == nil {
+			x.Access = new(TaskAccess)
+		}
+		return protoreflect.ValueOfMessage(x.Access.ProtoReflect())
+	case "janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask.priority_fee":
+		if x.PriorityFee == nil {
+			x.PriorityFee = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.PriorityFee.ProtoReflect())
+	case "janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask.options":
+		if x.Options == nil {
+			x.Options = new(UpscaleOptions)
+		}
+		return protoreflect.ValueOfMessage(x.Options.ProtoReflect())
+	case "janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask.media_info":
+		if x.MediaInfo == nil {
+			x.MediaInfo = new(MediaInfo)
+		}
+		return protoreflect.ValueOfMessage(x.MediaInfo.ProtoReflect())
 	case "janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask.creator":
 		panic(fmt.Errorf("field creator of message janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask is not mutable"))
 	case "janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask.cid":
@@ -314,6 +475,12 @@ func (x *fastReflection_MsgCreateVideoUpscalerTask) Mutable(fd protoreflect.Fiel
 		panic(fmt.Errorf("field threads of message janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask is not mutable"))
 	case "janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask.scale":
 		panic(fmt.Errorf("field scale of message janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask is not mutable"))
+	case "janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask.target_thread_seconds":
+		panic(fmt.Errorf("field target_thread_seconds of message janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask is not mutable"))
+	case "janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask.expiry_height":
+		panic(fmt.Errorf("field expiry_height of message janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask is not mutable"))
+	case "janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask.input_type":
+		panic(fmt.Errorf("field input_type of message janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask"))
@@ -342,6 +509,27 @@ func (x *fastReflection_MsgCreateVideoUpscalerTask) NewField(fd protoreflect.Fie
 	case "janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask.reward":
 		m := new(v1beta1.Coin)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask.target_thread_seconds":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask.requirements":
+		m := new(TaskRequirements)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask.access":
+		m := new(TaskAccess)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask.expiry_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask.priority_fee":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask.options":
+		m := new(UpscaleOptions)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask.media_info":
+		m := new(MediaInfo)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask.input_type":
+		return protoreflect.ValueOfEnum(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask"))
@@ -435,6 +623,35 @@ func (x *fastReflection_MsgCreateVideoUpscalerTask) ProtoMethods() *protoiface.M
 			l = options.Size(x.Reward)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.TargetThreadSeconds != 0 {
+			n += 1 + runtime.Sov(uint64(x.TargetThreadSeconds))
+		}
+		if x.Requirements != nil {
+			l = options.Size(x.Requirements)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Access != nil {
+			l = options.Size(x.Access)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ExpiryHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExpiryHeight))
+		}
+		if x.PriorityFee != nil {
+			l = options.Size(x.PriorityFee)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Options != nil {
+			l = options.Size(x.Options)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MediaInfo != nil {
+			l = options.Size(x.MediaInfo)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.InputType != 0 {
+			n += 1 + runtime.Sov(uint64(x.InputType))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -464,6 +681,91 @@ func (x *fastReflection_MsgCreateVideoUpscalerTask) ProtoMethods() *protoiface.M
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.InputType != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.InputType))
+			i--
+			dAtA[i] = 0x78
+		}
+		if x.MediaInfo != nil {
+			encoded, err := options.Marshal(x.MediaInfo)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x72
+		}
+		if x.Options != nil {
+			encoded, err := options.Marshal(x.Options)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if x.PriorityFee != nil {
+			encoded, err := options.Marshal(x.PriorityFee)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if x.ExpiryHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExpiryHeight))
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.Access != nil {
+			encoded, err := options.Marshal(x.Access)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if x.Requirements != nil {
+			encoded, err := options.Marshal(x.Requirements)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if x.TargetThreadSeconds != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TargetThreadSeconds))
+			i--
+			dAtA[i] = 0x40
+		}
 		if x.Reward != nil {
 			encoded, err := options.Marshal(x.Reward)
 			if err != nil {
@@ -737,89 +1039,326 @@ func (x *fastReflection_MsgCreateVideoUpscalerTask) ProtoMethods() *protoiface.M
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TargetThreadSeconds", wireType)
+				}
+				x.TargetThreadSeconds = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TargetThreadSeconds |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Requirements", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Requirements == nil {
+					x.Requirements = &TaskRequirements{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Requirements); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Access", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				if x.Access == nil {
+					x.Access = &TaskAccess{}
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-var (
-	md_MsgCreateVideoUpscalerTaskResponse         protoreflect.MessageDescriptor
-	fd_MsgCreateVideoUpscalerTaskResponse_task_id protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_janction_videoUpscaler_v1_tx_proto_init()
-	md_MsgCreateVideoUpscalerTaskResponse = File_janction_videoUpscaler_v1_tx_proto.Messages().ByName("MsgCreateVideoUpscalerTaskResponse")
-	fd_MsgCreateVideoUpscalerTaskResponse_task_id = md_MsgCreateVideoUpscalerTaskResponse.Fields().ByName("task_id")
-}
-
-var _ protoreflect.Message = (*fastReflection_MsgCreateVideoUpscalerTaskResponse)(nil)
-
-type fastReflection_MsgCreateVideoUpscalerTaskResponse MsgCreateVideoUpscalerTaskResponse
-
-func (x *MsgCreateVideoUpscalerTaskResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgCreateVideoUpscalerTaskResponse)(x)
-}
-
-func (x *MsgCreateVideoUpscalerTaskResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-var _fastReflection_MsgCreateVideoUpscalerTaskResponse_messageType fastReflection_MsgCreateVideoUpscalerTaskResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgCreateVideoUpscalerTaskResponse_messageType{}
-
-type fastReflection_MsgCreateVideoUpscalerTaskResponse_messageType struct{}
-
-func (x fastReflection_MsgCreateVideoUpscalerTaskResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgCreateVideoUpscalerTaskResponse)(nil)
-}
-func (x fastReflection_MsgCreateVideoUpscalerTaskResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgCreateVideoUpscalerTaskResponse)
-}
-func (x fastReflection_MsgCreateVideoUpscalerTaskResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgCreateVideoUpscalerTaskResponse
-}
-
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Access); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+				}
+				x.ExpiryHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExpiryHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PriorityFee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.PriorityFee == nil {
+					x.PriorityFee = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PriorityFee); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Options == nil {
+					x.Options = &UpscaleOptions{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Options); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MediaInfo", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.MediaInfo == nil {
+					x.MediaInfo = &MediaInfo{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MediaInfo); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 15:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InputType", wireType)
+				}
+				x.InputType = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.InputType |= InputType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgCreateVideoUpscalerTaskResponse         protoreflect.MessageDescriptor
+	fd_MsgCreateVideoUpscalerTaskResponse_task_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_janction_videoUpscaler_v1_tx_proto_init()
+	md_MsgCreateVideoUpscalerTaskResponse = File_janction_videoUpscaler_v1_tx_proto.Messages().ByName("MsgCreateVideoUpscalerTaskResponse")
+	fd_MsgCreateVideoUpscalerTaskResponse_task_id = md_MsgCreateVideoUpscalerTaskResponse.Fields().ByName("task_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgCreateVideoUpscalerTaskResponse)(nil)
+
+type fastReflection_MsgCreateVideoUpscalerTaskResponse MsgCreateVideoUpscalerTaskResponse
+
+func (x *MsgCreateVideoUpscalerTaskResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgCreateVideoUpscalerTaskResponse)(x)
+}
+
+func (x *MsgCreateVideoUpscalerTaskResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgCreateVideoUpscalerTaskResponse_messageType fastReflection_MsgCreateVideoUpscalerTaskResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgCreateVideoUpscalerTaskResponse_messageType{}
+
+type fastReflection_MsgCreateVideoUpscalerTaskResponse_messageType struct{}
+
+func (x fastReflection_MsgCreateVideoUpscalerTaskResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgCreateVideoUpscalerTaskResponse)(nil)
+}
+func (x fastReflection_MsgCreateVideoUpscalerTaskResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgCreateVideoUpscalerTaskResponse)
+}
+func (x fastReflection_MsgCreateVideoUpscalerTaskResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCreateVideoUpscalerTaskResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
 func (x *fastReflection_MsgCreateVideoUpscalerTaskResponse) Descriptor() protoreflect.MessageDescriptor {
 	return md_MsgCreateVideoUpscalerTaskResponse
 }
@@ -1192,12 +1731,61 @@ func (x *fastReflection_MsgCreateVideoUpscalerTaskResponse) ProtoMethods() *prot
 	}
 }
 
+var _ protoreflect.List = (*_MsgAddWorker_7_list)(nil)
+
+type _MsgAddWorker_7_list struct {
+	list *[]string
+}
+
+func (x *_MsgAddWorker_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgAddWorker_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MsgAddWorker_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgAddWorker_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgAddWorker_7_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgAddWorker at list field AnnounceAddrs as it is not of Message kind"))
+}
+
+func (x *_MsgAddWorker_7_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgAddWorker_7_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MsgAddWorker_7_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_MsgAddWorker           protoreflect.MessageDescriptor
-	fd_MsgAddWorker_creator   protoreflect.FieldDescriptor
-	fd_MsgAddWorker_public_ip protoreflect.FieldDescriptor
-	fd_MsgAddWorker_ipfs_id   protoreflect.FieldDescriptor
-	fd_MsgAddWorker_stake     protoreflect.FieldDescriptor
+	md_MsgAddWorker                protoreflect.MessageDescriptor
+	fd_MsgAddWorker_creator        protoreflect.FieldDescriptor
+	fd_MsgAddWorker_public_ip      protoreflect.FieldDescriptor
+	fd_MsgAddWorker_ipfs_id        protoreflect.FieldDescriptor
+	fd_MsgAddWorker_stake          protoreflect.FieldDescriptor
+	fd_MsgAddWorker_max_threads    protoreflect.FieldDescriptor
+	fd_MsgAddWorker_capabilities   protoreflect.FieldDescriptor
+	fd_MsgAddWorker_announce_addrs protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1207,6 +1795,9 @@ func init() {
 	fd_MsgAddWorker_public_ip = md_MsgAddWorker.Fields().ByName("public_ip")
 	fd_MsgAddWorker_ipfs_id = md_MsgAddWorker.Fields().ByName("ipfs_id")
 	fd_MsgAddWorker_stake = md_MsgAddWorker.Fields().ByName("stake")
+	fd_MsgAddWorker_max_threads = md_MsgAddWorker.Fields().ByName("max_threads")
+	fd_MsgAddWorker_capabilities = md_MsgAddWorker.Fields().ByName("capabilities")
+	fd_MsgAddWorker_announce_addrs = md_MsgAddWorker.Fields().ByName("announce_addrs")
 }
 
 var _ protoreflect.Message = (*fastReflection_MsgAddWorker)(nil)
@@ -1298,6 +1889,24 @@ func (x *fastReflection_MsgAddWorker) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if x.MaxThreads != int32(0) {
+		value := protoreflect.ValueOfInt32(x.MaxThreads)
+		if !f(fd_MsgAddWorker_max_threads, value) {
+			return
+		}
+	}
+	if x.Capabilities != nil {
+		value := protoreflect.ValueOfMessage(x.Capabilities.ProtoReflect())
+		if !f(fd_MsgAddWorker_capabilities, value) {
+			return
+		}
+	}
+	if len(x.AnnounceAddrs) != 0 {
+		value := protoreflect.ValueOfList(&_MsgAddWorker_7_list{list: &x.AnnounceAddrs})
+		if !f(fd_MsgAddWorker_announce_addrs, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1321,6 +1930,12 @@ func (x *fastReflection_MsgAddWorker) Has(fd protoreflect.FieldDescriptor) bool
 		return x.IpfsId != ""
 	case "janction.videoUpscaler.v1.MsgAddWorker.stake":
 		return x.Stake != nil
+	case "janction.videoUpscaler.v1.MsgAddWorker.max_threads":
+		return x.MaxThreads != int32(0)
+	case "janction.videoUpscaler.v1.MsgAddWorker.capabilities":
+		return x.Capabilities != nil
+	case "janction.videoUpscaler.v1.MsgAddWorker.announce_addrs":
+		return len(x.AnnounceAddrs) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgAddWorker"))
@@ -1345,6 +1960,12 @@ func (x *fastReflection_MsgAddWorker) Clear(fd protoreflect.FieldDescriptor) {
 		x.IpfsId = ""
 	case "janction.videoUpscaler.v1.MsgAddWorker.stake":
 		x.Stake = nil
+	case "janction.videoUpscaler.v1.MsgAddWorker.max_threads":
+		x.MaxThreads = int32(0)
+	case "janction.videoUpscaler.v1.MsgAddWorker.capabilities":
+		x.Capabilities = nil
+	case "janction.videoUpscaler.v1.MsgAddWorker.announce_addrs":
+		x.AnnounceAddrs = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgAddWorker"))
@@ -1373,9 +1994,21 @@ func (x *fastReflection_MsgAddWorker) Get(descriptor protoreflect.FieldDescripto
 	case "janction.videoUpscaler.v1.MsgAddWorker.stake":
 		value := x.Stake
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	default:
-		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgAddWorker"))
+	case "janction.videoUpscaler.v1.MsgAddWorker.max_threads":
+		value := x.MaxThreads
+		return protoreflect.ValueOfInt32(value)
+	case "janction.videoUpscaler.v1.MsgAddWorker.capabilities":
+		value := x.Capabilities
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "janction.videoUpscaler.v1.MsgAddWorker.announce_addrs":
+		if len(x.AnnounceAddrs) == 0 {
+			return protoreflect.ValueOfList(&_MsgAddWorker_7_list{})
+		}
+		listValue := &_MsgAddWorker_7_list{list: &x.AnnounceAddrs}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgAddWorker"))
 		}
 		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgAddWorker does not contain field %s", descriptor.FullName()))
 	}
@@ -1401,6 +2034,14 @@ func (x *fastReflection_MsgAddWorker) Set(fd protoreflect.FieldDescriptor, value
 		x.IpfsId = value.Interface().(string)
 	case "janction.videoUpscaler.v1.MsgAddWorker.stake":
 		x.Stake = value.Message().Interface().(*v1beta1.Coin)
+	case "janction.videoUpscaler.v1.MsgAddWorker.max_threads":
+		x.MaxThreads = int32(value.Int())
+	case "janction.videoUpscaler.v1.MsgAddWorker.capabilities":
+		x.Capabilities = value.Message().Interface().(*WorkerCapabilities)
+	case "janction.videoUpscaler.v1.MsgAddWorker.announce_addrs":
+		lv := value.List()
+		clv := lv.(*_MsgAddWorker_7_list)
+		x.AnnounceAddrs = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgAddWorker"))
@@ -1426,12 +2067,25 @@ func (x *fastReflection_MsgAddWorker) Mutable(fd protoreflect.FieldDescriptor) p
 			x.Stake = new(v1beta1.Coin)
 		}
 		return protoreflect.ValueOfMessage(x.Stake.ProtoReflect())
+	case "janction.videoUpscaler.v1.MsgAddWorker.capabilities":
+		if x.Capabilities == nil {
+			x.Capabilities = new(WorkerCapabilities)
+		}
+		return protoreflect.ValueOfMessage(x.Capabilities.ProtoReflect())
+	case "janction.videoUpscaler.v1.MsgAddWorker.announce_addrs":
+		if x.AnnounceAddrs == nil {
+			x.AnnounceAddrs = []string{}
+		}
+		value := &_MsgAddWorker_7_list{list: &x.AnnounceAddrs}
+		return protoreflect.ValueOfList(value)
 	case "janction.videoUpscaler.v1.MsgAddWorker.creator":
 		panic(fmt.Errorf("field creator of message janction.videoUpscaler.v1.MsgAddWorker is not mutable"))
 	case "janction.videoUpscaler.v1.MsgAddWorker.public_ip":
 		panic(fmt.Errorf("field public_ip of message janction.videoUpscaler.v1.MsgAddWorker is not mutable"))
 	case "janction.videoUpscaler.v1.MsgAddWorker.ipfs_id":
 		panic(fmt.Errorf("field ipfs_id of message janction.videoUpscaler.v1.MsgAddWorker is not mutable"))
+	case "janction.videoUpscaler.v1.MsgAddWorker.max_threads":
+		panic(fmt.Errorf("field max_threads of message janction.videoUpscaler.v1.MsgAddWorker is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgAddWorker"))
@@ -1454,6 +2108,14 @@ func (x *fastReflection_MsgAddWorker) NewField(fd protoreflect.FieldDescriptor)
 	case "janction.videoUpscaler.v1.MsgAddWorker.stake":
 		m := new(v1beta1.Coin)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "janction.videoUpscaler.v1.MsgAddWorker.max_threads":
+		return protoreflect.ValueOfInt32(int32(0))
+	case "janction.videoUpscaler.v1.MsgAddWorker.capabilities":
+		m := new(WorkerCapabilities)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "janction.videoUpscaler.v1.MsgAddWorker.announce_addrs":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MsgAddWorker_7_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgAddWorker"))
@@ -1539,6 +2201,19 @@ func (x *fastReflection_MsgAddWorker) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.Stake)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.MaxThreads != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxThreads))
+		}
+		if x.Capabilities != nil {
+			l = options.Size(x.Capabilities)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.AnnounceAddrs) > 0 {
+			for _, s := range x.AnnounceAddrs {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1568,6 +2243,34 @@ func (x *fastReflection_MsgAddWorker) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.AnnounceAddrs) > 0 {
+			for iNdEx := len(x.AnnounceAddrs) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AnnounceAddrs[iNdEx])
+				copy(dAtA[i:], x.AnnounceAddrs[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AnnounceAddrs[iNdEx])))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if x.Capabilities != nil {
+			encoded, err := options.Marshal(x.Capabilities)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.MaxThreads != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxThreads))
+			i--
+			dAtA[i] = 0x28
+		}
 		if x.Stake != nil {
 			encoded, err := options.Marshal(x.Stake)
 			if err != nil {
@@ -1784,6 +2487,93 @@ func (x *fastReflection_MsgAddWorker) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxThreads", wireType)
+				}
+				x.MaxThreads = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxThreads |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Capabilities", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Capabilities == nil {
+					x.Capabilities = &WorkerCapabilities{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Capabilities); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AnnounceAddrs", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AnnounceAddrs = append(x.AnnounceAddrs, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2293,30 +3083,76 @@ func (x *fastReflection_MsgAddWorkerResponse) ProtoMethods() *protoiface.Methods
 	}
 }
 
+var _ protoreflect.List = (*_MsgSetWorkerGroup_3_list)(nil)
+
+type _MsgSetWorkerGroup_3_list struct {
+	list *[]string
+}
+
+func (x *_MsgSetWorkerGroup_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgSetWorkerGroup_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MsgSetWorkerGroup_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgSetWorkerGroup_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgSetWorkerGroup_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgSetWorkerGroup at list field Members as it is not of Message kind"))
+}
+
+func (x *_MsgSetWorkerGroup_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgSetWorkerGroup_3_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MsgSetWorkerGroup_3_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_MsgSubscribeWorkerToTask          protoreflect.MessageDescriptor
-	fd_MsgSubscribeWorkerToTask_address  protoreflect.FieldDescriptor
-	fd_MsgSubscribeWorkerToTask_taskId   protoreflect.FieldDescriptor
-	fd_MsgSubscribeWorkerToTask_threadId protoreflect.FieldDescriptor
+	md_MsgSetWorkerGroup         protoreflect.MessageDescriptor
+	fd_MsgSetWorkerGroup_creator protoreflect.FieldDescriptor
+	fd_MsgSetWorkerGroup_name    protoreflect.FieldDescriptor
+	fd_MsgSetWorkerGroup_members protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_janction_videoUpscaler_v1_tx_proto_init()
-	md_MsgSubscribeWorkerToTask = File_janction_videoUpscaler_v1_tx_proto.Messages().ByName("MsgSubscribeWorkerToTask")
-	fd_MsgSubscribeWorkerToTask_address = md_MsgSubscribeWorkerToTask.Fields().ByName("address")
-	fd_MsgSubscribeWorkerToTask_taskId = md_MsgSubscribeWorkerToTask.Fields().ByName("taskId")
-	fd_MsgSubscribeWorkerToTask_threadId = md_MsgSubscribeWorkerToTask.Fields().ByName("threadId")
+	md_MsgSetWorkerGroup = File_janction_videoUpscaler_v1_tx_proto.Messages().ByName("MsgSetWorkerGroup")
+	fd_MsgSetWorkerGroup_creator = md_MsgSetWorkerGroup.Fields().ByName("creator")
+	fd_MsgSetWorkerGroup_name = md_MsgSetWorkerGroup.Fields().ByName("name")
+	fd_MsgSetWorkerGroup_members = md_MsgSetWorkerGroup.Fields().ByName("members")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgSubscribeWorkerToTask)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgSetWorkerGroup)(nil)
 
-type fastReflection_MsgSubscribeWorkerToTask MsgSubscribeWorkerToTask
+type fastReflection_MsgSetWorkerGroup MsgSetWorkerGroup
 
-func (x *MsgSubscribeWorkerToTask) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgSubscribeWorkerToTask)(x)
+func (x *MsgSetWorkerGroup) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSetWorkerGroup)(x)
 }
 
-func (x *MsgSubscribeWorkerToTask) slowProtoReflect() protoreflect.Message {
+func (x *MsgSetWorkerGroup) slowProtoReflect() protoreflect.Message {
 	mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2328,43 +3164,43 @@ func (x *MsgSubscribeWorkerToTask) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgSubscribeWorkerToTask_messageType fastReflection_MsgSubscribeWorkerToTask_messageType
-var _ protoreflect.MessageType = fastReflection_MsgSubscribeWorkerToTask_messageType{}
+var _fastReflection_MsgSetWorkerGroup_messageType fastReflection_MsgSetWorkerGroup_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSetWorkerGroup_messageType{}
 
-type fastReflection_MsgSubscribeWorkerToTask_messageType struct{}
+type fastReflection_MsgSetWorkerGroup_messageType struct{}
 
-func (x fastReflection_MsgSubscribeWorkerToTask_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgSubscribeWorkerToTask)(nil)
+func (x fastReflection_MsgSetWorkerGroup_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSetWorkerGroup)(nil)
 }
-func (x fastReflection_MsgSubscribeWorkerToTask_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgSubscribeWorkerToTask)
+func (x fastReflection_MsgSetWorkerGroup_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSetWorkerGroup)
 }
-func (x fastReflection_MsgSubscribeWorkerToTask_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSubscribeWorkerToTask
+func (x fastReflection_MsgSetWorkerGroup_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetWorkerGroup
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgSubscribeWorkerToTask) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSubscribeWorkerToTask
+func (x *fastReflection_MsgSetWorkerGroup) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetWorkerGroup
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgSubscribeWorkerToTask) Type() protoreflect.MessageType {
-	return _fastReflection_MsgSubscribeWorkerToTask_messageType
+func (x *fastReflection_MsgSetWorkerGroup) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSetWorkerGroup_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgSubscribeWorkerToTask) New() protoreflect.Message {
-	return new(fastReflection_MsgSubscribeWorkerToTask)
+func (x *fastReflection_MsgSetWorkerGroup) New() protoreflect.Message {
+	return new(fastReflection_MsgSetWorkerGroup)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgSubscribeWorkerToTask) Interface() protoreflect.ProtoMessage {
-	return (*MsgSubscribeWorkerToTask)(x)
+func (x *fastReflection_MsgSetWorkerGroup) Interface() protoreflect.ProtoMessage {
+	return (*MsgSetWorkerGroup)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2372,22 +3208,22 @@ func (x *fastReflection_MsgSubscribeWorkerToTask) Interface() protoreflect.Proto
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgSubscribeWorkerToTask) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Address != "" {
-		value := protoreflect.ValueOfString(x.Address)
-		if !f(fd_MsgSubscribeWorkerToTask_address, value) {
+func (x *fastReflection_MsgSetWorkerGroup) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgSetWorkerGroup_creator, value) {
 			return
 		}
 	}
-	if x.TaskId != "" {
-		value := protoreflect.ValueOfString(x.TaskId)
-		if !f(fd_MsgSubscribeWorkerToTask_taskId, value) {
+	if x.Name != "" {
+		value := protoreflect.ValueOfString(x.Name)
+		if !f(fd_MsgSetWorkerGroup_name, value) {
 			return
 		}
 	}
-	if x.ThreadId != "" {
-		value := protoreflect.ValueOfString(x.ThreadId)
-		if !f(fd_MsgSubscribeWorkerToTask_threadId, value) {
+	if len(x.Members) != 0 {
+		value := protoreflect.ValueOfList(&_MsgSetWorkerGroup_3_list{list: &x.Members})
+		if !f(fd_MsgSetWorkerGroup_members, value) {
 			return
 		}
 	}
@@ -2404,19 +3240,19 @@ func (x *fastReflection_MsgSubscribeWorkerToTask) Range(f func(protoreflect.Fiel
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgSubscribeWorkerToTask) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgSetWorkerGroup) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "janction.videoUpscaler.v1.MsgSubscribeWorkerToTask.address":
-		return x.Address != ""
-	case "janction.videoUpscaler.v1.MsgSubscribeWorkerToTask.taskId":
-		return x.TaskId != ""
-	case "janction.videoUpscaler.v1.MsgSubscribeWorkerToTask.threadId":
-		return x.ThreadId != ""
+	case "janction.videoUpscaler.v1.MsgSetWorkerGroup.creator":
+		return x.Creator != ""
+	case "janction.videoUpscaler.v1.MsgSetWorkerGroup.name":
+		return x.Name != ""
+	case "janction.videoUpscaler.v1.MsgSetWorkerGroup.members":
+		return len(x.Members) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubscribeWorkerToTask"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSetWorkerGroup"))
 		}
-		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubscribeWorkerToTask does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSetWorkerGroup does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2426,19 +3262,19 @@ func (x *fastReflection_MsgSubscribeWorkerToTask) Has(fd protoreflect.FieldDescr
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubscribeWorkerToTask) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgSetWorkerGroup) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "janction.videoUpscaler.v1.MsgSubscribeWorkerToTask.address":
-		x.Address = ""
-	case "janction.videoUpscaler.v1.MsgSubscribeWorkerToTask.taskId":
-		x.TaskId = ""
-	case "janction.videoUpscaler.v1.MsgSubscribeWorkerToTask.threadId":
-		x.ThreadId = ""
+	case "janction.videoUpscaler.v1.MsgSetWorkerGroup.creator":
+		x.Creator = ""
+	case "janction.videoUpscaler.v1.MsgSetWorkerGroup.name":
+		x.Name = ""
+	case "janction.videoUpscaler.v1.MsgSetWorkerGroup.members":
+		x.Members = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubscribeWorkerToTask"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSetWorkerGroup"))
 		}
-		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubscribeWorkerToTask does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSetWorkerGroup does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2448,22 +3284,25 @@ func (x *fastReflection_MsgSubscribeWorkerToTask) Clear(fd protoreflect.FieldDes
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgSubscribeWorkerToTask) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgSetWorkerGroup) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "janction.videoUpscaler.v1.MsgSubscribeWorkerToTask.address":
-		value := x.Address
-		return protoreflect.ValueOfString(value)
-	case "janction.videoUpscaler.v1.MsgSubscribeWorkerToTask.taskId":
-		value := x.TaskId
+	case "janction.videoUpscaler.v1.MsgSetWorkerGroup.creator":
+		value := x.Creator
 		return protoreflect.ValueOfString(value)
-	case "janction.videoUpscaler.v1.MsgSubscribeWorkerToTask.threadId":
-		value := x.ThreadId
+	case "janction.videoUpscaler.v1.MsgSetWorkerGroup.name":
+		value := x.Name
 		return protoreflect.ValueOfString(value)
+	case "janction.videoUpscaler.v1.MsgSetWorkerGroup.members":
+		if len(x.Members) == 0 {
+			return protoreflect.ValueOfList(&_MsgSetWorkerGroup_3_list{})
+		}
+		listValue := &_MsgSetWorkerGroup_3_list{list: &x.Members}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubscribeWorkerToTask"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSetWorkerGroup"))
 		}
-		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubscribeWorkerToTask does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSetWorkerGroup does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2477,19 +3316,21 @@ func (x *fastReflection_MsgSubscribeWorkerToTask) Get(descriptor protoreflect.Fi
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubscribeWorkerToTask) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgSetWorkerGroup) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "janction.videoUpscaler.v1.MsgSubscribeWorkerToTask.address":
-		x.Address = value.Interface().(string)
-	case "janction.videoUpscaler.v1.MsgSubscribeWorkerToTask.taskId":
-		x.TaskId = value.Interface().(string)
-	case "janction.videoUpscaler.v1.MsgSubscribeWorkerToTask.threadId":
-		x.ThreadId = value.Interface().(string)
+	case "janction.videoUpscaler.v1.MsgSetWorkerGroup.creator":
+		x.Creator = value.Interface().(string)
+	case "janction.videoUpscaler.v1.MsgSetWorkerGroup.name":
+		x.Name = value.Interface().(string)
+	case "janction.videoUpscaler.v1.MsgSetWorkerGroup.members":
+		lv := value.List()
+		clv := lv.(*_MsgSetWorkerGroup_3_list)
+		x.Members = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubscribeWorkerToTask"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSetWorkerGroup"))
 		}
-		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubscribeWorkerToTask does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSetWorkerGroup does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2503,48 +3344,53 @@ func (x *fastReflection_MsgSubscribeWorkerToTask) Set(fd protoreflect.FieldDescr
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubscribeWorkerToTask) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgSetWorkerGroup) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "janction.videoUpscaler.v1.MsgSubscribeWorkerToTask.address":
-		panic(fmt.Errorf("field address of message janction.videoUpscaler.v1.MsgSubscribeWorkerToTask is not mutable"))
-	case "janction.videoUpscaler.v1.MsgSubscribeWorkerToTask.taskId":
-		panic(fmt.Errorf("field taskId of message janction.videoUpscaler.v1.MsgSubscribeWorkerToTask is not mutable"))
-	case "janction.videoUpscaler.v1.MsgSubscribeWorkerToTask.threadId":
-		panic(fmt.Errorf("field threadId of message janction.videoUpscaler.v1.MsgSubscribeWorkerToTask is not mutable"))
+	case "janction.videoUpscaler.v1.MsgSetWorkerGroup.members":
+		if x.Members == nil {
+			x.Members = []string{}
+		}
+		value := &_MsgSetWorkerGroup_3_list{list: &x.Members}
+		return protoreflect.ValueOfList(value)
+	case "janction.videoUpscaler.v1.MsgSetWorkerGroup.creator":
+		panic(fmt.Errorf("field creator of message janction.videoUpscaler.v1.MsgSetWorkerGroup is not mutable"))
+	case "janction.videoUpscaler.v1.MsgSetWorkerGroup.name":
+		panic(fmt.Errorf("field name of message janction.videoUpscaler.v1.MsgSetWorkerGroup is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubscribeWorkerToTask"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSetWorkerGroup"))
 		}
-		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubscribeWorkerToTask does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSetWorkerGroup does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgSubscribeWorkerToTask) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgSetWorkerGroup) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "janction.videoUpscaler.v1.MsgSubscribeWorkerToTask.address":
-		return protoreflect.ValueOfString("")
-	case "janction.videoUpscaler.v1.MsgSubscribeWorkerToTask.taskId":
+	case "janction.videoUpscaler.v1.MsgSetWorkerGroup.creator":
 		return protoreflect.ValueOfString("")
-	case "janction.videoUpscaler.v1.MsgSubscribeWorkerToTask.threadId":
+	case "janction.videoUpscaler.v1.MsgSetWorkerGroup.name":
 		return protoreflect.ValueOfString("")
+	case "janction.videoUpscaler.v1.MsgSetWorkerGroup.members":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MsgSetWorkerGroup_3_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubscribeWorkerToTask"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSetWorkerGroup"))
 		}
-		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubscribeWorkerToTask does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSetWorkerGroup does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgSubscribeWorkerToTask) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgSetWorkerGroup) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in janction.videoUpscaler.v1.MsgSubscribeWorkerToTask", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in janction.videoUpscaler.v1.MsgSetWorkerGroup", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2552,7 +3398,7 @@ func (x *fastReflection_MsgSubscribeWorkerToTask) WhichOneof(d protoreflect.Oneo
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgSubscribeWorkerToTask) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgSetWorkerGroup) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2563,7 +3409,7 @@ func (x *fastReflection_MsgSubscribeWorkerToTask) GetUnknown() protoreflect.RawF
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubscribeWorkerToTask) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgSetWorkerGroup) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2575,7 +3421,7 @@ func (x *fastReflection_MsgSubscribeWorkerToTask) SetUnknown(fields protoreflect
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgSubscribeWorkerToTask) IsValid() bool {
+func (x *fastReflection_MsgSetWorkerGroup) IsValid() bool {
 	return x != nil
 }
 
@@ -2585,9 +3431,9 @@ func (x *fastReflection_MsgSubscribeWorkerToTask) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgSubscribeWorkerToTask) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgSetWorkerGroup) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgSubscribeWorkerToTask)
+		x := input.Message.Interface().(*MsgSetWorkerGroup)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2599,17 +3445,19 @@ func (x *fastReflection_MsgSubscribeWorkerToTask) ProtoMethods() *protoiface.Met
 		var n int
 		var l int
 		_ = l
-		l = len(x.Address)
+		l = len(x.Creator)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.TaskId)
+		l = len(x.Name)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.ThreadId)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.Members) > 0 {
+			for _, s := range x.Members {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -2621,7 +3469,7 @@ func (x *fastReflection_MsgSubscribeWorkerToTask) ProtoMethods() *protoiface.Met
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSubscribeWorkerToTask)
+		x := input.Message.Interface().(*MsgSetWorkerGroup)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2640,24 +3488,26 @@ func (x *fastReflection_MsgSubscribeWorkerToTask) ProtoMethods() *protoiface.Met
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.ThreadId) > 0 {
-			i -= len(x.ThreadId)
-			copy(dAtA[i:], x.ThreadId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ThreadId)))
-			i--
-			dAtA[i] = 0x1a
+		if len(x.Members) > 0 {
+			for iNdEx := len(x.Members) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Members[iNdEx])
+				copy(dAtA[i:], x.Members[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Members[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
 		}
-		if len(x.TaskId) > 0 {
-			i -= len(x.TaskId)
-			copy(dAtA[i:], x.TaskId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TaskId)))
+		if len(x.Name) > 0 {
+			i -= len(x.Name)
+			copy(dAtA[i:], x.Name)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Address) > 0 {
-			i -= len(x.Address)
-			copy(dAtA[i:], x.Address)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -2672,7 +3522,7 @@ func (x *fastReflection_MsgSubscribeWorkerToTask) ProtoMethods() *protoiface.Met
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSubscribeWorkerToTask)
+		x := input.Message.Interface().(*MsgSetWorkerGroup)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2704,15 +3554,15 @@ func (x *fastReflection_MsgSubscribeWorkerToTask) ProtoMethods() *protoiface.Met
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubscribeWorkerToTask: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetWorkerGroup: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubscribeWorkerToTask: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetWorkerGroup: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -2740,11 +3590,11 @@ func (x *fastReflection_MsgSubscribeWorkerToTask) ProtoMethods() *protoiface.Met
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Address = string(dAtA[iNdEx:postIndex])
+				x.Creator = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -2772,11 +3622,11 @@ func (x *fastReflection_MsgSubscribeWorkerToTask) ProtoMethods() *protoiface.Met
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.TaskId = string(dAtA[iNdEx:postIndex])
+				x.Name = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ThreadId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -2804,7 +3654,7 @@ func (x *fastReflection_MsgSubscribeWorkerToTask) ProtoMethods() *protoiface.Met
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ThreadId = string(dAtA[iNdEx:postIndex])
+				x.Members = append(x.Members, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -2842,25 +3692,23 @@ func (x *fastReflection_MsgSubscribeWorkerToTask) ProtoMethods() *protoiface.Met
 }
 
 var (
-	md_MsgSubscribeWorkerToTaskResponse          protoreflect.MessageDescriptor
-	fd_MsgSubscribeWorkerToTaskResponse_threadId protoreflect.FieldDescriptor
+	md_MsgSetWorkerGroupResponse protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_janction_videoUpscaler_v1_tx_proto_init()
-	md_MsgSubscribeWorkerToTaskResponse = File_janction_videoUpscaler_v1_tx_proto.Messages().ByName("MsgSubscribeWorkerToTaskResponse")
-	fd_MsgSubscribeWorkerToTaskResponse_threadId = md_MsgSubscribeWorkerToTaskResponse.Fields().ByName("threadId")
+	md_MsgSetWorkerGroupResponse = File_janction_videoUpscaler_v1_tx_proto.Messages().ByName("MsgSetWorkerGroupResponse")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgSubscribeWorkerToTaskResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgSetWorkerGroupResponse)(nil)
 
-type fastReflection_MsgSubscribeWorkerToTaskResponse MsgSubscribeWorkerToTaskResponse
+type fastReflection_MsgSetWorkerGroupResponse MsgSetWorkerGroupResponse
 
-func (x *MsgSubscribeWorkerToTaskResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgSubscribeWorkerToTaskResponse)(x)
+func (x *MsgSetWorkerGroupResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSetWorkerGroupResponse)(x)
 }
 
-func (x *MsgSubscribeWorkerToTaskResponse) slowProtoReflect() protoreflect.Message {
+func (x *MsgSetWorkerGroupResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2872,43 +3720,43 @@ func (x *MsgSubscribeWorkerToTaskResponse) slowProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgSubscribeWorkerToTaskResponse_messageType fastReflection_MsgSubscribeWorkerToTaskResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgSubscribeWorkerToTaskResponse_messageType{}
+var _fastReflection_MsgSetWorkerGroupResponse_messageType fastReflection_MsgSetWorkerGroupResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSetWorkerGroupResponse_messageType{}
 
-type fastReflection_MsgSubscribeWorkerToTaskResponse_messageType struct{}
+type fastReflection_MsgSetWorkerGroupResponse_messageType struct{}
 
-func (x fastReflection_MsgSubscribeWorkerToTaskResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgSubscribeWorkerToTaskResponse)(nil)
+func (x fastReflection_MsgSetWorkerGroupResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSetWorkerGroupResponse)(nil)
 }
-func (x fastReflection_MsgSubscribeWorkerToTaskResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgSubscribeWorkerToTaskResponse)
+func (x fastReflection_MsgSetWorkerGroupResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSetWorkerGroupResponse)
 }
-func (x fastReflection_MsgSubscribeWorkerToTaskResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSubscribeWorkerToTaskResponse
+func (x fastReflection_MsgSetWorkerGroupResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetWorkerGroupResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgSubscribeWorkerToTaskResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSubscribeWorkerToTaskResponse
+func (x *fastReflection_MsgSetWorkerGroupResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetWorkerGroupResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgSubscribeWorkerToTaskResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgSubscribeWorkerToTaskResponse_messageType
+func (x *fastReflection_MsgSetWorkerGroupResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSetWorkerGroupResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgSubscribeWorkerToTaskResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgSubscribeWorkerToTaskResponse)
+func (x *fastReflection_MsgSetWorkerGroupResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgSetWorkerGroupResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgSubscribeWorkerToTaskResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgSubscribeWorkerToTaskResponse)(x)
+func (x *fastReflection_MsgSetWorkerGroupResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgSetWorkerGroupResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2916,13 +3764,7 @@ func (x *fastReflection_MsgSubscribeWorkerToTaskResponse) Interface() protorefle
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgSubscribeWorkerToTaskResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ThreadId != "" {
-		value := protoreflect.ValueOfString(x.ThreadId)
-		if !f(fd_MsgSubscribeWorkerToTaskResponse_threadId, value) {
-			return
-		}
-	}
+func (x *fastReflection_MsgSetWorkerGroupResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -2936,15 +3778,13 @@ func (x *fastReflection_MsgSubscribeWorkerToTaskResponse) Range(f func(protorefl
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgSubscribeWorkerToTaskResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgSetWorkerGroupResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "janction.videoUpscaler.v1.MsgSubscribeWorkerToTaskResponse.threadId":
-		return x.ThreadId != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubscribeWorkerToTaskResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSetWorkerGroupResponse"))
 		}
-		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubscribeWorkerToTaskResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSetWorkerGroupResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2954,15 +3794,13 @@ func (x *fastReflection_MsgSubscribeWorkerToTaskResponse) Has(fd protoreflect.Fi
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubscribeWorkerToTaskResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgSetWorkerGroupResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "janction.videoUpscaler.v1.MsgSubscribeWorkerToTaskResponse.threadId":
-		x.ThreadId = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubscribeWorkerToTaskResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSetWorkerGroupResponse"))
 		}
-		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubscribeWorkerToTaskResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSetWorkerGroupResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2972,16 +3810,13 @@ func (x *fastReflection_MsgSubscribeWorkerToTaskResponse) Clear(fd protoreflect.
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgSubscribeWorkerToTaskResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgSetWorkerGroupResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "janction.videoUpscaler.v1.MsgSubscribeWorkerToTaskResponse.threadId":
-		value := x.ThreadId
-		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubscribeWorkerToTaskResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSetWorkerGroupResponse"))
 		}
-		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubscribeWorkerToTaskResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSetWorkerGroupResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2995,15 +3830,13 @@ func (x *fastReflection_MsgSubscribeWorkerToTaskResponse) Get(descriptor protore
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubscribeWorkerToTaskResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgSetWorkerGroupResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "janction.videoUpscaler.v1.MsgSubscribeWorkerToTaskResponse.threadId":
-		x.ThreadId = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubscribeWorkerToTaskResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSetWorkerGroupResponse"))
 		}
-		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubscribeWorkerToTaskResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSetWorkerGroupResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3017,40 +3850,36 @@ func (x *fastReflection_MsgSubscribeWorkerToTaskResponse) Set(fd protoreflect.Fi
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubscribeWorkerToTaskResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgSetWorkerGroupResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "janction.videoUpscaler.v1.MsgSubscribeWorkerToTaskResponse.threadId":
-		panic(fmt.Errorf("field threadId of message janction.videoUpscaler.v1.MsgSubscribeWorkerToTaskResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubscribeWorkerToTaskResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSetWorkerGroupResponse"))
 		}
-		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubscribeWorkerToTaskResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSetWorkerGroupResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgSubscribeWorkerToTaskResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgSetWorkerGroupResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "janction.videoUpscaler.v1.MsgSubscribeWorkerToTaskResponse.threadId":
-		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubscribeWorkerToTaskResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSetWorkerGroupResponse"))
 		}
-		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubscribeWorkerToTaskResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSetWorkerGroupResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgSubscribeWorkerToTaskResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgSetWorkerGroupResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in janction.videoUpscaler.v1.MsgSubscribeWorkerToTaskResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in janction.videoUpscaler.v1.MsgSetWorkerGroupResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3058,7 +3887,7 @@ func (x *fastReflection_MsgSubscribeWorkerToTaskResponse) WhichOneof(d protorefl
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgSubscribeWorkerToTaskResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgSetWorkerGroupResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3069,7 +3898,7 @@ func (x *fastReflection_MsgSubscribeWorkerToTaskResponse) GetUnknown() protorefl
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubscribeWorkerToTaskResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgSetWorkerGroupResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3081,7 +3910,7 @@ func (x *fastReflection_MsgSubscribeWorkerToTaskResponse) SetUnknown(fields prot
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgSubscribeWorkerToTaskResponse) IsValid() bool {
+func (x *fastReflection_MsgSetWorkerGroupResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -3091,9 +3920,9 @@ func (x *fastReflection_MsgSubscribeWorkerToTaskResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgSubscribeWorkerToTaskResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgSetWorkerGroupResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgSubscribeWorkerToTaskResponse)
+		x := input.Message.Interface().(*MsgSetWorkerGroupResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3105,10 +3934,6 @@ func (x *fastReflection_MsgSubscribeWorkerToTaskResponse) ProtoMethods() *protoi
 		var n int
 		var l int
 		_ = l
-		l = len(x.ThreadId)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3119,7 +3944,7 @@ func (x *fastReflection_MsgSubscribeWorkerToTaskResponse) ProtoMethods() *protoi
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSubscribeWorkerToTaskResponse)
+		x := input.Message.Interface().(*MsgSetWorkerGroupResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3138,13 +3963,6 @@ func (x *fastReflection_MsgSubscribeWorkerToTaskResponse) ProtoMethods() *protoi
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.ThreadId) > 0 {
-			i -= len(x.ThreadId)
-			copy(dAtA[i:], x.ThreadId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ThreadId)))
-			i--
-			dAtA[i] = 0xa
-		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -3156,7 +3974,7 @@ func (x *fastReflection_MsgSubscribeWorkerToTaskResponse) ProtoMethods() *protoi
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSubscribeWorkerToTaskResponse)
+		x := input.Message.Interface().(*MsgSetWorkerGroupResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3188,44 +4006,12 @@ func (x *fastReflection_MsgSubscribeWorkerToTaskResponse) ProtoMethods() *protoi
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubscribeWorkerToTaskResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetWorkerGroupResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubscribeWorkerToTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetWorkerGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ThreadId", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.ThreadId = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3261,80 +4047,78 @@ func (x *fastReflection_MsgSubscribeWorkerToTaskResponse) ProtoMethods() *protoi
 	}
 }
 
-var _ protoreflect.List = (*_MsgProposeSolution_5_list)(nil)
+var _ protoreflect.List = (*_MsgUpdateWorker_4_list)(nil)
 
-type _MsgProposeSolution_5_list struct {
+type _MsgUpdateWorker_4_list struct {
 	list *[]string
 }
 
-func (x *_MsgProposeSolution_5_list) Len() int {
+func (x *_MsgUpdateWorker_4_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_MsgProposeSolution_5_list) Get(i int) protoreflect.Value {
+func (x *_MsgUpdateWorker_4_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfString((*x.list)[i])
 }
 
-func (x *_MsgProposeSolution_5_list) Set(i int, value protoreflect.Value) {
+func (x *_MsgUpdateWorker_4_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.String()
 	concreteValue := valueUnwrapped
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_MsgProposeSolution_5_list) Append(value protoreflect.Value) {
+func (x *_MsgUpdateWorker_4_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.String()
 	concreteValue := valueUnwrapped
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_MsgProposeSolution_5_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message MsgProposeSolution at list field Signatures as it is not of Message kind"))
+func (x *_MsgUpdateWorker_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgUpdateWorker at list field AnnounceAddrs as it is not of Message kind"))
 }
 
-func (x *_MsgProposeSolution_5_list) Truncate(n int) {
+func (x *_MsgUpdateWorker_4_list) Truncate(n int) {
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_MsgProposeSolution_5_list) NewElement() protoreflect.Value {
+func (x *_MsgUpdateWorker_4_list) NewElement() protoreflect.Value {
 	v := ""
 	return protoreflect.ValueOfString(v)
 }
 
-func (x *_MsgProposeSolution_5_list) IsValid() bool {
+func (x *_MsgUpdateWorker_4_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_MsgProposeSolution            protoreflect.MessageDescriptor
-	fd_MsgProposeSolution_creator    protoreflect.FieldDescriptor
-	fd_MsgProposeSolution_taskId     protoreflect.FieldDescriptor
-	fd_MsgProposeSolution_threadId   protoreflect.FieldDescriptor
-	fd_MsgProposeSolution_public_key protoreflect.FieldDescriptor
-	fd_MsgProposeSolution_signatures protoreflect.FieldDescriptor
+	md_MsgUpdateWorker                protoreflect.MessageDescriptor
+	fd_MsgUpdateWorker_creator        protoreflect.FieldDescriptor
+	fd_MsgUpdateWorker_public_ip      protoreflect.FieldDescriptor
+	fd_MsgUpdateWorker_ipfs_id        protoreflect.FieldDescriptor
+	fd_MsgUpdateWorker_announce_addrs protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_janction_videoUpscaler_v1_tx_proto_init()
-	md_MsgProposeSolution = File_janction_videoUpscaler_v1_tx_proto.Messages().ByName("MsgProposeSolution")
-	fd_MsgProposeSolution_creator = md_MsgProposeSolution.Fields().ByName("creator")
-	fd_MsgProposeSolution_taskId = md_MsgProposeSolution.Fields().ByName("taskId")
-	fd_MsgProposeSolution_threadId = md_MsgProposeSolution.Fields().ByName("threadId")
-	fd_MsgProposeSolution_public_key = md_MsgProposeSolution.Fields().ByName("public_key")
-	fd_MsgProposeSolution_signatures = md_MsgProposeSolution.Fields().ByName("signatures")
+	md_MsgUpdateWorker = File_janction_videoUpscaler_v1_tx_proto.Messages().ByName("MsgUpdateWorker")
+	fd_MsgUpdateWorker_creator = md_MsgUpdateWorker.Fields().ByName("creator")
+	fd_MsgUpdateWorker_public_ip = md_MsgUpdateWorker.Fields().ByName("public_ip")
+	fd_MsgUpdateWorker_ipfs_id = md_MsgUpdateWorker.Fields().ByName("ipfs_id")
+	fd_MsgUpdateWorker_announce_addrs = md_MsgUpdateWorker.Fields().ByName("announce_addrs")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgProposeSolution)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgUpdateWorker)(nil)
 
-type fastReflection_MsgProposeSolution MsgProposeSolution
+type fastReflection_MsgUpdateWorker MsgUpdateWorker
 
-func (x *MsgProposeSolution) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgProposeSolution)(x)
+func (x *MsgUpdateWorker) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateWorker)(x)
 }
 
-func (x *MsgProposeSolution) slowProtoReflect() protoreflect.Message {
+func (x *MsgUpdateWorker) slowProtoReflect() protoreflect.Message {
 	mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3346,43 +4130,43 @@ func (x *MsgProposeSolution) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgProposeSolution_messageType fastReflection_MsgProposeSolution_messageType
-var _ protoreflect.MessageType = fastReflection_MsgProposeSolution_messageType{}
+var _fastReflection_MsgUpdateWorker_messageType fastReflection_MsgUpdateWorker_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateWorker_messageType{}
 
-type fastReflection_MsgProposeSolution_messageType struct{}
+type fastReflection_MsgUpdateWorker_messageType struct{}
 
-func (x fastReflection_MsgProposeSolution_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgProposeSolution)(nil)
+func (x fastReflection_MsgUpdateWorker_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateWorker)(nil)
 }
-func (x fastReflection_MsgProposeSolution_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgProposeSolution)
+func (x fastReflection_MsgUpdateWorker_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateWorker)
 }
-func (x fastReflection_MsgProposeSolution_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgProposeSolution
+func (x fastReflection_MsgUpdateWorker_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateWorker
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgProposeSolution) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgProposeSolution
+func (x *fastReflection_MsgUpdateWorker) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateWorker
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgProposeSolution) Type() protoreflect.MessageType {
-	return _fastReflection_MsgProposeSolution_messageType
+func (x *fastReflection_MsgUpdateWorker) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateWorker_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgProposeSolution) New() protoreflect.Message {
-	return new(fastReflection_MsgProposeSolution)
+func (x *fastReflection_MsgUpdateWorker) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateWorker)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgProposeSolution) Interface() protoreflect.ProtoMessage {
-	return (*MsgProposeSolution)(x)
+func (x *fastReflection_MsgUpdateWorker) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateWorker)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3390,34 +4174,28 @@ func (x *fastReflection_MsgProposeSolution) Interface() protoreflect.ProtoMessag
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgProposeSolution) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgUpdateWorker) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Creator != "" {
 		value := protoreflect.ValueOfString(x.Creator)
-		if !f(fd_MsgProposeSolution_creator, value) {
-			return
-		}
-	}
-	if x.TaskId != "" {
-		value := protoreflect.ValueOfString(x.TaskId)
-		if !f(fd_MsgProposeSolution_taskId, value) {
+		if !f(fd_MsgUpdateWorker_creator, value) {
 			return
 		}
 	}
-	if x.ThreadId != "" {
-		value := protoreflect.ValueOfString(x.ThreadId)
-		if !f(fd_MsgProposeSolution_threadId, value) {
+	if x.PublicIp != "" {
+		value := protoreflect.ValueOfString(x.PublicIp)
+		if !f(fd_MsgUpdateWorker_public_ip, value) {
 			return
 		}
 	}
-	if x.PublicKey != "" {
-		value := protoreflect.ValueOfString(x.PublicKey)
-		if !f(fd_MsgProposeSolution_public_key, value) {
+	if x.IpfsId != "" {
+		value := protoreflect.ValueOfString(x.IpfsId)
+		if !f(fd_MsgUpdateWorker_ipfs_id, value) {
 			return
 		}
 	}
-	if len(x.Signatures) != 0 {
-		value := protoreflect.ValueOfList(&_MsgProposeSolution_5_list{list: &x.Signatures})
-		if !f(fd_MsgProposeSolution_signatures, value) {
+	if len(x.AnnounceAddrs) != 0 {
+		value := protoreflect.ValueOfList(&_MsgUpdateWorker_4_list{list: &x.AnnounceAddrs})
+		if !f(fd_MsgUpdateWorker_announce_addrs, value) {
 			return
 		}
 	}
@@ -3434,23 +4212,21 @@ func (x *fastReflection_MsgProposeSolution) Range(f func(protoreflect.FieldDescr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgProposeSolution) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgUpdateWorker) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "janction.videoUpscaler.v1.MsgProposeSolution.creator":
+	case "janction.videoUpscaler.v1.MsgUpdateWorker.creator":
 		return x.Creator != ""
-	case "janction.videoUpscaler.v1.MsgProposeSolution.taskId":
-		return x.TaskId != ""
-	case "janction.videoUpscaler.v1.MsgProposeSolution.threadId":
-		return x.ThreadId != ""
-	case "janction.videoUpscaler.v1.MsgProposeSolution.public_key":
-		return x.PublicKey != ""
-	case "janction.videoUpscaler.v1.MsgProposeSolution.signatures":
-		return len(x.Signatures) != 0
+	case "janction.videoUpscaler.v1.MsgUpdateWorker.public_ip":
+		return x.PublicIp != ""
+	case "janction.videoUpscaler.v1.MsgUpdateWorker.ipfs_id":
+		return x.IpfsId != ""
+	case "janction.videoUpscaler.v1.MsgUpdateWorker.announce_addrs":
+		return len(x.AnnounceAddrs) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgProposeSolution"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgUpdateWorker"))
 		}
-		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgProposeSolution does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgUpdateWorker does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3460,23 +4236,21 @@ func (x *fastReflection_MsgProposeSolution) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgProposeSolution) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgUpdateWorker) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "janction.videoUpscaler.v1.MsgProposeSolution.creator":
+	case "janction.videoUpscaler.v1.MsgUpdateWorker.creator":
 		x.Creator = ""
-	case "janction.videoUpscaler.v1.MsgProposeSolution.taskId":
-		x.TaskId = ""
-	case "janction.videoUpscaler.v1.MsgProposeSolution.threadId":
-		x.ThreadId = ""
-	case "janction.videoUpscaler.v1.MsgProposeSolution.public_key":
-		x.PublicKey = ""
-	case "janction.videoUpscaler.v1.MsgProposeSolution.signatures":
-		x.Signatures = nil
+	case "janction.videoUpscaler.v1.MsgUpdateWorker.public_ip":
+		x.PublicIp = ""
+	case "janction.videoUpscaler.v1.MsgUpdateWorker.ipfs_id":
+		x.IpfsId = ""
+	case "janction.videoUpscaler.v1.MsgUpdateWorker.announce_addrs":
+		x.AnnounceAddrs = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgProposeSolution"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgUpdateWorker"))
 		}
-		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgProposeSolution does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgUpdateWorker does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3486,31 +4260,28 @@ func (x *fastReflection_MsgProposeSolution) Clear(fd protoreflect.FieldDescripto
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgProposeSolution) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateWorker) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "janction.videoUpscaler.v1.MsgProposeSolution.creator":
+	case "janction.videoUpscaler.v1.MsgUpdateWorker.creator":
 		value := x.Creator
 		return protoreflect.ValueOfString(value)
-	case "janction.videoUpscaler.v1.MsgProposeSolution.taskId":
-		value := x.TaskId
-		return protoreflect.ValueOfString(value)
-	case "janction.videoUpscaler.v1.MsgProposeSolution.threadId":
-		value := x.ThreadId
+	case "janction.videoUpscaler.v1.MsgUpdateWorker.public_ip":
+		value := x.PublicIp
 		return protoreflect.ValueOfString(value)
-	case "janction.videoUpscaler.v1.MsgProposeSolution.public_key":
-		value := x.PublicKey
+	case "janction.videoUpscaler.v1.MsgUpdateWorker.ipfs_id":
+		value := x.IpfsId
 		return protoreflect.ValueOfString(value)
-	case "janction.videoUpscaler.v1.MsgProposeSolution.signatures":
-		if len(x.Signatures) == 0 {
-			return protoreflect.ValueOfList(&_MsgProposeSolution_5_list{})
+	case "janction.videoUpscaler.v1.MsgUpdateWorker.announce_addrs":
+		if len(x.AnnounceAddrs) == 0 {
+			return protoreflect.ValueOfList(&_MsgUpdateWorker_4_list{})
 		}
-		listValue := &_MsgProposeSolution_5_list{list: &x.Signatures}
+		listValue := &_MsgUpdateWorker_4_list{list: &x.AnnounceAddrs}
 		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgProposeSolution"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgUpdateWorker"))
 		}
-		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgProposeSolution does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgUpdateWorker does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3524,25 +4295,23 @@ func (x *fastReflection_MsgProposeSolution) Get(descriptor protoreflect.FieldDes
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgProposeSolution) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgUpdateWorker) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "janction.videoUpscaler.v1.MsgProposeSolution.creator":
+	case "janction.videoUpscaler.v1.MsgUpdateWorker.creator":
 		x.Creator = value.Interface().(string)
-	case "janction.videoUpscaler.v1.MsgProposeSolution.taskId":
-		x.TaskId = value.Interface().(string)
-	case "janction.videoUpscaler.v1.MsgProposeSolution.threadId":
-		x.ThreadId = value.Interface().(string)
-	case "janction.videoUpscaler.v1.MsgProposeSolution.public_key":
-		x.PublicKey = value.Interface().(string)
-	case "janction.videoUpscaler.v1.MsgProposeSolution.signatures":
+	case "janction.videoUpscaler.v1.MsgUpdateWorker.public_ip":
+		x.PublicIp = value.Interface().(string)
+	case "janction.videoUpscaler.v1.MsgUpdateWorker.ipfs_id":
+		x.IpfsId = value.Interface().(string)
+	case "janction.videoUpscaler.v1.MsgUpdateWorker.announce_addrs":
 		lv := value.List()
-		clv := lv.(*_MsgProposeSolution_5_list)
-		x.Signatures = *clv.list
+		clv := lv.(*_MsgUpdateWorker_4_list)
+		x.AnnounceAddrs = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgProposeSolution"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgUpdateWorker"))
 		}
-		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgProposeSolution does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgUpdateWorker does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3556,61 +4325,57 @@ func (x *fastReflection_MsgProposeSolution) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgProposeSolution) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateWorker) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "janction.videoUpscaler.v1.MsgProposeSolution.signatures":
-		if x.Signatures == nil {
-			x.Signatures = []string{}
+	case "janction.videoUpscaler.v1.MsgUpdateWorker.announce_addrs":
+		if x.AnnounceAddrs == nil {
+			x.AnnounceAddrs = []string{}
 		}
-		value := &_MsgProposeSolution_5_list{list: &x.Signatures}
+		value := &_MsgUpdateWorker_4_list{list: &x.AnnounceAddrs}
 		return protoreflect.ValueOfList(value)
-	case "janction.videoUpscaler.v1.MsgProposeSolution.creator":
-		panic(fmt.Errorf("field creator of message janction.videoUpscaler.v1.MsgProposeSolution is not mutable"))
-	case "janction.videoUpscaler.v1.MsgProposeSolution.taskId":
-		panic(fmt.Errorf("field taskId of message janction.videoUpscaler.v1.MsgProposeSolution is not mutable"))
-	case "janction.videoUpscaler.v1.MsgProposeSolution.threadId":
-		panic(fmt.Errorf("field threadId of message janction.videoUpscaler.v1.MsgProposeSolution is not mutable"))
-	case "janction.videoUpscaler.v1.MsgProposeSolution.public_key":
-		panic(fmt.Errorf("field public_key of message janction.videoUpscaler.v1.MsgProposeSolution is not mutable"))
+	case "janction.videoUpscaler.v1.MsgUpdateWorker.creator":
+		panic(fmt.Errorf("field creator of message janction.videoUpscaler.v1.MsgUpdateWorker is not mutable"))
+	case "janction.videoUpscaler.v1.MsgUpdateWorker.public_ip":
+		panic(fmt.Errorf("field public_ip of message janction.videoUpscaler.v1.MsgUpdateWorker is not mutable"))
+	case "janction.videoUpscaler.v1.MsgUpdateWorker.ipfs_id":
+		panic(fmt.Errorf("field ipfs_id of message janction.videoUpscaler.v1.MsgUpdateWorker is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgProposeSolution"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgUpdateWorker"))
 		}
-		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgProposeSolution does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgUpdateWorker does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgProposeSolution) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateWorker) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "janction.videoUpscaler.v1.MsgProposeSolution.creator":
-		return protoreflect.ValueOfString("")
-	case "janction.videoUpscaler.v1.MsgProposeSolution.taskId":
+	case "janction.videoUpscaler.v1.MsgUpdateWorker.creator":
 		return protoreflect.ValueOfString("")
-	case "janction.videoUpscaler.v1.MsgProposeSolution.threadId":
+	case "janction.videoUpscaler.v1.MsgUpdateWorker.public_ip":
 		return protoreflect.ValueOfString("")
-	case "janction.videoUpscaler.v1.MsgProposeSolution.public_key":
+	case "janction.videoUpscaler.v1.MsgUpdateWorker.ipfs_id":
 		return protoreflect.ValueOfString("")
-	case "janction.videoUpscaler.v1.MsgProposeSolution.signatures":
+	case "janction.videoUpscaler.v1.MsgUpdateWorker.announce_addrs":
 		list := []string{}
-		return protoreflect.ValueOfList(&_MsgProposeSolution_5_list{list: &list})
+		return protoreflect.ValueOfList(&_MsgUpdateWorker_4_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgProposeSolution"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgUpdateWorker"))
 		}
-		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgProposeSolution does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgUpdateWorker does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgProposeSolution) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgUpdateWorker) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in janction.videoUpscaler.v1.MsgProposeSolution", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in janction.videoUpscaler.v1.MsgUpdateWorker", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3618,7 +4383,7 @@ func (x *fastReflection_MsgProposeSolution) WhichOneof(d protoreflect.OneofDescr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgProposeSolution) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgUpdateWorker) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3629,7 +4394,7 @@ func (x *fastReflection_MsgProposeSolution) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgProposeSolution) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgUpdateWorker) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3641,7 +4406,7 @@ func (x *fastReflection_MsgProposeSolution) SetUnknown(fields protoreflect.RawFi
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgProposeSolution) IsValid() bool {
+func (x *fastReflection_MsgUpdateWorker) IsValid() bool {
 	return x != nil
 }
 
@@ -3651,9 +4416,9 @@ func (x *fastReflection_MsgProposeSolution) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgProposeSolution) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgUpdateWorker) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgProposeSolution)
+		x := input.Message.Interface().(*MsgUpdateWorker)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3669,20 +4434,16 @@ func (x *fastReflection_MsgProposeSolution) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.TaskId)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.ThreadId)
+		l = len(x.PublicIp)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.PublicKey)
+		l = len(x.IpfsId)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.Signatures) > 0 {
-			for _, s := range x.Signatures {
+		if len(x.AnnounceAddrs) > 0 {
+			for _, s := range x.AnnounceAddrs {
 				l = len(s)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
@@ -3697,7 +4458,7 @@ func (x *fastReflection_MsgProposeSolution) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgProposeSolution)
+		x := input.Message.Interface().(*MsgUpdateWorker)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3716,33 +4477,26 @@ func (x *fastReflection_MsgProposeSolution) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Signatures) > 0 {
-			for iNdEx := len(x.Signatures) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.Signatures[iNdEx])
-				copy(dAtA[i:], x.Signatures[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signatures[iNdEx])))
+		if len(x.AnnounceAddrs) > 0 {
+			for iNdEx := len(x.AnnounceAddrs) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AnnounceAddrs[iNdEx])
+				copy(dAtA[i:], x.AnnounceAddrs[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AnnounceAddrs[iNdEx])))
 				i--
-				dAtA[i] = 0x2a
+				dAtA[i] = 0x22
 			}
 		}
-		if len(x.PublicKey) > 0 {
-			i -= len(x.PublicKey)
-			copy(dAtA[i:], x.PublicKey)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PublicKey)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if len(x.ThreadId) > 0 {
-			i -= len(x.ThreadId)
-			copy(dAtA[i:], x.ThreadId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ThreadId)))
+		if len(x.IpfsId) > 0 {
+			i -= len(x.IpfsId)
+			copy(dAtA[i:], x.IpfsId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.IpfsId)))
 			i--
 			dAtA[i] = 0x1a
 		}
-		if len(x.TaskId) > 0 {
-			i -= len(x.TaskId)
-			copy(dAtA[i:], x.TaskId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TaskId)))
+		if len(x.PublicIp) > 0 {
+			i -= len(x.PublicIp)
+			copy(dAtA[i:], x.PublicIp)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PublicIp)))
 			i--
 			dAtA[i] = 0x12
 		}
@@ -3764,7 +4518,7 @@ func (x *fastReflection_MsgProposeSolution) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgProposeSolution)
+		x := input.Message.Interface().(*MsgUpdateWorker)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3796,10 +4550,10 @@ func (x *fastReflection_MsgProposeSolution) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgProposeSolution: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateWorker: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgProposeSolution: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateWorker: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -3836,7 +4590,7 @@ func (x *fastReflection_MsgProposeSolution) ProtoMethods() *protoiface.Methods {
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PublicIp", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3864,11 +4618,11 @@ func (x *fastReflection_MsgProposeSolution) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.TaskId = string(dAtA[iNdEx:postIndex])
+				x.PublicIp = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ThreadId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IpfsId", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3896,43 +4650,11 @@ func (x *fastReflection_MsgProposeSolution) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ThreadId = string(dAtA[iNdEx:postIndex])
+				x.IpfsId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 4:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.PublicKey = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 5:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AnnounceAddrs", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3960,7 +4682,7 @@ func (x *fastReflection_MsgProposeSolution) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Signatures = append(x.Signatures, string(dAtA[iNdEx:postIndex]))
+				x.AnnounceAddrs = append(x.AnnounceAddrs, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -3998,23 +4720,23 @@ func (x *fastReflection_MsgProposeSolution) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MsgProposeSolutionResponse protoreflect.MessageDescriptor
+	md_MsgUpdateWorkerResponse protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_janction_videoUpscaler_v1_tx_proto_init()
-	md_MsgProposeSolutionResponse = File_janction_videoUpscaler_v1_tx_proto.Messages().ByName("MsgProposeSolutionResponse")
+	md_MsgUpdateWorkerResponse = File_janction_videoUpscaler_v1_tx_proto.Messages().ByName("MsgUpdateWorkerResponse")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgProposeSolutionResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgUpdateWorkerResponse)(nil)
 
-type fastReflection_MsgProposeSolutionResponse MsgProposeSolutionResponse
+type fastReflection_MsgUpdateWorkerResponse MsgUpdateWorkerResponse
 
-func (x *MsgProposeSolutionResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgProposeSolutionResponse)(x)
+func (x *MsgUpdateWorkerResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateWorkerResponse)(x)
 }
 
-func (x *MsgProposeSolutionResponse) slowProtoReflect() protoreflect.Message {
+func (x *MsgUpdateWorkerResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4026,43 +4748,43 @@ func (x *MsgProposeSolutionResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgProposeSolutionResponse_messageType fastReflection_MsgProposeSolutionResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgProposeSolutionResponse_messageType{}
+var _fastReflection_MsgUpdateWorkerResponse_messageType fastReflection_MsgUpdateWorkerResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateWorkerResponse_messageType{}
 
-type fastReflection_MsgProposeSolutionResponse_messageType struct{}
+type fastReflection_MsgUpdateWorkerResponse_messageType struct{}
 
-func (x fastReflection_MsgProposeSolutionResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgProposeSolutionResponse)(nil)
+func (x fastReflection_MsgUpdateWorkerResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateWorkerResponse)(nil)
 }
-func (x fastReflection_MsgProposeSolutionResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgProposeSolutionResponse)
+func (x fastReflection_MsgUpdateWorkerResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateWorkerResponse)
 }
-func (x fastReflection_MsgProposeSolutionResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgProposeSolutionResponse
+func (x fastReflection_MsgUpdateWorkerResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateWorkerResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgProposeSolutionResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgProposeSolutionResponse
+func (x *fastReflection_MsgUpdateWorkerResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateWorkerResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgProposeSolutionResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgProposeSolutionResponse_messageType
+func (x *fastReflection_MsgUpdateWorkerResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateWorkerResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgProposeSolutionResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgProposeSolutionResponse)
+func (x *fastReflection_MsgUpdateWorkerResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateWorkerResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgProposeSolutionResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgProposeSolutionResponse)(x)
+func (x *fastReflection_MsgUpdateWorkerResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateWorkerResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4070,7 +4792,7 @@ func (x *fastReflection_MsgProposeSolutionResponse) Interface() protoreflect.Pro
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgProposeSolutionResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgUpdateWorkerResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -4084,13 +4806,13 @@ func (x *fastReflection_MsgProposeSolutionResponse) Range(f func(protoreflect.Fi
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgProposeSolutionResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgUpdateWorkerResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgProposeSolutionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgUpdateWorkerResponse"))
 		}
-		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgProposeSolutionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgUpdateWorkerResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4100,13 +4822,13 @@ func (x *fastReflection_MsgProposeSolutionResponse) Has(fd protoreflect.FieldDes
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgProposeSolutionResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgUpdateWorkerResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgProposeSolutionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgUpdateWorkerResponse"))
 		}
-		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgProposeSolutionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgUpdateWorkerResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4116,13 +4838,13 @@ func (x *fastReflection_MsgProposeSolutionResponse) Clear(fd protoreflect.FieldD
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgProposeSolutionResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateWorkerResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgProposeSolutionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgUpdateWorkerResponse"))
 		}
-		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgProposeSolutionResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgUpdateWorkerResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4136,13 +4858,13 @@ func (x *fastReflection_MsgProposeSolutionResponse) Get(descriptor protoreflect.
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgProposeSolutionResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgUpdateWorkerResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgProposeSolutionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgUpdateWorkerResponse"))
 		}
-		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgProposeSolutionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgUpdateWorkerResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4156,36 +4878,36 @@ func (x *fastReflection_MsgProposeSolutionResponse) Set(fd protoreflect.FieldDes
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgProposeSolutionResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateWorkerResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgProposeSolutionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgUpdateWorkerResponse"))
 		}
-		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgProposeSolutionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgUpdateWorkerResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgProposeSolutionResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateWorkerResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgProposeSolutionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgUpdateWorkerResponse"))
 		}
-		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgProposeSolutionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgUpdateWorkerResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgProposeSolutionResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgUpdateWorkerResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in janction.videoUpscaler.v1.MsgProposeSolutionResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in janction.videoUpscaler.v1.MsgUpdateWorkerResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4193,7 +4915,7 @@ func (x *fastReflection_MsgProposeSolutionResponse) WhichOneof(d protoreflect.On
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgProposeSolutionResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgUpdateWorkerResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4204,7 +4926,7 @@ func (x *fastReflection_MsgProposeSolutionResponse) GetUnknown() protoreflect.Ra
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgProposeSolutionResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgUpdateWorkerResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4216,7 +4938,7 @@ func (x *fastReflection_MsgProposeSolutionResponse) SetUnknown(fields protorefle
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgProposeSolutionResponse) IsValid() bool {
+func (x *fastReflection_MsgUpdateWorkerResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -4226,9 +4948,9 @@ func (x *fastReflection_MsgProposeSolutionResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgProposeSolutionResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgUpdateWorkerResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgProposeSolutionResponse)
+		x := input.Message.Interface().(*MsgUpdateWorkerResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4250,7 +4972,7 @@ func (x *fastReflection_MsgProposeSolutionResponse) ProtoMethods() *protoiface.M
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgProposeSolutionResponse)
+		x := input.Message.Interface().(*MsgUpdateWorkerResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4280,7 +5002,7 @@ func (x *fastReflection_MsgProposeSolutionResponse) ProtoMethods() *protoiface.M
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgProposeSolutionResponse)
+		x := input.Message.Interface().(*MsgUpdateWorkerResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4312,10 +5034,10 @@ func (x *fastReflection_MsgProposeSolutionResponse) ProtoMethods() *protoiface.M
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgProposeSolutionResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateWorkerResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgProposeSolutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateWorkerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			default:
@@ -4353,126 +5075,76 @@ func (x *fastReflection_MsgProposeSolutionResponse) ProtoMethods() *protoiface.M
 	}
 }
 
-var _ protoreflect.List = (*_MsgRevealSolution_4_list)(nil)
+var (
+	md_MsgUpdateWorkerCapabilities              protoreflect.MessageDescriptor
+	fd_MsgUpdateWorkerCapabilities_creator      protoreflect.FieldDescriptor
+	fd_MsgUpdateWorkerCapabilities_capabilities protoreflect.FieldDescriptor
+)
 
-type _MsgRevealSolution_4_list struct {
-	list *[]string
+func init() {
+	file_janction_videoUpscaler_v1_tx_proto_init()
+	md_MsgUpdateWorkerCapabilities = File_janction_videoUpscaler_v1_tx_proto.Messages().ByName("MsgUpdateWorkerCapabilities")
+	fd_MsgUpdateWorkerCapabilities_creator = md_MsgUpdateWorkerCapabilities.Fields().ByName("creator")
+	fd_MsgUpdateWorkerCapabilities_capabilities = md_MsgUpdateWorkerCapabilities.Fields().ByName("capabilities")
 }
 
-func (x *_MsgRevealSolution_4_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
+var _ protoreflect.Message = (*fastReflection_MsgUpdateWorkerCapabilities)(nil)
 
-func (x *_MsgRevealSolution_4_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
+type fastReflection_MsgUpdateWorkerCapabilities MsgUpdateWorkerCapabilities
+
+func (x *MsgUpdateWorkerCapabilities) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateWorkerCapabilities)(x)
 }
 
-func (x *_MsgRevealSolution_4_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
+func (x *MsgUpdateWorkerCapabilities) slowProtoReflect() protoreflect.Message {
+	mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *_MsgRevealSolution_4_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
+var _fastReflection_MsgUpdateWorkerCapabilities_messageType fastReflection_MsgUpdateWorkerCapabilities_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateWorkerCapabilities_messageType{}
+
+type fastReflection_MsgUpdateWorkerCapabilities_messageType struct{}
+
+func (x fastReflection_MsgUpdateWorkerCapabilities_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateWorkerCapabilities)(nil)
+}
+func (x fastReflection_MsgUpdateWorkerCapabilities_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateWorkerCapabilities)
+}
+func (x fastReflection_MsgUpdateWorkerCapabilities_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateWorkerCapabilities
 }
 
-func (x *_MsgRevealSolution_4_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message MsgRevealSolution at list field Frames as it is not of Message kind"))
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdateWorkerCapabilities) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateWorkerCapabilities
 }
 
-func (x *_MsgRevealSolution_4_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdateWorkerCapabilities) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateWorkerCapabilities_messageType
 }
 
-func (x *_MsgRevealSolution_4_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdateWorkerCapabilities) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateWorkerCapabilities)
 }
 
-func (x *_MsgRevealSolution_4_list) IsValid() bool {
-	return x.list != nil
-}
-
-var (
-	md_MsgRevealSolution          protoreflect.MessageDescriptor
-	fd_MsgRevealSolution_creator  protoreflect.FieldDescriptor
-	fd_MsgRevealSolution_taskId   protoreflect.FieldDescriptor
-	fd_MsgRevealSolution_threadId protoreflect.FieldDescriptor
-	fd_MsgRevealSolution_frames   protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_janction_videoUpscaler_v1_tx_proto_init()
-	md_MsgRevealSolution = File_janction_videoUpscaler_v1_tx_proto.Messages().ByName("MsgRevealSolution")
-	fd_MsgRevealSolution_creator = md_MsgRevealSolution.Fields().ByName("creator")
-	fd_MsgRevealSolution_taskId = md_MsgRevealSolution.Fields().ByName("taskId")
-	fd_MsgRevealSolution_threadId = md_MsgRevealSolution.Fields().ByName("threadId")
-	fd_MsgRevealSolution_frames = md_MsgRevealSolution.Fields().ByName("frames")
-}
-
-var _ protoreflect.Message = (*fastReflection_MsgRevealSolution)(nil)
-
-type fastReflection_MsgRevealSolution MsgRevealSolution
-
-func (x *MsgRevealSolution) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgRevealSolution)(x)
-}
-
-func (x *MsgRevealSolution) slowProtoReflect() protoreflect.Message {
-	mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[8]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-var _fastReflection_MsgRevealSolution_messageType fastReflection_MsgRevealSolution_messageType
-var _ protoreflect.MessageType = fastReflection_MsgRevealSolution_messageType{}
-
-type fastReflection_MsgRevealSolution_messageType struct{}
-
-func (x fastReflection_MsgRevealSolution_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgRevealSolution)(nil)
-}
-func (x fastReflection_MsgRevealSolution_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgRevealSolution)
-}
-func (x fastReflection_MsgRevealSolution_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgRevealSolution
-}
-
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_MsgRevealSolution) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgRevealSolution
-}
-
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgRevealSolution) Type() protoreflect.MessageType {
-	return _fastReflection_MsgRevealSolution_messageType
-}
-
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgRevealSolution) New() protoreflect.Message {
-	return new(fastReflection_MsgRevealSolution)
-}
-
-// Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgRevealSolution) Interface() protoreflect.ProtoMessage {
-	return (*MsgRevealSolution)(x)
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdateWorkerCapabilities) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateWorkerCapabilities)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4480,28 +5152,16 @@ func (x *fastReflection_MsgRevealSolution) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgRevealSolution) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgUpdateWorkerCapabilities) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Creator != "" {
 		value := protoreflect.ValueOfString(x.Creator)
-		if !f(fd_MsgRevealSolution_creator, value) {
-			return
-		}
-	}
-	if x.TaskId != "" {
-		value := protoreflect.ValueOfString(x.TaskId)
-		if !f(fd_MsgRevealSolution_taskId, value) {
-			return
-		}
-	}
-	if x.ThreadId != "" {
-		value := protoreflect.ValueOfString(x.ThreadId)
-		if !f(fd_MsgRevealSolution_threadId, value) {
+		if !f(fd_MsgUpdateWorkerCapabilities_creator, value) {
 			return
 		}
 	}
-	if len(x.Frames) != 0 {
-		value := protoreflect.ValueOfList(&_MsgRevealSolution_4_list{list: &x.Frames})
-		if !f(fd_MsgRevealSolution_frames, value) {
+	if x.Capabilities != nil {
+		value := protoreflect.ValueOfMessage(x.Capabilities.ProtoReflect())
+		if !f(fd_MsgUpdateWorkerCapabilities_capabilities, value) {
 			return
 		}
 	}
@@ -4518,21 +5178,17 @@ func (x *fastReflection_MsgRevealSolution) Range(f func(protoreflect.FieldDescri
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgRevealSolution) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgUpdateWorkerCapabilities) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "janction.videoUpscaler.v1.MsgRevealSolution.creator":
+	case "janction.videoUpscaler.v1.MsgUpdateWorkerCapabilities.creator":
 		return x.Creator != ""
-	case "janction.videoUpscaler.v1.MsgRevealSolution.taskId":
-		return x.TaskId != ""
-	case "janction.videoUpscaler.v1.MsgRevealSolution.threadId":
-		return x.ThreadId != ""
-	case "janction.videoUpscaler.v1.MsgRevealSolution.frames":
-		return len(x.Frames) != 0
+	case "janction.videoUpscaler.v1.MsgUpdateWorkerCapabilities.capabilities":
+		return x.Capabilities != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgRevealSolution"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgUpdateWorkerCapabilities"))
 		}
-		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgRevealSolution does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgUpdateWorkerCapabilities does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4542,21 +5198,17 @@ func (x *fastReflection_MsgRevealSolution) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRevealSolution) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgUpdateWorkerCapabilities) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "janction.videoUpscaler.v1.MsgRevealSolution.creator":
+	case "janction.videoUpscaler.v1.MsgUpdateWorkerCapabilities.creator":
 		x.Creator = ""
-	case "janction.videoUpscaler.v1.MsgRevealSolution.taskId":
-		x.TaskId = ""
-	case "janction.videoUpscaler.v1.MsgRevealSolution.threadId":
-		x.ThreadId = ""
-	case "janction.videoUpscaler.v1.MsgRevealSolution.frames":
-		x.Frames = nil
+	case "janction.videoUpscaler.v1.MsgUpdateWorkerCapabilities.capabilities":
+		x.Capabilities = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgRevealSolution"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgUpdateWorkerCapabilities"))
 		}
-		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgRevealSolution does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgUpdateWorkerCapabilities does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4566,28 +5218,19 @@ func (x *fastReflection_MsgRevealSolution) Clear(fd protoreflect.FieldDescriptor
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgRevealSolution) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateWorkerCapabilities) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "janction.videoUpscaler.v1.MsgRevealSolution.creator":
+	case "janction.videoUpscaler.v1.MsgUpdateWorkerCapabilities.creator":
 		value := x.Creator
 		return protoreflect.ValueOfString(value)
-	case "janction.videoUpscaler.v1.MsgRevealSolution.taskId":
-		value := x.TaskId
-		return protoreflect.ValueOfString(value)
-	case "janction.videoUpscaler.v1.MsgRevealSolution.threadId":
-		value := x.ThreadId
-		return protoreflect.ValueOfString(value)
-	case "janction.videoUpscaler.v1.MsgRevealSolution.frames":
-		if len(x.Frames) == 0 {
-			return protoreflect.ValueOfList(&_MsgRevealSolution_4_list{})
-		}
-		listValue := &_MsgRevealSolution_4_list{list: &x.Frames}
-		return protoreflect.ValueOfList(listValue)
+	case "janction.videoUpscaler.v1.MsgUpdateWorkerCapabilities.capabilities":
+		value := x.Capabilities
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgRevealSolution"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgUpdateWorkerCapabilities"))
 		}
-		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgRevealSolution does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgUpdateWorkerCapabilities does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4601,23 +5244,17 @@ func (x *fastReflection_MsgRevealSolution) Get(descriptor protoreflect.FieldDesc
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRevealSolution) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgUpdateWorkerCapabilities) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "janction.videoUpscaler.v1.MsgRevealSolution.creator":
+	case "janction.videoUpscaler.v1.MsgUpdateWorkerCapabilities.creator":
 		x.Creator = value.Interface().(string)
-	case "janction.videoUpscaler.v1.MsgRevealSolution.taskId":
-		x.TaskId = value.Interface().(string)
-	case "janction.videoUpscaler.v1.MsgRevealSolution.threadId":
-		x.ThreadId = value.Interface().(string)
-	case "janction.videoUpscaler.v1.MsgRevealSolution.frames":
-		lv := value.List()
-		clv := lv.(*_MsgRevealSolution_4_list)
-		x.Frames = *clv.list
+	case "janction.videoUpscaler.v1.MsgUpdateWorkerCapabilities.capabilities":
+		x.Capabilities = value.Message().Interface().(*WorkerCapabilities)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgRevealSolution"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgUpdateWorkerCapabilities"))
 		}
-		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgRevealSolution does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgUpdateWorkerCapabilities does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4631,57 +5268,48 @@ func (x *fastReflection_MsgRevealSolution) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRevealSolution) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateWorkerCapabilities) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "janction.videoUpscaler.v1.MsgRevealSolution.frames":
-		if x.Frames == nil {
-			x.Frames = []string{}
+	case "janction.videoUpscaler.v1.MsgUpdateWorkerCapabilities.capabilities":
+		if x.Capabilities == nil {
+			x.Capabilities = new(WorkerCapabilities)
 		}
-		value := &_MsgRevealSolution_4_list{list: &x.Frames}
-		return protoreflect.ValueOfList(value)
-	case "janction.videoUpscaler.v1.MsgRevealSolution.creator":
-		panic(fmt.Errorf("field creator of message janction.videoUpscaler.v1.MsgRevealSolution is not mutable"))
-	case "janction.videoUpscaler.v1.MsgRevealSolution.taskId":
-		panic(fmt.Errorf("field taskId of message janction.videoUpscaler.v1.MsgRevealSolution is not mutable"))
-	case "janction.videoUpscaler.v1.MsgRevealSolution.threadId":
-		panic(fmt.Errorf("field threadId of message janction.videoUpscaler.v1.MsgRevealSolution is not mutable"))
+		return protoreflect.ValueOfMessage(x.Capabilities.ProtoReflect())
+	case "janction.videoUpscaler.v1.MsgUpdateWorkerCapabilities.creator":
+		panic(fmt.Errorf("field creator of message janction.videoUpscaler.v1.MsgUpdateWorkerCapabilities is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgRevealSolution"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgUpdateWorkerCapabilities"))
 		}
-		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgRevealSolution does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgUpdateWorkerCapabilities does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgRevealSolution) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateWorkerCapabilities) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "janction.videoUpscaler.v1.MsgRevealSolution.creator":
-		return protoreflect.ValueOfString("")
-	case "janction.videoUpscaler.v1.MsgRevealSolution.taskId":
+	case "janction.videoUpscaler.v1.MsgUpdateWorkerCapabilities.creator":
 		return protoreflect.ValueOfString("")
-	case "janction.videoUpscaler.v1.MsgRevealSolution.threadId":
-		return protoreflect.ValueOfString("")
-	case "janction.videoUpscaler.v1.MsgRevealSolution.frames":
-		list := []string{}
-		return protoreflect.ValueOfList(&_MsgRevealSolution_4_list{list: &list})
+	case "janction.videoUpscaler.v1.MsgUpdateWorkerCapabilities.capabilities":
+		m := new(WorkerCapabilities)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgRevealSolution"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgUpdateWorkerCapabilities"))
 		}
-		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgRevealSolution does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgUpdateWorkerCapabilities does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgRevealSolution) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgUpdateWorkerCapabilities) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in janction.videoUpscaler.v1.MsgRevealSolution", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in janction.videoUpscaler.v1.MsgUpdateWorkerCapabilities", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4689,7 +5317,7 @@ func (x *fastReflection_MsgRevealSolution) WhichOneof(d protoreflect.OneofDescri
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgRevealSolution) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgUpdateWorkerCapabilities) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4700,7 +5328,7 @@ func (x *fastReflection_MsgRevealSolution) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRevealSolution) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgUpdateWorkerCapabilities) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4712,7 +5340,7 @@ func (x *fastReflection_MsgRevealSolution) SetUnknown(fields protoreflect.RawFie
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgRevealSolution) IsValid() bool {
+func (x *fastReflection_MsgUpdateWorkerCapabilities) IsValid() bool {
 	return x != nil
 }
 
@@ -4722,9 +5350,9 @@ func (x *fastReflection_MsgRevealSolution) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgRevealSolution) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgUpdateWorkerCapabilities) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgRevealSolution)
+		x := input.Message.Interface().(*MsgUpdateWorkerCapabilities)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4740,20 +5368,10 @@ func (x *fastReflection_MsgRevealSolution) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.TaskId)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.ThreadId)
-		if l > 0 {
+		if x.Capabilities != nil {
+			l = options.Size(x.Capabilities)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.Frames) > 0 {
-			for _, s := range x.Frames {
-				l = len(s)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -4764,7 +5382,7 @@ func (x *fastReflection_MsgRevealSolution) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgRevealSolution)
+		x := input.Message.Interface().(*MsgUpdateWorkerCapabilities)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4783,26 +5401,17 @@ func (x *fastReflection_MsgRevealSolution) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Frames) > 0 {
-			for iNdEx := len(x.Frames) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.Frames[iNdEx])
-				copy(dAtA[i:], x.Frames[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Frames[iNdEx])))
-				i--
-				dAtA[i] = 0x22
+		if x.Capabilities != nil {
+			encoded, err := options.Marshal(x.Capabilities)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
 			}
-		}
-		if len(x.ThreadId) > 0 {
-			i -= len(x.ThreadId)
-			copy(dAtA[i:], x.ThreadId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ThreadId)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.TaskId) > 0 {
-			i -= len(x.TaskId)
-			copy(dAtA[i:], x.TaskId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TaskId)))
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0x12
 		}
@@ -4824,7 +5433,7 @@ func (x *fastReflection_MsgRevealSolution) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgRevealSolution)
+		x := input.Message.Interface().(*MsgUpdateWorkerCapabilities)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4856,10 +5465,10 @@ func (x *fastReflection_MsgRevealSolution) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRevealSolution: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateWorkerCapabilities: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRevealSolution: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateWorkerCapabilities: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -4896,9 +5505,9 @@ func (x *fastReflection_MsgRevealSolution) ProtoMethods() *protoiface.Methods {
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Capabilities", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4908,96 +5517,36 @@ func (x *fastReflection_MsgRevealSolution) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.TaskId = string(dAtA[iNdEx:postIndex])
+				if x.Capabilities == nil {
+					x.Capabilities = &WorkerCapabilities{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Capabilities); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ThreadId", wireType)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.ThreadId = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Frames", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Frames = append(x.Frames, string(dAtA[iNdEx:postIndex]))
-				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if (iNdEx + skippy) > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
@@ -5026,23 +5575,23 @@ func (x *fastReflection_MsgRevealSolution) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MsgRevealSolutionResponse protoreflect.MessageDescriptor
+	md_MsgUpdateWorkerCapabilitiesResponse protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_janction_videoUpscaler_v1_tx_proto_init()
-	md_MsgRevealSolutionResponse = File_janction_videoUpscaler_v1_tx_proto.Messages().ByName("MsgRevealSolutionResponse")
+	md_MsgUpdateWorkerCapabilitiesResponse = File_janction_videoUpscaler_v1_tx_proto.Messages().ByName("MsgUpdateWorkerCapabilitiesResponse")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgRevealSolutionResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgUpdateWorkerCapabilitiesResponse)(nil)
 
-type fastReflection_MsgRevealSolutionResponse MsgRevealSolutionResponse
+type fastReflection_MsgUpdateWorkerCapabilitiesResponse MsgUpdateWorkerCapabilitiesResponse
 
-func (x *MsgRevealSolutionResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgRevealSolutionResponse)(x)
+func (x *MsgUpdateWorkerCapabilitiesResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateWorkerCapabilitiesResponse)(x)
 }
 
-func (x *MsgRevealSolutionResponse) slowProtoReflect() protoreflect.Message {
+func (x *MsgUpdateWorkerCapabilitiesResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5054,43 +5603,43 @@ func (x *MsgRevealSolutionResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgRevealSolutionResponse_messageType fastReflection_MsgRevealSolutionResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgRevealSolutionResponse_messageType{}
+var _fastReflection_MsgUpdateWorkerCapabilitiesResponse_messageType fastReflection_MsgUpdateWorkerCapabilitiesResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateWorkerCapabilitiesResponse_messageType{}
 
-type fastReflection_MsgRevealSolutionResponse_messageType struct{}
+type fastReflection_MsgUpdateWorkerCapabilitiesResponse_messageType struct{}
 
-func (x fastReflection_MsgRevealSolutionResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgRevealSolutionResponse)(nil)
+func (x fastReflection_MsgUpdateWorkerCapabilitiesResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateWorkerCapabilitiesResponse)(nil)
 }
-func (x fastReflection_MsgRevealSolutionResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgRevealSolutionResponse)
+func (x fastReflection_MsgUpdateWorkerCapabilitiesResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateWorkerCapabilitiesResponse)
 }
-func (x fastReflection_MsgRevealSolutionResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgRevealSolutionResponse
+func (x fastReflection_MsgUpdateWorkerCapabilitiesResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateWorkerCapabilitiesResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgRevealSolutionResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgRevealSolutionResponse
+func (x *fastReflection_MsgUpdateWorkerCapabilitiesResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateWorkerCapabilitiesResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgRevealSolutionResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgRevealSolutionResponse_messageType
+func (x *fastReflection_MsgUpdateWorkerCapabilitiesResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateWorkerCapabilitiesResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgRevealSolutionResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgRevealSolutionResponse)
+func (x *fastReflection_MsgUpdateWorkerCapabilitiesResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateWorkerCapabilitiesResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgRevealSolutionResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgRevealSolutionResponse)(x)
+func (x *fastReflection_MsgUpdateWorkerCapabilitiesResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateWorkerCapabilitiesResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5098,7 +5647,7 @@ func (x *fastReflection_MsgRevealSolutionResponse) Interface() protoreflect.Prot
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgRevealSolutionResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgUpdateWorkerCapabilitiesResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -5112,13 +5661,13 @@ func (x *fastReflection_MsgRevealSolutionResponse) Range(f func(protoreflect.Fie
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgRevealSolutionResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgUpdateWorkerCapabilitiesResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgRevealSolutionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgUpdateWorkerCapabilitiesResponse"))
 		}
-		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgRevealSolutionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgUpdateWorkerCapabilitiesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5128,13 +5677,13 @@ func (x *fastReflection_MsgRevealSolutionResponse) Has(fd protoreflect.FieldDesc
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRevealSolutionResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgUpdateWorkerCapabilitiesResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgRevealSolutionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgUpdateWorkerCapabilitiesResponse"))
 		}
-		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgRevealSolutionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgUpdateWorkerCapabilitiesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5144,13 +5693,13 @@ func (x *fastReflection_MsgRevealSolutionResponse) Clear(fd protoreflect.FieldDe
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgRevealSolutionResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateWorkerCapabilitiesResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgRevealSolutionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgUpdateWorkerCapabilitiesResponse"))
 		}
-		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgRevealSolutionResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgUpdateWorkerCapabilitiesResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5164,13 +5713,13 @@ func (x *fastReflection_MsgRevealSolutionResponse) Get(descriptor protoreflect.F
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRevealSolutionResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgUpdateWorkerCapabilitiesResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgRevealSolutionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgUpdateWorkerCapabilitiesResponse"))
 		}
-		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgRevealSolutionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgUpdateWorkerCapabilitiesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5184,36 +5733,36 @@ func (x *fastReflection_MsgRevealSolutionResponse) Set(fd protoreflect.FieldDesc
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRevealSolutionResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateWorkerCapabilitiesResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgRevealSolutionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgUpdateWorkerCapabilitiesResponse"))
 		}
-		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgRevealSolutionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgUpdateWorkerCapabilitiesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgRevealSolutionResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateWorkerCapabilitiesResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgRevealSolutionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgUpdateWorkerCapabilitiesResponse"))
 		}
-		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgRevealSolutionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgUpdateWorkerCapabilitiesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgRevealSolutionResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgUpdateWorkerCapabilitiesResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in janction.videoUpscaler.v1.MsgRevealSolutionResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in janction.videoUpscaler.v1.MsgUpdateWorkerCapabilitiesResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5221,7 +5770,7 @@ func (x *fastReflection_MsgRevealSolutionResponse) WhichOneof(d protoreflect.One
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgRevealSolutionResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgUpdateWorkerCapabilitiesResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5232,7 +5781,7 @@ func (x *fastReflection_MsgRevealSolutionResponse) GetUnknown() protoreflect.Raw
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRevealSolutionResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgUpdateWorkerCapabilitiesResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5244,7 +5793,7 @@ func (x *fastReflection_MsgRevealSolutionResponse) SetUnknown(fields protoreflec
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgRevealSolutionResponse) IsValid() bool {
+func (x *fastReflection_MsgUpdateWorkerCapabilitiesResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -5254,9 +5803,9 @@ func (x *fastReflection_MsgRevealSolutionResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgRevealSolutionResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgUpdateWorkerCapabilitiesResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgRevealSolutionResponse)
+		x := input.Message.Interface().(*MsgUpdateWorkerCapabilitiesResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5278,7 +5827,7 @@ func (x *fastReflection_MsgRevealSolutionResponse) ProtoMethods() *protoiface.Me
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgRevealSolutionResponse)
+		x := input.Message.Interface().(*MsgUpdateWorkerCapabilitiesResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5308,7 +5857,7 @@ func (x *fastReflection_MsgRevealSolutionResponse) ProtoMethods() *protoiface.Me
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgRevealSolutionResponse)
+		x := input.Message.Interface().(*MsgUpdateWorkerCapabilitiesResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5340,10 +5889,10 @@ func (x *fastReflection_MsgRevealSolutionResponse) ProtoMethods() *protoiface.Me
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRevealSolutionResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateWorkerCapabilitiesResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRevealSolutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateWorkerCapabilitiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			default:
@@ -5381,80 +5930,26 @@ func (x *fastReflection_MsgRevealSolutionResponse) ProtoMethods() *protoiface.Me
 	}
 }
 
-var _ protoreflect.List = (*_MsgSubmitValidation_5_list)(nil)
-
-type _MsgSubmitValidation_5_list struct {
-	list *[]string
-}
-
-func (x *_MsgSubmitValidation_5_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_MsgSubmitValidation_5_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
-}
-
-func (x *_MsgSubmitValidation_5_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_MsgSubmitValidation_5_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_MsgSubmitValidation_5_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message MsgSubmitValidation at list field Signatures as it is not of Message kind"))
-}
-
-func (x *_MsgSubmitValidation_5_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_MsgSubmitValidation_5_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
-}
-
-func (x *_MsgSubmitValidation_5_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_MsgSubmitValidation            protoreflect.MessageDescriptor
-	fd_MsgSubmitValidation_creator    protoreflect.FieldDescriptor
-	fd_MsgSubmitValidation_taskId     protoreflect.FieldDescriptor
-	fd_MsgSubmitValidation_threadId   protoreflect.FieldDescriptor
-	fd_MsgSubmitValidation_public_key protoreflect.FieldDescriptor
-	fd_MsgSubmitValidation_signatures protoreflect.FieldDescriptor
+	md_MsgWorkerHeartbeat         protoreflect.MessageDescriptor
+	fd_MsgWorkerHeartbeat_creator protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_janction_videoUpscaler_v1_tx_proto_init()
-	md_MsgSubmitValidation = File_janction_videoUpscaler_v1_tx_proto.Messages().ByName("MsgSubmitValidation")
-	fd_MsgSubmitValidation_creator = md_MsgSubmitValidation.Fields().ByName("creator")
-	fd_MsgSubmitValidation_taskId = md_MsgSubmitValidation.Fields().ByName("taskId")
-	fd_MsgSubmitValidation_threadId = md_MsgSubmitValidation.Fields().ByName("threadId")
-	fd_MsgSubmitValidation_public_key = md_MsgSubmitValidation.Fields().ByName("public_key")
-	fd_MsgSubmitValidation_signatures = md_MsgSubmitValidation.Fields().ByName("signatures")
+	md_MsgWorkerHeartbeat = File_janction_videoUpscaler_v1_tx_proto.Messages().ByName("MsgWorkerHeartbeat")
+	fd_MsgWorkerHeartbeat_creator = md_MsgWorkerHeartbeat.Fields().ByName("creator")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgSubmitValidation)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgWorkerHeartbeat)(nil)
 
-type fastReflection_MsgSubmitValidation MsgSubmitValidation
+type fastReflection_MsgWorkerHeartbeat MsgWorkerHeartbeat
 
-func (x *MsgSubmitValidation) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgSubmitValidation)(x)
+func (x *MsgWorkerHeartbeat) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgWorkerHeartbeat)(x)
 }
 
-func (x *MsgSubmitValidation) slowProtoReflect() protoreflect.Message {
+func (x *MsgWorkerHeartbeat) slowProtoReflect() protoreflect.Message {
 	mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5466,43 +5961,43 @@ func (x *MsgSubmitValidation) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgSubmitValidation_messageType fastReflection_MsgSubmitValidation_messageType
-var _ protoreflect.MessageType = fastReflection_MsgSubmitValidation_messageType{}
+var _fastReflection_MsgWorkerHeartbeat_messageType fastReflection_MsgWorkerHeartbeat_messageType
+var _ protoreflect.MessageType = fastReflection_MsgWorkerHeartbeat_messageType{}
 
-type fastReflection_MsgSubmitValidation_messageType struct{}
+type fastReflection_MsgWorkerHeartbeat_messageType struct{}
 
-func (x fastReflection_MsgSubmitValidation_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgSubmitValidation)(nil)
+func (x fastReflection_MsgWorkerHeartbeat_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgWorkerHeartbeat)(nil)
 }
-func (x fastReflection_MsgSubmitValidation_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgSubmitValidation)
+func (x fastReflection_MsgWorkerHeartbeat_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgWorkerHeartbeat)
 }
-func (x fastReflection_MsgSubmitValidation_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSubmitValidation
+func (x fastReflection_MsgWorkerHeartbeat_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgWorkerHeartbeat
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgSubmitValidation) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSubmitValidation
+func (x *fastReflection_MsgWorkerHeartbeat) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgWorkerHeartbeat
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgSubmitValidation) Type() protoreflect.MessageType {
-	return _fastReflection_MsgSubmitValidation_messageType
+func (x *fastReflection_MsgWorkerHeartbeat) Type() protoreflect.MessageType {
+	return _fastReflection_MsgWorkerHeartbeat_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgSubmitValidation) New() protoreflect.Message {
-	return new(fastReflection_MsgSubmitValidation)
+func (x *fastReflection_MsgWorkerHeartbeat) New() protoreflect.Message {
+	return new(fastReflection_MsgWorkerHeartbeat)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgSubmitValidation) Interface() protoreflect.ProtoMessage {
-	return (*MsgSubmitValidation)(x)
+func (x *fastReflection_MsgWorkerHeartbeat) Interface() protoreflect.ProtoMessage {
+	return (*MsgWorkerHeartbeat)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5510,34 +6005,10 @@ func (x *fastReflection_MsgSubmitValidation) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgSubmitValidation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgWorkerHeartbeat) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Creator != "" {
 		value := protoreflect.ValueOfString(x.Creator)
-		if !f(fd_MsgSubmitValidation_creator, value) {
-			return
-		}
-	}
-	if x.TaskId != "" {
-		value := protoreflect.ValueOfString(x.TaskId)
-		if !f(fd_MsgSubmitValidation_taskId, value) {
-			return
-		}
-	}
-	if x.ThreadId != "" {
-		value := protoreflect.ValueOfString(x.ThreadId)
-		if !f(fd_MsgSubmitValidation_threadId, value) {
-			return
-		}
-	}
-	if x.PublicKey != "" {
-		value := protoreflect.ValueOfString(x.PublicKey)
-		if !f(fd_MsgSubmitValidation_public_key, value) {
-			return
-		}
-	}
-	if len(x.Signatures) != 0 {
-		value := protoreflect.ValueOfList(&_MsgSubmitValidation_5_list{list: &x.Signatures})
-		if !f(fd_MsgSubmitValidation_signatures, value) {
+		if !f(fd_MsgWorkerHeartbeat_creator, value) {
 			return
 		}
 	}
@@ -5554,23 +6025,15 @@ func (x *fastReflection_MsgSubmitValidation) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgSubmitValidation) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgWorkerHeartbeat) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "janction.videoUpscaler.v1.MsgSubmitValidation.creator":
+	case "janction.videoUpscaler.v1.MsgWorkerHeartbeat.creator":
 		return x.Creator != ""
-	case "janction.videoUpscaler.v1.MsgSubmitValidation.taskId":
-		return x.TaskId != ""
-	case "janction.videoUpscaler.v1.MsgSubmitValidation.threadId":
-		return x.ThreadId != ""
-	case "janction.videoUpscaler.v1.MsgSubmitValidation.public_key":
-		return x.PublicKey != ""
-	case "janction.videoUpscaler.v1.MsgSubmitValidation.signatures":
-		return len(x.Signatures) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubmitValidation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgWorkerHeartbeat"))
 		}
-		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubmitValidation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgWorkerHeartbeat does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5580,23 +6043,15 @@ func (x *fastReflection_MsgSubmitValidation) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitValidation) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgWorkerHeartbeat) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "janction.videoUpscaler.v1.MsgSubmitValidation.creator":
+	case "janction.videoUpscaler.v1.MsgWorkerHeartbeat.creator":
 		x.Creator = ""
-	case "janction.videoUpscaler.v1.MsgSubmitValidation.taskId":
-		x.TaskId = ""
-	case "janction.videoUpscaler.v1.MsgSubmitValidation.threadId":
-		x.ThreadId = ""
-	case "janction.videoUpscaler.v1.MsgSubmitValidation.public_key":
-		x.PublicKey = ""
-	case "janction.videoUpscaler.v1.MsgSubmitValidation.signatures":
-		x.Signatures = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubmitValidation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgWorkerHeartbeat"))
 		}
-		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubmitValidation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgWorkerHeartbeat does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5606,31 +6061,16 @@ func (x *fastReflection_MsgSubmitValidation) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgSubmitValidation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgWorkerHeartbeat) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "janction.videoUpscaler.v1.MsgSubmitValidation.creator":
+	case "janction.videoUpscaler.v1.MsgWorkerHeartbeat.creator":
 		value := x.Creator
 		return protoreflect.ValueOfString(value)
-	case "janction.videoUpscaler.v1.MsgSubmitValidation.taskId":
-		value := x.TaskId
-		return protoreflect.ValueOfString(value)
-	case "janction.videoUpscaler.v1.MsgSubmitValidation.threadId":
-		value := x.ThreadId
-		return protoreflect.ValueOfString(value)
-	case "janction.videoUpscaler.v1.MsgSubmitValidation.public_key":
-		value := x.PublicKey
-		return protoreflect.ValueOfString(value)
-	case "janction.videoUpscaler.v1.MsgSubmitValidation.signatures":
-		if len(x.Signatures) == 0 {
-			return protoreflect.ValueOfList(&_MsgSubmitValidation_5_list{})
-		}
-		listValue := &_MsgSubmitValidation_5_list{list: &x.Signatures}
-		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubmitValidation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgWorkerHeartbeat"))
 		}
-		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubmitValidation does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgWorkerHeartbeat does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5644,25 +6084,15 @@ func (x *fastReflection_MsgSubmitValidation) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitValidation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgWorkerHeartbeat) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "janction.videoUpscaler.v1.MsgSubmitValidation.creator":
+	case "janction.videoUpscaler.v1.MsgWorkerHeartbeat.creator":
 		x.Creator = value.Interface().(string)
-	case "janction.videoUpscaler.v1.MsgSubmitValidation.taskId":
-		x.TaskId = value.Interface().(string)
-	case "janction.videoUpscaler.v1.MsgSubmitValidation.threadId":
-		x.ThreadId = value.Interface().(string)
-	case "janction.videoUpscaler.v1.MsgSubmitValidation.public_key":
-		x.PublicKey = value.Interface().(string)
-	case "janction.videoUpscaler.v1.MsgSubmitValidation.signatures":
-		lv := value.List()
-		clv := lv.(*_MsgSubmitValidation_5_list)
-		x.Signatures = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubmitValidation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgWorkerHeartbeat"))
 		}
-		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubmitValidation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgWorkerHeartbeat does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5676,61 +6106,40 @@ func (x *fastReflection_MsgSubmitValidation) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitValidation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgWorkerHeartbeat) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "janction.videoUpscaler.v1.MsgSubmitValidation.signatures":
-		if x.Signatures == nil {
-			x.Signatures = []string{}
-		}
-		value := &_MsgSubmitValidation_5_list{list: &x.Signatures}
-		return protoreflect.ValueOfList(value)
-	case "janction.videoUpscaler.v1.MsgSubmitValidation.creator":
-		panic(fmt.Errorf("field creator of message janction.videoUpscaler.v1.MsgSubmitValidation is not mutable"))
-	case "janction.videoUpscaler.v1.MsgSubmitValidation.taskId":
-		panic(fmt.Errorf("field taskId of message janction.videoUpscaler.v1.MsgSubmitValidation is not mutable"))
-	case "janction.videoUpscaler.v1.MsgSubmitValidation.threadId":
-		panic(fmt.Errorf("field threadId of message janction.videoUpscaler.v1.MsgSubmitValidation is not mutable"))
-	case "janction.videoUpscaler.v1.MsgSubmitValidation.public_key":
-		panic(fmt.Errorf("field public_key of message janction.videoUpscaler.v1.MsgSubmitValidation is not mutable"))
+	case "janction.videoUpscaler.v1.MsgWorkerHeartbeat.creator":
+		panic(fmt.Errorf("field creator of message janction.videoUpscaler.v1.MsgWorkerHeartbeat is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubmitValidation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgWorkerHeartbeat"))
 		}
-		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubmitValidation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgWorkerHeartbeat does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgSubmitValidation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgWorkerHeartbeat) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "janction.videoUpscaler.v1.MsgSubmitValidation.creator":
-		return protoreflect.ValueOfString("")
-	case "janction.videoUpscaler.v1.MsgSubmitValidation.taskId":
-		return protoreflect.ValueOfString("")
-	case "janction.videoUpscaler.v1.MsgSubmitValidation.threadId":
-		return protoreflect.ValueOfString("")
-	case "janction.videoUpscaler.v1.MsgSubmitValidation.public_key":
+	case "janction.videoUpscaler.v1.MsgWorkerHeartbeat.creator":
 		return protoreflect.ValueOfString("")
-	case "janction.videoUpscaler.v1.MsgSubmitValidation.signatures":
-		list := []string{}
-		return protoreflect.ValueOfList(&_MsgSubmitValidation_5_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubmitValidation"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgWorkerHeartbeat"))
 		}
-		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubmitValidation does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgWorkerHeartbeat does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgSubmitValidation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgWorkerHeartbeat) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in janction.videoUpscaler.v1.MsgSubmitValidation", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in janction.videoUpscaler.v1.MsgWorkerHeartbeat", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5738,7 +6147,7 @@ func (x *fastReflection_MsgSubmitValidation) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgSubmitValidation) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgWorkerHeartbeat) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5749,7 +6158,7 @@ func (x *fastReflection_MsgSubmitValidation) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitValidation) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgWorkerHeartbeat) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5761,7 +6170,7 @@ func (x *fastReflection_MsgSubmitValidation) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgSubmitValidation) IsValid() bool {
+func (x *fastReflection_MsgWorkerHeartbeat) IsValid() bool {
 	return x != nil
 }
 
@@ -5771,9 +6180,9 @@ func (x *fastReflection_MsgSubmitValidation) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgSubmitValidation) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgWorkerHeartbeat) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgSubmitValidation)
+		x := input.Message.Interface().(*MsgWorkerHeartbeat)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5789,24 +6198,6 @@ func (x *fastReflection_MsgSubmitValidation) ProtoMethods() *protoiface.Methods
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.TaskId)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.ThreadId)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.PublicKey)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if len(x.Signatures) > 0 {
-			for _, s := range x.Signatures {
-				l = len(s)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -5817,7 +6208,7 @@ func (x *fastReflection_MsgSubmitValidation) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSubmitValidation)
+		x := input.Message.Interface().(*MsgWorkerHeartbeat)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5836,36 +6227,6 @@ func (x *fastReflection_MsgSubmitValidation) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Signatures) > 0 {
-			for iNdEx := len(x.Signatures) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.Signatures[iNdEx])
-				copy(dAtA[i:], x.Signatures[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signatures[iNdEx])))
-				i--
-				dAtA[i] = 0x2a
-			}
-		}
-		if len(x.PublicKey) > 0 {
-			i -= len(x.PublicKey)
-			copy(dAtA[i:], x.PublicKey)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PublicKey)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if len(x.ThreadId) > 0 {
-			i -= len(x.ThreadId)
-			copy(dAtA[i:], x.ThreadId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ThreadId)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.TaskId) > 0 {
-			i -= len(x.TaskId)
-			copy(dAtA[i:], x.TaskId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TaskId)))
-			i--
-			dAtA[i] = 0x12
-		}
 		if len(x.Creator) > 0 {
 			i -= len(x.Creator)
 			copy(dAtA[i:], x.Creator)
@@ -5884,7 +6245,7 @@ func (x *fastReflection_MsgSubmitValidation) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSubmitValidation)
+		x := input.Message.Interface().(*MsgWorkerHeartbeat)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5916,10 +6277,10 @@ func (x *fastReflection_MsgSubmitValidation) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitValidation: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgWorkerHeartbeat: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitValidation: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgWorkerHeartbeat: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -5954,134 +6315,6 @@ func (x *fastReflection_MsgSubmitValidation) ProtoMethods() *protoiface.Methods
 				}
 				x.Creator = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.TaskId = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ThreadId", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.ThreadId = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.PublicKey = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 5:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Signatures = append(x.Signatures, string(dAtA[iNdEx:postIndex]))
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -6118,23 +6351,23 @@ func (x *fastReflection_MsgSubmitValidation) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_MsgSubmitValidationResponse protoreflect.MessageDescriptor
+	md_MsgWorkerHeartbeatResponse protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_janction_videoUpscaler_v1_tx_proto_init()
-	md_MsgSubmitValidationResponse = File_janction_videoUpscaler_v1_tx_proto.Messages().ByName("MsgSubmitValidationResponse")
+	md_MsgWorkerHeartbeatResponse = File_janction_videoUpscaler_v1_tx_proto.Messages().ByName("MsgWorkerHeartbeatResponse")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgSubmitValidationResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgWorkerHeartbeatResponse)(nil)
 
-type fastReflection_MsgSubmitValidationResponse MsgSubmitValidationResponse
+type fastReflection_MsgWorkerHeartbeatResponse MsgWorkerHeartbeatResponse
 
-func (x *MsgSubmitValidationResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgSubmitValidationResponse)(x)
+func (x *MsgWorkerHeartbeatResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgWorkerHeartbeatResponse)(x)
 }
 
-func (x *MsgSubmitValidationResponse) slowProtoReflect() protoreflect.Message {
+func (x *MsgWorkerHeartbeatResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6146,43 +6379,43 @@ func (x *MsgSubmitValidationResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgSubmitValidationResponse_messageType fastReflection_MsgSubmitValidationResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgSubmitValidationResponse_messageType{}
+var _fastReflection_MsgWorkerHeartbeatResponse_messageType fastReflection_MsgWorkerHeartbeatResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgWorkerHeartbeatResponse_messageType{}
 
-type fastReflection_MsgSubmitValidationResponse_messageType struct{}
+type fastReflection_MsgWorkerHeartbeatResponse_messageType struct{}
 
-func (x fastReflection_MsgSubmitValidationResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgSubmitValidationResponse)(nil)
+func (x fastReflection_MsgWorkerHeartbeatResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgWorkerHeartbeatResponse)(nil)
 }
-func (x fastReflection_MsgSubmitValidationResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgSubmitValidationResponse)
+func (x fastReflection_MsgWorkerHeartbeatResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgWorkerHeartbeatResponse)
 }
-func (x fastReflection_MsgSubmitValidationResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSubmitValidationResponse
+func (x fastReflection_MsgWorkerHeartbeatResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgWorkerHeartbeatResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgSubmitValidationResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSubmitValidationResponse
+func (x *fastReflection_MsgWorkerHeartbeatResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgWorkerHeartbeatResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgSubmitValidationResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgSubmitValidationResponse_messageType
+func (x *fastReflection_MsgWorkerHeartbeatResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgWorkerHeartbeatResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgSubmitValidationResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgSubmitValidationResponse)
+func (x *fastReflection_MsgWorkerHeartbeatResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgWorkerHeartbeatResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgSubmitValidationResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgSubmitValidationResponse)(x)
+func (x *fastReflection_MsgWorkerHeartbeatResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgWorkerHeartbeatResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6190,7 +6423,7 @@ func (x *fastReflection_MsgSubmitValidationResponse) Interface() protoreflect.Pr
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgSubmitValidationResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgWorkerHeartbeatResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -6204,13 +6437,13 @@ func (x *fastReflection_MsgSubmitValidationResponse) Range(f func(protoreflect.F
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgSubmitValidationResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgWorkerHeartbeatResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubmitValidationResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgWorkerHeartbeatResponse"))
 		}
-		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubmitValidationResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgWorkerHeartbeatResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6220,13 +6453,13 @@ func (x *fastReflection_MsgSubmitValidationResponse) Has(fd protoreflect.FieldDe
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitValidationResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgWorkerHeartbeatResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubmitValidationResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgWorkerHeartbeatResponse"))
 		}
-		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubmitValidationResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgWorkerHeartbeatResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6236,13 +6469,13 @@ func (x *fastReflection_MsgSubmitValidationResponse) Clear(fd protoreflect.Field
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgSubmitValidationResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgWorkerHeartbeatResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubmitValidationResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgWorkerHeartbeatResponse"))
 		}
-		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubmitValidationResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgWorkerHeartbeatResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6256,13 +6489,13 @@ func (x *fastReflection_MsgSubmitValidationResponse) Get(descriptor protoreflect
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitValidationResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgWorkerHeartbeatResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubmitValidationResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgWorkerHeartbeatResponse"))
 		}
-		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubmitValidationResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgWorkerHeartbeatResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6276,36 +6509,36 @@ func (x *fastReflection_MsgSubmitValidationResponse) Set(fd protoreflect.FieldDe
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitValidationResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgWorkerHeartbeatResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubmitValidationResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgWorkerHeartbeatResponse"))
 		}
-		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubmitValidationResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgWorkerHeartbeatResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgSubmitValidationResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgWorkerHeartbeatResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubmitValidationResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgWorkerHeartbeatResponse"))
 		}
-		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubmitValidationResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgWorkerHeartbeatResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgSubmitValidationResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgWorkerHeartbeatResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in janction.videoUpscaler.v1.MsgSubmitValidationResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in janction.videoUpscaler.v1.MsgWorkerHeartbeatResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6313,7 +6546,7 @@ func (x *fastReflection_MsgSubmitValidationResponse) WhichOneof(d protoreflect.O
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgSubmitValidationResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgWorkerHeartbeatResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6324,7 +6557,7 @@ func (x *fastReflection_MsgSubmitValidationResponse) GetUnknown() protoreflect.R
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitValidationResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgWorkerHeartbeatResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6336,7 +6569,7 @@ func (x *fastReflection_MsgSubmitValidationResponse) SetUnknown(fields protorefl
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgSubmitValidationResponse) IsValid() bool {
+func (x *fastReflection_MsgWorkerHeartbeatResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -6346,9 +6579,9 @@ func (x *fastReflection_MsgSubmitValidationResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgSubmitValidationResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgWorkerHeartbeatResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgSubmitValidationResponse)
+		x := input.Message.Interface().(*MsgWorkerHeartbeatResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6370,7 +6603,7 @@ func (x *fastReflection_MsgSubmitValidationResponse) ProtoMethods() *protoiface.
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSubmitValidationResponse)
+		x := input.Message.Interface().(*MsgWorkerHeartbeatResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6400,7 +6633,7 @@ func (x *fastReflection_MsgSubmitValidationResponse) ProtoMethods() *protoiface.
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSubmitValidationResponse)
+		x := input.Message.Interface().(*MsgWorkerHeartbeatResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6432,10 +6665,10 @@ func (x *fastReflection_MsgSubmitValidationResponse) ProtoMethods() *protoiface.
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitValidationResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgWorkerHeartbeatResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitValidationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgWorkerHeartbeatResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			default:
@@ -6474,33 +6707,27 @@ func (x *fastReflection_MsgSubmitValidationResponse) ProtoMethods() *protoiface.
 }
 
 var (
-	md_MsgSubmitSolution                        protoreflect.MessageDescriptor
-	fd_MsgSubmitSolution_creator                protoreflect.FieldDescriptor
-	fd_MsgSubmitSolution_taskId                 protoreflect.FieldDescriptor
-	fd_MsgSubmitSolution_threadId               protoreflect.FieldDescriptor
-	fd_MsgSubmitSolution_dir                    protoreflect.FieldDescriptor
-	fd_MsgSubmitSolution_average_render_seconds protoreflect.FieldDescriptor
+	md_MsgUpdateParams           protoreflect.MessageDescriptor
+	fd_MsgUpdateParams_authority protoreflect.FieldDescriptor
+	fd_MsgUpdateParams_params    protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_janction_videoUpscaler_v1_tx_proto_init()
-	md_MsgSubmitSolution = File_janction_videoUpscaler_v1_tx_proto.Messages().ByName("MsgSubmitSolution")
-	fd_MsgSubmitSolution_creator = md_MsgSubmitSolution.Fields().ByName("creator")
-	fd_MsgSubmitSolution_taskId = md_MsgSubmitSolution.Fields().ByName("taskId")
-	fd_MsgSubmitSolution_threadId = md_MsgSubmitSolution.Fields().ByName("threadId")
-	fd_MsgSubmitSolution_dir = md_MsgSubmitSolution.Fields().ByName("dir")
-	fd_MsgSubmitSolution_average_render_seconds = md_MsgSubmitSolution.Fields().ByName("average_render_seconds")
+	md_MsgUpdateParams = File_janction_videoUpscaler_v1_tx_proto.Messages().ByName("MsgUpdateParams")
+	fd_MsgUpdateParams_authority = md_MsgUpdateParams.Fields().ByName("authority")
+	fd_MsgUpdateParams_params = md_MsgUpdateParams.Fields().ByName("params")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgSubmitSolution)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgUpdateParams)(nil)
 
-type fastReflection_MsgSubmitSolution MsgSubmitSolution
+type fastReflection_MsgUpdateParams MsgUpdateParams
 
-func (x *MsgSubmitSolution) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgSubmitSolution)(x)
+func (x *MsgUpdateParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateParams)(x)
 }
 
-func (x *MsgSubmitSolution) slowProtoReflect() protoreflect.Message {
+func (x *MsgUpdateParams) slowProtoReflect() protoreflect.Message {
 	mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6512,43 +6739,43 @@ func (x *MsgSubmitSolution) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgSubmitSolution_messageType fastReflection_MsgSubmitSolution_messageType
-var _ protoreflect.MessageType = fastReflection_MsgSubmitSolution_messageType{}
+var _fastReflection_MsgUpdateParams_messageType fastReflection_MsgUpdateParams_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateParams_messageType{}
 
-type fastReflection_MsgSubmitSolution_messageType struct{}
+type fastReflection_MsgUpdateParams_messageType struct{}
 
-func (x fastReflection_MsgSubmitSolution_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgSubmitSolution)(nil)
+func (x fastReflection_MsgUpdateParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateParams)(nil)
 }
-func (x fastReflection_MsgSubmitSolution_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgSubmitSolution)
+func (x fastReflection_MsgUpdateParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateParams)
 }
-func (x fastReflection_MsgSubmitSolution_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSubmitSolution
+func (x fastReflection_MsgUpdateParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateParams
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgSubmitSolution) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSubmitSolution
+func (x *fastReflection_MsgUpdateParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateParams
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgSubmitSolution) Type() protoreflect.MessageType {
-	return _fastReflection_MsgSubmitSolution_messageType
+func (x *fastReflection_MsgUpdateParams) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateParams_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgSubmitSolution) New() protoreflect.Message {
-	return new(fastReflection_MsgSubmitSolution)
+func (x *fastReflection_MsgUpdateParams) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateParams)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgSubmitSolution) Interface() protoreflect.ProtoMessage {
-	return (*MsgSubmitSolution)(x)
+func (x *fastReflection_MsgUpdateParams) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateParams)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6556,34 +6783,16 @@ func (x *fastReflection_MsgSubmitSolution) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgSubmitSolution) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Creator != "" {
-		value := protoreflect.ValueOfString(x.Creator)
-		if !f(fd_MsgSubmitSolution_creator, value) {
-			return
-		}
-	}
-	if x.TaskId != "" {
-		value := protoreflect.ValueOfString(x.TaskId)
-		if !f(fd_MsgSubmitSolution_taskId, value) {
-			return
-		}
-	}
-	if x.ThreadId != "" {
-		value := protoreflect.ValueOfString(x.ThreadId)
-		if !f(fd_MsgSubmitSolution_threadId, value) {
+func (x *fastReflection_MsgUpdateParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Authority != "" {
+		value := protoreflect.ValueOfString(x.Authority)
+		if !f(fd_MsgUpdateParams_authority, value) {
 			return
 		}
 	}
-	if x.Dir != "" {
-		value := protoreflect.ValueOfString(x.Dir)
-		if !f(fd_MsgSubmitSolution_dir, value) {
-			return
-		}
-	}
-	if x.AverageRenderSeconds != int64(0) {
-		value := protoreflect.ValueOfInt64(x.AverageRenderSeconds)
-		if !f(fd_MsgSubmitSolution_average_render_seconds, value) {
+	if x.Params != nil {
+		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		if !f(fd_MsgUpdateParams_params, value) {
 			return
 		}
 	}
@@ -6600,23 +6809,17 @@ func (x *fastReflection_MsgSubmitSolution) Range(f func(protoreflect.FieldDescri
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgSubmitSolution) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgUpdateParams) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "janction.videoUpscaler.v1.MsgSubmitSolution.creator":
-		return x.Creator != ""
-	case "janction.videoUpscaler.v1.MsgSubmitSolution.taskId":
-		return x.TaskId != ""
-	case "janction.videoUpscaler.v1.MsgSubmitSolution.threadId":
-		return x.ThreadId != ""
-	case "janction.videoUpscaler.v1.MsgSubmitSolution.dir":
-		return x.Dir != ""
-	case "janction.videoUpscaler.v1.MsgSubmitSolution.average_render_seconds":
-		return x.AverageRenderSeconds != int64(0)
+	case "janction.videoUpscaler.v1.MsgUpdateParams.authority":
+		return x.Authority != ""
+	case "janction.videoUpscaler.v1.MsgUpdateParams.params":
+		return x.Params != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubmitSolution"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgUpdateParams"))
 		}
-		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubmitSolution does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgUpdateParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6626,23 +6829,17 @@ func (x *fastReflection_MsgSubmitSolution) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitSolution) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgUpdateParams) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "janction.videoUpscaler.v1.MsgSubmitSolution.creator":
-		x.Creator = ""
-	case "janction.videoUpscaler.v1.MsgSubmitSolution.taskId":
-		x.TaskId = ""
-	case "janction.videoUpscaler.v1.MsgSubmitSolution.threadId":
-		x.ThreadId = ""
-	case "janction.videoUpscaler.v1.MsgSubmitSolution.dir":
-		x.Dir = ""
-	case "janction.videoUpscaler.v1.MsgSubmitSolution.average_render_seconds":
-		x.AverageRenderSeconds = int64(0)
+	case "janction.videoUpscaler.v1.MsgUpdateParams.authority":
+		x.Authority = ""
+	case "janction.videoUpscaler.v1.MsgUpdateParams.params":
+		x.Params = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubmitSolution"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgUpdateParams"))
 		}
-		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubmitSolution does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgUpdateParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6652,28 +6849,19 @@ func (x *fastReflection_MsgSubmitSolution) Clear(fd protoreflect.FieldDescriptor
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgSubmitSolution) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "janction.videoUpscaler.v1.MsgSubmitSolution.creator":
-		value := x.Creator
-		return protoreflect.ValueOfString(value)
-	case "janction.videoUpscaler.v1.MsgSubmitSolution.taskId":
-		value := x.TaskId
-		return protoreflect.ValueOfString(value)
-	case "janction.videoUpscaler.v1.MsgSubmitSolution.threadId":
-		value := x.ThreadId
+	case "janction.videoUpscaler.v1.MsgUpdateParams.authority":
+		value := x.Authority
 		return protoreflect.ValueOfString(value)
-	case "janction.videoUpscaler.v1.MsgSubmitSolution.dir":
-		value := x.Dir
-		return protoreflect.ValueOfString(value)
-	case "janction.videoUpscaler.v1.MsgSubmitSolution.average_render_seconds":
-		value := x.AverageRenderSeconds
-		return protoreflect.ValueOfInt64(value)
+	case "janction.videoUpscaler.v1.MsgUpdateParams.params":
+		value := x.Params
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubmitSolution"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgUpdateParams"))
 		}
-		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubmitSolution does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgUpdateParams does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6687,23 +6875,17 @@ func (x *fastReflection_MsgSubmitSolution) Get(descriptor protoreflect.FieldDesc
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitSolution) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgUpdateParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "janction.videoUpscaler.v1.MsgSubmitSolution.creator":
-		x.Creator = value.Interface().(string)
-	case "janction.videoUpscaler.v1.MsgSubmitSolution.taskId":
-		x.TaskId = value.Interface().(string)
-	case "janction.videoUpscaler.v1.MsgSubmitSolution.threadId":
-		x.ThreadId = value.Interface().(string)
-	case "janction.videoUpscaler.v1.MsgSubmitSolution.dir":
-		x.Dir = value.Interface().(string)
-	case "janction.videoUpscaler.v1.MsgSubmitSolution.average_render_seconds":
-		x.AverageRenderSeconds = value.Int()
+	case "janction.videoUpscaler.v1.MsgUpdateParams.authority":
+		x.Authority = value.Interface().(string)
+	case "janction.videoUpscaler.v1.MsgUpdateParams.params":
+		x.Params = value.Message().Interface().(*Params)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubmitSolution"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgUpdateParams"))
 		}
-		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubmitSolution does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgUpdateParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6717,56 +6899,48 @@ func (x *fastReflection_MsgSubmitSolution) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitSolution) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "janction.videoUpscaler.v1.MsgSubmitSolution.creator":
-		panic(fmt.Errorf("field creator of message janction.videoUpscaler.v1.MsgSubmitSolution is not mutable"))
-	case "janction.videoUpscaler.v1.MsgSubmitSolution.taskId":
-		panic(fmt.Errorf("field taskId of message janction.videoUpscaler.v1.MsgSubmitSolution is not mutable"))
-	case "janction.videoUpscaler.v1.MsgSubmitSolution.threadId":
-		panic(fmt.Errorf("field threadId of message janction.videoUpscaler.v1.MsgSubmitSolution is not mutable"))
-	case "janction.videoUpscaler.v1.MsgSubmitSolution.dir":
-		panic(fmt.Errorf("field dir of message janction.videoUpscaler.v1.MsgSubmitSolution is not mutable"))
-	case "janction.videoUpscaler.v1.MsgSubmitSolution.average_render_seconds":
-		panic(fmt.Errorf("field average_render_seconds of message janction.videoUpscaler.v1.MsgSubmitSolution is not mutable"))
+	case "janction.videoUpscaler.v1.MsgUpdateParams.params":
+		if x.Params == nil {
+			x.Params = new(Params)
+		}
+		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "janction.videoUpscaler.v1.MsgUpdateParams.authority":
+		panic(fmt.Errorf("field authority of message janction.videoUpscaler.v1.MsgUpdateParams is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubmitSolution"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgUpdateParams"))
 		}
-		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubmitSolution does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgUpdateParams does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgSubmitSolution) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "janction.videoUpscaler.v1.MsgSubmitSolution.creator":
-		return protoreflect.ValueOfString("")
-	case "janction.videoUpscaler.v1.MsgSubmitSolution.taskId":
-		return protoreflect.ValueOfString("")
-	case "janction.videoUpscaler.v1.MsgSubmitSolution.threadId":
-		return protoreflect.ValueOfString("")
-	case "janction.videoUpscaler.v1.MsgSubmitSolution.dir":
+	case "janction.videoUpscaler.v1.MsgUpdateParams.authority":
 		return protoreflect.ValueOfString("")
-	case "janction.videoUpscaler.v1.MsgSubmitSolution.average_render_seconds":
-		return protoreflect.ValueOfInt64(int64(0))
+	case "janction.videoUpscaler.v1.MsgUpdateParams.params":
+		m := new(Params)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubmitSolution"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgUpdateParams"))
 		}
-		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubmitSolution does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgUpdateParams does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgSubmitSolution) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgUpdateParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in janction.videoUpscaler.v1.MsgSubmitSolution", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in janction.videoUpscaler.v1.MsgUpdateParams", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6774,7 +6948,7 @@ func (x *fastReflection_MsgSubmitSolution) WhichOneof(d protoreflect.OneofDescri
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgSubmitSolution) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgUpdateParams) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6785,7 +6959,7 @@ func (x *fastReflection_MsgSubmitSolution) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitSolution) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgUpdateParams) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6797,7 +6971,7 @@ func (x *fastReflection_MsgSubmitSolution) SetUnknown(fields protoreflect.RawFie
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgSubmitSolution) IsValid() bool {
+func (x *fastReflection_MsgUpdateParams) IsValid() bool {
 	return x != nil
 }
 
@@ -6807,9 +6981,9 @@ func (x *fastReflection_MsgSubmitSolution) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgSubmitSolution) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgUpdateParams) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgSubmitSolution)
+		x := input.Message.Interface().(*MsgUpdateParams)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6821,25 +6995,14 @@ func (x *fastReflection_MsgSubmitSolution) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Creator)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.TaskId)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.ThreadId)
+		l = len(x.Authority)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Dir)
-		if l > 0 {
+		if x.Params != nil {
+			l = options.Size(x.Params)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.AverageRenderSeconds != 0 {
-			n += 1 + runtime.Sov(uint64(x.AverageRenderSeconds))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -6850,7 +7013,7 @@ func (x *fastReflection_MsgSubmitSolution) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSubmitSolution)
+		x := input.Message.Interface().(*MsgUpdateParams)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6869,36 +7032,24 @@ func (x *fastReflection_MsgSubmitSolution) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.AverageRenderSeconds != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.AverageRenderSeconds))
-			i--
-			dAtA[i] = 0x28
-		}
-		if len(x.Dir) > 0 {
-			i -= len(x.Dir)
-			copy(dAtA[i:], x.Dir)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Dir)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if len(x.ThreadId) > 0 {
-			i -= len(x.ThreadId)
-			copy(dAtA[i:], x.ThreadId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ThreadId)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.TaskId) > 0 {
-			i -= len(x.TaskId)
-			copy(dAtA[i:], x.TaskId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TaskId)))
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Creator) > 0 {
-			i -= len(x.Creator)
-			copy(dAtA[i:], x.Creator)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+		if len(x.Authority) > 0 {
+			i -= len(x.Authority)
+			copy(dAtA[i:], x.Authority)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -6913,7 +7064,7 @@ func (x *fastReflection_MsgSubmitSolution) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSubmitSolution)
+		x := input.Message.Interface().(*MsgUpdateParams)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6945,15 +7096,15 @@ func (x *fastReflection_MsgSubmitSolution) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitSolution: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitSolution: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -6981,13 +7132,13 @@ func (x *fastReflection_MsgSubmitSolution) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Creator = string(dAtA[iNdEx:postIndex])
+				x.Authority = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6997,107 +7148,28 @@ func (x *fastReflection_MsgSubmitSolution) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.TaskId = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ThreadId", wireType)
+				if x.Params == nil {
+					x.Params = &Params{}
 				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.ThreadId = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Dir", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				x.Dir = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 5:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AverageRenderSeconds", wireType)
-				}
-				x.AverageRenderSeconds = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.AverageRenderSeconds |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -7134,23 +7206,23 @@ func (x *fastReflection_MsgSubmitSolution) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MsgSubmitSolutionResponse protoreflect.MessageDescriptor
+	md_MsgUpdateParamsResponse protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_janction_videoUpscaler_v1_tx_proto_init()
-	md_MsgSubmitSolutionResponse = File_janction_videoUpscaler_v1_tx_proto.Messages().ByName("MsgSubmitSolutionResponse")
+	md_MsgUpdateParamsResponse = File_janction_videoUpscaler_v1_tx_proto.Messages().ByName("MsgUpdateParamsResponse")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgSubmitSolutionResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgUpdateParamsResponse)(nil)
 
-type fastReflection_MsgSubmitSolutionResponse MsgSubmitSolutionResponse
+type fastReflection_MsgUpdateParamsResponse MsgUpdateParamsResponse
 
-func (x *MsgSubmitSolutionResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgSubmitSolutionResponse)(x)
+func (x *MsgUpdateParamsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateParamsResponse)(x)
 }
 
-func (x *MsgSubmitSolutionResponse) slowProtoReflect() protoreflect.Message {
+func (x *MsgUpdateParamsResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7162,43 +7234,43 @@ func (x *MsgSubmitSolutionResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgSubmitSolutionResponse_messageType fastReflection_MsgSubmitSolutionResponse_messageType
-var _ protoreflect.MessageType = fastReflection_MsgSubmitSolutionResponse_messageType{}
+var _fastReflection_MsgUpdateParamsResponse_messageType fastReflection_MsgUpdateParamsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateParamsResponse_messageType{}
 
-type fastReflection_MsgSubmitSolutionResponse_messageType struct{}
+type fastReflection_MsgUpdateParamsResponse_messageType struct{}
 
-func (x fastReflection_MsgSubmitSolutionResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgSubmitSolutionResponse)(nil)
+func (x fastReflection_MsgUpdateParamsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateParamsResponse)(nil)
 }
-func (x fastReflection_MsgSubmitSolutionResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgSubmitSolutionResponse)
+func (x fastReflection_MsgUpdateParamsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateParamsResponse)
 }
-func (x fastReflection_MsgSubmitSolutionResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSubmitSolutionResponse
+func (x fastReflection_MsgUpdateParamsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateParamsResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgSubmitSolutionResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgSubmitSolutionResponse
+func (x *fastReflection_MsgUpdateParamsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateParamsResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgSubmitSolutionResponse) Type() protoreflect.MessageType {
-	return _fastReflection_MsgSubmitSolutionResponse_messageType
+func (x *fastReflection_MsgUpdateParamsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateParamsResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgSubmitSolutionResponse) New() protoreflect.Message {
-	return new(fastReflection_MsgSubmitSolutionResponse)
+func (x *fastReflection_MsgUpdateParamsResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateParamsResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgSubmitSolutionResponse) Interface() protoreflect.ProtoMessage {
-	return (*MsgSubmitSolutionResponse)(x)
+func (x *fastReflection_MsgUpdateParamsResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateParamsResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7206,7 +7278,7 @@ func (x *fastReflection_MsgSubmitSolutionResponse) Interface() protoreflect.Prot
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgSubmitSolutionResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgUpdateParamsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -7220,13 +7292,13 @@ func (x *fastReflection_MsgSubmitSolutionResponse) Range(f func(protoreflect.Fie
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgSubmitSolutionResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgUpdateParamsResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubmitSolutionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgUpdateParamsResponse"))
 		}
-		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubmitSolutionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7236,13 +7308,13 @@ func (x *fastReflection_MsgSubmitSolutionResponse) Has(fd protoreflect.FieldDesc
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitSolutionResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgUpdateParamsResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubmitSolutionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgUpdateParamsResponse"))
 		}
-		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubmitSolutionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7252,13 +7324,13 @@ func (x *fastReflection_MsgSubmitSolutionResponse) Clear(fd protoreflect.FieldDe
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgSubmitSolutionResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateParamsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubmitSolutionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgUpdateParamsResponse"))
 		}
-		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubmitSolutionResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgUpdateParamsResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7272,13 +7344,13 @@ func (x *fastReflection_MsgSubmitSolutionResponse) Get(descriptor protoreflect.F
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitSolutionResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgUpdateParamsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubmitSolutionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgUpdateParamsResponse"))
 		}
-		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubmitSolutionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7292,36 +7364,36 @@ func (x *fastReflection_MsgSubmitSolutionResponse) Set(fd protoreflect.FieldDesc
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitSolutionResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateParamsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubmitSolutionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgUpdateParamsResponse"))
 		}
-		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubmitSolutionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgSubmitSolutionResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateParamsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubmitSolutionResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgUpdateParamsResponse"))
 		}
-		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubmitSolutionResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgUpdateParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgSubmitSolutionResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgUpdateParamsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in janction.videoUpscaler.v1.MsgSubmitSolutionResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in janction.videoUpscaler.v1.MsgUpdateParamsResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7329,7 +7401,7 @@ func (x *fastReflection_MsgSubmitSolutionResponse) WhichOneof(d protoreflect.One
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgSubmitSolutionResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgUpdateParamsResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7340,7 +7412,7 @@ func (x *fastReflection_MsgSubmitSolutionResponse) GetUnknown() protoreflect.Raw
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgSubmitSolutionResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgUpdateParamsResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7352,7 +7424,7 @@ func (x *fastReflection_MsgSubmitSolutionResponse) SetUnknown(fields protoreflec
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgSubmitSolutionResponse) IsValid() bool {
+func (x *fastReflection_MsgUpdateParamsResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -7362,9 +7434,9 @@ func (x *fastReflection_MsgSubmitSolutionResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgSubmitSolutionResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgUpdateParamsResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgSubmitSolutionResponse)
+		x := input.Message.Interface().(*MsgUpdateParamsResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7386,7 +7458,7 @@ func (x *fastReflection_MsgSubmitSolutionResponse) ProtoMethods() *protoiface.Me
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSubmitSolutionResponse)
+		x := input.Message.Interface().(*MsgUpdateParamsResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7416,7 +7488,7 @@ func (x *fastReflection_MsgSubmitSolutionResponse) ProtoMethods() *protoiface.Me
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgSubmitSolutionResponse)
+		x := input.Message.Interface().(*MsgUpdateParamsResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7448,10 +7520,10 @@ func (x *fastReflection_MsgSubmitSolutionResponse) ProtoMethods() *protoiface.Me
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitSolutionResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitSolutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			default:
@@ -7489,242 +7561,8977 @@ func (x *fastReflection_MsgSubmitSolutionResponse) ProtoMethods() *protoiface.Me
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: janction/videoUpscaler/v1/tx.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_MsgSubscribeWorkerToTask          protoreflect.MessageDescriptor
+	fd_MsgSubscribeWorkerToTask_address  protoreflect.FieldDescriptor
+	fd_MsgSubscribeWorkerToTask_taskId   protoreflect.FieldDescriptor
+	fd_MsgSubscribeWorkerToTask_threadId protoreflect.FieldDescriptor
 )
 
-// MsgCreateGame defines the Msg/CreateGame request type.
-type MsgCreateVideoUpscalerTask struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func init() {
+	file_janction_videoUpscaler_v1_tx_proto_init()
+	md_MsgSubscribeWorkerToTask = File_janction_videoUpscaler_v1_tx_proto.Messages().ByName("MsgSubscribeWorkerToTask")
+	fd_MsgSubscribeWorkerToTask_address = md_MsgSubscribeWorkerToTask.Fields().ByName("address")
+	fd_MsgSubscribeWorkerToTask_taskId = md_MsgSubscribeWorkerToTask.Fields().ByName("taskId")
+	fd_MsgSubscribeWorkerToTask_threadId = md_MsgSubscribeWorkerToTask.Fields().ByName("threadId")
+}
 
-	// creator is the message sender.
-	Creator    string        `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Cid        string        `protobuf:"bytes,2,opt,name=cid,proto3" json:"cid,omitempty"`
-	StartFrame int32         `protobuf:"varint,3,opt,name=startFrame,proto3" json:"startFrame,omitempty"`
-	EndFrame   int32         `protobuf:"varint,4,opt,name=endFrame,proto3" json:"endFrame,omitempty"`
-	Threads    int32         `protobuf:"varint,5,opt,name=threads,proto3" json:"threads,omitempty"`
-	Scale      int32         `protobuf:"varint,6,opt,name=scale,proto3" json:"scale,omitempty"`
-	Reward     *v1beta1.Coin `protobuf:"bytes,7,opt,name=reward,proto3" json:"reward,omitempty"`
+var _ protoreflect.Message = (*fastReflection_MsgSubscribeWorkerToTask)(nil)
+
+type fastReflection_MsgSubscribeWorkerToTask MsgSubscribeWorkerToTask
+
+func (x *MsgSubscribeWorkerToTask) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSubscribeWorkerToTask)(x)
 }
 
-func (x *MsgCreateVideoUpscalerTask) Reset() {
-	*x = MsgCreateVideoUpscalerTask{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[0]
+func (x *MsgSubscribeWorkerToTask) slowProtoReflect() protoreflect.Message {
+	mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *MsgCreateVideoUpscalerTask) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*MsgCreateVideoUpscalerTask) ProtoMessage() {}
+var _fastReflection_MsgSubscribeWorkerToTask_messageType fastReflection_MsgSubscribeWorkerToTask_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSubscribeWorkerToTask_messageType{}
 
-// Deprecated: Use MsgCreateVideoUpscalerTask.ProtoReflect.Descriptor instead.
-func (*MsgCreateVideoUpscalerTask) Descriptor() ([]byte, []int) {
-	return file_janction_videoUpscaler_v1_tx_proto_rawDescGZIP(), []int{0}
-}
+type fastReflection_MsgSubscribeWorkerToTask_messageType struct{}
 
-func (x *MsgCreateVideoUpscalerTask) GetCreator() string {
-	if x != nil {
-		return x.Creator
-	}
-	return ""
+func (x fastReflection_MsgSubscribeWorkerToTask_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSubscribeWorkerToTask)(nil)
 }
-
-func (x *MsgCreateVideoUpscalerTask) GetCid() string {
-	if x != nil {
-		return x.Cid
-	}
-	return ""
+func (x fastReflection_MsgSubscribeWorkerToTask_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSubscribeWorkerToTask)
 }
-
-func (x *MsgCreateVideoUpscalerTask) GetStartFrame() int32 {
-	if x != nil {
-		return x.StartFrame
-	}
-	return 0
+func (x fastReflection_MsgSubscribeWorkerToTask_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubscribeWorkerToTask
 }
 
-func (x *MsgCreateVideoUpscalerTask) GetEndFrame() int32 {
-	if x != nil {
-		return x.EndFrame
-	}
-	return 0
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSubscribeWorkerToTask) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubscribeWorkerToTask
 }
 
-func (x *MsgCreateVideoUpscalerTask) GetThreads() int32 {
-	if x != nil {
-		return x.Threads
-	}
-	return 0
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSubscribeWorkerToTask) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSubscribeWorkerToTask_messageType
 }
 
-func (x *MsgCreateVideoUpscalerTask) GetScale() int32 {
-	if x != nil {
-		return x.Scale
-	}
-	return 0
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSubscribeWorkerToTask) New() protoreflect.Message {
+	return new(fastReflection_MsgSubscribeWorkerToTask)
 }
 
-func (x *MsgCreateVideoUpscalerTask) GetReward() *v1beta1.Coin {
-	if x != nil {
-		return x.Reward
-	}
-	return nil
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSubscribeWorkerToTask) Interface() protoreflect.ProtoMessage {
+	return (*MsgSubscribeWorkerToTask)(x)
 }
 
-// MsgCreateGameResponse defines the Msg/CreateGame response type.
-type MsgCreateVideoUpscalerTaskResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSubscribeWorkerToTask) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_MsgSubscribeWorkerToTask_address, value) {
+			return
+		}
+	}
+	if x.TaskId != "" {
+		value := protoreflect.ValueOfString(x.TaskId)
+		if !f(fd_MsgSubscribeWorkerToTask_taskId, value) {
+			return
+		}
+	}
+	if x.ThreadId != "" {
+		value := protoreflect.ValueOfString(x.ThreadId)
+		if !f(fd_MsgSubscribeWorkerToTask_threadId, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSubscribeWorkerToTask) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "janction.videoUpscaler.v1.MsgSubscribeWorkerToTask.address":
+		return x.Address != ""
+	case "janction.videoUpscaler.v1.MsgSubscribeWorkerToTask.taskId":
+		return x.TaskId != ""
+	case "janction.videoUpscaler.v1.MsgSubscribeWorkerToTask.threadId":
+		return x.ThreadId != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubscribeWorkerToTask"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubscribeWorkerToTask does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubscribeWorkerToTask) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "janction.videoUpscaler.v1.MsgSubscribeWorkerToTask.address":
+		x.Address = ""
+	case "janction.videoUpscaler.v1.MsgSubscribeWorkerToTask.taskId":
+		x.TaskId = ""
+	case "janction.videoUpscaler.v1.MsgSubscribeWorkerToTask.threadId":
+		x.ThreadId = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubscribeWorkerToTask"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubscribeWorkerToTask does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSubscribeWorkerToTask) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "janction.videoUpscaler.v1.MsgSubscribeWorkerToTask.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "janction.videoUpscaler.v1.MsgSubscribeWorkerToTask.taskId":
+		value := x.TaskId
+		return protoreflect.ValueOfString(value)
+	case "janction.videoUpscaler.v1.MsgSubscribeWorkerToTask.threadId":
+		value := x.ThreadId
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubscribeWorkerToTask"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubscribeWorkerToTask does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubscribeWorkerToTask) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "janction.videoUpscaler.v1.MsgSubscribeWorkerToTask.address":
+		x.Address = value.Interface().(string)
+	case "janction.videoUpscaler.v1.MsgSubscribeWorkerToTask.taskId":
+		x.TaskId = value.Interface().(string)
+	case "janction.videoUpscaler.v1.MsgSubscribeWorkerToTask.threadId":
+		x.ThreadId = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubscribeWorkerToTask"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubscribeWorkerToTask does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubscribeWorkerToTask) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "janction.videoUpscaler.v1.MsgSubscribeWorkerToTask.address":
+		panic(fmt.Errorf("field address of message janction.videoUpscaler.v1.MsgSubscribeWorkerToTask is not mutable"))
+	case "janction.videoUpscaler.v1.MsgSubscribeWorkerToTask.taskId":
+		panic(fmt.Errorf("field taskId of message janction.videoUpscaler.v1.MsgSubscribeWorkerToTask is not mutable"))
+	case "janction.videoUpscaler.v1.MsgSubscribeWorkerToTask.threadId":
+		panic(fmt.Errorf("field threadId of message janction.videoUpscaler.v1.MsgSubscribeWorkerToTask is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubscribeWorkerToTask"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubscribeWorkerToTask does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSubscribeWorkerToTask) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "janction.videoUpscaler.v1.MsgSubscribeWorkerToTask.address":
+		return protoreflect.ValueOfString("")
+	case "janction.videoUpscaler.v1.MsgSubscribeWorkerToTask.taskId":
+		return protoreflect.ValueOfString("")
+	case "janction.videoUpscaler.v1.MsgSubscribeWorkerToTask.threadId":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubscribeWorkerToTask"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubscribeWorkerToTask does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSubscribeWorkerToTask) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in janction.videoUpscaler.v1.MsgSubscribeWorkerToTask", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSubscribeWorkerToTask) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubscribeWorkerToTask) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSubscribeWorkerToTask) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSubscribeWorkerToTask) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSubscribeWorkerToTask)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TaskId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ThreadId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSubscribeWorkerToTask)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ThreadId) > 0 {
+			i -= len(x.ThreadId)
+			copy(dAtA[i:], x.ThreadId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ThreadId)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.TaskId) > 0 {
+			i -= len(x.TaskId)
+			copy(dAtA[i:], x.TaskId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TaskId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSubscribeWorkerToTask)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubscribeWorkerToTask: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubscribeWorkerToTask: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TaskId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ThreadId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ThreadId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSubscribeWorkerToTaskResponse          protoreflect.MessageDescriptor
+	fd_MsgSubscribeWorkerToTaskResponse_threadId protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_janction_videoUpscaler_v1_tx_proto_init()
+	md_MsgSubscribeWorkerToTaskResponse = File_janction_videoUpscaler_v1_tx_proto.Messages().ByName("MsgSubscribeWorkerToTaskResponse")
+	fd_MsgSubscribeWorkerToTaskResponse_threadId = md_MsgSubscribeWorkerToTaskResponse.Fields().ByName("threadId")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSubscribeWorkerToTaskResponse)(nil)
+
+type fastReflection_MsgSubscribeWorkerToTaskResponse MsgSubscribeWorkerToTaskResponse
+
+func (x *MsgSubscribeWorkerToTaskResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSubscribeWorkerToTaskResponse)(x)
+}
+
+func (x *MsgSubscribeWorkerToTaskResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSubscribeWorkerToTaskResponse_messageType fastReflection_MsgSubscribeWorkerToTaskResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSubscribeWorkerToTaskResponse_messageType{}
+
+type fastReflection_MsgSubscribeWorkerToTaskResponse_messageType struct{}
+
+func (x fastReflection_MsgSubscribeWorkerToTaskResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSubscribeWorkerToTaskResponse)(nil)
+}
+func (x fastReflection_MsgSubscribeWorkerToTaskResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSubscribeWorkerToTaskResponse)
+}
+func (x fastReflection_MsgSubscribeWorkerToTaskResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubscribeWorkerToTaskResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSubscribeWorkerToTaskResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubscribeWorkerToTaskResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSubscribeWorkerToTaskResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSubscribeWorkerToTaskResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSubscribeWorkerToTaskResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgSubscribeWorkerToTaskResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSubscribeWorkerToTaskResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgSubscribeWorkerToTaskResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSubscribeWorkerToTaskResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ThreadId != "" {
+		value := protoreflect.ValueOfString(x.ThreadId)
+		if !f(fd_MsgSubscribeWorkerToTaskResponse_threadId, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSubscribeWorkerToTaskResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "janction.videoUpscaler.v1.MsgSubscribeWorkerToTaskResponse.threadId":
+		return x.ThreadId != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubscribeWorkerToTaskResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubscribeWorkerToTaskResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubscribeWorkerToTaskResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "janction.videoUpscaler.v1.MsgSubscribeWorkerToTaskResponse.threadId":
+		x.ThreadId = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubscribeWorkerToTaskResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubscribeWorkerToTaskResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSubscribeWorkerToTaskResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "janction.videoUpscaler.v1.MsgSubscribeWorkerToTaskResponse.threadId":
+		value := x.ThreadId
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubscribeWorkerToTaskResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubscribeWorkerToTaskResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubscribeWorkerToTaskResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "janction.videoUpscaler.v1.MsgSubscribeWorkerToTaskResponse.threadId":
+		x.ThreadId = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubscribeWorkerToTaskResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubscribeWorkerToTaskResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubscribeWorkerToTaskResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "janction.videoUpscaler.v1.MsgSubscribeWorkerToTaskResponse.threadId":
+		panic(fmt.Errorf("field threadId of message janction.videoUpscaler.v1.MsgSubscribeWorkerToTaskResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubscribeWorkerToTaskResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubscribeWorkerToTaskResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSubscribeWorkerToTaskResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "janction.videoUpscaler.v1.MsgSubscribeWorkerToTaskResponse.threadId":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubscribeWorkerToTaskResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubscribeWorkerToTaskResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSubscribeWorkerToTaskResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in janction.videoUpscaler.v1.MsgSubscribeWorkerToTaskResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSubscribeWorkerToTaskResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubscribeWorkerToTaskResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSubscribeWorkerToTaskResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSubscribeWorkerToTaskResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSubscribeWorkerToTaskResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ThreadId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSubscribeWorkerToTaskResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ThreadId) > 0 {
+			i -= len(x.ThreadId)
+			copy(dAtA[i:], x.ThreadId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ThreadId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSubscribeWorkerToTaskResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubscribeWorkerToTaskResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubscribeWorkerToTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ThreadId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ThreadId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgProposeSolution_5_list)(nil)
+
+type _MsgProposeSolution_5_list struct {
+	list *[]string
+}
+
+func (x *_MsgProposeSolution_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgProposeSolution_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MsgProposeSolution_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgProposeSolution_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgProposeSolution_5_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgProposeSolution at list field Signatures as it is not of Message kind"))
+}
+
+func (x *_MsgProposeSolution_5_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgProposeSolution_5_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MsgProposeSolution_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgProposeSolution              protoreflect.MessageDescriptor
+	fd_MsgProposeSolution_creator      protoreflect.FieldDescriptor
+	fd_MsgProposeSolution_taskId       protoreflect.FieldDescriptor
+	fd_MsgProposeSolution_threadId     protoreflect.FieldDescriptor
+	fd_MsgProposeSolution_public_key   protoreflect.FieldDescriptor
+	fd_MsgProposeSolution_signatures   protoreflect.FieldDescriptor
+	fd_MsgProposeSolution_image_digest protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_janction_videoUpscaler_v1_tx_proto_init()
+	md_MsgProposeSolution = File_janction_videoUpscaler_v1_tx_proto.Messages().ByName("MsgProposeSolution")
+	fd_MsgProposeSolution_creator = md_MsgProposeSolution.Fields().ByName("creator")
+	fd_MsgProposeSolution_taskId = md_MsgProposeSolution.Fields().ByName("taskId")
+	fd_MsgProposeSolution_threadId = md_MsgProposeSolution.Fields().ByName("threadId")
+	fd_MsgProposeSolution_public_key = md_MsgProposeSolution.Fields().ByName("public_key")
+	fd_MsgProposeSolution_signatures = md_MsgProposeSolution.Fields().ByName("signatures")
+	fd_MsgProposeSolution_image_digest = md_MsgProposeSolution.Fields().ByName("image_digest")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgProposeSolution)(nil)
+
+type fastReflection_MsgProposeSolution MsgProposeSolution
+
+func (x *MsgProposeSolution) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgProposeSolution)(x)
+}
+
+func (x *MsgProposeSolution) slowProtoReflect() protoreflect.Message {
+	mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgProposeSolution_messageType fastReflection_MsgProposeSolution_messageType
+var _ protoreflect.MessageType = fastReflection_MsgProposeSolution_messageType{}
+
+type fastReflection_MsgProposeSolution_messageType struct{}
+
+func (x fastReflection_MsgProposeSolution_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgProposeSolution)(nil)
+}
+func (x fastReflection_MsgProposeSolution_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgProposeSolution)
+}
+func (x fastReflection_MsgProposeSolution_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgProposeSolution
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgProposeSolution) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgProposeSolution
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgProposeSolution) Type() protoreflect.MessageType {
+	return _fastReflection_MsgProposeSolution_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgProposeSolution) New() protoreflect.Message {
+	return new(fastReflection_MsgProposeSolution)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgProposeSolution) Interface() protoreflect.ProtoMessage {
+	return (*MsgProposeSolution)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgProposeSolution) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgProposeSolution_creator, value) {
+			return
+		}
+	}
+	if x.TaskId != "" {
+		value := protoreflect.ValueOfString(x.TaskId)
+		if !f(fd_MsgProposeSolution_taskId, value) {
+			return
+		}
+	}
+	if x.ThreadId != "" {
+		value := protoreflect.ValueOfString(x.ThreadId)
+		if !f(fd_MsgProposeSolution_threadId, value) {
+			return
+		}
+	}
+	if x.PublicKey != "" {
+		value := protoreflect.ValueOfString(x.PublicKey)
+		if !f(fd_MsgProposeSolution_public_key, value) {
+			return
+		}
+	}
+	if len(x.Signatures) != 0 {
+		value := protoreflect.ValueOfList(&_MsgProposeSolution_5_list{list: &x.Signatures})
+		if !f(fd_MsgProposeSolution_signatures, value) {
+			return
+		}
+	}
+	if x.ImageDigest != "" {
+		value := protoreflect.ValueOfString(x.ImageDigest)
+		if !f(fd_MsgProposeSolution_image_digest, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgProposeSolution) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "janction.videoUpscaler.v1.MsgProposeSolution.creator":
+		return x.Creator != ""
+	case "janction.videoUpscaler.v1.MsgProposeSolution.taskId":
+		return x.TaskId != ""
+	case "janction.videoUpscaler.v1.MsgProposeSolution.threadId":
+		return x.ThreadId != ""
+	case "janction.videoUpscaler.v1.MsgProposeSolution.public_key":
+		return x.PublicKey != ""
+	case "janction.videoUpscaler.v1.MsgProposeSolution.signatures":
+		return len(x.Signatures) != 0
+	case "janction.videoUpscaler.v1.MsgProposeSolution.image_digest":
+		return x.ImageDigest != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgProposeSolution"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgProposeSolution does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgProposeSolution) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "janction.videoUpscaler.v1.MsgProposeSolution.creator":
+		x.Creator = ""
+	case "janction.videoUpscaler.v1.MsgProposeSolution.taskId":
+		x.TaskId = ""
+	case "janction.videoUpscaler.v1.MsgProposeSolution.threadId":
+		x.ThreadId = ""
+	case "janction.videoUpscaler.v1.MsgProposeSolution.public_key":
+		x.PublicKey = ""
+	case "janction.videoUpscaler.v1.MsgProposeSolution.signatures":
+		x.Signatures = nil
+	case "janction.videoUpscaler.v1.MsgProposeSolution.image_digest":
+		x.ImageDigest = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgProposeSolution"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgProposeSolution does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgProposeSolution) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "janction.videoUpscaler.v1.MsgProposeSolution.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "janction.videoUpscaler.v1.MsgProposeSolution.taskId":
+		value := x.TaskId
+		return protoreflect.ValueOfString(value)
+	case "janction.videoUpscaler.v1.MsgProposeSolution.threadId":
+		value := x.ThreadId
+		return protoreflect.ValueOfString(value)
+	case "janction.videoUpscaler.v1.MsgProposeSolution.public_key":
+		value := x.PublicKey
+		return protoreflect.ValueOfString(value)
+	case "janction.videoUpscaler.v1.MsgProposeSolution.signatures":
+		if len(x.Signatures) == 0 {
+			return protoreflect.ValueOfList(&_MsgProposeSolution_5_list{})
+		}
+		listValue := &_MsgProposeSolution_5_list{list: &x.Signatures}
+		return protoreflect.ValueOfList(listValue)
+	case "janction.videoUpscaler.v1.MsgProposeSolution.image_digest":
+		value := x.ImageDigest
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgProposeSolution"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgProposeSolution does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgProposeSolution) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "janction.videoUpscaler.v1.MsgProposeSolution.creator":
+		x.Creator = value.Interface().(string)
+	case "janction.videoUpscaler.v1.MsgProposeSolution.taskId":
+		x.TaskId = value.Interface().(string)
+	case "janction.videoUpscaler.v1.MsgProposeSolution.threadId":
+		x.ThreadId = value.Interface().(string)
+	case "janction.videoUpscaler.v1.MsgProposeSolution.public_key":
+		x.PublicKey = value.Interface().(string)
+	case "janction.videoUpscaler.v1.MsgProposeSolution.signatures":
+		lv := value.List()
+		clv := lv.(*_MsgProposeSolution_5_list)
+		x.Signatures = *clv.list
+	case "janction.videoUpscaler.v1.MsgProposeSolution.image_digest":
+		x.ImageDigest = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgProposeSolution"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgProposeSolution does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgProposeSolution) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "janction.videoUpscaler.v1.MsgProposeSolution.signatures":
+		if x.Signatures == nil {
+			x.Signatures = []string{}
+		}
+		value := &_MsgProposeSolution_5_list{list: &x.Signatures}
+		return protoreflect.ValueOfList(value)
+	case "janction.videoUpscaler.v1.MsgProposeSolution.creator":
+		panic(fmt.Errorf("field creator of message janction.videoUpscaler.v1.MsgProposeSolution is not mutable"))
+	case "janction.videoUpscaler.v1.MsgProposeSolution.taskId":
+		panic(fmt.Errorf("field taskId of message janction.videoUpscaler.v1.MsgProposeSolution is not mutable"))
+	case "janction.videoUpscaler.v1.MsgProposeSolution.threadId":
+		panic(fmt.Errorf("field threadId of message janction.videoUpscaler.v1.MsgProposeSolution is not mutable"))
+	case "janction.videoUpscaler.v1.MsgProposeSolution.public_key":
+		panic(fmt.Errorf("field public_key of message janction.videoUpscaler.v1.MsgProposeSolution is not mutable"))
+	case "janction.videoUpscaler.v1.MsgProposeSolution.image_digest":
+		panic(fmt.Errorf("field image_digest of message janction.videoUpscaler.v1.MsgProposeSolution is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgProposeSolution"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgProposeSolution does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgProposeSolution) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "janction.videoUpscaler.v1.MsgProposeSolution.creator":
+		return protoreflect.ValueOfString("")
+	case "janction.videoUpscaler.v1.MsgProposeSolution.taskId":
+		return protoreflect.ValueOfString("")
+	case "janction.videoUpscaler.v1.MsgProposeSolution.threadId":
+		return protoreflect.ValueOfString("")
+	case "janction.videoUpscaler.v1.MsgProposeSolution.public_key":
+		return protoreflect.ValueOfString("")
+	case "janction.videoUpscaler.v1.MsgProposeSolution.signatures":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MsgProposeSolution_5_list{list: &list})
+	case "janction.videoUpscaler.v1.MsgProposeSolution.image_digest":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgProposeSolution"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgProposeSolution does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgProposeSolution) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in janction.videoUpscaler.v1.MsgProposeSolution", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgProposeSolution) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgProposeSolution) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgProposeSolution) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgProposeSolution) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgProposeSolution)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TaskId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ThreadId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PublicKey)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Signatures) > 0 {
+			for _, s := range x.Signatures {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.ImageDigest)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgProposeSolution)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ImageDigest) > 0 {
+			i -= len(x.ImageDigest)
+			copy(dAtA[i:], x.ImageDigest)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ImageDigest)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.Signatures) > 0 {
+			for iNdEx := len(x.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Signatures[iNdEx])
+				copy(dAtA[i:], x.Signatures[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signatures[iNdEx])))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.PublicKey) > 0 {
+			i -= len(x.PublicKey)
+			copy(dAtA[i:], x.PublicKey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PublicKey)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.ThreadId) > 0 {
+			i -= len(x.ThreadId)
+			copy(dAtA[i:], x.ThreadId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ThreadId)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.TaskId) > 0 {
+			i -= len(x.TaskId)
+			copy(dAtA[i:], x.TaskId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TaskId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgProposeSolution)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgProposeSolution: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgProposeSolution: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TaskId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ThreadId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ThreadId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PublicKey = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signatures = append(x.Signatures, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ImageDigest", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ImageDigest = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgProposeSolutionResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_janction_videoUpscaler_v1_tx_proto_init()
+	md_MsgProposeSolutionResponse = File_janction_videoUpscaler_v1_tx_proto.Messages().ByName("MsgProposeSolutionResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgProposeSolutionResponse)(nil)
+
+type fastReflection_MsgProposeSolutionResponse MsgProposeSolutionResponse
+
+func (x *MsgProposeSolutionResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgProposeSolutionResponse)(x)
+}
+
+func (x *MsgProposeSolutionResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgProposeSolutionResponse_messageType fastReflection_MsgProposeSolutionResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgProposeSolutionResponse_messageType{}
+
+type fastReflection_MsgProposeSolutionResponse_messageType struct{}
+
+func (x fastReflection_MsgProposeSolutionResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgProposeSolutionResponse)(nil)
+}
+func (x fastReflection_MsgProposeSolutionResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgProposeSolutionResponse)
+}
+func (x fastReflection_MsgProposeSolutionResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgProposeSolutionResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgProposeSolutionResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgProposeSolutionResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgProposeSolutionResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgProposeSolutionResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgProposeSolutionResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgProposeSolutionResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgProposeSolutionResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgProposeSolutionResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgProposeSolutionResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgProposeSolutionResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgProposeSolutionResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgProposeSolutionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgProposeSolutionResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgProposeSolutionResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgProposeSolutionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgProposeSolutionResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgProposeSolutionResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgProposeSolutionResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgProposeSolutionResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgProposeSolutionResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgProposeSolutionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgProposeSolutionResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgProposeSolutionResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgProposeSolutionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgProposeSolutionResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgProposeSolutionResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgProposeSolutionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgProposeSolutionResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in janction.videoUpscaler.v1.MsgProposeSolutionResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgProposeSolutionResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgProposeSolutionResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgProposeSolutionResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgProposeSolutionResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgProposeSolutionResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgProposeSolutionResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgProposeSolutionResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgProposeSolutionResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgProposeSolutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgRevealSolution_4_list)(nil)
+
+type _MsgRevealSolution_4_list struct {
+	list *[]string
+}
+
+func (x *_MsgRevealSolution_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgRevealSolution_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MsgRevealSolution_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgRevealSolution_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgRevealSolution_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgRevealSolution at list field Frames as it is not of Message kind"))
+}
+
+func (x *_MsgRevealSolution_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgRevealSolution_4_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MsgRevealSolution_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgRevealSolution          protoreflect.MessageDescriptor
+	fd_MsgRevealSolution_creator  protoreflect.FieldDescriptor
+	fd_MsgRevealSolution_taskId   protoreflect.FieldDescriptor
+	fd_MsgRevealSolution_threadId protoreflect.FieldDescriptor
+	fd_MsgRevealSolution_frames   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_janction_videoUpscaler_v1_tx_proto_init()
+	md_MsgRevealSolution = File_janction_videoUpscaler_v1_tx_proto.Messages().ByName("MsgRevealSolution")
+	fd_MsgRevealSolution_creator = md_MsgRevealSolution.Fields().ByName("creator")
+	fd_MsgRevealSolution_taskId = md_MsgRevealSolution.Fields().ByName("taskId")
+	fd_MsgRevealSolution_threadId = md_MsgRevealSolution.Fields().ByName("threadId")
+	fd_MsgRevealSolution_frames = md_MsgRevealSolution.Fields().ByName("frames")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgRevealSolution)(nil)
+
+type fastReflection_MsgRevealSolution MsgRevealSolution
+
+func (x *MsgRevealSolution) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRevealSolution)(x)
+}
+
+func (x *MsgRevealSolution) slowProtoReflect() protoreflect.Message {
+	mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgRevealSolution_messageType fastReflection_MsgRevealSolution_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRevealSolution_messageType{}
+
+type fastReflection_MsgRevealSolution_messageType struct{}
+
+func (x fastReflection_MsgRevealSolution_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRevealSolution)(nil)
+}
+func (x fastReflection_MsgRevealSolution_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRevealSolution)
+}
+func (x fastReflection_MsgRevealSolution_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRevealSolution
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgRevealSolution) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRevealSolution
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgRevealSolution) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRevealSolution_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgRevealSolution) New() protoreflect.Message {
+	return new(fastReflection_MsgRevealSolution)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgRevealSolution) Interface() protoreflect.ProtoMessage {
+	return (*MsgRevealSolution)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgRevealSolution) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgRevealSolution_creator, value) {
+			return
+		}
+	}
+	if x.TaskId != "" {
+		value := protoreflect.ValueOfString(x.TaskId)
+		if !f(fd_MsgRevealSolution_taskId, value) {
+			return
+		}
+	}
+	if x.ThreadId != "" {
+		value := protoreflect.ValueOfString(x.ThreadId)
+		if !f(fd_MsgRevealSolution_threadId, value) {
+			return
+		}
+	}
+	if len(x.Frames) != 0 {
+		value := protoreflect.ValueOfList(&_MsgRevealSolution_4_list{list: &x.Frames})
+		if !f(fd_MsgRevealSolution_frames, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgRevealSolution) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "janction.videoUpscaler.v1.MsgRevealSolution.creator":
+		return x.Creator != ""
+	case "janction.videoUpscaler.v1.MsgRevealSolution.taskId":
+		return x.TaskId != ""
+	case "janction.videoUpscaler.v1.MsgRevealSolution.threadId":
+		return x.ThreadId != ""
+	case "janction.videoUpscaler.v1.MsgRevealSolution.frames":
+		return len(x.Frames) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgRevealSolution"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgRevealSolution does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRevealSolution) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "janction.videoUpscaler.v1.MsgRevealSolution.creator":
+		x.Creator = ""
+	case "janction.videoUpscaler.v1.MsgRevealSolution.taskId":
+		x.TaskId = ""
+	case "janction.videoUpscaler.v1.MsgRevealSolution.threadId":
+		x.ThreadId = ""
+	case "janction.videoUpscaler.v1.MsgRevealSolution.frames":
+		x.Frames = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgRevealSolution"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgRevealSolution does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgRevealSolution) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "janction.videoUpscaler.v1.MsgRevealSolution.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "janction.videoUpscaler.v1.MsgRevealSolution.taskId":
+		value := x.TaskId
+		return protoreflect.ValueOfString(value)
+	case "janction.videoUpscaler.v1.MsgRevealSolution.threadId":
+		value := x.ThreadId
+		return protoreflect.ValueOfString(value)
+	case "janction.videoUpscaler.v1.MsgRevealSolution.frames":
+		if len(x.Frames) == 0 {
+			return protoreflect.ValueOfList(&_MsgRevealSolution_4_list{})
+		}
+		listValue := &_MsgRevealSolution_4_list{list: &x.Frames}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgRevealSolution"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgRevealSolution does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRevealSolution) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "janction.videoUpscaler.v1.MsgRevealSolution.creator":
+		x.Creator = value.Interface().(string)
+	case "janction.videoUpscaler.v1.MsgRevealSolution.taskId":
+		x.TaskId = value.Interface().(string)
+	case "janction.videoUpscaler.v1.MsgRevealSolution.threadId":
+		x.ThreadId = value.Interface().(string)
+	case "janction.videoUpscaler.v1.MsgRevealSolution.frames":
+		lv := value.List()
+		clv := lv.(*_MsgRevealSolution_4_list)
+		x.Frames = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgRevealSolution"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgRevealSolution does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRevealSolution) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "janction.videoUpscaler.v1.MsgRevealSolution.frames":
+		if x.Frames == nil {
+			x.Frames = []string{}
+		}
+		value := &_MsgRevealSolution_4_list{list: &x.Frames}
+		return protoreflect.ValueOfList(value)
+	case "janction.videoUpscaler.v1.MsgRevealSolution.creator":
+		panic(fmt.Errorf("field creator of message janction.videoUpscaler.v1.MsgRevealSolution is not mutable"))
+	case "janction.videoUpscaler.v1.MsgRevealSolution.taskId":
+		panic(fmt.Errorf("field taskId of message janction.videoUpscaler.v1.MsgRevealSolution is not mutable"))
+	case "janction.videoUpscaler.v1.MsgRevealSolution.threadId":
+		panic(fmt.Errorf("field threadId of message janction.videoUpscaler.v1.MsgRevealSolution is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgRevealSolution"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgRevealSolution does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgRevealSolution) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "janction.videoUpscaler.v1.MsgRevealSolution.creator":
+		return protoreflect.ValueOfString("")
+	case "janction.videoUpscaler.v1.MsgRevealSolution.taskId":
+		return protoreflect.ValueOfString("")
+	case "janction.videoUpscaler.v1.MsgRevealSolution.threadId":
+		return protoreflect.ValueOfString("")
+	case "janction.videoUpscaler.v1.MsgRevealSolution.frames":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MsgRevealSolution_4_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgRevealSolution"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgRevealSolution does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgRevealSolution) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in janction.videoUpscaler.v1.MsgRevealSolution", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgRevealSolution) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRevealSolution) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgRevealSolution) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgRevealSolution) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgRevealSolution)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TaskId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ThreadId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Frames) > 0 {
+			for _, s := range x.Frames {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRevealSolution)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Frames) > 0 {
+			for iNdEx := len(x.Frames) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Frames[iNdEx])
+				copy(dAtA[i:], x.Frames[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Frames[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.ThreadId) > 0 {
+			i -= len(x.ThreadId)
+			copy(dAtA[i:], x.ThreadId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ThreadId)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.TaskId) > 0 {
+			i -= len(x.TaskId)
+			copy(dAtA[i:], x.TaskId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TaskId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRevealSolution)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRevealSolution: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRevealSolution: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TaskId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ThreadId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ThreadId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Frames", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Frames = append(x.Frames, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgRevealSolutionResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_janction_videoUpscaler_v1_tx_proto_init()
+	md_MsgRevealSolutionResponse = File_janction_videoUpscaler_v1_tx_proto.Messages().ByName("MsgRevealSolutionResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgRevealSolutionResponse)(nil)
+
+type fastReflection_MsgRevealSolutionResponse MsgRevealSolutionResponse
+
+func (x *MsgRevealSolutionResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRevealSolutionResponse)(x)
+}
+
+func (x *MsgRevealSolutionResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgRevealSolutionResponse_messageType fastReflection_MsgRevealSolutionResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRevealSolutionResponse_messageType{}
+
+type fastReflection_MsgRevealSolutionResponse_messageType struct{}
+
+func (x fastReflection_MsgRevealSolutionResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRevealSolutionResponse)(nil)
+}
+func (x fastReflection_MsgRevealSolutionResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRevealSolutionResponse)
+}
+func (x fastReflection_MsgRevealSolutionResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRevealSolutionResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgRevealSolutionResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRevealSolutionResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgRevealSolutionResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRevealSolutionResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgRevealSolutionResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgRevealSolutionResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgRevealSolutionResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgRevealSolutionResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgRevealSolutionResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgRevealSolutionResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgRevealSolutionResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgRevealSolutionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRevealSolutionResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgRevealSolutionResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgRevealSolutionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgRevealSolutionResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgRevealSolutionResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgRevealSolutionResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRevealSolutionResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgRevealSolutionResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgRevealSolutionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRevealSolutionResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgRevealSolutionResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgRevealSolutionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgRevealSolutionResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgRevealSolutionResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgRevealSolutionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgRevealSolutionResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in janction.videoUpscaler.v1.MsgRevealSolutionResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgRevealSolutionResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRevealSolutionResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgRevealSolutionResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgRevealSolutionResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgRevealSolutionResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRevealSolutionResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRevealSolutionResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRevealSolutionResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRevealSolutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgSubmitValidation_5_list)(nil)
+
+type _MsgSubmitValidation_5_list struct {
+	list *[]string
+}
+
+func (x *_MsgSubmitValidation_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgSubmitValidation_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MsgSubmitValidation_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgSubmitValidation_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgSubmitValidation_5_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgSubmitValidation at list field Signatures as it is not of Message kind"))
+}
+
+func (x *_MsgSubmitValidation_5_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgSubmitValidation_5_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MsgSubmitValidation_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgSubmitValidation            protoreflect.MessageDescriptor
+	fd_MsgSubmitValidation_creator    protoreflect.FieldDescriptor
+	fd_MsgSubmitValidation_taskId     protoreflect.FieldDescriptor
+	fd_MsgSubmitValidation_threadId   protoreflect.FieldDescriptor
+	fd_MsgSubmitValidation_public_key protoreflect.FieldDescriptor
+	fd_MsgSubmitValidation_signatures protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_janction_videoUpscaler_v1_tx_proto_init()
+	md_MsgSubmitValidation = File_janction_videoUpscaler_v1_tx_proto.Messages().ByName("MsgSubmitValidation")
+	fd_MsgSubmitValidation_creator = md_MsgSubmitValidation.Fields().ByName("creator")
+	fd_MsgSubmitValidation_taskId = md_MsgSubmitValidation.Fields().ByName("taskId")
+	fd_MsgSubmitValidation_threadId = md_MsgSubmitValidation.Fields().ByName("threadId")
+	fd_MsgSubmitValidation_public_key = md_MsgSubmitValidation.Fields().ByName("public_key")
+	fd_MsgSubmitValidation_signatures = md_MsgSubmitValidation.Fields().ByName("signatures")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSubmitValidation)(nil)
+
+type fastReflection_MsgSubmitValidation MsgSubmitValidation
+
+func (x *MsgSubmitValidation) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSubmitValidation)(x)
+}
+
+func (x *MsgSubmitValidation) slowProtoReflect() protoreflect.Message {
+	mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSubmitValidation_messageType fastReflection_MsgSubmitValidation_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSubmitValidation_messageType{}
+
+type fastReflection_MsgSubmitValidation_messageType struct{}
+
+func (x fastReflection_MsgSubmitValidation_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSubmitValidation)(nil)
+}
+func (x fastReflection_MsgSubmitValidation_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitValidation)
+}
+func (x fastReflection_MsgSubmitValidation_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitValidation
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSubmitValidation) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitValidation
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSubmitValidation) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSubmitValidation_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSubmitValidation) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitValidation)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSubmitValidation) Interface() protoreflect.ProtoMessage {
+	return (*MsgSubmitValidation)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSubmitValidation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgSubmitValidation_creator, value) {
+			return
+		}
+	}
+	if x.TaskId != "" {
+		value := protoreflect.ValueOfString(x.TaskId)
+		if !f(fd_MsgSubmitValidation_taskId, value) {
+			return
+		}
+	}
+	if x.ThreadId != "" {
+		value := protoreflect.ValueOfString(x.ThreadId)
+		if !f(fd_MsgSubmitValidation_threadId, value) {
+			return
+		}
+	}
+	if x.PublicKey != "" {
+		value := protoreflect.ValueOfString(x.PublicKey)
+		if !f(fd_MsgSubmitValidation_public_key, value) {
+			return
+		}
+	}
+	if len(x.Signatures) != 0 {
+		value := protoreflect.ValueOfList(&_MsgSubmitValidation_5_list{list: &x.Signatures})
+		if !f(fd_MsgSubmitValidation_signatures, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSubmitValidation) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "janction.videoUpscaler.v1.MsgSubmitValidation.creator":
+		return x.Creator != ""
+	case "janction.videoUpscaler.v1.MsgSubmitValidation.taskId":
+		return x.TaskId != ""
+	case "janction.videoUpscaler.v1.MsgSubmitValidation.threadId":
+		return x.ThreadId != ""
+	case "janction.videoUpscaler.v1.MsgSubmitValidation.public_key":
+		return x.PublicKey != ""
+	case "janction.videoUpscaler.v1.MsgSubmitValidation.signatures":
+		return len(x.Signatures) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubmitValidation"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubmitValidation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitValidation) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "janction.videoUpscaler.v1.MsgSubmitValidation.creator":
+		x.Creator = ""
+	case "janction.videoUpscaler.v1.MsgSubmitValidation.taskId":
+		x.TaskId = ""
+	case "janction.videoUpscaler.v1.MsgSubmitValidation.threadId":
+		x.ThreadId = ""
+	case "janction.videoUpscaler.v1.MsgSubmitValidation.public_key":
+		x.PublicKey = ""
+	case "janction.videoUpscaler.v1.MsgSubmitValidation.signatures":
+		x.Signatures = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubmitValidation"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubmitValidation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSubmitValidation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "janction.videoUpscaler.v1.MsgSubmitValidation.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "janction.videoUpscaler.v1.MsgSubmitValidation.taskId":
+		value := x.TaskId
+		return protoreflect.ValueOfString(value)
+	case "janction.videoUpscaler.v1.MsgSubmitValidation.threadId":
+		value := x.ThreadId
+		return protoreflect.ValueOfString(value)
+	case "janction.videoUpscaler.v1.MsgSubmitValidation.public_key":
+		value := x.PublicKey
+		return protoreflect.ValueOfString(value)
+	case "janction.videoUpscaler.v1.MsgSubmitValidation.signatures":
+		if len(x.Signatures) == 0 {
+			return protoreflect.ValueOfList(&_MsgSubmitValidation_5_list{})
+		}
+		listValue := &_MsgSubmitValidation_5_list{list: &x.Signatures}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubmitValidation"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubmitValidation does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitValidation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "janction.videoUpscaler.v1.MsgSubmitValidation.creator":
+		x.Creator = value.Interface().(string)
+	case "janction.videoUpscaler.v1.MsgSubmitValidation.taskId":
+		x.TaskId = value.Interface().(string)
+	case "janction.videoUpscaler.v1.MsgSubmitValidation.threadId":
+		x.ThreadId = value.Interface().(string)
+	case "janction.videoUpscaler.v1.MsgSubmitValidation.public_key":
+		x.PublicKey = value.Interface().(string)
+	case "janction.videoUpscaler.v1.MsgSubmitValidation.signatures":
+		lv := value.List()
+		clv := lv.(*_MsgSubmitValidation_5_list)
+		x.Signatures = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubmitValidation"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubmitValidation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitValidation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "janction.videoUpscaler.v1.MsgSubmitValidation.signatures":
+		if x.Signatures == nil {
+			x.Signatures = []string{}
+		}
+		value := &_MsgSubmitValidation_5_list{list: &x.Signatures}
+		return protoreflect.ValueOfList(value)
+	case "janction.videoUpscaler.v1.MsgSubmitValidation.creator":
+		panic(fmt.Errorf("field creator of message janction.videoUpscaler.v1.MsgSubmitValidation is not mutable"))
+	case "janction.videoUpscaler.v1.MsgSubmitValidation.taskId":
+		panic(fmt.Errorf("field taskId of message janction.videoUpscaler.v1.MsgSubmitValidation is not mutable"))
+	case "janction.videoUpscaler.v1.MsgSubmitValidation.threadId":
+		panic(fmt.Errorf("field threadId of message janction.videoUpscaler.v1.MsgSubmitValidation is not mutable"))
+	case "janction.videoUpscaler.v1.MsgSubmitValidation.public_key":
+		panic(fmt.Errorf("field public_key of message janction.videoUpscaler.v1.MsgSubmitValidation is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubmitValidation"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubmitValidation does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSubmitValidation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "janction.videoUpscaler.v1.MsgSubmitValidation.creator":
+		return protoreflect.ValueOfString("")
+	case "janction.videoUpscaler.v1.MsgSubmitValidation.taskId":
+		return protoreflect.ValueOfString("")
+	case "janction.videoUpscaler.v1.MsgSubmitValidation.threadId":
+		return protoreflect.ValueOfString("")
+	case "janction.videoUpscaler.v1.MsgSubmitValidation.public_key":
+		return protoreflect.ValueOfString("")
+	case "janction.videoUpscaler.v1.MsgSubmitValidation.signatures":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MsgSubmitValidation_5_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubmitValidation"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubmitValidation does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSubmitValidation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in janction.videoUpscaler.v1.MsgSubmitValidation", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSubmitValidation) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitValidation) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSubmitValidation) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSubmitValidation) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSubmitValidation)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TaskId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ThreadId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PublicKey)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Signatures) > 0 {
+			for _, s := range x.Signatures {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSubmitValidation)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Signatures) > 0 {
+			for iNdEx := len(x.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Signatures[iNdEx])
+				copy(dAtA[i:], x.Signatures[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signatures[iNdEx])))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.PublicKey) > 0 {
+			i -= len(x.PublicKey)
+			copy(dAtA[i:], x.PublicKey)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PublicKey)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.ThreadId) > 0 {
+			i -= len(x.ThreadId)
+			copy(dAtA[i:], x.ThreadId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ThreadId)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.TaskId) > 0 {
+			i -= len(x.TaskId)
+			copy(dAtA[i:], x.TaskId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TaskId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSubmitValidation)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitValidation: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitValidation: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TaskId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ThreadId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ThreadId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PublicKey = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signatures = append(x.Signatures, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSubmitValidationResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_janction_videoUpscaler_v1_tx_proto_init()
+	md_MsgSubmitValidationResponse = File_janction_videoUpscaler_v1_tx_proto.Messages().ByName("MsgSubmitValidationResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSubmitValidationResponse)(nil)
+
+type fastReflection_MsgSubmitValidationResponse MsgSubmitValidationResponse
+
+func (x *MsgSubmitValidationResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSubmitValidationResponse)(x)
+}
+
+func (x *MsgSubmitValidationResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSubmitValidationResponse_messageType fastReflection_MsgSubmitValidationResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSubmitValidationResponse_messageType{}
+
+type fastReflection_MsgSubmitValidationResponse_messageType struct{}
+
+func (x fastReflection_MsgSubmitValidationResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSubmitValidationResponse)(nil)
+}
+func (x fastReflection_MsgSubmitValidationResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitValidationResponse)
+}
+func (x fastReflection_MsgSubmitValidationResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitValidationResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSubmitValidationResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitValidationResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSubmitValidationResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSubmitValidationResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSubmitValidationResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitValidationResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSubmitValidationResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgSubmitValidationResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSubmitValidationResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSubmitValidationResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubmitValidationResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubmitValidationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitValidationResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubmitValidationResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubmitValidationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSubmitValidationResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubmitValidationResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubmitValidationResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitValidationResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubmitValidationResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubmitValidationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitValidationResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubmitValidationResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubmitValidationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSubmitValidationResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubmitValidationResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubmitValidationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSubmitValidationResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in janction.videoUpscaler.v1.MsgSubmitValidationResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSubmitValidationResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitValidationResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSubmitValidationResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSubmitValidationResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSubmitValidationResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSubmitValidationResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSubmitValidationResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitValidationResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitValidationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_MsgRevealValidation_4_list)(nil)
+
+type _MsgRevealValidation_4_list struct {
+	list *[]string
+}
+
+func (x *_MsgRevealValidation_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgRevealValidation_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MsgRevealValidation_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgRevealValidation_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgRevealValidation_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgRevealValidation at list field Fingerprints as it is not of Message kind"))
+}
+
+func (x *_MsgRevealValidation_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgRevealValidation_4_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MsgRevealValidation_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_MsgRevealValidation              protoreflect.MessageDescriptor
+	fd_MsgRevealValidation_creator      protoreflect.FieldDescriptor
+	fd_MsgRevealValidation_taskId       protoreflect.FieldDescriptor
+	fd_MsgRevealValidation_threadId     protoreflect.FieldDescriptor
+	fd_MsgRevealValidation_fingerprints protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_janction_videoUpscaler_v1_tx_proto_init()
+	md_MsgRevealValidation = File_janction_videoUpscaler_v1_tx_proto.Messages().ByName("MsgRevealValidation")
+	fd_MsgRevealValidation_creator = md_MsgRevealValidation.Fields().ByName("creator")
+	fd_MsgRevealValidation_taskId = md_MsgRevealValidation.Fields().ByName("taskId")
+	fd_MsgRevealValidation_threadId = md_MsgRevealValidation.Fields().ByName("threadId")
+	fd_MsgRevealValidation_fingerprints = md_MsgRevealValidation.Fields().ByName("fingerprints")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgRevealValidation)(nil)
+
+type fastReflection_MsgRevealValidation MsgRevealValidation
+
+func (x *MsgRevealValidation) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRevealValidation)(x)
+}
+
+func (x *MsgRevealValidation) slowProtoReflect() protoreflect.Message {
+	mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgRevealValidation_messageType fastReflection_MsgRevealValidation_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRevealValidation_messageType{}
+
+type fastReflection_MsgRevealValidation_messageType struct{}
+
+func (x fastReflection_MsgRevealValidation_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRevealValidation)(nil)
+}
+func (x fastReflection_MsgRevealValidation_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRevealValidation)
+}
+func (x fastReflection_MsgRevealValidation_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRevealValidation
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgRevealValidation) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRevealValidation
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgRevealValidation) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRevealValidation_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgRevealValidation) New() protoreflect.Message {
+	return new(fastReflection_MsgRevealValidation)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgRevealValidation) Interface() protoreflect.ProtoMessage {
+	return (*MsgRevealValidation)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgRevealValidation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgRevealValidation_creator, value) {
+			return
+		}
+	}
+	if x.TaskId != "" {
+		value := protoreflect.ValueOfString(x.TaskId)
+		if !f(fd_MsgRevealValidation_taskId, value) {
+			return
+		}
+	}
+	if x.ThreadId != "" {
+		value := protoreflect.ValueOfString(x.ThreadId)
+		if !f(fd_MsgRevealValidation_threadId, value) {
+			return
+		}
+	}
+	if len(x.Fingerprints) != 0 {
+		value := protoreflect.ValueOfList(&_MsgRevealValidation_4_list{list: &x.Fingerprints})
+		if !f(fd_MsgRevealValidation_fingerprints, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgRevealValidation) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "janction.videoUpscaler.v1.MsgRevealValidation.creator":
+		return x.Creator != ""
+	case "janction.videoUpscaler.v1.MsgRevealValidation.taskId":
+		return x.TaskId != ""
+	case "janction.videoUpscaler.v1.MsgRevealValidation.threadId":
+		return x.ThreadId != ""
+	case "janction.videoUpscaler.v1.MsgRevealValidation.fingerprints":
+		return len(x.Fingerprints) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgRevealValidation"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgRevealValidation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRevealValidation) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "janction.videoUpscaler.v1.MsgRevealValidation.creator":
+		x.Creator = ""
+	case "janction.videoUpscaler.v1.MsgRevealValidation.taskId":
+		x.TaskId = ""
+	case "janction.videoUpscaler.v1.MsgRevealValidation.threadId":
+		x.ThreadId = ""
+	case "janction.videoUpscaler.v1.MsgRevealValidation.fingerprints":
+		x.Fingerprints = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgRevealValidation"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgRevealValidation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgRevealValidation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "janction.videoUpscaler.v1.MsgRevealValidation.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "janction.videoUpscaler.v1.MsgRevealValidation.taskId":
+		value := x.TaskId
+		return protoreflect.ValueOfString(value)
+	case "janction.videoUpscaler.v1.MsgRevealValidation.threadId":
+		value := x.ThreadId
+		return protoreflect.ValueOfString(value)
+	case "janction.videoUpscaler.v1.MsgRevealValidation.fingerprints":
+		if len(x.Fingerprints) == 0 {
+			return protoreflect.ValueOfList(&_MsgRevealValidation_4_list{})
+		}
+		listValue := &_MsgRevealValidation_4_list{list: &x.Fingerprints}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgRevealValidation"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgRevealValidation does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRevealValidation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "janction.videoUpscaler.v1.MsgRevealValidation.creator":
+		x.Creator = value.Interface().(string)
+	case "janction.videoUpscaler.v1.MsgRevealValidation.taskId":
+		x.TaskId = value.Interface().(string)
+	case "janction.videoUpscaler.v1.MsgRevealValidation.threadId":
+		x.ThreadId = value.Interface().(string)
+	case "janction.videoUpscaler.v1.MsgRevealValidation.fingerprints":
+		lv := value.List()
+		clv := lv.(*_MsgRevealValidation_4_list)
+		x.Fingerprints = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgRevealValidation"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgRevealValidation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRevealValidation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "janction.videoUpscaler.v1.MsgRevealValidation.fingerprints":
+		if x.Fingerprints == nil {
+			x.Fingerprints = []string{}
+		}
+		value := &_MsgRevealValidation_4_list{list: &x.Fingerprints}
+		return protoreflect.ValueOfList(value)
+	case "janction.videoUpscaler.v1.MsgRevealValidation.creator":
+		panic(fmt.Errorf("field creator of message janction.videoUpscaler.v1.MsgRevealValidation is not mutable"))
+	case "janction.videoUpscaler.v1.MsgRevealValidation.taskId":
+		panic(fmt.Errorf("field taskId of message janction.videoUpscaler.v1.MsgRevealValidation is not mutable"))
+	case "janction.videoUpscaler.v1.MsgRevealValidation.threadId":
+		panic(fmt.Errorf("field threadId of message janction.videoUpscaler.v1.MsgRevealValidation is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgRevealValidation"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgRevealValidation does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgRevealValidation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "janction.videoUpscaler.v1.MsgRevealValidation.creator":
+		return protoreflect.ValueOfString("")
+	case "janction.videoUpscaler.v1.MsgRevealValidation.taskId":
+		return protoreflect.ValueOfString("")
+	case "janction.videoUpscaler.v1.MsgRevealValidation.threadId":
+		return protoreflect.ValueOfString("")
+	case "janction.videoUpscaler.v1.MsgRevealValidation.fingerprints":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MsgRevealValidation_4_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgRevealValidation"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgRevealValidation does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgRevealValidation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in janction.videoUpscaler.v1.MsgRevealValidation", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgRevealValidation) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRevealValidation) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgRevealValidation) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgRevealValidation) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgRevealValidation)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TaskId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ThreadId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Fingerprints) > 0 {
+			for _, s := range x.Fingerprints {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRevealValidation)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Fingerprints) > 0 {
+			for iNdEx := len(x.Fingerprints) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Fingerprints[iNdEx])
+				copy(dAtA[i:], x.Fingerprints[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Fingerprints[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.ThreadId) > 0 {
+			i -= len(x.ThreadId)
+			copy(dAtA[i:], x.ThreadId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ThreadId)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.TaskId) > 0 {
+			i -= len(x.TaskId)
+			copy(dAtA[i:], x.TaskId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TaskId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRevealValidation)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRevealValidation: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRevealValidation: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TaskId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ThreadId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ThreadId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Fingerprints", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Fingerprints = append(x.Fingerprints, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgRevealValidationResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_janction_videoUpscaler_v1_tx_proto_init()
+	md_MsgRevealValidationResponse = File_janction_videoUpscaler_v1_tx_proto.Messages().ByName("MsgRevealValidationResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgRevealValidationResponse)(nil)
+
+type fastReflection_MsgRevealValidationResponse MsgRevealValidationResponse
+
+func (x *MsgRevealValidationResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRevealValidationResponse)(x)
+}
+
+func (x *MsgRevealValidationResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgRevealValidationResponse_messageType fastReflection_MsgRevealValidationResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRevealValidationResponse_messageType{}
+
+type fastReflection_MsgRevealValidationResponse_messageType struct{}
+
+func (x fastReflection_MsgRevealValidationResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRevealValidationResponse)(nil)
+}
+func (x fastReflection_MsgRevealValidationResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRevealValidationResponse)
+}
+func (x fastReflection_MsgRevealValidationResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRevealValidationResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgRevealValidationResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRevealValidationResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgRevealValidationResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRevealValidationResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgRevealValidationResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgRevealValidationResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgRevealValidationResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgRevealValidationResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgRevealValidationResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgRevealValidationResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgRevealValidationResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgRevealValidationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRevealValidationResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgRevealValidationResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgRevealValidationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgRevealValidationResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgRevealValidationResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgRevealValidationResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRevealValidationResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgRevealValidationResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgRevealValidationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRevealValidationResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgRevealValidationResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgRevealValidationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgRevealValidationResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgRevealValidationResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgRevealValidationResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgRevealValidationResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in janction.videoUpscaler.v1.MsgRevealValidationResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgRevealValidationResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRevealValidationResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgRevealValidationResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgRevealValidationResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgRevealValidationResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRevealValidationResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRevealValidationResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRevealValidationResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRevealValidationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSubmitSolution                        protoreflect.MessageDescriptor
+	fd_MsgSubmitSolution_creator                protoreflect.FieldDescriptor
+	fd_MsgSubmitSolution_taskId                 protoreflect.FieldDescriptor
+	fd_MsgSubmitSolution_threadId               protoreflect.FieldDescriptor
+	fd_MsgSubmitSolution_dir                    protoreflect.FieldDescriptor
+	fd_MsgSubmitSolution_average_render_seconds protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_janction_videoUpscaler_v1_tx_proto_init()
+	md_MsgSubmitSolution = File_janction_videoUpscaler_v1_tx_proto.Messages().ByName("MsgSubmitSolution")
+	fd_MsgSubmitSolution_creator = md_MsgSubmitSolution.Fields().ByName("creator")
+	fd_MsgSubmitSolution_taskId = md_MsgSubmitSolution.Fields().ByName("taskId")
+	fd_MsgSubmitSolution_threadId = md_MsgSubmitSolution.Fields().ByName("threadId")
+	fd_MsgSubmitSolution_dir = md_MsgSubmitSolution.Fields().ByName("dir")
+	fd_MsgSubmitSolution_average_render_seconds = md_MsgSubmitSolution.Fields().ByName("average_render_seconds")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSubmitSolution)(nil)
+
+type fastReflection_MsgSubmitSolution MsgSubmitSolution
+
+func (x *MsgSubmitSolution) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSubmitSolution)(x)
+}
+
+func (x *MsgSubmitSolution) slowProtoReflect() protoreflect.Message {
+	mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSubmitSolution_messageType fastReflection_MsgSubmitSolution_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSubmitSolution_messageType{}
+
+type fastReflection_MsgSubmitSolution_messageType struct{}
+
+func (x fastReflection_MsgSubmitSolution_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSubmitSolution)(nil)
+}
+func (x fastReflection_MsgSubmitSolution_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitSolution)
+}
+func (x fastReflection_MsgSubmitSolution_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitSolution
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSubmitSolution) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitSolution
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSubmitSolution) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSubmitSolution_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSubmitSolution) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitSolution)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSubmitSolution) Interface() protoreflect.ProtoMessage {
+	return (*MsgSubmitSolution)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSubmitSolution) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgSubmitSolution_creator, value) {
+			return
+		}
+	}
+	if x.TaskId != "" {
+		value := protoreflect.ValueOfString(x.TaskId)
+		if !f(fd_MsgSubmitSolution_taskId, value) {
+			return
+		}
+	}
+	if x.ThreadId != "" {
+		value := protoreflect.ValueOfString(x.ThreadId)
+		if !f(fd_MsgSubmitSolution_threadId, value) {
+			return
+		}
+	}
+	if x.Dir != "" {
+		value := protoreflect.ValueOfString(x.Dir)
+		if !f(fd_MsgSubmitSolution_dir, value) {
+			return
+		}
+	}
+	if x.AverageRenderSeconds != int64(0) {
+		value := protoreflect.ValueOfInt64(x.AverageRenderSeconds)
+		if !f(fd_MsgSubmitSolution_average_render_seconds, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSubmitSolution) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "janction.videoUpscaler.v1.MsgSubmitSolution.creator":
+		return x.Creator != ""
+	case "janction.videoUpscaler.v1.MsgSubmitSolution.taskId":
+		return x.TaskId != ""
+	case "janction.videoUpscaler.v1.MsgSubmitSolution.threadId":
+		return x.ThreadId != ""
+	case "janction.videoUpscaler.v1.MsgSubmitSolution.dir":
+		return x.Dir != ""
+	case "janction.videoUpscaler.v1.MsgSubmitSolution.average_render_seconds":
+		return x.AverageRenderSeconds != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubmitSolution"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubmitSolution does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitSolution) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "janction.videoUpscaler.v1.MsgSubmitSolution.creator":
+		x.Creator = ""
+	case "janction.videoUpscaler.v1.MsgSubmitSolution.taskId":
+		x.TaskId = ""
+	case "janction.videoUpscaler.v1.MsgSubmitSolution.threadId":
+		x.ThreadId = ""
+	case "janction.videoUpscaler.v1.MsgSubmitSolution.dir":
+		x.Dir = ""
+	case "janction.videoUpscaler.v1.MsgSubmitSolution.average_render_seconds":
+		x.AverageRenderSeconds = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubmitSolution"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubmitSolution does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSubmitSolution) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "janction.videoUpscaler.v1.MsgSubmitSolution.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "janction.videoUpscaler.v1.MsgSubmitSolution.taskId":
+		value := x.TaskId
+		return protoreflect.ValueOfString(value)
+	case "janction.videoUpscaler.v1.MsgSubmitSolution.threadId":
+		value := x.ThreadId
+		return protoreflect.ValueOfString(value)
+	case "janction.videoUpscaler.v1.MsgSubmitSolution.dir":
+		value := x.Dir
+		return protoreflect.ValueOfString(value)
+	case "janction.videoUpscaler.v1.MsgSubmitSolution.average_render_seconds":
+		value := x.AverageRenderSeconds
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubmitSolution"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubmitSolution does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitSolution) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "janction.videoUpscaler.v1.MsgSubmitSolution.creator":
+		x.Creator = value.Interface().(string)
+	case "janction.videoUpscaler.v1.MsgSubmitSolution.taskId":
+		x.TaskId = value.Interface().(string)
+	case "janction.videoUpscaler.v1.MsgSubmitSolution.threadId":
+		x.ThreadId = value.Interface().(string)
+	case "janction.videoUpscaler.v1.MsgSubmitSolution.dir":
+		x.Dir = value.Interface().(string)
+	case "janction.videoUpscaler.v1.MsgSubmitSolution.average_render_seconds":
+		x.AverageRenderSeconds = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubmitSolution"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubmitSolution does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitSolution) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "janction.videoUpscaler.v1.MsgSubmitSolution.creator":
+		panic(fmt.Errorf("field creator of message janction.videoUpscaler.v1.MsgSubmitSolution is not mutable"))
+	case "janction.videoUpscaler.v1.MsgSubmitSolution.taskId":
+		panic(fmt.Errorf("field taskId of message janction.videoUpscaler.v1.MsgSubmitSolution is not mutable"))
+	case "janction.videoUpscaler.v1.MsgSubmitSolution.threadId":
+		panic(fmt.Errorf("field threadId of message janction.videoUpscaler.v1.MsgSubmitSolution is not mutable"))
+	case "janction.videoUpscaler.v1.MsgSubmitSolution.dir":
+		panic(fmt.Errorf("field dir of message janction.videoUpscaler.v1.MsgSubmitSolution is not mutable"))
+	case "janction.videoUpscaler.v1.MsgSubmitSolution.average_render_seconds":
+		panic(fmt.Errorf("field average_render_seconds of message janction.videoUpscaler.v1.MsgSubmitSolution is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubmitSolution"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubmitSolution does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSubmitSolution) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "janction.videoUpscaler.v1.MsgSubmitSolution.creator":
+		return protoreflect.ValueOfString("")
+	case "janction.videoUpscaler.v1.MsgSubmitSolution.taskId":
+		return protoreflect.ValueOfString("")
+	case "janction.videoUpscaler.v1.MsgSubmitSolution.threadId":
+		return protoreflect.ValueOfString("")
+	case "janction.videoUpscaler.v1.MsgSubmitSolution.dir":
+		return protoreflect.ValueOfString("")
+	case "janction.videoUpscaler.v1.MsgSubmitSolution.average_render_seconds":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubmitSolution"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubmitSolution does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSubmitSolution) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in janction.videoUpscaler.v1.MsgSubmitSolution", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSubmitSolution) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitSolution) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSubmitSolution) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSubmitSolution) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSubmitSolution)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TaskId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ThreadId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Dir)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AverageRenderSeconds != 0 {
+			n += 1 + runtime.Sov(uint64(x.AverageRenderSeconds))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSubmitSolution)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.AverageRenderSeconds != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AverageRenderSeconds))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Dir) > 0 {
+			i -= len(x.Dir)
+			copy(dAtA[i:], x.Dir)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Dir)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.ThreadId) > 0 {
+			i -= len(x.ThreadId)
+			copy(dAtA[i:], x.ThreadId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ThreadId)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.TaskId) > 0 {
+			i -= len(x.TaskId)
+			copy(dAtA[i:], x.TaskId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TaskId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSubmitSolution)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitSolution: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitSolution: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TaskId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ThreadId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ThreadId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Dir", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Dir = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AverageRenderSeconds", wireType)
+				}
+				x.AverageRenderSeconds = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AverageRenderSeconds |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSubmitSolutionResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_janction_videoUpscaler_v1_tx_proto_init()
+	md_MsgSubmitSolutionResponse = File_janction_videoUpscaler_v1_tx_proto.Messages().ByName("MsgSubmitSolutionResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSubmitSolutionResponse)(nil)
+
+type fastReflection_MsgSubmitSolutionResponse MsgSubmitSolutionResponse
+
+func (x *MsgSubmitSolutionResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSubmitSolutionResponse)(x)
+}
+
+func (x *MsgSubmitSolutionResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSubmitSolutionResponse_messageType fastReflection_MsgSubmitSolutionResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSubmitSolutionResponse_messageType{}
+
+type fastReflection_MsgSubmitSolutionResponse_messageType struct{}
+
+func (x fastReflection_MsgSubmitSolutionResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSubmitSolutionResponse)(nil)
+}
+func (x fastReflection_MsgSubmitSolutionResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitSolutionResponse)
+}
+func (x fastReflection_MsgSubmitSolutionResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitSolutionResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSubmitSolutionResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitSolutionResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSubmitSolutionResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSubmitSolutionResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSubmitSolutionResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitSolutionResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSubmitSolutionResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgSubmitSolutionResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSubmitSolutionResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSubmitSolutionResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubmitSolutionResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubmitSolutionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitSolutionResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubmitSolutionResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubmitSolutionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSubmitSolutionResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubmitSolutionResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubmitSolutionResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitSolutionResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubmitSolutionResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubmitSolutionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitSolutionResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubmitSolutionResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubmitSolutionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSubmitSolutionResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubmitSolutionResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubmitSolutionResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSubmitSolutionResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in janction.videoUpscaler.v1.MsgSubmitSolutionResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSubmitSolutionResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitSolutionResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSubmitSolutionResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSubmitSolutionResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSubmitSolutionResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSubmitSolutionResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSubmitSolutionResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitSolutionResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitSolutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgReportThreadFailure          protoreflect.MessageDescriptor
+	fd_MsgReportThreadFailure_creator  protoreflect.FieldDescriptor
+	fd_MsgReportThreadFailure_taskId   protoreflect.FieldDescriptor
+	fd_MsgReportThreadFailure_threadId protoreflect.FieldDescriptor
+	fd_MsgReportThreadFailure_reason   protoreflect.FieldDescriptor
+	fd_MsgReportThreadFailure_message  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_janction_videoUpscaler_v1_tx_proto_init()
+	md_MsgReportThreadFailure = File_janction_videoUpscaler_v1_tx_proto.Messages().ByName("MsgReportThreadFailure")
+	fd_MsgReportThreadFailure_creator = md_MsgReportThreadFailure.Fields().ByName("creator")
+	fd_MsgReportThreadFailure_taskId = md_MsgReportThreadFailure.Fields().ByName("taskId")
+	fd_MsgReportThreadFailure_threadId = md_MsgReportThreadFailure.Fields().ByName("threadId")
+	fd_MsgReportThreadFailure_reason = md_MsgReportThreadFailure.Fields().ByName("reason")
+	fd_MsgReportThreadFailure_message = md_MsgReportThreadFailure.Fields().ByName("message")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgReportThreadFailure)(nil)
+
+type fastReflection_MsgReportThreadFailure MsgReportThreadFailure
+
+func (x *MsgReportThreadFailure) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgReportThreadFailure)(x)
+}
+
+func (x *MsgReportThreadFailure) slowProtoReflect() protoreflect.Message {
+	mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgReportThreadFailure_messageType fastReflection_MsgReportThreadFailure_messageType
+var _ protoreflect.MessageType = fastReflection_MsgReportThreadFailure_messageType{}
+
+type fastReflection_MsgReportThreadFailure_messageType struct{}
+
+func (x fastReflection_MsgReportThreadFailure_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgReportThreadFailure)(nil)
+}
+func (x fastReflection_MsgReportThreadFailure_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgReportThreadFailure)
+}
+func (x fastReflection_MsgReportThreadFailure_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgReportThreadFailure
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgReportThreadFailure) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgReportThreadFailure
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgReportThreadFailure) Type() protoreflect.MessageType {
+	return _fastReflection_MsgReportThreadFailure_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgReportThreadFailure) New() protoreflect.Message {
+	return new(fastReflection_MsgReportThreadFailure)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgReportThreadFailure) Interface() protoreflect.ProtoMessage {
+	return (*MsgReportThreadFailure)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgReportThreadFailure) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgReportThreadFailure_creator, value) {
+			return
+		}
+	}
+	if x.TaskId != "" {
+		value := protoreflect.ValueOfString(x.TaskId)
+		if !f(fd_MsgReportThreadFailure_taskId, value) {
+			return
+		}
+	}
+	if x.ThreadId != "" {
+		value := protoreflect.ValueOfString(x.ThreadId)
+		if !f(fd_MsgReportThreadFailure_threadId, value) {
+			return
+		}
+	}
+	if x.Reason != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Reason))
+		if !f(fd_MsgReportThreadFailure_reason, value) {
+			return
+		}
+	}
+	if x.Message != "" {
+		value := protoreflect.ValueOfString(x.Message)
+		if !f(fd_MsgReportThreadFailure_message, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgReportThreadFailure) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "janction.videoUpscaler.v1.MsgReportThreadFailure.creator":
+		return x.Creator != ""
+	case "janction.videoUpscaler.v1.MsgReportThreadFailure.taskId":
+		return x.TaskId != ""
+	case "janction.videoUpscaler.v1.MsgReportThreadFailure.threadId":
+		return x.ThreadId != ""
+	case "janction.videoUpscaler.v1.MsgReportThreadFailure.reason":
+		return x.Reason != 0
+	case "janction.videoUpscaler.v1.MsgReportThreadFailure.message":
+		return x.Message != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgReportThreadFailure"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgReportThreadFailure does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgReportThreadFailure) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "janction.videoUpscaler.v1.MsgReportThreadFailure.creator":
+		x.Creator = ""
+	case "janction.videoUpscaler.v1.MsgReportThreadFailure.taskId":
+		x.TaskId = ""
+	case "janction.videoUpscaler.v1.MsgReportThreadFailure.threadId":
+		x.ThreadId = ""
+	case "janction.videoUpscaler.v1.MsgReportThreadFailure.reason":
+		x.Reason = 0
+	case "janction.videoUpscaler.v1.MsgReportThreadFailure.message":
+		x.Message = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgReportThreadFailure"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgReportThreadFailure does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgReportThreadFailure) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "janction.videoUpscaler.v1.MsgReportThreadFailure.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "janction.videoUpscaler.v1.MsgReportThreadFailure.taskId":
+		value := x.TaskId
+		return protoreflect.ValueOfString(value)
+	case "janction.videoUpscaler.v1.MsgReportThreadFailure.threadId":
+		value := x.ThreadId
+		return protoreflect.ValueOfString(value)
+	case "janction.videoUpscaler.v1.MsgReportThreadFailure.reason":
+		value := x.Reason
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "janction.videoUpscaler.v1.MsgReportThreadFailure.message":
+		value := x.Message
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgReportThreadFailure"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgReportThreadFailure does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgReportThreadFailure) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "janction.videoUpscaler.v1.MsgReportThreadFailure.creator":
+		x.Creator = value.Interface().(string)
+	case "janction.videoUpscaler.v1.MsgReportThreadFailure.taskId":
+		x.TaskId = value.Interface().(string)
+	case "janction.videoUpscaler.v1.MsgReportThreadFailure.threadId":
+		x.ThreadId = value.Interface().(string)
+	case "janction.videoUpscaler.v1.MsgReportThreadFailure.reason":
+		x.Reason = (ThreadFailureReason)(value.Enum())
+	case "janction.videoUpscaler.v1.MsgReportThreadFailure.message":
+		x.Message = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgReportThreadFailure"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgReportThreadFailure does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgReportThreadFailure) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "janction.videoUpscaler.v1.MsgReportThreadFailure.creator":
+		panic(fmt.Errorf("field creator of message janction.videoUpscaler.v1.MsgReportThreadFailure is not mutable"))
+	case "janction.videoUpscaler.v1.MsgReportThreadFailure.taskId":
+		panic(fmt.Errorf("field taskId of message janction.videoUpscaler.v1.MsgReportThreadFailure is not mutable"))
+	case "janction.videoUpscaler.v1.MsgReportThreadFailure.threadId":
+		panic(fmt.Errorf("field threadId of message janction.videoUpscaler.v1.MsgReportThreadFailure is not mutable"))
+	case "janction.videoUpscaler.v1.MsgReportThreadFailure.reason":
+		panic(fmt.Errorf("field reason of message janction.videoUpscaler.v1.MsgReportThreadFailure is not mutable"))
+	case "janction.videoUpscaler.v1.MsgReportThreadFailure.message":
+		panic(fmt.Errorf("field message of message janction.videoUpscaler.v1.MsgReportThreadFailure is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgReportThreadFailure"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgReportThreadFailure does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgReportThreadFailure) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "janction.videoUpscaler.v1.MsgReportThreadFailure.creator":
+		return protoreflect.ValueOfString("")
+	case "janction.videoUpscaler.v1.MsgReportThreadFailure.taskId":
+		return protoreflect.ValueOfString("")
+	case "janction.videoUpscaler.v1.MsgReportThreadFailure.threadId":
+		return protoreflect.ValueOfString("")
+	case "janction.videoUpscaler.v1.MsgReportThreadFailure.reason":
+		return protoreflect.ValueOfEnum(0)
+	case "janction.videoUpscaler.v1.MsgReportThreadFailure.message":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgReportThreadFailure"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgReportThreadFailure does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgReportThreadFailure) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in janction.videoUpscaler.v1.MsgReportThreadFailure", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgReportThreadFailure) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgReportThreadFailure) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgReportThreadFailure) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgReportThreadFailure) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgReportThreadFailure)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TaskId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ThreadId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Reason != 0 {
+			n += 1 + runtime.Sov(uint64(x.Reason))
+		}
+		l = len(x.Message)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgReportThreadFailure)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Message) > 0 {
+			i -= len(x.Message)
+			copy(dAtA[i:], x.Message)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Message)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.Reason != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Reason))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.ThreadId) > 0 {
+			i -= len(x.ThreadId)
+			copy(dAtA[i:], x.ThreadId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ThreadId)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.TaskId) > 0 {
+			i -= len(x.TaskId)
+			copy(dAtA[i:], x.TaskId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TaskId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgReportThreadFailure)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgReportThreadFailure: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgReportThreadFailure: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TaskId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ThreadId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ThreadId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				x.Reason = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Reason |= ThreadFailureReason(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Message = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgReportThreadFailureResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_janction_videoUpscaler_v1_tx_proto_init()
+	md_MsgReportThreadFailureResponse = File_janction_videoUpscaler_v1_tx_proto.Messages().ByName("MsgReportThreadFailureResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgReportThreadFailureResponse)(nil)
+
+type fastReflection_MsgReportThreadFailureResponse MsgReportThreadFailureResponse
+
+func (x *MsgReportThreadFailureResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgReportThreadFailureResponse)(x)
+}
+
+func (x *MsgReportThreadFailureResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgReportThreadFailureResponse_messageType fastReflection_MsgReportThreadFailureResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgReportThreadFailureResponse_messageType{}
+
+type fastReflection_MsgReportThreadFailureResponse_messageType struct{}
+
+func (x fastReflection_MsgReportThreadFailureResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgReportThreadFailureResponse)(nil)
+}
+func (x fastReflection_MsgReportThreadFailureResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgReportThreadFailureResponse)
+}
+func (x fastReflection_MsgReportThreadFailureResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgReportThreadFailureResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgReportThreadFailureResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgReportThreadFailureResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgReportThreadFailureResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgReportThreadFailureResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgReportThreadFailureResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgReportThreadFailureResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgReportThreadFailureResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgReportThreadFailureResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgReportThreadFailureResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgReportThreadFailureResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgReportThreadFailureResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgReportThreadFailureResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgReportThreadFailureResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgReportThreadFailureResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgReportThreadFailureResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgReportThreadFailureResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgReportThreadFailureResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgReportThreadFailureResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgReportThreadFailureResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgReportThreadFailureResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgReportThreadFailureResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgReportThreadFailureResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgReportThreadFailureResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgReportThreadFailureResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgReportThreadFailureResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgReportThreadFailureResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgReportThreadFailureResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgReportThreadFailureResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in janction.videoUpscaler.v1.MsgReportThreadFailureResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgReportThreadFailureResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgReportThreadFailureResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgReportThreadFailureResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgReportThreadFailureResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgReportThreadFailureResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgReportThreadFailureResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgReportThreadFailureResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgReportThreadFailureResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgReportThreadFailureResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSubmitFinalVideo         protoreflect.MessageDescriptor
+	fd_MsgSubmitFinalVideo_creator protoreflect.FieldDescriptor
+	fd_MsgSubmitFinalVideo_taskId  protoreflect.FieldDescriptor
+	fd_MsgSubmitFinalVideo_cid     protoreflect.FieldDescriptor
+	fd_MsgSubmitFinalVideo_hash    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_janction_videoUpscaler_v1_tx_proto_init()
+	md_MsgSubmitFinalVideo = File_janction_videoUpscaler_v1_tx_proto.Messages().ByName("MsgSubmitFinalVideo")
+	fd_MsgSubmitFinalVideo_creator = md_MsgSubmitFinalVideo.Fields().ByName("creator")
+	fd_MsgSubmitFinalVideo_taskId = md_MsgSubmitFinalVideo.Fields().ByName("taskId")
+	fd_MsgSubmitFinalVideo_cid = md_MsgSubmitFinalVideo.Fields().ByName("cid")
+	fd_MsgSubmitFinalVideo_hash = md_MsgSubmitFinalVideo.Fields().ByName("hash")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSubmitFinalVideo)(nil)
+
+type fastReflection_MsgSubmitFinalVideo MsgSubmitFinalVideo
+
+func (x *MsgSubmitFinalVideo) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSubmitFinalVideo)(x)
+}
+
+func (x *MsgSubmitFinalVideo) slowProtoReflect() protoreflect.Message {
+	mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSubmitFinalVideo_messageType fastReflection_MsgSubmitFinalVideo_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSubmitFinalVideo_messageType{}
+
+type fastReflection_MsgSubmitFinalVideo_messageType struct{}
+
+func (x fastReflection_MsgSubmitFinalVideo_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSubmitFinalVideo)(nil)
+}
+func (x fastReflection_MsgSubmitFinalVideo_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitFinalVideo)
+}
+func (x fastReflection_MsgSubmitFinalVideo_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitFinalVideo
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSubmitFinalVideo) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitFinalVideo
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSubmitFinalVideo) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSubmitFinalVideo_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSubmitFinalVideo) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitFinalVideo)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSubmitFinalVideo) Interface() protoreflect.ProtoMessage {
+	return (*MsgSubmitFinalVideo)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSubmitFinalVideo) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgSubmitFinalVideo_creator, value) {
+			return
+		}
+	}
+	if x.TaskId != "" {
+		value := protoreflect.ValueOfString(x.TaskId)
+		if !f(fd_MsgSubmitFinalVideo_taskId, value) {
+			return
+		}
+	}
+	if x.Cid != "" {
+		value := protoreflect.ValueOfString(x.Cid)
+		if !f(fd_MsgSubmitFinalVideo_cid, value) {
+			return
+		}
+	}
+	if x.Hash != "" {
+		value := protoreflect.ValueOfString(x.Hash)
+		if !f(fd_MsgSubmitFinalVideo_hash, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSubmitFinalVideo) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "janction.videoUpscaler.v1.MsgSubmitFinalVideo.creator":
+		return x.Creator != ""
+	case "janction.videoUpscaler.v1.MsgSubmitFinalVideo.taskId":
+		return x.TaskId != ""
+	case "janction.videoUpscaler.v1.MsgSubmitFinalVideo.cid":
+		return x.Cid != ""
+	case "janction.videoUpscaler.v1.MsgSubmitFinalVideo.hash":
+		return x.Hash != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubmitFinalVideo"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubmitFinalVideo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitFinalVideo) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "janction.videoUpscaler.v1.MsgSubmitFinalVideo.creator":
+		x.Creator = ""
+	case "janction.videoUpscaler.v1.MsgSubmitFinalVideo.taskId":
+		x.TaskId = ""
+	case "janction.videoUpscaler.v1.MsgSubmitFinalVideo.cid":
+		x.Cid = ""
+	case "janction.videoUpscaler.v1.MsgSubmitFinalVideo.hash":
+		x.Hash = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubmitFinalVideo"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubmitFinalVideo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSubmitFinalVideo) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "janction.videoUpscaler.v1.MsgSubmitFinalVideo.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "janction.videoUpscaler.v1.MsgSubmitFinalVideo.taskId":
+		value := x.TaskId
+		return protoreflect.ValueOfString(value)
+	case "janction.videoUpscaler.v1.MsgSubmitFinalVideo.cid":
+		value := x.Cid
+		return protoreflect.ValueOfString(value)
+	case "janction.videoUpscaler.v1.MsgSubmitFinalVideo.hash":
+		value := x.Hash
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubmitFinalVideo"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubmitFinalVideo does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitFinalVideo) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "janction.videoUpscaler.v1.MsgSubmitFinalVideo.creator":
+		x.Creator = value.Interface().(string)
+	case "janction.videoUpscaler.v1.MsgSubmitFinalVideo.taskId":
+		x.TaskId = value.Interface().(string)
+	case "janction.videoUpscaler.v1.MsgSubmitFinalVideo.cid":
+		x.Cid = value.Interface().(string)
+	case "janction.videoUpscaler.v1.MsgSubmitFinalVideo.hash":
+		x.Hash = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubmitFinalVideo"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubmitFinalVideo does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitFinalVideo) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "janction.videoUpscaler.v1.MsgSubmitFinalVideo.creator":
+		panic(fmt.Errorf("field creator of message janction.videoUpscaler.v1.MsgSubmitFinalVideo is not mutable"))
+	case "janction.videoUpscaler.v1.MsgSubmitFinalVideo.taskId":
+		panic(fmt.Errorf("field taskId of message janction.videoUpscaler.v1.MsgSubmitFinalVideo is not mutable"))
+	case "janction.videoUpscaler.v1.MsgSubmitFinalVideo.cid":
+		panic(fmt.Errorf("field cid of message janction.videoUpscaler.v1.MsgSubmitFinalVideo is not mutable"))
+	case "janction.videoUpscaler.v1.MsgSubmitFinalVideo.hash":
+		panic(fmt.Errorf("field hash of message janction.videoUpscaler.v1.MsgSubmitFinalVideo is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubmitFinalVideo"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubmitFinalVideo does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSubmitFinalVideo) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "janction.videoUpscaler.v1.MsgSubmitFinalVideo.creator":
+		return protoreflect.ValueOfString("")
+	case "janction.videoUpscaler.v1.MsgSubmitFinalVideo.taskId":
+		return protoreflect.ValueOfString("")
+	case "janction.videoUpscaler.v1.MsgSubmitFinalVideo.cid":
+		return protoreflect.ValueOfString("")
+	case "janction.videoUpscaler.v1.MsgSubmitFinalVideo.hash":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubmitFinalVideo"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubmitFinalVideo does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSubmitFinalVideo) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in janction.videoUpscaler.v1.MsgSubmitFinalVideo", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSubmitFinalVideo) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitFinalVideo) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSubmitFinalVideo) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSubmitFinalVideo) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSubmitFinalVideo)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TaskId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Cid)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Hash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSubmitFinalVideo)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Hash) > 0 {
+			i -= len(x.Hash)
+			copy(dAtA[i:], x.Hash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Hash)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Cid) > 0 {
+			i -= len(x.Cid)
+			copy(dAtA[i:], x.Cid)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Cid)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.TaskId) > 0 {
+			i -= len(x.TaskId)
+			copy(dAtA[i:], x.TaskId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TaskId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSubmitFinalVideo)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitFinalVideo: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitFinalVideo: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TaskId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Cid", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Cid = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Hash = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSubmitFinalVideoResponse protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_janction_videoUpscaler_v1_tx_proto_init()
+	md_MsgSubmitFinalVideoResponse = File_janction_videoUpscaler_v1_tx_proto.Messages().ByName("MsgSubmitFinalVideoResponse")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSubmitFinalVideoResponse)(nil)
+
+type fastReflection_MsgSubmitFinalVideoResponse MsgSubmitFinalVideoResponse
+
+func (x *MsgSubmitFinalVideoResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSubmitFinalVideoResponse)(x)
+}
+
+func (x *MsgSubmitFinalVideoResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSubmitFinalVideoResponse_messageType fastReflection_MsgSubmitFinalVideoResponse_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSubmitFinalVideoResponse_messageType{}
+
+type fastReflection_MsgSubmitFinalVideoResponse_messageType struct{}
+
+func (x fastReflection_MsgSubmitFinalVideoResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSubmitFinalVideoResponse)(nil)
+}
+func (x fastReflection_MsgSubmitFinalVideoResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitFinalVideoResponse)
+}
+func (x fastReflection_MsgSubmitFinalVideoResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitFinalVideoResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSubmitFinalVideoResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSubmitFinalVideoResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSubmitFinalVideoResponse) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSubmitFinalVideoResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSubmitFinalVideoResponse) New() protoreflect.Message {
+	return new(fastReflection_MsgSubmitFinalVideoResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSubmitFinalVideoResponse) Interface() protoreflect.ProtoMessage {
+	return (*MsgSubmitFinalVideoResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSubmitFinalVideoResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSubmitFinalVideoResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubmitFinalVideoResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubmitFinalVideoResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitFinalVideoResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubmitFinalVideoResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubmitFinalVideoResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSubmitFinalVideoResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubmitFinalVideoResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubmitFinalVideoResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitFinalVideoResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubmitFinalVideoResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubmitFinalVideoResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitFinalVideoResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubmitFinalVideoResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubmitFinalVideoResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSubmitFinalVideoResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: janction.videoUpscaler.v1.MsgSubmitFinalVideoResponse"))
+		}
+		panic(fmt.Errorf("message janction.videoUpscaler.v1.MsgSubmitFinalVideoResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSubmitFinalVideoResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in janction.videoUpscaler.v1.MsgSubmitFinalVideoResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSubmitFinalVideoResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSubmitFinalVideoResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSubmitFinalVideoResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSubmitFinalVideoResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSubmitFinalVideoResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSubmitFinalVideoResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSubmitFinalVideoResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitFinalVideoResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSubmitFinalVideoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: janction/videoUpscaler/v1/tx.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// MsgCreateGame defines the Msg/CreateGame request type.
+type MsgCreateVideoUpscalerTask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// creator is the message sender.
+	Creator    string        `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Cid        string        `protobuf:"bytes,2,opt,name=cid,proto3" json:"cid,omitempty"`
+	StartFrame int32         `protobuf:"varint,3,opt,name=startFrame,proto3" json:"startFrame,omitempty"`
+	EndFrame   int32         `protobuf:"varint,4,opt,name=endFrame,proto3" json:"endFrame,omitempty"`
+	Threads    int32         `protobuf:"varint,5,opt,name=threads,proto3" json:"threads,omitempty"`
+	Scale      int32         `protobuf:"varint,6,opt,name=scale,proto3" json:"scale,omitempty"`
+	Reward     *v1beta1.Coin `protobuf:"bytes,7,opt,name=reward,proto3" json:"reward,omitempty"`
+	// if set, threads are sized on the fly from the render speed of each worker
+	TargetThreadSeconds int64 `protobuf:"varint,8,opt,name=target_thread_seconds,json=targetThreadSeconds,proto3" json:"target_thread_seconds,omitempty"`
+	// workers must match these requirements to subscribe
+	Requirements *TaskRequirements `protobuf:"bytes,9,opt,name=requirements,proto3" json:"requirements,omitempty"`
+	// optional allowlist, denylist or worker group of the workers that can process the task
+	Access *TaskAccess `protobuf:"bytes,10,opt,name=access,proto3" json:"access,omitempty"`
+	// block height after which unused reward is refunded. Zero never expires
+	ExpiryHeight int64 `protobuf:"varint,11,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+	// optional fee, in the reward denom, so workers pick the task first
+	PriorityFee *v1beta1.Coin `protobuf:"bytes,12,opt,name=priority_fee,json=priorityFee,proto3" json:"priority_fee,omitempty"`
+	// scale, denoise, model, deterministic mode and validation mode. The scale must match the scale field if both are set
+	Options *UpscaleOptions `protobuf:"bytes,13,opt,name=options,proto3" json:"options,omitempty"`
+	// properties of the input, from the probe command. The frame range must be within the video
+	MediaInfo *MediaInfo `protobuf:"bytes,14,opt,name=media_info,json=mediaInfo,proto3" json:"media_info,omitempty"`
+	// video file or image sequence directory. Defaults to a video file
+	InputType InputType `protobuf:"varint,15,opt,name=input_type,json=inputType,proto3,enum=janction.videoUpscaler.v1.InputType" json:"input_type,omitempty"`
+}
+
+func (x *MsgCreateVideoUpscalerTask) Reset() {
+	*x = MsgCreateVideoUpscalerTask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgCreateVideoUpscalerTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgCreateVideoUpscalerTask) ProtoMessage() {}
+
+// Deprecated: Use MsgCreateVideoUpscalerTask.ProtoReflect.Descriptor instead.
+func (*MsgCreateVideoUpscalerTask) Descriptor() ([]byte, []int) {
+	return file_janction_videoUpscaler_v1_tx_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MsgCreateVideoUpscalerTask) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MsgCreateVideoUpscalerTask) GetCid() string {
+	if x != nil {
+		return x.Cid
+	}
+	return ""
+}
+
+func (x *MsgCreateVideoUpscalerTask) GetStartFrame() int32 {
+	if x != nil {
+		return x.StartFrame
+	}
+	return 0
+}
+
+func (x *MsgCreateVideoUpscalerTask) GetEndFrame() int32 {
+	if x != nil {
+		return x.EndFrame
+	}
+	return 0
+}
+
+func (x *MsgCreateVideoUpscalerTask) GetThreads() int32 {
+	if x != nil {
+		return x.Threads
+	}
+	return 0
+}
+
+func (x *MsgCreateVideoUpscalerTask) GetScale() int32 {
+	if x != nil {
+		return x.Scale
+	}
+	return 0
+}
+
+func (x *MsgCreateVideoUpscalerTask) GetReward() *v1beta1.Coin {
+	if x != nil {
+		return x.Reward
+	}
+	return nil
+}
+
+func (x *MsgCreateVideoUpscalerTask) GetTargetThreadSeconds() int64 {
+	if x != nil {
+		return x.TargetThreadSeconds
+	}
+	return 0
+}
+
+func (x *MsgCreateVideoUpscalerTask) GetRequirements() *TaskRequirements {
+	if x != nil {
+		return x.Requirements
+	}
+	return nil
+}
+
+func (x *MsgCreateVideoUpscalerTask) GetAccess() *TaskAccess {
+	if x != nil {
+		return x.Access
+	}
+	return nil
+}
+
+func (x *MsgCreateVideoUpscalerTask) GetExpiryHeight() int64 {
+	if x != nil {
+		return x.ExpiryHeight
+	}
+	return 0
+}
+
+func (x *MsgCreateVideoUpscalerTask) GetPriorityFee() *v1beta1.Coin {
+	if x != nil {
+		return x.PriorityFee
+	}
+	return nil
+}
+
+func (x *MsgCreateVideoUpscalerTask) GetOptions() *UpscaleOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *MsgCreateVideoUpscalerTask) GetMediaInfo() *MediaInfo {
+	if x != nil {
+		return x.MediaInfo
+	}
+	return nil
+}
+
+func (x *MsgCreateVideoUpscalerTask) GetInputType() InputType {
+	if x != nil {
+		return x.InputType
+	}
+	return InputType_INPUT_TYPE_VIDEO
+}
+
+// MsgCreateGameResponse defines the Msg/CreateGame response type.
+type MsgCreateVideoUpscalerTaskResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TaskId string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+}
+
+func (x *MsgCreateVideoUpscalerTaskResponse) Reset() {
+	*x = MsgCreateVideoUpscalerTaskResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgCreateVideoUpscalerTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgCreateVideoUpscalerTaskResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgCreateVideoUpscalerTaskResponse.ProtoReflect.Descriptor instead.
+func (*MsgCreateVideoUpscalerTaskResponse) Descriptor() ([]byte, []int) {
+	return file_janction_videoUpscaler_v1_tx_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MsgCreateVideoUpscalerTaskResponse) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+type MsgAddWorker struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator  string        `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	PublicIp string        `protobuf:"bytes,2,opt,name=public_ip,json=publicIp,proto3" json:"public_ip,omitempty"`
+	IpfsId   string        `protobuf:"bytes,3,opt,name=ipfs_id,json=ipfsId,proto3" json:"ipfs_id,omitempty"`
+	Stake    *v1beta1.Coin `protobuf:"bytes,4,opt,name=stake,proto3" json:"stake,omitempty"`
+	// amount of threads the worker will process concurrently. Defaults to 1
+	MaxThreads   int32               `protobuf:"varint,5,opt,name=max_threads,json=maxThreads,proto3" json:"max_threads,omitempty"`
+	Capabilities *WorkerCapabilities `protobuf:"bytes,6,opt,name=capabilities,proto3" json:"capabilities,omitempty"`
+	// full multiaddrs of the IPFS node, like /ip6/::1/udp/4001/quic-v1 or /dns4/worker.example.com/tcp/4001
+	AnnounceAddrs []string `protobuf:"bytes,7,rep,name=announce_addrs,json=announceAddrs,proto3" json:"announce_addrs,omitempty"`
+}
+
+func (x *MsgAddWorker) Reset() {
+	*x = MsgAddWorker{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgAddWorker) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgAddWorker) ProtoMessage() {}
+
+// Deprecated: Use MsgAddWorker.ProtoReflect.Descriptor instead.
+func (*MsgAddWorker) Descriptor() ([]byte, []int) {
+	return file_janction_videoUpscaler_v1_tx_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MsgAddWorker) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MsgAddWorker) GetPublicIp() string {
+	if x != nil {
+		return x.PublicIp
+	}
+	return ""
+}
+
+func (x *MsgAddWorker) GetIpfsId() string {
+	if x != nil {
+		return x.IpfsId
+	}
+	return ""
+}
+
+func (x *MsgAddWorker) GetStake() *v1beta1.Coin {
+	if x != nil {
+		return x.Stake
+	}
+	return nil
+}
+
+func (x *MsgAddWorker) GetMaxThreads() int32 {
+	if x != nil {
+		return x.MaxThreads
+	}
+	return 0
+}
+
+func (x *MsgAddWorker) GetCapabilities() *WorkerCapabilities {
+	if x != nil {
+		return x.Capabilities
+	}
+	return nil
+}
+
+func (x *MsgAddWorker) GetAnnounceAddrs() []string {
+	if x != nil {
+		return x.AnnounceAddrs
+	}
+	return nil
+}
+
+type MsgAddWorkerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TaskId string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Ok      bool   `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *MsgCreateVideoUpscalerTaskResponse) Reset() {
-	*x = MsgCreateVideoUpscalerTaskResponse{}
+func (x *MsgAddWorkerResponse) Reset() {
+	*x = MsgAddWorkerResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[1]
+		mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgCreateVideoUpscalerTaskResponse) String() string {
+func (x *MsgAddWorkerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgCreateVideoUpscalerTaskResponse) ProtoMessage() {}
+func (*MsgAddWorkerResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgCreateVideoUpscalerTaskResponse.ProtoReflect.Descriptor instead.
-func (*MsgCreateVideoUpscalerTaskResponse) Descriptor() ([]byte, []int) {
-	return file_janction_videoUpscaler_v1_tx_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use MsgAddWorkerResponse.ProtoReflect.Descriptor instead.
+func (*MsgAddWorkerResponse) Descriptor() ([]byte, []int) {
+	return file_janction_videoUpscaler_v1_tx_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *MsgCreateVideoUpscalerTaskResponse) GetTaskId() string {
+func (x *MsgAddWorkerResponse) GetOk() bool {
 	if x != nil {
-		return x.TaskId
+		return x.Ok
+	}
+	return false
+}
+
+func (x *MsgAddWorkerResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
 	}
 	return ""
 }
 
-type MsgAddWorker struct {
+type MsgSetWorkerGroup struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Creator  string        `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	PublicIp string        `protobuf:"bytes,2,opt,name=public_ip,json=publicIp,proto3" json:"public_ip,omitempty"`
-	IpfsId   string        `protobuf:"bytes,3,opt,name=ipfs_id,json=ipfsId,proto3" json:"ipfs_id,omitempty"`
-	Stake    *v1beta1.Coin `protobuf:"bytes,4,opt,name=stake,proto3" json:"stake,omitempty"`
+	Creator string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Name    string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Members []string `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`
 }
 
-func (x *MsgAddWorker) Reset() {
-	*x = MsgAddWorker{}
+func (x *MsgSetWorkerGroup) Reset() {
+	*x = MsgSetWorkerGroup{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[2]
+		mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgAddWorker) String() string {
+func (x *MsgSetWorkerGroup) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgAddWorker) ProtoMessage() {}
+func (*MsgSetWorkerGroup) ProtoMessage() {}
 
-// Deprecated: Use MsgAddWorker.ProtoReflect.Descriptor instead.
-func (*MsgAddWorker) Descriptor() ([]byte, []int) {
-	return file_janction_videoUpscaler_v1_tx_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use MsgSetWorkerGroup.ProtoReflect.Descriptor instead.
+func (*MsgSetWorkerGroup) Descriptor() ([]byte, []int) {
+	return file_janction_videoUpscaler_v1_tx_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *MsgAddWorker) GetCreator() string {
+func (x *MsgSetWorkerGroup) GetCreator() string {
 	if x != nil {
 		return x.Creator
 	}
 	return ""
 }
 
-func (x *MsgAddWorker) GetPublicIp() string {
+func (x *MsgSetWorkerGroup) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MsgSetWorkerGroup) GetMembers() []string {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type MsgSetWorkerGroupResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgSetWorkerGroupResponse) Reset() {
+	*x = MsgSetWorkerGroupResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSetWorkerGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSetWorkerGroupResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgSetWorkerGroupResponse.ProtoReflect.Descriptor instead.
+func (*MsgSetWorkerGroupResponse) Descriptor() ([]byte, []int) {
+	return file_janction_videoUpscaler_v1_tx_proto_rawDescGZIP(), []int{5}
+}
+
+// replaces the network details of the worker
+type MsgUpdateWorker struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator       string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	PublicIp      string   `protobuf:"bytes,2,opt,name=public_ip,json=publicIp,proto3" json:"public_ip,omitempty"`
+	IpfsId        string   `protobuf:"bytes,3,opt,name=ipfs_id,json=ipfsId,proto3" json:"ipfs_id,omitempty"`
+	AnnounceAddrs []string `protobuf:"bytes,4,rep,name=announce_addrs,json=announceAddrs,proto3" json:"announce_addrs,omitempty"`
+}
+
+func (x *MsgUpdateWorker) Reset() {
+	*x = MsgUpdateWorker{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateWorker) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateWorker) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateWorker.ProtoReflect.Descriptor instead.
+func (*MsgUpdateWorker) Descriptor() ([]byte, []int) {
+	return file_janction_videoUpscaler_v1_tx_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MsgUpdateWorker) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MsgUpdateWorker) GetPublicIp() string {
 	if x != nil {
 		return x.PublicIp
 	}
 	return ""
 }
 
-func (x *MsgAddWorker) GetIpfsId() string {
+func (x *MsgUpdateWorker) GetIpfsId() string {
 	if x != nil {
 		return x.IpfsId
 	}
 	return ""
 }
 
-func (x *MsgAddWorker) GetStake() *v1beta1.Coin {
+func (x *MsgUpdateWorker) GetAnnounceAddrs() []string {
 	if x != nil {
-		return x.Stake
+		return x.AnnounceAddrs
 	}
 	return nil
 }
 
-type MsgAddWorkerResponse struct {
+type MsgUpdateWorkerResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+}
 
-	Ok      bool   `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+func (x *MsgUpdateWorkerResponse) Reset() {
+	*x = MsgUpdateWorkerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (x *MsgAddWorkerResponse) Reset() {
-	*x = MsgAddWorkerResponse{}
+func (x *MsgUpdateWorkerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateWorkerResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateWorkerResponse.ProtoReflect.Descriptor instead.
+func (*MsgUpdateWorkerResponse) Descriptor() ([]byte, []int) {
+	return file_janction_videoUpscaler_v1_tx_proto_rawDescGZIP(), []int{7}
+}
+
+// replaces the capabilities of the worker after hardware changes
+type MsgUpdateWorkerCapabilities struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator      string              `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Capabilities *WorkerCapabilities `protobuf:"bytes,2,opt,name=capabilities,proto3" json:"capabilities,omitempty"`
+}
+
+func (x *MsgUpdateWorkerCapabilities) Reset() {
+	*x = MsgUpdateWorkerCapabilities{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[3]
+		mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateWorkerCapabilities) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateWorkerCapabilities) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateWorkerCapabilities.ProtoReflect.Descriptor instead.
+func (*MsgUpdateWorkerCapabilities) Descriptor() ([]byte, []int) {
+	return file_janction_videoUpscaler_v1_tx_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MsgUpdateWorkerCapabilities) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MsgUpdateWorkerCapabilities) GetCapabilities() *WorkerCapabilities {
+	if x != nil {
+		return x.Capabilities
+	}
+	return nil
+}
+
+type MsgUpdateWorkerCapabilitiesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgUpdateWorkerCapabilitiesResponse) Reset() {
+	*x = MsgUpdateWorkerCapabilitiesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateWorkerCapabilitiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateWorkerCapabilitiesResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateWorkerCapabilitiesResponse.ProtoReflect.Descriptor instead.
+func (*MsgUpdateWorkerCapabilitiesResponse) Descriptor() ([]byte, []int) {
+	return file_janction_videoUpscaler_v1_tx_proto_rawDescGZIP(), []int{9}
+}
+
+type MsgWorkerHeartbeat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+}
+
+func (x *MsgWorkerHeartbeat) Reset() {
+	*x = MsgWorkerHeartbeat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgWorkerHeartbeat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgWorkerHeartbeat) ProtoMessage() {}
+
+// Deprecated: Use MsgWorkerHeartbeat.ProtoReflect.Descriptor instead.
+func (*MsgWorkerHeartbeat) Descriptor() ([]byte, []int) {
+	return file_janction_videoUpscaler_v1_tx_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MsgWorkerHeartbeat) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+type MsgWorkerHeartbeatResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgWorkerHeartbeatResponse) Reset() {
+	*x = MsgWorkerHeartbeatResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgWorkerHeartbeatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgWorkerHeartbeatResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgWorkerHeartbeatResponse.ProtoReflect.Descriptor instead.
+func (*MsgWorkerHeartbeatResponse) Descriptor() ([]byte, []int) {
+	return file_janction_videoUpscaler_v1_tx_proto_rawDescGZIP(), []int{11}
+}
+
+// MsgUpdateParams replaces the module params, typically through a governance proposal
+type MsgUpdateParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Authority string  `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Params    *Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (x *MsgUpdateParams) Reset() {
+	*x = MsgUpdateParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateParams) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateParams.ProtoReflect.Descriptor instead.
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return file_janction_videoUpscaler_v1_tx_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MsgUpdateParams) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
+}
+
+func (x *MsgUpdateParams) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+type MsgUpdateParamsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgUpdateParamsResponse) Reset() {
+	*x = MsgUpdateParamsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgAddWorkerResponse) String() string {
+func (x *MsgUpdateParamsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgAddWorkerResponse) ProtoMessage() {}
-
-// Deprecated: Use MsgAddWorkerResponse.ProtoReflect.Descriptor instead.
-func (*MsgAddWorkerResponse) Descriptor() ([]byte, []int) {
-	return file_janction_videoUpscaler_v1_tx_proto_rawDescGZIP(), []int{3}
-}
-
-func (x *MsgAddWorkerResponse) GetOk() bool {
-	if x != nil {
-		return x.Ok
-	}
-	return false
-}
+func (*MsgUpdateParamsResponse) ProtoMessage() {}
 
-func (x *MsgAddWorkerResponse) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
+// Deprecated: Use MsgUpdateParamsResponse.ProtoReflect.Descriptor instead.
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return file_janction_videoUpscaler_v1_tx_proto_rawDescGZIP(), []int{13}
 }
 
+// an empty threadId on an adaptive task cuts a new thread for the worker
 type MsgSubscribeWorkerToTask struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -7738,7 +16545,7 @@ type MsgSubscribeWorkerToTask struct {
 func (x *MsgSubscribeWorkerToTask) Reset() {
 	*x = MsgSubscribeWorkerToTask{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[4]
+		mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7752,7 +16559,7 @@ func (*MsgSubscribeWorkerToTask) ProtoMessage() {}
 
 // Deprecated: Use MsgSubscribeWorkerToTask.ProtoReflect.Descriptor instead.
 func (*MsgSubscribeWorkerToTask) Descriptor() ([]byte, []int) {
-	return file_janction_videoUpscaler_v1_tx_proto_rawDescGZIP(), []int{4}
+	return file_janction_videoUpscaler_v1_tx_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *MsgSubscribeWorkerToTask) GetAddress() string {
@@ -7787,7 +16594,7 @@ type MsgSubscribeWorkerToTaskResponse struct {
 func (x *MsgSubscribeWorkerToTaskResponse) Reset() {
 	*x = MsgSubscribeWorkerToTaskResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[5]
+		mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7801,7 +16608,7 @@ func (*MsgSubscribeWorkerToTaskResponse) ProtoMessage() {}
 
 // Deprecated: Use MsgSubscribeWorkerToTaskResponse.ProtoReflect.Descriptor instead.
 func (*MsgSubscribeWorkerToTaskResponse) Descriptor() ([]byte, []int) {
-	return file_janction_videoUpscaler_v1_tx_proto_rawDescGZIP(), []int{5}
+	return file_janction_videoUpscaler_v1_tx_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *MsgSubscribeWorkerToTaskResponse) GetThreadId() string {
@@ -7823,12 +16630,14 @@ type MsgProposeSolution struct {
 	ThreadId   string   `protobuf:"bytes,3,opt,name=threadId,proto3" json:"threadId,omitempty"`
 	PublicKey  string   `protobuf:"bytes,4,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
 	Signatures []string `protobuf:"bytes,5,rep,name=signatures,proto3" json:"signatures,omitempty"`
+	// digest of the upscaler image used to render the frames
+	ImageDigest string `protobuf:"bytes,6,opt,name=image_digest,json=imageDigest,proto3" json:"image_digest,omitempty"`
 }
 
 func (x *MsgProposeSolution) Reset() {
 	*x = MsgProposeSolution{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[6]
+		mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7842,7 +16651,7 @@ func (*MsgProposeSolution) ProtoMessage() {}
 
 // Deprecated: Use MsgProposeSolution.ProtoReflect.Descriptor instead.
 func (*MsgProposeSolution) Descriptor() ([]byte, []int) {
-	return file_janction_videoUpscaler_v1_tx_proto_rawDescGZIP(), []int{6}
+	return file_janction_videoUpscaler_v1_tx_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *MsgProposeSolution) GetCreator() string {
@@ -7880,6 +16689,13 @@ func (x *MsgProposeSolution) GetSignatures() []string {
 	return nil
 }
 
+func (x *MsgProposeSolution) GetImageDigest() string {
+	if x != nil {
+		return x.ImageDigest
+	}
+	return ""
+}
+
 // no response needed to a proposed solution
 type MsgProposeSolutionResponse struct {
 	state         protoimpl.MessageState
@@ -7890,7 +16706,7 @@ type MsgProposeSolutionResponse struct {
 func (x *MsgProposeSolutionResponse) Reset() {
 	*x = MsgProposeSolutionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[7]
+		mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7904,7 +16720,7 @@ func (*MsgProposeSolutionResponse) ProtoMessage() {}
 
 // Deprecated: Use MsgProposeSolutionResponse.ProtoReflect.Descriptor instead.
 func (*MsgProposeSolutionResponse) Descriptor() ([]byte, []int) {
-	return file_janction_videoUpscaler_v1_tx_proto_rawDescGZIP(), []int{7}
+	return file_janction_videoUpscaler_v1_tx_proto_rawDescGZIP(), []int{17}
 }
 
 // Msg to Propose a solution to an specific thread
@@ -7923,7 +16739,7 @@ type MsgRevealSolution struct {
 func (x *MsgRevealSolution) Reset() {
 	*x = MsgRevealSolution{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[8]
+		mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7937,7 +16753,7 @@ func (*MsgRevealSolution) ProtoMessage() {}
 
 // Deprecated: Use MsgRevealSolution.ProtoReflect.Descriptor instead.
 func (*MsgRevealSolution) Descriptor() ([]byte, []int) {
-	return file_janction_videoUpscaler_v1_tx_proto_rawDescGZIP(), []int{8}
+	return file_janction_videoUpscaler_v1_tx_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *MsgRevealSolution) GetCreator() string {
@@ -7978,7 +16794,7 @@ type MsgRevealSolutionResponse struct {
 func (x *MsgRevealSolutionResponse) Reset() {
 	*x = MsgRevealSolutionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[9]
+		mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7992,7 +16808,7 @@ func (*MsgRevealSolutionResponse) ProtoMessage() {}
 
 // Deprecated: Use MsgRevealSolutionResponse.ProtoReflect.Descriptor instead.
 func (*MsgRevealSolutionResponse) Descriptor() ([]byte, []int) {
-	return file_janction_videoUpscaler_v1_tx_proto_rawDescGZIP(), []int{9}
+	return file_janction_videoUpscaler_v1_tx_proto_rawDescGZIP(), []int{19}
 }
 
 type MsgSubmitValidation struct {
@@ -8010,7 +16826,7 @@ type MsgSubmitValidation struct {
 func (x *MsgSubmitValidation) Reset() {
 	*x = MsgSubmitValidation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[10]
+		mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8024,7 +16840,7 @@ func (*MsgSubmitValidation) ProtoMessage() {}
 
 // Deprecated: Use MsgSubmitValidation.ProtoReflect.Descriptor instead.
 func (*MsgSubmitValidation) Descriptor() ([]byte, []int) {
-	return file_janction_videoUpscaler_v1_tx_proto_rawDescGZIP(), []int{10}
+	return file_janction_videoUpscaler_v1_tx_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *MsgSubmitValidation) GetCreator() string {
@@ -8057,128 +16873,396 @@ func (x *MsgSubmitValidation) GetPublicKey() string {
 
 func (x *MsgSubmitValidation) GetSignatures() []string {
 	if x != nil {
-		return x.Signatures
+		return x.Signatures
+	}
+	return nil
+}
+
+type MsgSubmitValidationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgSubmitValidationResponse) Reset() {
+	*x = MsgSubmitValidationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSubmitValidationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSubmitValidationResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgSubmitValidationResponse.ProtoReflect.Descriptor instead.
+func (*MsgSubmitValidationResponse) Descriptor() ([]byte, []int) {
+	return file_janction_videoUpscaler_v1_tx_proto_rawDescGZIP(), []int{21}
+}
+
+// Sent by the validators of tasks validated by fingerprint once the solution is revealed, with the
+// filename=fingerprint of each frame they signed
+type MsgRevealValidation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator      string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TaskId       string   `protobuf:"bytes,2,opt,name=taskId,proto3" json:"taskId,omitempty"`
+	ThreadId     string   `protobuf:"bytes,3,opt,name=threadId,proto3" json:"threadId,omitempty"`
+	Fingerprints []string `protobuf:"bytes,4,rep,name=fingerprints,proto3" json:"fingerprints,omitempty"`
+}
+
+func (x *MsgRevealValidation) Reset() {
+	*x = MsgRevealValidation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgRevealValidation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgRevealValidation) ProtoMessage() {}
+
+// Deprecated: Use MsgRevealValidation.ProtoReflect.Descriptor instead.
+func (*MsgRevealValidation) Descriptor() ([]byte, []int) {
+	return file_janction_videoUpscaler_v1_tx_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *MsgRevealValidation) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MsgRevealValidation) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *MsgRevealValidation) GetThreadId() string {
+	if x != nil {
+		return x.ThreadId
+	}
+	return ""
+}
+
+func (x *MsgRevealValidation) GetFingerprints() []string {
+	if x != nil {
+		return x.Fingerprints
+	}
+	return nil
+}
+
+type MsgRevealValidationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgRevealValidationResponse) Reset() {
+	*x = MsgRevealValidationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgRevealValidationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgRevealValidationResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgRevealValidationResponse.ProtoReflect.Descriptor instead.
+func (*MsgRevealValidationResponse) Descriptor() ([]byte, []int) {
+	return file_janction_videoUpscaler_v1_tx_proto_rawDescGZIP(), []int{23}
+}
+
+type MsgSubmitSolution struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator              string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TaskId               string `protobuf:"bytes,2,opt,name=taskId,proto3" json:"taskId,omitempty"`
+	ThreadId             string `protobuf:"bytes,3,opt,name=threadId,proto3" json:"threadId,omitempty"`
+	Dir                  string `protobuf:"bytes,4,opt,name=dir,proto3" json:"dir,omitempty"`
+	AverageRenderSeconds int64  `protobuf:"varint,5,opt,name=average_render_seconds,json=averageRenderSeconds,proto3" json:"average_render_seconds,omitempty"`
+}
+
+func (x *MsgSubmitSolution) Reset() {
+	*x = MsgSubmitSolution{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSubmitSolution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSubmitSolution) ProtoMessage() {}
+
+// Deprecated: Use MsgSubmitSolution.ProtoReflect.Descriptor instead.
+func (*MsgSubmitSolution) Descriptor() ([]byte, []int) {
+	return file_janction_videoUpscaler_v1_tx_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *MsgSubmitSolution) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MsgSubmitSolution) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *MsgSubmitSolution) GetThreadId() string {
+	if x != nil {
+		return x.ThreadId
+	}
+	return ""
+}
+
+func (x *MsgSubmitSolution) GetDir() string {
+	if x != nil {
+		return x.Dir
+	}
+	return ""
+}
+
+func (x *MsgSubmitSolution) GetAverageRenderSeconds() int64 {
+	if x != nil {
+		return x.AverageRenderSeconds
+	}
+	return 0
+}
+
+type MsgSubmitSolutionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MsgSubmitSolutionResponse) Reset() {
+	*x = MsgSubmitSolutionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSubmitSolutionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSubmitSolutionResponse) ProtoMessage() {}
+
+// Deprecated: Use MsgSubmitSolutionResponse.ProtoReflect.Descriptor instead.
+func (*MsgSubmitSolutionResponse) Descriptor() ([]byte, []int) {
+	return file_janction_videoUpscaler_v1_tx_proto_rawDescGZIP(), []int{25}
+}
+
+// Msg of a worker that couldn't render a thread after retrying
+type MsgReportThreadFailure struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator  string              `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TaskId   string              `protobuf:"bytes,2,opt,name=taskId,proto3" json:"taskId,omitempty"`
+	ThreadId string              `protobuf:"bytes,3,opt,name=threadId,proto3" json:"threadId,omitempty"`
+	Reason   ThreadFailureReason `protobuf:"varint,4,opt,name=reason,proto3,enum=janction.videoUpscaler.v1.ThreadFailureReason" json:"reason,omitempty"`
+	Message  string              `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *MsgReportThreadFailure) Reset() {
+	*x = MsgReportThreadFailure{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgReportThreadFailure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgReportThreadFailure) ProtoMessage() {}
+
+// Deprecated: Use MsgReportThreadFailure.ProtoReflect.Descriptor instead.
+func (*MsgReportThreadFailure) Descriptor() ([]byte, []int) {
+	return file_janction_videoUpscaler_v1_tx_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *MsgReportThreadFailure) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MsgReportThreadFailure) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *MsgReportThreadFailure) GetThreadId() string {
+	if x != nil {
+		return x.ThreadId
+	}
+	return ""
+}
+
+func (x *MsgReportThreadFailure) GetReason() ThreadFailureReason {
+	if x != nil {
+		return x.Reason
+	}
+	return ThreadFailureReason_THREAD_FAILURE_REASON_UNSPECIFIED
+}
+
+func (x *MsgReportThreadFailure) GetMessage() string {
+	if x != nil {
+		return x.Message
 	}
-	return nil
+	return ""
 }
 
-type MsgSubmitValidationResponse struct {
+type MsgReportThreadFailureResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgSubmitValidationResponse) Reset() {
-	*x = MsgSubmitValidationResponse{}
+func (x *MsgReportThreadFailureResponse) Reset() {
+	*x = MsgReportThreadFailureResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[11]
+		mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgSubmitValidationResponse) String() string {
+func (x *MsgReportThreadFailureResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgSubmitValidationResponse) ProtoMessage() {}
+func (*MsgReportThreadFailureResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgSubmitValidationResponse.ProtoReflect.Descriptor instead.
-func (*MsgSubmitValidationResponse) Descriptor() ([]byte, []int) {
-	return file_janction_videoUpscaler_v1_tx_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use MsgReportThreadFailureResponse.ProtoReflect.Descriptor instead.
+func (*MsgReportThreadFailureResponse) Descriptor() ([]byte, []int) {
+	return file_janction_videoUpscaler_v1_tx_proto_rawDescGZIP(), []int{27}
 }
 
-type MsgSubmitSolution struct {
+// Sent by the assembler with the final video of the task, and then by the verifier with the cid and hash
+// of the video it assembled again from the frames of the threads. A different cid or hash rejects the video
+type MsgSubmitFinalVideo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Creator              string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	TaskId               string `protobuf:"bytes,2,opt,name=taskId,proto3" json:"taskId,omitempty"`
-	ThreadId             string `protobuf:"bytes,3,opt,name=threadId,proto3" json:"threadId,omitempty"`
-	Dir                  string `protobuf:"bytes,4,opt,name=dir,proto3" json:"dir,omitempty"`
-	AverageRenderSeconds int64  `protobuf:"varint,5,opt,name=average_render_seconds,json=averageRenderSeconds,proto3" json:"average_render_seconds,omitempty"`
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TaskId  string `protobuf:"bytes,2,opt,name=taskId,proto3" json:"taskId,omitempty"`
+	Cid     string `protobuf:"bytes,3,opt,name=cid,proto3" json:"cid,omitempty"`
+	Hash    string `protobuf:"bytes,4,opt,name=hash,proto3" json:"hash,omitempty"`
 }
 
-func (x *MsgSubmitSolution) Reset() {
-	*x = MsgSubmitSolution{}
+func (x *MsgSubmitFinalVideo) Reset() {
+	*x = MsgSubmitFinalVideo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[12]
+		mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgSubmitSolution) String() string {
+func (x *MsgSubmitFinalVideo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgSubmitSolution) ProtoMessage() {}
+func (*MsgSubmitFinalVideo) ProtoMessage() {}
 
-// Deprecated: Use MsgSubmitSolution.ProtoReflect.Descriptor instead.
-func (*MsgSubmitSolution) Descriptor() ([]byte, []int) {
-	return file_janction_videoUpscaler_v1_tx_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use MsgSubmitFinalVideo.ProtoReflect.Descriptor instead.
+func (*MsgSubmitFinalVideo) Descriptor() ([]byte, []int) {
+	return file_janction_videoUpscaler_v1_tx_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *MsgSubmitSolution) GetCreator() string {
+func (x *MsgSubmitFinalVideo) GetCreator() string {
 	if x != nil {
 		return x.Creator
 	}
 	return ""
 }
 
-func (x *MsgSubmitSolution) GetTaskId() string {
+func (x *MsgSubmitFinalVideo) GetTaskId() string {
 	if x != nil {
 		return x.TaskId
 	}
 	return ""
 }
 
-func (x *MsgSubmitSolution) GetThreadId() string {
+func (x *MsgSubmitFinalVideo) GetCid() string {
 	if x != nil {
-		return x.ThreadId
+		return x.Cid
 	}
 	return ""
 }
 
-func (x *MsgSubmitSolution) GetDir() string {
+func (x *MsgSubmitFinalVideo) GetHash() string {
 	if x != nil {
-		return x.Dir
+		return x.Hash
 	}
 	return ""
 }
 
-func (x *MsgSubmitSolution) GetAverageRenderSeconds() int64 {
-	if x != nil {
-		return x.AverageRenderSeconds
-	}
-	return 0
-}
-
-type MsgSubmitSolutionResponse struct {
+type MsgSubmitFinalVideoResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *MsgSubmitSolutionResponse) Reset() {
-	*x = MsgSubmitSolutionResponse{}
+func (x *MsgSubmitFinalVideoResponse) Reset() {
+	*x = MsgSubmitFinalVideoResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[13]
+		mi := &file_janction_videoUpscaler_v1_tx_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgSubmitSolutionResponse) String() string {
+func (x *MsgSubmitFinalVideoResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgSubmitSolutionResponse) ProtoMessage() {}
+func (*MsgSubmitFinalVideoResponse) ProtoMessage() {}
 
-// Deprecated: Use MsgSubmitSolutionResponse.ProtoReflect.Descriptor instead.
-func (*MsgSubmitSolutionResponse) Descriptor() ([]byte, []int) {
-	return file_janction_videoUpscaler_v1_tx_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use MsgSubmitFinalVideoResponse.ProtoReflect.Descriptor instead.
+func (*MsgSubmitFinalVideoResponse) Descriptor() ([]byte, []int) {
+	return file_janction_videoUpscaler_v1_tx_proto_rawDescGZIP(), []int{29}
 }
 
 var File_janction_videoUpscaler_v1_tx_proto protoreflect.FileDescriptor
@@ -8198,8 +17282,8 @@ var file_janction_videoUpscaler_v1_tx_proto_rawDesc = []byte{
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x1a, 0x1c, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x74, 0x2f, 0x63, 0x72, 0x79,
-	0x70, 0x74, 0x6f, 0x2f, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf5,
-	0x01, 0x0a, 0x1a, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x69, 0x64, 0x65,
+	0x70, 0x74, 0x6f, 0x2f, 0x6b, 0x65, 0x79, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xeb,
+	0x05, 0x0a, 0x1a, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x69, 0x64, 0x65,
 	0x6f, 0x55, 0x70, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x18, 0x0a,
 	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
 	0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x02,
@@ -8213,160 +17297,351 @@ var file_janction_videoUpscaler_v1_tx_proto_rawDesc = []byte{
 	0x73, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18,
 	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
 	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
-	0x52, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x3d, 0x0a, 0x22, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x55, 0x70, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72,
-	0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07,
-	0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74,
-	0x61, 0x73, 0x6b, 0x49, 0x64, 0x22, 0xa3, 0x01, 0x0a, 0x0c, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64,
-	0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
-	0x12, 0x1b, 0x0a, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x69, 0x70, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x70, 0x12, 0x17, 0x0a,
-	0x07, 0x69, 0x70, 0x66, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x69, 0x70, 0x66, 0x73, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
-	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
-	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x3a, 0x0c, 0x82,
-	0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x40, 0x0a, 0x14, 0x4d,
-	0x73, 0x67, 0x41, 0x64, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x02, 0x6f, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x76, 0x0a,
-	0x18, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x57, 0x6f, 0x72,
-	0x6b, 0x65, 0x72, 0x54, 0x6f, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74,
-	0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74,
-	0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x3e, 0x0a, 0x20, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x62, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x54, 0x6f, 0x54, 0x61, 0x73,
-	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x68, 0x72,
-	0x65, 0x61, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x68, 0x72,
-	0x65, 0x61, 0x64, 0x49, 0x64, 0x22, 0xaf, 0x01, 0x0a, 0x12, 0x4d, 0x73, 0x67, 0x50, 0x72, 0x6f,
-	0x70, 0x6f, 0x73, 0x65, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07,
+	0x52, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x32, 0x0a, 0x15, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54,
+	0x68, 0x72, 0x65, 0x61, 0x64, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x4f, 0x0a, 0x0c,
+	0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69,
+	0x64, 0x65, 0x6f, 0x55, 0x70, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x0c, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x3d, 0x0a,
+	0x06, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e,
+	0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x55, 0x70,
+	0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x41, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x52, 0x06, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x23, 0x0a, 0x0d,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x3c, 0x0a, 0x0c, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x66, 0x65,
+	0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f,
+	0x69, 0x6e, 0x52, 0x0b, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x46, 0x65, 0x65, 0x12,
+	0x43, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x29, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69, 0x64, 0x65,
+	0x6f, 0x55, 0x70, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x73,
+	0x63, 0x61, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x43, 0x0a, 0x0a, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x69, 0x6e,
+	0x66, 0x6f, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x55, 0x70, 0x73, 0x63, 0x61, 0x6c, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09,
+	0x6d, 0x65, 0x64, 0x69, 0x61, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x43, 0x0a, 0x0a, 0x69, 0x6e, 0x70,
+	0x75, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e,
+	0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x55, 0x70,
+	0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x09, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x54, 0x79, 0x70, 0x65, 0x3a, 0x0c,
+	0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x3d, 0x0a, 0x22,
+	0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x55, 0x70,
+	0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x22, 0xbe, 0x02, 0x0a, 0x0c,
+	0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07,
 	0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64,
+	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x5f, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x49, 0x70, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x70, 0x66, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x70, 0x66, 0x73, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x6b, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x61,
+	0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x54, 0x68, 0x72,
+	0x65, 0x61, 0x64, 0x73, 0x12, 0x51, 0x0a, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6a, 0x61, 0x6e,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x55, 0x70, 0x73, 0x63, 0x61,
+	0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x43, 0x61, 0x70,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x6e, 0x6e, 0x6f, 0x75,
+	0x6e, 0x63, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0d, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72, 0x73, 0x3a, 0x0c,
+	0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x40, 0x0a, 0x14,
+	0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x02, 0x6f, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x69,
+	0x0a, 0x11, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x3a, 0x0c, 0x82, 0xe7, 0xb0,
+	0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x1b, 0x0a, 0x19, 0x4d, 0x73, 0x67,
+	0x53, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x96, 0x01, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x6f, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x69,
+	0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49,
+	0x70, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x70, 0x66, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x69, 0x70, 0x66, 0x73, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x6e,
+	0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0d, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72,
+	0x73, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22,
+	0x19, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x98, 0x01, 0x0a, 0x1b, 0x4d,
+	0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x43, 0x61,
+	0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x6f, 0x72, 0x12, 0x51, 0x0a, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6a, 0x61, 0x6e,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x55, 0x70, 0x73, 0x63, 0x61,
+	0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x43, 0x61, 0x70,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x25, 0x0a, 0x23, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3c, 0x0a, 0x12,
+	0x4d, 0x73, 0x67, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65,
+	0x61, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x3a, 0x0c, 0x82, 0xe7,
+	0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x1c, 0x0a, 0x1a, 0x4d, 0x73,
+	0x67, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9a, 0x01, 0x0a, 0x0f, 0x4d, 0x73, 0x67,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x36, 0x0a, 0x09,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x12, 0x3f, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x76, 0x69, 0x64, 0x65, 0x6f, 0x55, 0x70, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x3a, 0x0e, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0x19, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x76, 0x0a, 0x18, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x54, 0x6f, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64,
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x1a,
 	0x0a, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75,
-	0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x69, 0x67,
-	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x73,
-	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x1c, 0x0a, 0x1a, 0x4d, 0x73, 0x67, 0x50, 0x72,
-	0x6f, 0x70, 0x6f, 0x73, 0x65, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x87, 0x01, 0x0a, 0x11, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x76,
-	0x65, 0x61, 0x6c, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x1a, 0x0a,
-	0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x72, 0x61,
-	0x6d, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x66, 0x72, 0x61, 0x6d, 0x65,
-	0x73, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22,
-	0x1b, 0x0a, 0x19, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x53, 0x6f, 0x6c, 0x75,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb0, 0x01, 0x0a,
-	0x13, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16,
-	0x0a, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64,
-	0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64,
-	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65,
-	0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18,
-	0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x73, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22,
-	0x1d, 0x0a, 0x1b, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb7,
-	0x01, 0x0a, 0x11, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x53, 0x6f, 0x6c, 0x75,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16,
-	0x0a, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64,
-	0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64,
-	0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x69, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x64, 0x69, 0x72, 0x12, 0x34, 0x0a, 0x16, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f,
-	0x72, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x6e,
-	0x64, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a,
-	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x1b, 0x0a, 0x19, 0x4d, 0x73, 0x67, 0x53,
-	0x75, 0x62, 0x6d, 0x69, 0x74, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xf2, 0x06, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x8f, 0x01,
-	0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x55, 0x70, 0x73,
-	0x63, 0x61, 0x6c, 0x65, 0x72, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x35, 0x2e, 0x6a, 0x61, 0x6e, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x55, 0x70, 0x73, 0x63, 0x61, 0x6c,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56,
-	0x69, 0x64, 0x65, 0x6f, 0x55, 0x70, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x54, 0x61, 0x73, 0x6b,
-	0x1a, 0x3d, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69, 0x64, 0x65,
-	0x6f, 0x55, 0x70, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x55, 0x70, 0x73, 0x63, 0x61,
-	0x6c, 0x65, 0x72, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x65, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x27, 0x2e, 0x6a,
-	0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x55, 0x70, 0x73,
-	0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x57,
-	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x1a, 0x2f, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x55, 0x70, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x89, 0x01, 0x0a, 0x15, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x54, 0x6f, 0x54, 0x61, 0x73, 0x6b,
-	0x12, 0x33, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69, 0x64, 0x65,
+	0x52, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x3e, 0x0a, 0x20, 0x4d, 0x73, 0x67, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x54, 0x6f,
+	0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x22, 0xd2, 0x01, 0x0a, 0x12, 0x4d, 0x73, 0x67,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x73,
+	0x6b, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x1e, 0x0a, 0x0a,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x3a,
+	0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x1c, 0x0a,
+	0x1a, 0x4d, 0x73, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x53, 0x6f, 0x6c, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x87, 0x01, 0x0a, 0x11,
+	0x4d, 0x73, 0x67, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x74,
+	0x61, 0x73, 0x6b, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73,
+	0x6b, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x06, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x73, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x1b, 0x0a, 0x19, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x76, 0x65,
+	0x61, 0x6c, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0xb6, 0x01, 0x0a, 0x13, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x6f, 0x72, 0x4a, 0x04, 0x08, 0x06, 0x10, 0x07, 0x22, 0x1d, 0x0a, 0x1b, 0x4d,
+	0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x95, 0x01, 0x0a, 0x13, 0x4d,
+	0x73, 0x67, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06,
+	0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61,
+	0x73, 0x6b, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x64,
+	0x12, 0x22, 0x0a, 0x0c, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72,
+	0x69, 0x6e, 0x74, 0x73, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x6f, 0x72, 0x22, 0x1d, 0x0a, 0x1b, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0xb7, 0x01, 0x0a, 0x11, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x53,
+	0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
+	0x72, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x68, 0x72,
+	0x65, 0x61, 0x64, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x68, 0x72,
+	0x65, 0x61, 0x64, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x69, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x64, 0x69, 0x72, 0x12, 0x34, 0x0a, 0x16, 0x61, 0x76, 0x65, 0x72, 0x61,
+	0x67, 0x65, 0x5f, 0x72, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x3a, 0x0c, 0x82,
+	0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x1b, 0x0a, 0x19, 0x4d,
+	0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xd6, 0x01, 0x0a, 0x16, 0x4d, 0x73, 0x67,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x46, 0x61, 0x69, 0x6c,
+	0x75, 0x72, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a,
+	0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74,
+	0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49,
+	0x64, 0x12, 0x46, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x2e, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69, 0x64,
+	0x65, 0x6f, 0x55, 0x70, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x68,
+	0x72, 0x65, 0x61, 0x64, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
+	0x72, 0x22, 0x20, 0x0a, 0x1e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x68,
+	0x72, 0x65, 0x61, 0x64, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x7b, 0x0a, 0x13, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74,
+	0x46, 0x69, 0x6e, 0x61, 0x6c, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03,
+	0x63, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61,
+	0x73, 0x68, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
+	0x22, 0x1d, 0x0a, 0x1b, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x46, 0x69, 0x6e,
+	0x61, 0x6c, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32,
+	0xd4, 0x0e, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x8f, 0x01, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x55, 0x70, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x54,
+	0x61, 0x73, 0x6b, 0x12, 0x35, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x69, 0x64, 0x65, 0x6f, 0x55, 0x70, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x55, 0x70,
+	0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x3d, 0x2e, 0x6a, 0x61, 0x6e,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x55, 0x70, 0x73, 0x63, 0x61,
+	0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x56, 0x69, 0x64, 0x65, 0x6f, 0x55, 0x70, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x54, 0x61, 0x73,
+	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x09, 0x41, 0x64, 0x64,
+	0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x27, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x55, 0x70, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x1a,
+	0x2f, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f,
+	0x55, 0x70, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x41,
+	0x64, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x74, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x12, 0x2c, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69,
+	0x64, 0x65, 0x6f, 0x55, 0x70, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x73, 0x67, 0x53, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x1a, 0x34, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69, 0x64, 0x65,
 	0x6f, 0x55, 0x70, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67,
-	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x54,
-	0x6f, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x3b, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x55, 0x70, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x57, 0x6f,
-	0x72, 0x6b, 0x65, 0x72, 0x54, 0x6f, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x77, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x53, 0x6f, 0x6c,
-	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x2a, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x55, 0x70, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b,
+	0x65, 0x72, 0x1a, 0x32, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69,
+	0x64, 0x65, 0x6f, 0x55, 0x70, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x92, 0x01, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x12, 0x36, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x69, 0x64, 0x65, 0x6f, 0x55, 0x70, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x43,
+	0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x1a, 0x3e, 0x2e, 0x6a, 0x61,
+	0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x55, 0x70, 0x73, 0x63,
+	0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x77, 0x0a, 0x0f, 0x57,
+	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x12, 0x2d,
+	0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x55,
+	0x70, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x57, 0x6f,
+	0x72, 0x6b, 0x65, 0x72, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x1a, 0x35, 0x2e,
+	0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x55, 0x70,
+	0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x57, 0x6f, 0x72,
+	0x6b, 0x65, 0x72, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x89, 0x01, 0x0a, 0x15, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x54, 0x6f, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x33,
+	0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x55,
+	0x70, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x54, 0x6f, 0x54,
+	0x61, 0x73, 0x6b, 0x1a, 0x3b, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x69, 0x64, 0x65, 0x6f, 0x55, 0x70, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x57, 0x6f, 0x72, 0x6b,
+	0x65, 0x72, 0x54, 0x6f, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x77, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x53, 0x6f, 0x6c, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x69, 0x64, 0x65, 0x6f, 0x55, 0x70, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x73, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x1a, 0x35, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69,
+	0x64, 0x65, 0x6f, 0x55, 0x70, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
+	0x73, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7a, 0x0a, 0x10, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x2e,
+	0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x55, 0x70,
+	0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x36, 0x2e,
+	0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x55, 0x70,
+	0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x74, 0x0a, 0x0e, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x53,
+	0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x55, 0x70, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x53, 0x6f, 0x6c,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x34, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e,
 	0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x55, 0x70, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x53, 0x6f, 0x6c, 0x75,
-	0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x35, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
-	0x76, 0x69, 0x64, 0x65, 0x6f, 0x55, 0x70, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x4d, 0x73, 0x67, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x53, 0x6f, 0x6c, 0x75, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7a, 0x0a, 0x10, 0x53,
-	0x75, 0x62, 0x6d, 0x69, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x53, 0x6f, 0x6c, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7a, 0x0a, 0x10, 0x52,
+	0x65, 0x76, 0x65, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
 	0x2e, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f,
-	0x55, 0x70, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53,
-	0x75, 0x62, 0x6d, 0x69, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a,
+	0x55, 0x70, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52,
+	0x65, 0x76, 0x65, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a,
 	0x36, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f,
-	0x55, 0x70, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53,
-	0x75, 0x62, 0x6d, 0x69, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x74, 0x0a, 0x0e, 0x52, 0x65, 0x76, 0x65, 0x61,
-	0x6c, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x2e, 0x6a, 0x61, 0x6e, 0x63,
+	0x55, 0x70, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52,
+	0x65, 0x76, 0x65, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x74, 0x0a, 0x0e, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x74, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x2e, 0x6a, 0x61, 0x6e, 0x63,
 	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x55, 0x70, 0x73, 0x63, 0x61, 0x6c,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x53,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x53,
 	0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x34, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69,
 	0x6f, 0x6e, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x55, 0x70, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72,
-	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x76, 0x65, 0x61, 0x6c, 0x53, 0x6f, 0x6c,
-	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x74, 0x0a,
-	0x0e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x2c, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f,
-	0x55, 0x70, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53,
-	0x75, 0x62, 0x6d, 0x69, 0x74, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x34, 0x2e,
-	0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x55, 0x70,
-	0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62,
-	0x6d, 0x69, 0x74, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x1a, 0x05, 0x80, 0xe7, 0xb0, 0x2a, 0x01, 0x42, 0xff, 0x01, 0x0a, 0x1d, 0x63,
-	0x6f, 0x6d, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69, 0x64, 0x65,
-	0x6f, 0x55, 0x70, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x42, 0x07, 0x54, 0x78,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x69, 0x64,
-	0x65, 0x6f, 0x55, 0x70, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6a,
-	0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x55, 0x70, 0x73,
-	0x63, 0x61, 0x6c, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x55, 0x70,
-	0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4a, 0x56, 0x58, 0xaa, 0x02,
-	0x19, 0x4a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x55,
-	0x70, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x19, 0x4a, 0x61, 0x6e,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x55, 0x70, 0x73, 0x63, 0x61,
-	0x6c, 0x65, 0x72, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x25, 0x4a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x53, 0x6f, 0x6c,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x83, 0x01,
+	0x0a, 0x13, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x46, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x31, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x55, 0x70, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61,
+	0x64, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x1a, 0x39, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x55, 0x70, 0x73, 0x63, 0x61, 0x6c, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x68,
+	0x72, 0x65, 0x61, 0x64, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x7a, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x46, 0x69, 0x6e,
+	0x61, 0x6c, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x12, 0x2e, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x55, 0x70, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x46, 0x69, 0x6e,
+	0x61, 0x6c, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x1a, 0x36, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x55, 0x70, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x46, 0x69, 0x6e,
+	0x61, 0x6c, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x6e, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
+	0x2a, 0x2e, 0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f,
+	0x55, 0x70, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x32, 0x2e, 0x6a, 0x61,
+	0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x55, 0x70, 0x73, 0x63,
+	0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a,
+	0x05, 0x80, 0xe7, 0xb0, 0x2a, 0x01, 0x42, 0xff, 0x01, 0x0a, 0x1d, 0x63, 0x6f, 0x6d, 0x2e, 0x6a,
+	0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x55, 0x70, 0x73,
+	0x63, 0x61, 0x6c, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x50, 0x01, 0x5a, 0x4f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x6a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x55, 0x70,
+	0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6a, 0x61, 0x6e, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x55, 0x70, 0x73, 0x63, 0x61, 0x6c, 0x65,
+	0x72, 0x2f, 0x76, 0x31, 0x3b, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x55, 0x70, 0x73, 0x63, 0x61, 0x6c,
+	0x65, 0x72, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4a, 0x56, 0x58, 0xaa, 0x02, 0x19, 0x4a, 0x61, 0x6e,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x55, 0x70, 0x73, 0x63, 0x61,
+	0x6c, 0x65, 0x72, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x19, 0x4a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f,
 	0x6e, 0x5c, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x55, 0x70, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x5c,
-	0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
-	0x1b, 0x4a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x3a, 0x56, 0x69, 0x64, 0x65, 0x6f,
-	0x55, 0x70, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x56, 0x31, 0xe2, 0x02, 0x25, 0x4a, 0x61, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5c, 0x56, 0x69,
+	0x64, 0x65, 0x6f, 0x55, 0x70, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x72, 0x5c, 0x56, 0x31, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x1b, 0x4a, 0x61, 0x6e,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x3a, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x55, 0x70, 0x73, 0x63,
+	0x61, 0x6c, 0x65, 0x72, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -8381,46 +17656,96 @@ func file_janction_videoUpscaler_v1_tx_proto_rawDescGZIP() []byte {
 	return file_janction_videoUpscaler_v1_tx_proto_rawDescData
 }
 
-var file_janction_videoUpscaler_v1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_janction_videoUpscaler_v1_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
 var file_janction_videoUpscaler_v1_tx_proto_goTypes = []interface{}{
-	(*MsgCreateVideoUpscalerTask)(nil),         // 0: janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask
-	(*MsgCreateVideoUpscalerTaskResponse)(nil), // 1: janction.videoUpscaler.v1.MsgCreateVideoUpscalerTaskResponse
-	(*MsgAddWorker)(nil),                       // 2: janction.videoUpscaler.v1.MsgAddWorker
-	(*MsgAddWorkerResponse)(nil),               // 3: janction.videoUpscaler.v1.MsgAddWorkerResponse
-	(*MsgSubscribeWorkerToTask)(nil),           // 4: janction.videoUpscaler.v1.MsgSubscribeWorkerToTask
-	(*MsgSubscribeWorkerToTaskResponse)(nil),   // 5: janction.videoUpscaler.v1.MsgSubscribeWorkerToTaskResponse
-	(*MsgProposeSolution)(nil),                 // 6: janction.videoUpscaler.v1.MsgProposeSolution
-	(*MsgProposeSolutionResponse)(nil),         // 7: janction.videoUpscaler.v1.MsgProposeSolutionResponse
-	(*MsgRevealSolution)(nil),                  // 8: janction.videoUpscaler.v1.MsgRevealSolution
-	(*MsgRevealSolutionResponse)(nil),          // 9: janction.videoUpscaler.v1.MsgRevealSolutionResponse
-	(*MsgSubmitValidation)(nil),                // 10: janction.videoUpscaler.v1.MsgSubmitValidation
-	(*MsgSubmitValidationResponse)(nil),        // 11: janction.videoUpscaler.v1.MsgSubmitValidationResponse
-	(*MsgSubmitSolution)(nil),                  // 12: janction.videoUpscaler.v1.MsgSubmitSolution
-	(*MsgSubmitSolutionResponse)(nil),          // 13: janction.videoUpscaler.v1.MsgSubmitSolutionResponse
-	(*v1beta1.Coin)(nil),                       // 14: cosmos.base.v1beta1.Coin
+	(*MsgCreateVideoUpscalerTask)(nil),          // 0: janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask
+	(*MsgCreateVideoUpscalerTaskResponse)(nil),  // 1: janction.videoUpscaler.v1.MsgCreateVideoUpscalerTaskResponse
+	(*MsgAddWorker)(nil),                        // 2: janction.videoUpscaler.v1.MsgAddWorker
+	(*MsgAddWorkerResponse)(nil),                // 3: janction.videoUpscaler.v1.MsgAddWorkerResponse
+	(*MsgSetWorkerGroup)(nil),                   // 4: janction.videoUpscaler.v1.MsgSetWorkerGroup
+	(*MsgSetWorkerGroupResponse)(nil),           // 5: janction.videoUpscaler.v1.MsgSetWorkerGroupResponse
+	(*MsgUpdateWorker)(nil),                     // 6: janction.videoUpscaler.v1.MsgUpdateWorker
+	(*MsgUpdateWorkerResponse)(nil),             // 7: janction.videoUpscaler.v1.MsgUpdateWorkerResponse
+	(*MsgUpdateWorkerCapabilities)(nil),         // 8: janction.videoUpscaler.v1.MsgUpdateWorkerCapabilities
+	(*MsgUpdateWorkerCapabilitiesResponse)(nil), // 9: janction.videoUpscaler.v1.MsgUpdateWorkerCapabilitiesResponse
+	(*MsgWorkerHeartbeat)(nil),                  // 10: janction.videoUpscaler.v1.MsgWorkerHeartbeat
+	(*MsgWorkerHeartbeatResponse)(nil),          // 11: janction.videoUpscaler.v1.MsgWorkerHeartbeatResponse
+	(*MsgUpdateParams)(nil),                     // 12: janction.videoUpscaler.v1.MsgUpdateParams
+	(*MsgUpdateParamsResponse)(nil),             // 13: janction.videoUpscaler.v1.MsgUpdateParamsResponse
+	(*MsgSubscribeWorkerToTask)(nil),            // 14: janction.videoUpscaler.v1.MsgSubscribeWorkerToTask
+	(*MsgSubscribeWorkerToTaskResponse)(nil),    // 15: janction.videoUpscaler.v1.MsgSubscribeWorkerToTaskResponse
+	(*MsgProposeSolution)(nil),                  // 16: janction.videoUpscaler.v1.MsgProposeSolution
+	(*MsgProposeSolutionResponse)(nil),          // 17: janction.videoUpscaler.v1.MsgProposeSolutionResponse
+	(*MsgRevealSolution)(nil),                   // 18: janction.videoUpscaler.v1.MsgRevealSolution
+	(*MsgRevealSolutionResponse)(nil),           // 19: janction.videoUpscaler.v1.MsgRevealSolutionResponse
+	(*MsgSubmitValidation)(nil),                 // 20: janction.videoUpscaler.v1.MsgSubmitValidation
+	(*MsgSubmitValidationResponse)(nil),         // 21: janction.videoUpscaler.v1.MsgSubmitValidationResponse
+	(*MsgRevealValidation)(nil),                 // 22: janction.videoUpscaler.v1.MsgRevealValidation
+	(*MsgRevealValidationResponse)(nil),         // 23: janction.videoUpscaler.v1.MsgRevealValidationResponse
+	(*MsgSubmitSolution)(nil),                   // 24: janction.videoUpscaler.v1.MsgSubmitSolution
+	(*MsgSubmitSolutionResponse)(nil),           // 25: janction.videoUpscaler.v1.MsgSubmitSolutionResponse
+	(*MsgReportThreadFailure)(nil),              // 26: janction.videoUpscaler.v1.MsgReportThreadFailure
+	(*MsgReportThreadFailureResponse)(nil),      // 27: janction.videoUpscaler.v1.MsgReportThreadFailureResponse
+	(*MsgSubmitFinalVideo)(nil),                 // 28: janction.videoUpscaler.v1.MsgSubmitFinalVideo
+	(*MsgSubmitFinalVideoResponse)(nil),         // 29: janction.videoUpscaler.v1.MsgSubmitFinalVideoResponse
+	(*v1beta1.Coin)(nil),                        // 30: cosmos.base.v1beta1.Coin
+	(*TaskRequirements)(nil),                    // 31: janction.videoUpscaler.v1.TaskRequirements
+	(*TaskAccess)(nil),                          // 32: janction.videoUpscaler.v1.TaskAccess
+	(*UpscaleOptions)(nil),                      // 33: janction.videoUpscaler.v1.UpscaleOptions
+	(*MediaInfo)(nil),                           // 34: janction.videoUpscaler.v1.MediaInfo
+	(InputType)(0),                              // 35: janction.videoUpscaler.v1.InputType
+	(*WorkerCapabilities)(nil),                  // 36: janction.videoUpscaler.v1.WorkerCapabilities
+	(*Params)(nil),                              // 37: janction.videoUpscaler.v1.Params
+	(ThreadFailureReason)(0),                    // 38: janction.videoUpscaler.v1.ThreadFailureReason
 }
 var file_janction_videoUpscaler_v1_tx_proto_depIdxs = []int32{
-	14, // 0: janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask.reward:type_name -> cosmos.base.v1beta1.Coin
-	14, // 1: janction.videoUpscaler.v1.MsgAddWorker.stake:type_name -> cosmos.base.v1beta1.Coin
-	0,  // 2: janction.videoUpscaler.v1.Msg.CreateVideoUpscalerTask:input_type -> janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask
-	2,  // 3: janction.videoUpscaler.v1.Msg.AddWorker:input_type -> janction.videoUpscaler.v1.MsgAddWorker
-	4,  // 4: janction.videoUpscaler.v1.Msg.SubscribeWorkerToTask:input_type -> janction.videoUpscaler.v1.MsgSubscribeWorkerToTask
-	6,  // 5: janction.videoUpscaler.v1.Msg.ProposeSolution:input_type -> janction.videoUpscaler.v1.MsgProposeSolution
-	10, // 6: janction.videoUpscaler.v1.Msg.SubmitValidation:input_type -> janction.videoUpscaler.v1.MsgSubmitValidation
-	8,  // 7: janction.videoUpscaler.v1.Msg.RevealSolution:input_type -> janction.videoUpscaler.v1.MsgRevealSolution
-	12, // 8: janction.videoUpscaler.v1.Msg.SubmitSolution:input_type -> janction.videoUpscaler.v1.MsgSubmitSolution
-	1,  // 9: janction.videoUpscaler.v1.Msg.CreateVideoUpscalerTask:output_type -> janction.videoUpscaler.v1.MsgCreateVideoUpscalerTaskResponse
-	3,  // 10: janction.videoUpscaler.v1.Msg.AddWorker:output_type -> janction.videoUpscaler.v1.MsgAddWorkerResponse
-	5,  // 11: janction.videoUpscaler.v1.Msg.SubscribeWorkerToTask:output_type -> janction.videoUpscaler.v1.MsgSubscribeWorkerToTaskResponse
-	7,  // 12: janction.videoUpscaler.v1.Msg.ProposeSolution:output_type -> janction.videoUpscaler.v1.MsgProposeSolutionResponse
-	11, // 13: janction.videoUpscaler.v1.Msg.SubmitValidation:output_type -> janction.videoUpscaler.v1.MsgSubmitValidationResponse
-	9,  // 14: janction.videoUpscaler.v1.Msg.RevealSolution:output_type -> janction.videoUpscaler.v1.MsgRevealSolutionResponse
-	13, // 15: janction.videoUpscaler.v1.Msg.SubmitSolution:output_type -> janction.videoUpscaler.v1.MsgSubmitSolutionResponse
-	9,  // [9:16] is the sub-list for method output_type
-	2,  // [2:9] is the sub-list for method input_type
-	2,  // [2:2] is the sub-list for extension type_name
-	2,  // [2:2] is the sub-list for extension extendee
-	0,  // [0:2] is the sub-list for field type_name
+	30, // 0: janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask.reward:type_name -> cosmos.base.v1beta1.Coin
+	31, // 1: janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask.requirements:type_name -> janction.videoUpscaler.v1.TaskRequirements
+	32, // 2: janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask.access:type_name -> janction.videoUpscaler.v1.TaskAccess
+	30, // 3: janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask.priority_fee:type_name -> cosmos.base.v1beta1.Coin
+	33, // 4: janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask.options:type_name -> janction.videoUpscaler.v1.UpscaleOptions
+	34, // 5: janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask.media_info:type_name -> janction.videoUpscaler.v1.MediaInfo
+	35, // 6: janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask.input_type:type_name -> janction.videoUpscaler.v1.InputType
+	30, // 7: janction.videoUpscaler.v1.MsgAddWorker.stake:type_name -> cosmos.base.v1beta1.Coin
+	36, // 8: janction.videoUpscaler.v1.MsgAddWorker.capabilities:type_name -> janction.videoUpscaler.v1.WorkerCapabilities
+	36, // 9: janction.videoUpscaler.v1.MsgUpdateWorkerCapabilities.capabilities:type_name -> janction.videoUpscaler.v1.WorkerCapabilities
+	37, // 10: janction.videoUpscaler.v1.MsgUpdateParams.params:type_name -> janction.videoUpscaler.v1.Params
+	38, // 11: janction.videoUpscaler.v1.MsgReportThreadFailure.reason:type_name -> janction.videoUpscaler.v1.ThreadFailureReason
+	0,  // 12: janction.videoUpscaler.v1.Msg.CreateVideoUpscalerTask:input_type -> janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask
+	2,  // 13: janction.videoUpscaler.v1.Msg.AddWorker:input_type -> janction.videoUpscaler.v1.MsgAddWorker
+	4,  // 14: janction.videoUpscaler.v1.Msg.SetWorkerGroup:input_type -> janction.videoUpscaler.v1.MsgSetWorkerGroup
+	6,  // 15: janction.videoUpscaler.v1.Msg.UpdateWorker:input_type -> janction.videoUpscaler.v1.MsgUpdateWorker
+	8,  // 16: janction.videoUpscaler.v1.Msg.UpdateWorkerCapabilities:input_type -> janction.videoUpscaler.v1.MsgUpdateWorkerCapabilities
+	10, // 17: janction.videoUpscaler.v1.Msg.WorkerHeartbeat:input_type -> janction.videoUpscaler.v1.MsgWorkerHeartbeat
+	14, // 18: janction.videoUpscaler.v1.Msg.SubscribeWorkerToTask:input_type -> janction.videoUpscaler.v1.MsgSubscribeWorkerToTask
+	16, // 19: janction.videoUpscaler.v1.Msg.ProposeSolution:input_type -> janction.videoUpscaler.v1.MsgProposeSolution
+	20, // 20: janction.videoUpscaler.v1.Msg.SubmitValidation:input_type -> janction.videoUpscaler.v1.MsgSubmitValidation
+	18, // 21: janction.videoUpscaler.v1.Msg.RevealSolution:input_type -> janction.videoUpscaler.v1.MsgRevealSolution
+	22, // 22: janction.videoUpscaler.v1.Msg.RevealValidation:input_type -> janction.videoUpscaler.v1.MsgRevealValidation
+	24, // 23: janction.videoUpscaler.v1.Msg.SubmitSolution:input_type -> janction.videoUpscaler.v1.MsgSubmitSolution
+	26, // 24: janction.videoUpscaler.v1.Msg.ReportThreadFailure:input_type -> janction.videoUpscaler.v1.MsgReportThreadFailure
+	28, // 25: janction.videoUpscaler.v1.Msg.SubmitFinalVideo:input_type -> janction.videoUpscaler.v1.MsgSubmitFinalVideo
+	12, // 26: janction.videoUpscaler.v1.Msg.UpdateParams:input_type -> janction.videoUpscaler.v1.MsgUpdateParams
+	1,  // 27: janction.videoUpscaler.v1.Msg.CreateVideoUpscalerTask:output_type -> janction.videoUpscaler.v1.MsgCreateVideoUpscalerTaskResponse
+	3,  // 28: janction.videoUpscaler.v1.Msg.AddWorker:output_type -> janction.videoUpscaler.v1.MsgAddWorkerResponse
+	5,  // 29: janction.videoUpscaler.v1.Msg.SetWorkerGroup:output_type -> janction.videoUpscaler.v1.MsgSetWorkerGroupResponse
+	7,  // 30: janction.videoUpscaler.v1.Msg.UpdateWorker:output_type -> janction.videoUpscaler.v1.MsgUpdateWorkerResponse
+	9,  // 31: janction.videoUpscaler.v1.Msg.UpdateWorkerCapabilities:output_type -> janction.videoUpscaler.v1.MsgUpdateWorkerCapabilitiesResponse
+	11, // 32: janction.videoUpscaler.v1.Msg.WorkerHeartbeat:output_type -> janction.videoUpscaler.v1.MsgWorkerHeartbeatResponse
+	15, // 33: janction.videoUpscaler.v1.Msg.SubscribeWorkerToTask:output_type -> janction.videoUpscaler.v1.MsgSubscribeWorkerToTaskResponse
+	17, // 34: janction.videoUpscaler.v1.Msg.ProposeSolution:output_type -> janction.videoUpscaler.v1.MsgProposeSolutionResponse
+	21, // 35: janction.videoUpscaler.v1.Msg.SubmitValidation:output_type -> janction.videoUpscaler.v1.MsgSubmitValidationResponse
+	19, // 36: janction.videoUpscaler.v1.Msg.RevealSolution:output_type -> janction.videoUpscaler.v1.MsgRevealSolutionResponse
+	23, // 37: janction.videoUpscaler.v1.Msg.RevealValidation:output_type -> janction.videoUpscaler.v1.MsgRevealValidationResponse
+	25, // 38: janction.videoUpscaler.v1.Msg.SubmitSolution:output_type -> janction.videoUpscaler.v1.MsgSubmitSolutionResponse
+	27, // 39: janction.videoUpscaler.v1.Msg.ReportThreadFailure:output_type -> janction.videoUpscaler.v1.MsgReportThreadFailureResponse
+	29, // 40: janction.videoUpscaler.v1.Msg.SubmitFinalVideo:output_type -> janction.videoUpscaler.v1.MsgSubmitFinalVideoResponse
+	13, // 41: janction.videoUpscaler.v1.Msg.UpdateParams:output_type -> janction.videoUpscaler.v1.MsgUpdateParamsResponse
+	27, // [27:42] is the sub-list for method output_type
+	12, // [12:27] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_janction_videoUpscaler_v1_tx_proto_init() }
@@ -8479,7 +17804,7 @@ func file_janction_videoUpscaler_v1_tx_proto_init() {
 			}
 		}
 		file_janction_videoUpscaler_v1_tx_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgSubscribeWorkerToTask); i {
+			switch v := v.(*MsgSetWorkerGroup); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8491,7 +17816,7 @@ func file_janction_videoUpscaler_v1_tx_proto_init() {
 			}
 		}
 		file_janction_videoUpscaler_v1_tx_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgSubscribeWorkerToTaskResponse); i {
+			switch v := v.(*MsgSetWorkerGroupResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8503,7 +17828,7 @@ func file_janction_videoUpscaler_v1_tx_proto_init() {
 			}
 		}
 		file_janction_videoUpscaler_v1_tx_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgProposeSolution); i {
+			switch v := v.(*MsgUpdateWorker); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8515,7 +17840,7 @@ func file_janction_videoUpscaler_v1_tx_proto_init() {
 			}
 		}
 		file_janction_videoUpscaler_v1_tx_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgProposeSolutionResponse); i {
+			switch v := v.(*MsgUpdateWorkerResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8527,7 +17852,7 @@ func file_janction_videoUpscaler_v1_tx_proto_init() {
 			}
 		}
 		file_janction_videoUpscaler_v1_tx_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgRevealSolution); i {
+			switch v := v.(*MsgUpdateWorkerCapabilities); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8539,7 +17864,7 @@ func file_janction_videoUpscaler_v1_tx_proto_init() {
 			}
 		}
 		file_janction_videoUpscaler_v1_tx_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgRevealSolutionResponse); i {
+			switch v := v.(*MsgUpdateWorkerCapabilitiesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8551,7 +17876,7 @@ func file_janction_videoUpscaler_v1_tx_proto_init() {
 			}
 		}
 		file_janction_videoUpscaler_v1_tx_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgSubmitValidation); i {
+			switch v := v.(*MsgWorkerHeartbeat); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8563,7 +17888,7 @@ func file_janction_videoUpscaler_v1_tx_proto_init() {
 			}
 		}
 		file_janction_videoUpscaler_v1_tx_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgSubmitValidationResponse); i {
+			switch v := v.(*MsgWorkerHeartbeatResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8575,7 +17900,7 @@ func file_janction_videoUpscaler_v1_tx_proto_init() {
 			}
 		}
 		file_janction_videoUpscaler_v1_tx_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgSubmitSolution); i {
+			switch v := v.(*MsgUpdateParams); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8587,6 +17912,150 @@ func file_janction_videoUpscaler_v1_tx_proto_init() {
 			}
 		}
 		file_janction_videoUpscaler_v1_tx_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUpdateParamsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_janction_videoUpscaler_v1_tx_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSubscribeWorkerToTask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_janction_videoUpscaler_v1_tx_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSubscribeWorkerToTaskResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_janction_videoUpscaler_v1_tx_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgProposeSolution); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_janction_videoUpscaler_v1_tx_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgProposeSolutionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_janction_videoUpscaler_v1_tx_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRevealSolution); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_janction_videoUpscaler_v1_tx_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRevealSolutionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_janction_videoUpscaler_v1_tx_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSubmitValidation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_janction_videoUpscaler_v1_tx_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSubmitValidationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_janction_videoUpscaler_v1_tx_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRevealValidation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_janction_videoUpscaler_v1_tx_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRevealValidationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_janction_videoUpscaler_v1_tx_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSubmitSolution); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_janction_videoUpscaler_v1_tx_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MsgSubmitSolutionResponse); i {
 			case 0:
 				return &v.state
@@ -8598,6 +18067,54 @@ func file_janction_videoUpscaler_v1_tx_proto_init() {
 				return nil
 			}
 		}
+		file_janction_videoUpscaler_v1_tx_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgReportThreadFailure); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_janction_videoUpscaler_v1_tx_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgReportThreadFailureResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_janction_videoUpscaler_v1_tx_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSubmitFinalVideo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_janction_videoUpscaler_v1_tx_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSubmitFinalVideoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -8605,7 +18122,7 @@ func file_janction_videoUpscaler_v1_tx_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_janction_videoUpscaler_v1_tx_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   14,
+			NumMessages:   30,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
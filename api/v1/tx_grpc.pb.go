@@ -19,13 +19,21 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_CreateVideoUpscalerTask_FullMethodName = "/janction.videoUpscaler.v1.Msg/CreateVideoUpscalerTask"
-	Msg_AddWorker_FullMethodName               = "/janction.videoUpscaler.v1.Msg/AddWorker"
-	Msg_SubscribeWorkerToTask_FullMethodName   = "/janction.videoUpscaler.v1.Msg/SubscribeWorkerToTask"
-	Msg_ProposeSolution_FullMethodName         = "/janction.videoUpscaler.v1.Msg/ProposeSolution"
-	Msg_SubmitValidation_FullMethodName        = "/janction.videoUpscaler.v1.Msg/SubmitValidation"
-	Msg_RevealSolution_FullMethodName          = "/janction.videoUpscaler.v1.Msg/RevealSolution"
-	Msg_SubmitSolution_FullMethodName          = "/janction.videoUpscaler.v1.Msg/SubmitSolution"
+	Msg_CreateVideoUpscalerTask_FullMethodName  = "/janction.videoUpscaler.v1.Msg/CreateVideoUpscalerTask"
+	Msg_AddWorker_FullMethodName                = "/janction.videoUpscaler.v1.Msg/AddWorker"
+	Msg_SetWorkerGroup_FullMethodName           = "/janction.videoUpscaler.v1.Msg/SetWorkerGroup"
+	Msg_UpdateWorker_FullMethodName             = "/janction.videoUpscaler.v1.Msg/UpdateWorker"
+	Msg_UpdateWorkerCapabilities_FullMethodName = "/janction.videoUpscaler.v1.Msg/UpdateWorkerCapabilities"
+	Msg_WorkerHeartbeat_FullMethodName          = "/janction.videoUpscaler.v1.Msg/WorkerHeartbeat"
+	Msg_SubscribeWorkerToTask_FullMethodName    = "/janction.videoUpscaler.v1.Msg/SubscribeWorkerToTask"
+	Msg_ProposeSolution_FullMethodName          = "/janction.videoUpscaler.v1.Msg/ProposeSolution"
+	Msg_SubmitValidation_FullMethodName         = "/janction.videoUpscaler.v1.Msg/SubmitValidation"
+	Msg_RevealSolution_FullMethodName           = "/janction.videoUpscaler.v1.Msg/RevealSolution"
+	Msg_RevealValidation_FullMethodName         = "/janction.videoUpscaler.v1.Msg/RevealValidation"
+	Msg_SubmitSolution_FullMethodName           = "/janction.videoUpscaler.v1.Msg/SubmitSolution"
+	Msg_ReportThreadFailure_FullMethodName      = "/janction.videoUpscaler.v1.Msg/ReportThreadFailure"
+	Msg_SubmitFinalVideo_FullMethodName         = "/janction.videoUpscaler.v1.Msg/SubmitFinalVideo"
+	Msg_UpdateParams_FullMethodName             = "/janction.videoUpscaler.v1.Msg/UpdateParams"
 )
 
 // MsgClient is the client API for Msg service.
@@ -36,6 +44,14 @@ type MsgClient interface {
 	CreateVideoUpscalerTask(ctx context.Context, in *MsgCreateVideoUpscalerTask, opts ...grpc.CallOption) (*MsgCreateVideoUpscalerTaskResponse, error)
 	// Adds a new worker
 	AddWorker(ctx context.Context, in *MsgAddWorker, opts ...grpc.CallOption) (*MsgAddWorkerResponse, error)
+	// Creates a worker group, or replaces its members if the sender owns it
+	SetWorkerGroup(ctx context.Context, in *MsgSetWorkerGroup, opts ...grpc.CallOption) (*MsgSetWorkerGroupResponse, error)
+	// Updates the network details of a registered worker
+	UpdateWorker(ctx context.Context, in *MsgUpdateWorker, opts ...grpc.CallOption) (*MsgUpdateWorkerResponse, error)
+	// Updates the capabilities of a registered worker
+	UpdateWorkerCapabilities(ctx context.Context, in *MsgUpdateWorkerCapabilities, opts ...grpc.CallOption) (*MsgUpdateWorkerCapabilitiesResponse, error)
+	// Signals the worker is alive. Re-enables workers disabled for missing heartbeats
+	WorkerHeartbeat(ctx context.Context, in *MsgWorkerHeartbeat, opts ...grpc.CallOption) (*MsgWorkerHeartbeatResponse, error)
 	SubscribeWorkerToTask(ctx context.Context, in *MsgSubscribeWorkerToTask, opts ...grpc.CallOption) (*MsgSubscribeWorkerToTaskResponse, error)
 	// Propose a solution for the test of the nodes to validate
 	ProposeSolution(ctx context.Context, in *MsgProposeSolution, opts ...grpc.CallOption) (*MsgProposeSolutionResponse, error)
@@ -43,8 +59,16 @@ type MsgClient interface {
 	SubmitValidation(ctx context.Context, in *MsgSubmitValidation, opts ...grpc.CallOption) (*MsgSubmitValidationResponse, error)
 	// Propose a solution for the test of the nodes to validate
 	RevealSolution(ctx context.Context, in *MsgRevealSolution, opts ...grpc.CallOption) (*MsgRevealSolutionResponse, error)
+	// Reveals the fingerprints a validator signed, once the solution is revealed
+	RevealValidation(ctx context.Context, in *MsgRevealValidation, opts ...grpc.CallOption) (*MsgRevealValidationResponse, error)
 	// Submits the solution to IPFS
 	SubmitSolution(ctx context.Context, in *MsgSubmitSolution, opts ...grpc.CallOption) (*MsgSubmitSolutionResponse, error)
+	// Gives up a thread the worker couldn't render, so it can be assigned to another worker
+	ReportThreadFailure(ctx context.Context, in *MsgReportThreadFailure, opts ...grpc.CallOption) (*MsgReportThreadFailureResponse, error)
+	// Records the final video of a completed task, or confirms it when sent by the verifier
+	SubmitFinalVideo(ctx context.Context, in *MsgSubmitFinalVideo, opts ...grpc.CallOption) (*MsgSubmitFinalVideoResponse, error)
+	// Updates the module params. Only the authority can execute it
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -73,6 +97,42 @@ func (c *msgClient) AddWorker(ctx context.Context, in *MsgAddWorker, opts ...grp
 	return out, nil
 }
 
+func (c *msgClient) SetWorkerGroup(ctx context.Context, in *MsgSetWorkerGroup, opts ...grpc.CallOption) (*MsgSetWorkerGroupResponse, error) {
+	out := new(MsgSetWorkerGroupResponse)
+	err := c.cc.Invoke(ctx, Msg_SetWorkerGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateWorker(ctx context.Context, in *MsgUpdateWorker, opts ...grpc.CallOption) (*MsgUpdateWorkerResponse, error) {
+	out := new(MsgUpdateWorkerResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateWorker_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateWorkerCapabilities(ctx context.Context, in *MsgUpdateWorkerCapabilities, opts ...grpc.CallOption) (*MsgUpdateWorkerCapabilitiesResponse, error) {
+	out := new(MsgUpdateWorkerCapabilitiesResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateWorkerCapabilities_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WorkerHeartbeat(ctx context.Context, in *MsgWorkerHeartbeat, opts ...grpc.CallOption) (*MsgWorkerHeartbeatResponse, error) {
+	out := new(MsgWorkerHeartbeatResponse)
+	err := c.cc.Invoke(ctx, Msg_WorkerHeartbeat_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) SubscribeWorkerToTask(ctx context.Context, in *MsgSubscribeWorkerToTask, opts ...grpc.CallOption) (*MsgSubscribeWorkerToTaskResponse, error) {
 	out := new(MsgSubscribeWorkerToTaskResponse)
 	err := c.cc.Invoke(ctx, Msg_SubscribeWorkerToTask_FullMethodName, in, out, opts...)
@@ -109,6 +169,15 @@ func (c *msgClient) RevealSolution(ctx context.Context, in *MsgRevealSolution, o
 	return out, nil
 }
 
+func (c *msgClient) RevealValidation(ctx context.Context, in *MsgRevealValidation, opts ...grpc.CallOption) (*MsgRevealValidationResponse, error) {
+	out := new(MsgRevealValidationResponse)
+	err := c.cc.Invoke(ctx, Msg_RevealValidation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) SubmitSolution(ctx context.Context, in *MsgSubmitSolution, opts ...grpc.CallOption) (*MsgSubmitSolutionResponse, error) {
 	out := new(MsgSubmitSolutionResponse)
 	err := c.cc.Invoke(ctx, Msg_SubmitSolution_FullMethodName, in, out, opts...)
@@ -118,6 +187,33 @@ func (c *msgClient) SubmitSolution(ctx context.Context, in *MsgSubmitSolution, o
 	return out, nil
 }
 
+func (c *msgClient) ReportThreadFailure(ctx context.Context, in *MsgReportThreadFailure, opts ...grpc.CallOption) (*MsgReportThreadFailureResponse, error) {
+	out := new(MsgReportThreadFailureResponse)
+	err := c.cc.Invoke(ctx, Msg_ReportThreadFailure_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitFinalVideo(ctx context.Context, in *MsgSubmitFinalVideo, opts ...grpc.CallOption) (*MsgSubmitFinalVideoResponse, error) {
+	out := new(MsgSubmitFinalVideoResponse)
+	err := c.cc.Invoke(ctx, Msg_SubmitFinalVideo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -126,6 +222,14 @@ type MsgServer interface {
 	CreateVideoUpscalerTask(context.Context, *MsgCreateVideoUpscalerTask) (*MsgCreateVideoUpscalerTaskResponse, error)
 	// Adds a new worker
 	AddWorker(context.Context, *MsgAddWorker) (*MsgAddWorkerResponse, error)
+	// Creates a worker group, or replaces its members if the sender owns it
+	SetWorkerGroup(context.Context, *MsgSetWorkerGroup) (*MsgSetWorkerGroupResponse, error)
+	// Updates the network details of a registered worker
+	UpdateWorker(context.Context, *MsgUpdateWorker) (*MsgUpdateWorkerResponse, error)
+	// Updates the capabilities of a registered worker
+	UpdateWorkerCapabilities(context.Context, *MsgUpdateWorkerCapabilities) (*MsgUpdateWorkerCapabilitiesResponse, error)
+	// Signals the worker is alive. Re-enables workers disabled for missing heartbeats
+	WorkerHeartbeat(context.Context, *MsgWorkerHeartbeat) (*MsgWorkerHeartbeatResponse, error)
 	SubscribeWorkerToTask(context.Context, *MsgSubscribeWorkerToTask) (*MsgSubscribeWorkerToTaskResponse, error)
 	// Propose a solution for the test of the nodes to validate
 	ProposeSolution(context.Context, *MsgProposeSolution) (*MsgProposeSolutionResponse, error)
@@ -133,8 +237,16 @@ type MsgServer interface {
 	SubmitValidation(context.Context, *MsgSubmitValidation) (*MsgSubmitValidationResponse, error)
 	// Propose a solution for the test of the nodes to validate
 	RevealSolution(context.Context, *MsgRevealSolution) (*MsgRevealSolutionResponse, error)
+	// Reveals the fingerprints a validator signed, once the solution is revealed
+	RevealValidation(context.Context, *MsgRevealValidation) (*MsgRevealValidationResponse, error)
 	// Submits the solution to IPFS
 	SubmitSolution(context.Context, *MsgSubmitSolution) (*MsgSubmitSolutionResponse, error)
+	// Gives up a thread the worker couldn't render, so it can be assigned to another worker
+	ReportThreadFailure(context.Context, *MsgReportThreadFailure) (*MsgReportThreadFailureResponse, error)
+	// Records the final video of a completed task, or confirms it when sent by the verifier
+	SubmitFinalVideo(context.Context, *MsgSubmitFinalVideo) (*MsgSubmitFinalVideoResponse, error)
+	// Updates the module params. Only the authority can execute it
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -148,6 +260,18 @@ func (UnimplementedMsgServer) CreateVideoUpscalerTask(context.Context, *MsgCreat
 func (UnimplementedMsgServer) AddWorker(context.Context, *MsgAddWorker) (*MsgAddWorkerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddWorker not implemented")
 }
+func (UnimplementedMsgServer) SetWorkerGroup(context.Context, *MsgSetWorkerGroup) (*MsgSetWorkerGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetWorkerGroup not implemented")
+}
+func (UnimplementedMsgServer) UpdateWorker(context.Context, *MsgUpdateWorker) (*MsgUpdateWorkerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWorker not implemented")
+}
+func (UnimplementedMsgServer) UpdateWorkerCapabilities(context.Context, *MsgUpdateWorkerCapabilities) (*MsgUpdateWorkerCapabilitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWorkerCapabilities not implemented")
+}
+func (UnimplementedMsgServer) WorkerHeartbeat(context.Context, *MsgWorkerHeartbeat) (*MsgWorkerHeartbeatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WorkerHeartbeat not implemented")
+}
 func (UnimplementedMsgServer) SubscribeWorkerToTask(context.Context, *MsgSubscribeWorkerToTask) (*MsgSubscribeWorkerToTaskResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubscribeWorkerToTask not implemented")
 }
@@ -160,9 +284,21 @@ func (UnimplementedMsgServer) SubmitValidation(context.Context, *MsgSubmitValida
 func (UnimplementedMsgServer) RevealSolution(context.Context, *MsgRevealSolution) (*MsgRevealSolutionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RevealSolution not implemented")
 }
+func (UnimplementedMsgServer) RevealValidation(context.Context, *MsgRevealValidation) (*MsgRevealValidationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealValidation not implemented")
+}
 func (UnimplementedMsgServer) SubmitSolution(context.Context, *MsgSubmitSolution) (*MsgSubmitSolutionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitSolution not implemented")
 }
+func (UnimplementedMsgServer) ReportThreadFailure(context.Context, *MsgReportThreadFailure) (*MsgReportThreadFailureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportThreadFailure not implemented")
+}
+func (UnimplementedMsgServer) SubmitFinalVideo(context.Context, *MsgSubmitFinalVideo) (*MsgSubmitFinalVideoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitFinalVideo not implemented")
+}
+func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -212,6 +348,78 @@ func _Msg_AddWorker_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetWorkerGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetWorkerGroup)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetWorkerGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetWorkerGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetWorkerGroup(ctx, req.(*MsgSetWorkerGroup))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateWorker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateWorker)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateWorker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateWorker_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateWorker(ctx, req.(*MsgUpdateWorker))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateWorkerCapabilities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateWorkerCapabilities)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateWorkerCapabilities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateWorkerCapabilities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateWorkerCapabilities(ctx, req.(*MsgUpdateWorkerCapabilities))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WorkerHeartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWorkerHeartbeat)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WorkerHeartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_WorkerHeartbeat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WorkerHeartbeat(ctx, req.(*MsgWorkerHeartbeat))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_SubscribeWorkerToTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgSubscribeWorkerToTask)
 	if err := dec(in); err != nil {
@@ -284,6 +492,24 @@ func _Msg_RevealSolution_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RevealValidation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevealValidation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevealValidation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RevealValidation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevealValidation(ctx, req.(*MsgRevealValidation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_SubmitSolution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgSubmitSolution)
 	if err := dec(in); err != nil {
@@ -302,6 +528,60 @@ func _Msg_SubmitSolution_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ReportThreadFailure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReportThreadFailure)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReportThreadFailure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ReportThreadFailure_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReportThreadFailure(ctx, req.(*MsgReportThreadFailure))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitFinalVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitFinalVideo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitFinalVideo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SubmitFinalVideo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitFinalVideo(ctx, req.(*MsgSubmitFinalVideo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -317,6 +597,22 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddWorker",
 			Handler:    _Msg_AddWorker_Handler,
 		},
+		{
+			MethodName: "SetWorkerGroup",
+			Handler:    _Msg_SetWorkerGroup_Handler,
+		},
+		{
+			MethodName: "UpdateWorker",
+			Handler:    _Msg_UpdateWorker_Handler,
+		},
+		{
+			MethodName: "UpdateWorkerCapabilities",
+			Handler:    _Msg_UpdateWorkerCapabilities_Handler,
+		},
+		{
+			MethodName: "WorkerHeartbeat",
+			Handler:    _Msg_WorkerHeartbeat_Handler,
+		},
 		{
 			MethodName: "SubscribeWorkerToTask",
 			Handler:    _Msg_SubscribeWorkerToTask_Handler,
@@ -333,10 +629,26 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RevealSolution",
 			Handler:    _Msg_RevealSolution_Handler,
 		},
+		{
+			MethodName: "RevealValidation",
+			Handler:    _Msg_RevealValidation_Handler,
+		},
 		{
 			MethodName: "SubmitSolution",
 			Handler:    _Msg_SubmitSolution_Handler,
 		},
+		{
+			MethodName: "ReportThreadFailure",
+			Handler:    _Msg_ReportThreadFailure_Handler,
+		},
+		{
+			MethodName: "SubmitFinalVideo",
+			Handler:    _Msg_SubmitFinalVideo_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "janction/videoUpscaler/v1/tx.proto",
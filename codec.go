@@ -11,11 +11,19 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgCreateVideoUpscalerTask{},
 		&MsgAddWorker{},
+		&MsgUpdateWorker{},
+		&MsgSetWorkerGroup{},
+		&MsgUpdateWorkerCapabilities{},
+		&MsgWorkerHeartbeat{},
 		&MsgSubscribeWorkerToTask{},
 		&MsgProposeSolution{},
 		&MsgSubmitValidation{},
 		&MsgRevealSolution{},
+		&MsgRevealValidation{},
 		&MsgSubmitSolution{},
+		&MsgReportThreadFailure{},
+		&MsgSubmitFinalVideo{},
+		&MsgUpdateParams{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -32,6 +32,14 @@ type Thread struct {
 	SubmitionStarted    bool
 }
 
+// Assembly tracks the local work on the final video of a task. Each attempt after a rejection is tracked apart
+type Assembly struct {
+	TaskId              string
+	Attempt             int32
+	AssemblyStarted     bool
+	VerificationStarted bool
+}
+
 type Worker struct {
 	Address    string
 	Registered bool
@@ -109,6 +117,23 @@ func Init(databasePath string) (*DB, error) {
 		frame_number NUMBER,
 		render_duration NUMBER
 	);
+	CREATE TABLE IF NOT EXISTS rendered_frames (
+		thread_id TEXT,
+		frame_number NUMBER,
+		hash TEXT,
+		PRIMARY KEY (thread_id, frame_number)
+	);
+	CREATE TABLE IF NOT EXISTS assemblies (
+		task_id TEXT,
+		attempt NUMBER,
+		assembly_started BOOLEAN,
+		verification_started BOOLEAN,
+		PRIMARY KEY (task_id, attempt)
+	);
+	CREATE TABLE IF NOT EXISTS validation_reveals (
+		thread_id TEXT PRIMARY KEY,
+		reveal_started BOOLEAN
+	);
     `
 
 	if _, err := db.Exec(createTables); err != nil {
@@ -326,3 +351,98 @@ func (db *DB) GetAverageRenderTime(threadId string) (int, error) {
 
 	return avg, nil
 }
+
+// AddRenderedFrame checkpoints a rendered frame with the pixel hash of its output file
+func (db *DB) AddRenderedFrame(threadId string, frameNumber int64, hash string) error {
+	insertQuery := `INSERT OR REPLACE INTO rendered_frames (thread_id, frame_number, hash) VALUES (?,?,?)`
+	_, err := db.conn.Exec(insertQuery, threadId, frameNumber, hash)
+	if err != nil {
+		videoUpscalerLogger.Logger.Error("failed to insert rendered frame: %s", err.Error())
+		return fmt.Errorf("failed to insert rendered frame: %w", err)
+	}
+
+	return nil
+}
+
+// GetRenderedFrames returns the checkpointed frames of a thread with their pixel hash
+func (db *DB) GetRenderedFrames(threadId string) (map[int64]string, error) {
+	query := `SELECT frame_number, hash FROM rendered_frames WHERE thread_id = ?`
+	rows, err := db.conn.Query(query, threadId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read rendered frames: %w", err)
+	}
+	defer rows.Close()
+
+	frames := make(map[int64]string)
+	for rows.Next() {
+		var frameNumber int64
+		var hash string
+		if err := rows.Scan(&frameNumber, &hash); err != nil {
+			return nil, fmt.Errorf("failed to read rendered frame: %w", err)
+		}
+		frames[frameNumber] = hash
+	}
+
+	return frames, rows.Err()
+}
+
+// DeleteRenderedFrame removes the checkpoint of a frame that has to be rendered again
+func (db *DB) DeleteRenderedFrame(threadId string, frameNumber int64) error {
+	deleteQuery := `DELETE FROM rendered_frames WHERE thread_id = ? AND frame_number = ?`
+	_, err := db.conn.Exec(deleteQuery, threadId, frameNumber)
+	if err != nil {
+		return fmt.Errorf("failed to delete rendered frame: %w", err)
+	}
+	return nil
+}
+
+// ReadAssembly returns the local state of an attempt to assemble the final video of a task
+func (db *DB) ReadAssembly(taskId string, attempt int32) (*Assembly, error) {
+	query := `SELECT task_id, attempt, assembly_started, verification_started FROM assemblies WHERE task_id = ? AND attempt = ?`
+	row := db.conn.QueryRow(query, taskId, attempt)
+
+	var assembly Assembly
+	if err := row.Scan(&assembly.TaskId, &assembly.Attempt, &assembly.AssemblyStarted, &assembly.VerificationStarted); err != nil {
+		if err == sql.ErrNoRows {
+			return &Assembly{TaskId: taskId, Attempt: attempt}, nil
+		}
+		return nil, fmt.Errorf("failed to read assembly: %w", err)
+	}
+
+	return &assembly, nil
+}
+
+// UpdateAssembly stores the local state of an attempt to assemble the final video of a task
+func (db *DB) UpdateAssembly(taskId string, attempt int32, assemblyStarted, verificationStarted bool) error {
+	updateQuery := `INSERT OR REPLACE INTO assemblies (task_id, attempt, assembly_started, verification_started) VALUES (?,?,?,?)`
+	_, err := db.conn.Exec(updateQuery, taskId, attempt, assemblyStarted, verificationStarted)
+	if err != nil {
+		return fmt.Errorf("failed to update assembly: %w", err)
+	}
+	return nil
+}
+
+// IsValidationRevealStarted returns true if this node already started revealing its validation of the thread
+func (db *DB) IsValidationRevealStarted(threadId string) (bool, error) {
+	query := `SELECT reveal_started FROM validation_reveals WHERE thread_id = ?`
+	row := db.conn.QueryRow(query, threadId)
+
+	var started bool
+	if err := row.Scan(&started); err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to read validation reveal: %w", err)
+	}
+	return started, nil
+}
+
+// UpdateValidationReveal stores if this node started revealing its validation of the thread
+func (db *DB) UpdateValidationReveal(threadId string, started bool) error {
+	updateQuery := `INSERT OR REPLACE INTO validation_reveals (thread_id, reveal_started) VALUES (?,?)`
+	_, err := db.conn.Exec(updateQuery, threadId, started)
+	if err != nil {
+		return fmt.Errorf("failed to update validation reveal: %w", err)
+	}
+	return nil
+}
@@ -4,13 +4,17 @@ set -euo pipefail
 # Defaults
 SCALE=2              # scale ratio (maps to --scale-ratio)
 NOISE=-1             # -1 disables denoise; 0..3 maps to --noise-level
+MODEL="models_rgb"   # waifu2x model, a dir under /usr/local/share/waifu2x-converter-cpp
+FORMAT="png"         # batch mode: output format of the frames, png, webp (lossless) or tiff16 (16 bits per channel)
 FAST=0               # internal: if 1, we use more threads (non-deterministic)
 TIME=0               # -ss start time in seconds
 DURATION=""          # -t duration in seconds (empty = full)
 FRAME_STEP=1         # extract every Nth frame
 FRAME_ONLY=""        # if set, extract only that exact frame index (0-based)
+START_FRAME=""       # batch mode: first frame index of the range (0-based, inclusive)
+END_FRAME=""         # batch mode: last frame index of the range (0-based, inclusive)
 OUTPUT=""            # output file (video) or dir (when --frame is used)
-INPUT=""             # input video
+INPUT=""             # input video, or pattern of an image sequence like frame_%06d.exr (batch mode only)
 
 # Parse args
 while [[ $# -gt 0 ]]; do
@@ -19,11 +23,15 @@ while [[ $# -gt 0 ]]; do
     -o) OUTPUT="$2"; shift 2 ;;
     -s) SCALE="$2"; shift 2 ;;         # will map to --scale-ratio
     -n) NOISE="$2"; shift 2 ;;         # will map to --noise-level
+    --model) MODEL="$2"; shift 2 ;;
     --fast) FAST=1; shift ;;
+    --format) FORMAT="$2"; shift 2 ;;
     --time) TIME="$2"; shift 2 ;;
     --duration) DURATION="$2"; shift 2 ;;
     --frame-step) FRAME_STEP="$2"; shift 2 ;;
     --frame) FRAME_ONLY="$2"; shift 2 ;;  # single frame index (0-based)
+    --start-frame) START_FRAME="$2"; shift 2 ;;
+    --end-frame) END_FRAME="$2"; shift 2 ;;
     *) echo "Unknown option: $1" >&2; exit 1 ;;
   esac
 done
@@ -32,13 +40,42 @@ if [[ -z "$INPUT" || -z "$OUTPUT" ]]; then
   echo "Usage:"
   echo "  $0 -i input.mp4 -o output.mp4 [-s 2] [-n -1|0..3] [--fast] [--time SS] [--duration SS] [--frame-step N]"
   echo "  $0 -i input.mp4 -o /out/dir --frame 1234 [-s 2] [-n -1|0..3] [--fast]"
+  echo "  $0 -i input.mp4 -o /out/dir --start-frame 100 --end-frame 199 [-s 2] [-n -1|0..3] [--model models_rgb] [--format png|webp|tiff16] [--fast]"
   echo "Notes:"
-  echo "  • In --frame mode, -o must be a directory (PNG frames will be saved there as frame_%08d.png)."
+  echo "  • In --frame mode, -o must be a directory (PNG frames will be saved there as frame_%06d.png, named after the 0-based frame index)."
+  echo "  • In batch mode, -o must be a directory (PNG frames will be saved there as frame_%06d.png)."
+  echo "  • In batch mode, -i can be an image sequence pattern like /input/frame_%06d.exr, with a file per frame index."
   exit 1
 fi
 
-if [[ ! -f "$INPUT" ]]; then
-  echo "Input not found: $INPUT" >&2
+# frames are upscaled as PNG and encoded in the output format, with the same arguments the worker uses
+case "$FORMAT" in
+  png) EXT="png"; ENCODE_ARGS=() ;;
+  webp) EXT="webp"; ENCODE_ARGS=(-c:v libwebp -lossless 1 -f webp) ;;
+  tiff16) EXT="tiff"; ENCODE_ARGS=(-c:v tiff -pix_fmt rgb48le -compression_algo deflate -f image2 -update 1) ;;
+  *) echo "Unknown format: $FORMAT" >&2; exit 1 ;;
+esac
+
+# an image sequence is passed as a pattern like /input/cid/frame_%06d.exr, with a file per frame
+SEQUENCE=0
+INPUT_FILE="$INPUT"
+if [[ "$INPUT" == *%06d* ]]; then
+  if [[ -z "$START_FRAME" ]]; then
+    echo "Image sequences are only supported in batch mode" >&2
+    exit 1
+  fi
+  SEQUENCE=1
+  printf -v INPUT_FILE "$INPUT" "$START_FRAME"
+fi
+
+if [[ ! -f "$INPUT_FILE" ]]; then
+  echo "Input not found: $INPUT_FILE" >&2
+  exit 1
+fi
+
+MODEL_DIR="/usr/local/share/waifu2x-converter-cpp/$MODEL"
+if [[ "$MODEL" == */* || ! -d "$MODEL_DIR" ]]; then
+  echo "Model not found: $MODEL" >&2
   exit 1
 fi
 
@@ -47,11 +84,71 @@ FRAMES_IN="$TMP_DIR/frames_in"
 FRAMES_OUT="$TMP_DIR/frames_out"
 mkdir -p "$FRAMES_IN" "$FRAMES_OUT"
 
+# Build waifu2x options using the correct long flags for this build
+W2X_OPTS=(
+  --scale-ratio "$SCALE"
+  --png-compression 0
+  --image-quality 100
+  --model-dir "$MODEL_DIR"
+  # --verbose
+)
+
+# Noise handling and mode
+if [[ "$NOISE" -ge 0 ]]; then
+  W2X_OPTS+=(--noise-level "$NOISE" -m noise_scale)
+else
+  W2X_OPTS+=(-m scale)
+fi
+
+# Threads: default 1 (deterministic). If --fast, use all cores (faster, may be non-deterministic).
+if [[ "$FAST" -eq 1 ]]; then
+  W2X_OPTS+=(-j "$(nproc)")
+else
+  W2X_OPTS+=(-j 1)
+fi
+
+# ---------- Batch mode: a whole range of frames in a single run ----------
+if [[ -n "$START_FRAME" && -n "$END_FRAME" ]]; then
+  echo "[1/2] Extracting frames ${START_FRAME}-${END_FRAME}..."
+  if [[ "$SEQUENCE" -eq 1 ]]; then
+    DECODE_ARGS=(-start_number "$START_FRAME" -i "$INPUT" -frames:v $(( END_FRAME - START_FRAME + 1 )))
+  else
+    DECODE_ARGS=(-i "$INPUT" -vf "select=between(n\,${START_FRAME}\,${END_FRAME})" -vsync vfr)
+  fi
+  ffmpeg -hide_banner -loglevel error -y \
+    "${DECODE_ARGS[@]}" \
+    -start_number "$START_FRAME" \
+    "$FRAMES_IN/frame_%06d.png"
+
+  echo "[2/2] Running waifu2x upscale per frame..."
+  mkdir -p "$OUTPUT"
+  for FRAME_FILE in "$FRAMES_IN"/frame_*.png; do
+    NAME="$(basename "$FRAME_FILE")"
+    FRAME_NUMBER=$((10#${NAME//[!0-9]/}))
+    FRAME_STARTED=$(date +%s)
+    waifu2x-converter-cpp -i "$FRAME_FILE" -o "$FRAMES_OUT/$NAME" "${W2X_OPTS[@]}"
+    OUT_NAME="${NAME%.png}.$EXT"
+    # a frame only gets its final name once it is completely written
+    if [[ "$FORMAT" == "png" ]]; then
+      cp "$FRAMES_OUT/$NAME" "$OUTPUT/.$OUT_NAME.tmp"
+    else
+      ffmpeg -hide_banner -loglevel error -y -i "$FRAMES_OUT/$NAME" "${ENCODE_ARGS[@]}" "$OUTPUT/.$OUT_NAME.tmp"
+    fi
+    mv "$OUTPUT/.$OUT_NAME.tmp" "$OUTPUT/$OUT_NAME"
+    # parsed by the worker to record the duration of each frame
+    echo "FRAME_DONE $FRAME_NUMBER $(( $(date +%s) - FRAME_STARTED ))"
+  done
+
+  rm -rf "$TMP_DIR"
+  echo "Done!"
+  exit 0
+fi
+
 # ---------- [1] Extract frames ----------
 echo "[1/3] Extracting frames..."
 if [[ -n "$FRAME_ONLY" ]]; then
   # Single exact frame
-  PADDED_FRAME=$(printf "%08d" "$FRAME_ONLY")
+  PADDED_FRAME=$(printf "%06d" "$FRAME_ONLY")
   ffmpeg -hide_banner -loglevel error -y \
     -i "$INPUT" \
     -vf "select=eq(n\,${FRAME_ONLY})" -vsync vfr \
@@ -67,38 +164,14 @@ else
     -ss "$TIME" "${DURATION_ARG[@]}" \
     -i "$INPUT" \
     -vf "select=not(mod(n\,$FRAME_STEP))" -vsync vfr \
-    "$FRAMES_IN/frame_%08d.png"
+    -start_number 0 \
+    "$FRAMES_IN/frame_%06d.png"
 fi
 
 # ---------- [2] Waifu2x upscale ----------
 echo "[2/3] Running waifu2x upscale..."
 
-# Build waifu2x args using the correct long flags for this build
-W2X_ARGS=(
-  -i "$FRAMES_IN"
-  -o "$FRAMES_OUT"
-  --scale-ratio "$SCALE"
-  --png-compression 0
-  --image-quality 100
-  --model-dir /usr/local/share/waifu2x-converter-cpp/models_rgb
-  # --verbose
-)
-
-# Noise handling and mode
-if [[ "$NOISE" -ge 0 ]]; then
-  W2X_ARGS+=(--noise-level "$NOISE" -m noise_scale)
-else
-  W2X_ARGS+=(-m scale)
-fi
-
-# Threads: default 1 (deterministic). If --fast, use all cores (faster, may be non-deterministic).
-if [[ "$FAST" -eq 1 ]]; then
-  W2X_ARGS+=(-j "$(nproc)")
-else
-  W2X_ARGS+=(-j 1)
-fi
-
-waifu2x-converter-cpp "${W2X_ARGS[@]}"
+waifu2x-converter-cpp -i "$FRAMES_IN" -o "$FRAMES_OUT" "${W2X_OPTS[@]}"
 
 # ---------- [3] Save output ----------
 echo "[3/3] Saving..."
@@ -110,7 +183,7 @@ else
   # re-encode PNG frames to a video; adjust framerate if you need original FPS preservation
   ffmpeg -hide_banner -loglevel error -y \
     -framerate 30 \
-    -i "$FRAMES_OUT/frame_%08d.png" \
+    -start_number 0 -i "$FRAMES_OUT/frame_%06d.png" \
     -c:v libx264 -pix_fmt yuv420p -preset medium -crf 18 \
     "$OUTPUT"
 fi
@@ -0,0 +1,103 @@
+#!/usr/bin/env bash
+set -euo pipefail
+
+# Upscales a range of frames of a video with realesrgan-ncnn-vulkan on the CPU (lavapipe)
+SCALE=4                        # scale ratio, 2 to 4
+MODEL="realesr-animevideov3"   # model under /usr/local/share/realesrgan/models
+FORMAT="png"                   # output format of the frames: png, webp (lossless) or tiff16 (16 bits per channel)
+FAST=0                         # if 1, use more threads (non-deterministic)
+START_FRAME=""                 # first frame index of the range (0-based, inclusive)
+END_FRAME=""                   # last frame index of the range (0-based, inclusive)
+OUTPUT=""                      # output dir, frames are saved as frame_%06d.png, or with the extension of the format
+INPUT=""                       # input video, or pattern of an image sequence like frame_%06d.exr
+
+while [[ $# -gt 0 ]]; do
+  case "$1" in
+    -i) INPUT="$2"; shift 2 ;;
+    -o) OUTPUT="$2"; shift 2 ;;
+    -s) SCALE="$2"; shift 2 ;;
+    --model) MODEL="$2"; shift 2 ;;
+    --fast) FAST=1; shift ;;
+    --format) FORMAT="$2"; shift 2 ;;
+    --start-frame) START_FRAME="$2"; shift 2 ;;
+    --end-frame) END_FRAME="$2"; shift 2 ;;
+    *) echo "Unknown option: $1" >&2; exit 1 ;;
+  esac
+done
+
+if [[ -z "$INPUT" || -z "$OUTPUT" || -z "$START_FRAME" || -z "$END_FRAME" ]]; then
+  echo "Usage:"
+  echo "  $0 -i input.mp4 -o /out/dir --start-frame 100 --end-frame 199 [-s 2|3|4] [--model realesr-animevideov3] [--format png|webp|tiff16] [--fast]"
+  exit 1
+fi
+
+# frames are upscaled as PNG and encoded in the output format, with the same arguments the worker uses
+case "$FORMAT" in
+  png) EXT="png"; ENCODE_ARGS=() ;;
+  webp) EXT="webp"; ENCODE_ARGS=(-c:v libwebp -lossless 1 -f webp) ;;
+  tiff16) EXT="tiff"; ENCODE_ARGS=(-c:v tiff -pix_fmt rgb48le -compression_algo deflate -f image2 -update 1) ;;
+  *) echo "Unknown format: $FORMAT" >&2; exit 1 ;;
+esac
+
+# an image sequence is passed as a pattern like /input/cid/frame_%06d.exr, with a file per frame
+SEQUENCE=0
+INPUT_FILE="$INPUT"
+if [[ "$INPUT" == *%06d* ]]; then
+  SEQUENCE=1
+  printf -v INPUT_FILE "$INPUT" "$START_FRAME"
+fi
+
+if [[ ! -f "$INPUT_FILE" ]]; then
+  echo "Input not found: $INPUT_FILE" >&2
+  exit 1
+fi
+
+MODEL_DIR="/usr/local/share/realesrgan/models"
+if [[ "$MODEL" == */* || ! -f "$MODEL_DIR/$MODEL.param" ]]; then
+  echo "Model not found: $MODEL" >&2
+  exit 1
+fi
+
+# Threads load:proc:save. A single proc thread gives every worker the same pixels
+if [[ "$FAST" -eq 1 ]]; then
+  THREADS="1:$(nproc):1"
+else
+  THREADS="1:1:1"
+fi
+
+TMP_DIR="$(mktemp -d)"
+FRAMES_IN="$TMP_DIR/frames_in"
+FRAMES_OUT="$TMP_DIR/frames_out"
+mkdir -p "$FRAMES_IN" "$FRAMES_OUT" "$OUTPUT"
+
+echo "[1/2] Extracting frames ${START_FRAME}-${END_FRAME}..."
+if [[ "$SEQUENCE" -eq 1 ]]; then
+  DECODE_ARGS=(-start_number "$START_FRAME" -i "$INPUT" -frames:v $(( END_FRAME - START_FRAME + 1 )))
+else
+  DECODE_ARGS=(-i "$INPUT" -vf "select=between(n\,${START_FRAME}\,${END_FRAME})" -vsync vfr)
+fi
+ffmpeg -hide_banner -loglevel error -y \
+  "${DECODE_ARGS[@]}" \
+  -start_number "$START_FRAME" \
+  "$FRAMES_IN/frame_%06d.png"
+
+echo "[2/2] Running Real-ESRGAN upscale per frame..."
+for FRAME_FILE in "$FRAMES_IN"/frame_*.png; do
+  NAME="$(basename "$FRAME_FILE")"
+  FRAME_NUMBER=$((10#${NAME//[!0-9]/}))
+  FRAME_STARTED=$(date +%s)
+  realesrgan-ncnn-vulkan -i "$FRAME_FILE" -o "$FRAMES_OUT/$NAME" -s "$SCALE" -n "$MODEL" -m "$MODEL_DIR" -f png -j "$THREADS"
+  OUT_NAME="${NAME%.png}.$EXT"
+  # a frame only gets its final name once it is completely written
+  if [[ "$FORMAT" == "png" ]]; then
+    cp "$FRAMES_OUT/$NAME" "$OUTPUT/.$OUT_NAME.tmp"
+  else
+    ffmpeg -hide_banner -loglevel error -y -i "$FRAMES_OUT/$NAME" "${ENCODE_ARGS[@]}" "$OUTPUT/.$OUT_NAME.tmp"
+  fi
+  mv "$OUTPUT/.$OUT_NAME.tmp" "$OUTPUT/$OUT_NAME"
+  # parsed by the worker to record the duration of each frame
+  echo "FRAME_DONE $FRAME_NUMBER $(( $(date +%s) - FRAME_STARTED ))"
+done
+
+rm -rf "$TMP_DIR"
+echo "Done!"
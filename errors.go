@@ -10,10 +10,21 @@ var (
 	ErrWorkerNotAvailable      = errors.Register(ModuleName, 11, "worker cannot subscribe to task")
 	ErrWorkerTaskNotAvailable  = errors.Register(ModuleName, 12, "task is already completed")
 	ErrWorkerIncorrectStake    = errors.Register(ModuleName, 13, "staked coin is incorrect")
+	ErrWorkerCapabilities      = errors.Register(ModuleName, 14, "worker capabilities don't match")
+	ErrInvalidWorkerAddress    = errors.Register(ModuleName, 15, "worker network address is invalid")
+	ErrWorkerNotAllowed        = errors.Register(ModuleName, 16, "worker is not allowed to process the task")
 
 	ErrInvalidVideoUpscalerTask = errors.Register(ModuleName, 20, "invalid video upscaler task")
+	ErrInvalidThreadFailure     = errors.Register(ModuleName, 21, "invalid thread failure report")
 
-	ErrInvalidSolution = errors.Register(ModuleName, 30, "proposed solution is invalid")
+	ErrInvalidSolution   = errors.Register(ModuleName, 30, "proposed solution is invalid")
+	ErrInvalidFinalVideo = errors.Register(ModuleName, 31, "final video is invalid")
 
 	ErrInvalidVerification = errors.Register(ModuleName, 40, "verification to solution is invalid")
+
+	ErrInsufficientEscrow = errors.Register(ModuleName, 50, "not enough escrowed funds")
+
+	ErrInvalidWorkerGroup = errors.Register(ModuleName, 60, "invalid worker group")
+
+	ErrInvalidParams = errors.Register(ModuleName, 70, "invalid params")
 )
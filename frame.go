@@ -1,5 +1,12 @@
 package videoUpscaler
 
+import (
+	fmt "fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
 // finds an specific Frame from the Frames slice
 func GetFrame(frames []*VideoUpscalerThread_Frame, filename string) *VideoUpscalerThread_Frame {
 	for _, frame := range frames {
@@ -9,3 +16,18 @@ func GetFrame(frames []*VideoUpscalerThread_Frame, filename string) *VideoUpscal
 	}
 	return nil
 }
+
+// extracts the frame number from a rendered frame filename like frame_000042.png
+func GetFrameNumber(filename string) (int64, error) {
+	name := strings.TrimSuffix(filename, filepath.Ext(filename))
+	idx := strings.LastIndex(name, "_")
+	if idx < 0 {
+		return 0, fmt.Errorf("filename %s doesn't have a frame number", filename)
+	}
+
+	number, err := strconv.ParseInt(name[idx+1:], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("filename %s doesn't have a frame number: %w", filename, err)
+	}
+	return number, nil
+}
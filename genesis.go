@@ -1,5 +1,7 @@
 package videoUpscaler
 
+import "fmt"
+
 // NewGenesisState creates a new genesis state with default values.
 func NewGenesisState() *GenesisState {
 	return &GenesisState{
@@ -7,6 +9,8 @@ func NewGenesisState() *GenesisState {
 		VideoUpscalerTaskList: GetEmptyVideoUpscalerTaskList(),
 		VideoUpscalerTaskInfo: VideoUpscalerTaskInfo{NextId: 1},
 		Workers:               []Worker{},
+		TaskEscrows:           []ModuleAccounting_TaskEscrow{},
+		WorkerStakes:          []ModuleAccounting_WorkerStake{},
 	}
 }
 
@@ -16,5 +20,19 @@ func (gs *GenesisState) Validate() error {
 		return err
 	}
 
+	for _, escrow := range gs.TaskEscrows {
+		if err := escrow.Escrowed.Validate(); err != nil {
+			return fmt.Errorf("invalid escrow of task %s: %w", escrow.TaskId, err)
+		}
+	}
+	for _, stake := range gs.WorkerStakes {
+		if err := stake.Staked.Validate(); err != nil {
+			return fmt.Errorf("invalid stake of worker %s: %w", stake.Worker, err)
+		}
+	}
+	if err := gs.PaidOut.Validate(); err != nil {
+		return fmt.Errorf("invalid paid out: %w", err)
+	}
+
 	return nil
 }
@@ -6,6 +6,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net"
 	"net/http"
 	"os"
 	"os/exec"
@@ -13,8 +14,10 @@ import (
 	"strings"
 	"time"
 
+	gocid "github.com/ipfs/go-cid"
 	shell "github.com/ipfs/go-ipfs-api"
 	"github.com/janction/videoUpscaler/videoUpscalerLogger"
+	"github.com/multiformats/go-multiaddr"
 )
 
 func IPFSGet(cid string, path string) error {
@@ -39,6 +42,56 @@ func IPFSGet(cid string, path string) error {
 	return nil
 }
 
+// IPFSGetFile downloads a single file, like {cid}/{filename} of a directory, to output. Any previous
+// content at output is replaced
+func IPFSGetFile(ipfsPath string, output string) error {
+	if err := os.MkdirAll(filepath.Dir(output), os.ModePerm); err != nil {
+		return err
+	}
+	// the file is only written inside output if it is an existing dir
+	if err := os.RemoveAll(output); err != nil {
+		return err
+	}
+
+	sh := shell.NewShell("127.0.0.1:5001")
+	if err := sh.Get(ipfsPath, output); err != nil {
+		videoUpscalerLogger.Logger.Error("Error Downloading IPFS %s: %s", ipfsPath, err.Error())
+		return err
+	}
+	return nil
+}
+
+// IsDownloadComplete returns true if the content of the cid is already at path. The cid of the
+// local copy is calculated without adding it, so partial downloads don't match
+func IsDownloadComplete(cid string, path string) bool {
+	target := filepath.Join(path, cid)
+	if _, err := os.Stat(target); err != nil {
+		return false
+	}
+
+	cmd := exec.Command("ipfs", "add", "-Q", "-r", "--only-hash", target)
+	output, err := cmd.Output()
+	if err != nil {
+		videoUpscalerLogger.Logger.Error("failed to calculate cid of %s: %s", target, err.Error())
+		return false
+	}
+
+	return IsSameContent(cid, strings.TrimSpace(string(output)))
+}
+
+// IsSameContent compares the multihash of two cids, so v0 and v1 cids of the same content match
+func IsSameContent(a, b string) bool {
+	cidA, err := gocid.Decode(a)
+	if err != nil {
+		return false
+	}
+	cidB, err := gocid.Decode(b)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(cidA.Hash(), cidB.Hash())
+}
+
 // CalculateCIDs recursively computes the CIDs of a directory and its contents using `ipfs add --only-hash --recursive`
 func CalculateCIDs(dirPath string) (map[string]string, error) {
 	cidMap := make(map[string]string)
@@ -57,21 +110,13 @@ func CalculateCIDs(dirPath string) (map[string]string, error) {
 			return nil
 		}
 
-		// Execute the IPFS add command with -Q and --only-hash to get the CID
-		cmd := exec.Command("ipfs", "add", "-Q", "--only-hash", path)
-		var out bytes.Buffer
-		cmd.Stdout = &out
-		cmd.Stderr = &out
-
-		if err := cmd.Run(); err != nil {
-			fail := fmt.Errorf("failed to calculate CID for %s: %s, %w", path, out.String(), err)
-			videoUpscalerLogger.Logger.Error(fail.Error())
-			return fail
+		cid, err := CalculateCID(path)
+		if err != nil {
+			return err
 		}
 
 		// Extract only the file name and add the result to the map
 		fileName := filepath.Base(path)
-		cid := strings.TrimSpace(out.String())
 		cidMap[fileName] = cid
 		return nil
 	})
@@ -83,7 +128,24 @@ func CalculateCIDs(dirPath string) (map[string]string, error) {
 	return cidMap, nil
 }
 
-func UploadSolution(ctx context.Context, rootPath, threadId string) (string, error) {
+// CalculateCID returns the CID the file gets when added to IPFS, without adding it
+func CalculateCID(path string) (string, error) {
+	cmd := exec.Command("ipfs", "add", "-Q", "--only-hash", path)
+	var out bytes.Buffer
+	cmd.Stdout = &out
+	cmd.Stderr = &out
+
+	if err := cmd.Run(); err != nil {
+		fail := fmt.Errorf("failed to calculate CID for %s: %s, %w", path, out.String(), err)
+		videoUpscalerLogger.Logger.Error(fail.Error())
+		return "", fail
+	}
+	return strings.TrimSpace(out.String()), nil
+}
+
+// UploadSolution adds the output dir of the thread to IPFS and returns the CID of the dir.
+// The dir must contain exactly the files of the frame manifest, so nothing is missing or added to the solution
+func UploadSolution(ctx context.Context, rootPath, threadId string, files []string) (string, error) {
 	// Connect to the IPFS daemon
 	sh := shell.NewShell("localhost:5001") // Replace with your IPFS API address
 
@@ -103,6 +165,11 @@ func UploadSolution(ctx context.Context, rootPath, threadId string) (string, err
 		return "", fail
 	}
 
+	if err := checkDirFiles(threadOutputPath, files); err != nil {
+		videoUpscalerLogger.Logger.Error(err.Error())
+		return "", err
+	}
+
 	cid, err := sh.AddDir(threadOutputPath)
 	if err != nil {
 		fail := fmt.Errorf("failed to upload files for threadId %s: %w", threadId, err)
@@ -113,6 +180,49 @@ func UploadSolution(ctx context.Context, rootPath, threadId string) (string, err
 	return cid, nil
 }
 
+// UploadFile adds a single file to IPFS and returns its CID
+func UploadFile(path string) (string, error) {
+	sh := shell.NewShell("localhost:5001")
+
+	file, err := os.Open(path)
+	if err != nil {
+		fail := fmt.Errorf("failed to open %s: %w", path, err)
+		videoUpscalerLogger.Logger.Error(fail.Error())
+		return "", fail
+	}
+	defer file.Close()
+
+	cid, err := sh.Add(file)
+	if err != nil {
+		fail := fmt.Errorf("failed to upload %s: %w", path, err)
+		videoUpscalerLogger.Logger.Error(fail.Error())
+		return "", fail
+	}
+	return cid, nil
+}
+
+// checkDirFiles makes sure the dir has the files and nothing else
+func checkDirFiles(dir string, files []string) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	expected := make(map[string]bool)
+	for _, file := range files {
+		expected[file] = true
+	}
+	for _, entry := range entries {
+		if !expected[entry.Name()] {
+			return fmt.Errorf("unexpected file %s at %s", entry.Name(), dir)
+		}
+		delete(expected, entry.Name())
+	}
+	for file := range expected {
+		return fmt.Errorf("file %s is missing at %s", file, dir)
+	}
+	return nil
+}
+
 // CheckIPFSStatus pings the IPFS daemon to check if it's running
 func CheckIPFSStatus() error {
 	client := http.Client{
@@ -219,15 +329,23 @@ func ListDirectory(cid string) (map[string]string, error) {
 	return result, nil
 }
 
-// Function to connect to IPFS nodes
-func ConnectToIPFSNode(ip, peerId string) {
-	seed, _ := GenerateSwarmConnectURL(ip, peerId)
-	cmd := exec.Command("ipfs", "swarm", "connect", seed)
-	output, err := cmd.CombinedOutput()
+// Function to connect to IPFS nodes. Announced addresses are tried first, and the public ip last
+func ConnectToIPFSNode(ip, peerId string, announceAddrs []string) {
+	seeds, err := GenerateSwarmConnectAddrs(ip, peerId, announceAddrs)
 	if err != nil {
-		videoUpscalerLogger.Logger.Error("Failed to connect to %s: %v\nOutput: %s", seed, err, output)
-	} else {
+		videoUpscalerLogger.Logger.Error("Failed to connect to %s: %s", peerId, err.Error())
+		return
+	}
+
+	for _, seed := range seeds {
+		cmd := exec.Command("ipfs", "swarm", "connect", seed)
+		output, err := cmd.CombinedOutput()
+		if err != nil {
+			videoUpscalerLogger.Logger.Error("Failed to connect to %s: %v\nOutput: %s", seed, err, output)
+			continue
+		}
 		videoUpscalerLogger.Logger.Info("Connected to IPFS node: %s\n", seed)
+		return
 	}
 }
 
@@ -258,9 +376,59 @@ func GetIPFSPeerID() (string, error) {
 
 // GenerateSwarmConnectURL creates the full IPFS swarm connect URL.
 func GenerateSwarmConnectURL(ip, peerID string) (string, error) {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return "", fmt.Errorf("invalid ip %s", ip)
+	}
+	if parsed.To4() == nil {
+		return fmt.Sprintf("/ip6/%s/tcp/4001/p2p/%s", ip, peerID), nil
+	}
 	return fmt.Sprintf("/ip4/%s/tcp/4001/p2p/%s", ip, peerID), nil
 }
 
+// ValidateAnnounceAddr makes sure the address is a valid multiaddr other nodes can dial,
+// so it needs a tcp or udp (quic) transport after the host
+func ValidateAnnounceAddr(addr string) error {
+	m, err := multiaddr.NewMultiaddr(addr)
+	if err != nil {
+		return fmt.Errorf("invalid multiaddr %s: %w", addr, err)
+	}
+	for _, protocol := range m.Protocols() {
+		if protocol.Code == multiaddr.P_TCP || protocol.Code == multiaddr.P_UDP {
+			return nil
+		}
+	}
+	return fmt.Errorf("multiaddr %s has no tcp or udp transport", addr)
+}
+
+// GenerateSwarmConnectAddrs returns the addresses to connect to the peer, adding the /p2p component
+// to the announced multiaddrs that don't have it, and the public ip as the last option
+func GenerateSwarmConnectAddrs(ip, peerID string, announceAddrs []string) ([]string, error) {
+	var result []string
+	for _, addr := range announceAddrs {
+		if err := ValidateAnnounceAddr(addr); err != nil {
+			videoUpscalerLogger.Logger.Error(err.Error())
+			continue
+		}
+		if !strings.Contains(addr, "/p2p/") && !strings.Contains(addr, "/ipfs/") {
+			addr = strings.TrimSuffix(addr, "/") + "/p2p/" + peerID
+		}
+		result = append(result, addr)
+	}
+
+	if ip != "" {
+		url, err := GenerateSwarmConnectURL(ip, peerID)
+		if err == nil {
+			result = append(result, url)
+		}
+	}
+
+	if len(result) == 0 {
+		return nil, fmt.Errorf("no valid address to connect to %s", peerID)
+	}
+	return result, nil
+}
+
 // Checks at the specified path if a file exists. This path will be tipically
 // .janctiond/renders/[threadId]. If IPFS started downloading a file, a temp file will exists
 // if it is empty, it is safe to assume download hasn't started and probably won't
@@ -18,6 +18,7 @@ import (
 	"time"
 
 	"bou.ke/monkey"
+	gocid "github.com/ipfs/go-cid"
 	shell "github.com/ipfs/go-ipfs-api"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -176,16 +177,37 @@ func TestUploadSolution_Success(t *testing.T) {
 	})
 	defer patch.Unpatch()
 
-	cid, err := UploadSolution(context.Background(), dir, threadId)
+	cid, err := UploadSolution(context.Background(), dir, threadId, []string{"result.png"})
 	assert.NoError(t, err)
 	assert.Equal(t, "QmFakeCID123", cid)
 }
 
+func TestUploadSolution_FilesDontMatch(t *testing.T) {
+	dir := t.TempDir()
+	threadId := "thread123"
+	threadOutputPath := filepath.Join(dir, "upscales", threadId, "output")
+
+	require.NoError(t, os.MkdirAll(threadOutputPath, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(threadOutputPath, "result.png"), []byte("dummy"), 0644))
+
+	// a file of the manifest is missing
+	cid, err := UploadSolution(context.Background(), dir, threadId, []string{"result.png", "other.png"})
+	assert.Error(t, err)
+	assert.Empty(t, cid)
+	assert.Contains(t, err.Error(), "other.png is missing")
+
+	// a file is not in the manifest
+	cid, err = UploadSolution(context.Background(), dir, threadId, []string{})
+	assert.Error(t, err)
+	assert.Empty(t, cid)
+	assert.Contains(t, err.Error(), "unexpected file result.png")
+}
+
 func TestUploadSolution_PathDoesNotExist(t *testing.T) {
 	dir := t.TempDir()
 	threadId := "nonexistent"
 
-	cid, err := UploadSolution(context.Background(), dir, threadId)
+	cid, err := UploadSolution(context.Background(), dir, threadId, []string{"result.png"})
 	assert.Error(t, err)
 	assert.Empty(t, cid)
 	assert.Contains(t, err.Error(), "failed to access thread output path")
@@ -201,7 +223,7 @@ func TestUploadSolution_PathIsNotDirectory(t *testing.T) {
 	outputFilePath := filepath.Join(renderPath, "output")
 	require.NoError(t, os.WriteFile(outputFilePath, []byte("not a dir"), 0644))
 
-	cid, err := UploadSolution(context.Background(), dir, threadId)
+	cid, err := UploadSolution(context.Background(), dir, threadId, []string{"result.png"})
 	assert.Error(t, err)
 	assert.Empty(t, cid)
 	assert.Contains(t, err.Error(), "thread output path is not a directory")
@@ -221,7 +243,7 @@ func TestUploadSolution_AddDirFails(t *testing.T) {
 	})
 	defer patch.Unpatch()
 
-	cid, err := UploadSolution(context.Background(), dir, threadId)
+	cid, err := UploadSolution(context.Background(), dir, threadId, []string{"result.png"})
 	assert.Error(t, err)
 	assert.Empty(t, cid)
 	assert.Contains(t, err.Error(), "failed to upload files")
@@ -533,7 +555,7 @@ func TestConnectToIPFSNode(t *testing.T) {
 		})
 		defer patch.Unpatch()
 
-		ConnectToIPFSNode("127.0.0.1", "peerId123")
+		ConnectToIPFSNode("127.0.0.1", "peerId123", nil)
 	})
 
 	// Negative path: simulate connection failure (CombinedOutput returns an error)
@@ -544,7 +566,7 @@ func TestConnectToIPFSNode(t *testing.T) {
 		})
 		defer patch.Unpatch()
 
-		ConnectToIPFSNode("127.0.0.1", "peerId123")
+		ConnectToIPFSNode("127.0.0.1", "peerId123", nil)
 	})
 }
 
@@ -627,6 +649,34 @@ func TestGenerateSwarmConnectURL(t *testing.T) {
 	}
 }
 
+func TestValidateAnnounceAddr(t *testing.T) {
+	assert.NoError(t, ValidateAnnounceAddr("/dns4/worker.example.com/tcp/4002"))
+	assert.NoError(t, ValidateAnnounceAddr("/ip6/2001:db8::1/udp/4001/quic-v1"))
+	assert.NoError(t, ValidateAnnounceAddr("/ip4/1.2.3.4/tcp/4001/p2p/QmYyQSo1c1Ym7orWxLYvCrM2EmxFTANf8wXmmE7DWjhx5N"))
+
+	// addresses without a transport can't be dialed
+	assert.Error(t, ValidateAnnounceAddr("/ip4/1.2.3.4"))
+	assert.Error(t, ValidateAnnounceAddr("/dns4/worker.example.com"))
+	assert.Error(t, ValidateAnnounceAddr("not a multiaddr"))
+}
+
+func TestGenerateSwarmConnectAddrs(t *testing.T) {
+	result, err := GenerateSwarmConnectAddrs("2001:db8::1", "QmYyQSo1c1Ym7orWxLYvCrM2EmxFTANf8wXmmE7DWjhx5N", []string{
+		"/dns4/worker.example.com/tcp/4002",
+		"/ip4/1.2.3.4/udp/4001/quic-v1/p2p/QmYyQSo1c1Ym7orWxLYvCrM2EmxFTANf8wXmmE7DWjhx5N",
+		"not a multiaddr",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"/dns4/worker.example.com/tcp/4002/p2p/QmYyQSo1c1Ym7orWxLYvCrM2EmxFTANf8wXmmE7DWjhx5N",
+		"/ip4/1.2.3.4/udp/4001/quic-v1/p2p/QmYyQSo1c1Ym7orWxLYvCrM2EmxFTANf8wXmmE7DWjhx5N",
+		"/ip6/2001:db8::1/tcp/4001/p2p/QmYyQSo1c1Ym7orWxLYvCrM2EmxFTANf8wXmmE7DWjhx5N",
+	}, result)
+
+	_, err = GenerateSwarmConnectAddrs("", "QmYyQSo1c1Ym7orWxLYvCrM2EmxFTANf8wXmmE7DWjhx5N", nil)
+	assert.Error(t, err)
+}
+
 func TestIsDownloadStarted(t *testing.T) {
 	tests := []struct {
 		dir      string
@@ -671,3 +721,14 @@ func TestIsDownloadStarted(t *testing.T) {
 		os.RemoveAll(tt.dir)
 	}
 }
+
+func TestIsSameContent(t *testing.T) {
+	// same content as v0 and v1 cids
+	v0 := "QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG"
+	parsed, err := gocid.Decode(v0)
+	assert.NoError(t, err)
+	v1 := gocid.NewCidV1(gocid.DagProtobuf, parsed.Hash()).String()
+	assert.True(t, IsSameContent(v0, v1))
+	assert.False(t, IsSameContent(v0, "bafybeigdyrztxx3b7d5qzq2ujay5g4qxxuj5f6x3h6lgv7d4ttrddn3cxa"))
+	assert.False(t, IsSameContent(v0, "invalid"))
+}
@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/janction/videoUpscaler"
+)
+
+// CanWorkerTakeTask returns nil if the access of the task allows the worker to process it
+func (k Keeper) CanWorkerTakeTask(ctx context.Context, worker string, task videoUpscaler.VideoUpscalerTask) error {
+	if task.Access == nil {
+		return nil
+	}
+
+	var group *videoUpscaler.WorkerGroup
+	if task.Access.WorkerGroup != "" {
+		value, err := k.WorkerGroups.Get(ctx, task.Access.WorkerGroup)
+		if err != nil {
+			return err
+		}
+		group = &value
+	}
+
+	return task.Access.Allows(worker, group)
+}
+
+// validateAddresses makes sure all the addresses are valid account addresses
+func (k Keeper) validateAddresses(addresses []string) error {
+	for _, address := range addresses {
+		if _, err := k.addressCodec.StringToBytes(address); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,162 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/janction/videoUpscaler"
+)
+
+// AddTaskEscrow records the reward of a task held by the module account
+func (k Keeper) AddTaskEscrow(ctx context.Context, taskId string, amount sdk.Coin) error {
+	escrowed, err := k.TaskEscrows.Get(ctx, taskId)
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+		escrowed = sdk.NewCoin(amount.Denom, math.ZeroInt())
+	}
+
+	return k.TaskEscrows.Set(ctx, taskId, escrowed.Add(amount))
+}
+
+// PayFromTaskEscrow discounts a payment made with the reward of a task
+// and adds it to the total paid out by the module
+func (k Keeper) PayFromTaskEscrow(ctx context.Context, taskId string, payment sdk.Coin) error {
+	if err := k.subtractTaskEscrow(ctx, taskId, payment); err != nil {
+		return err
+	}
+
+	return k.addPaidOut(ctx, payment)
+}
+
+// RefundTaskEscrow sends back to the requester an amount of the escrowed reward of a task
+func (k Keeper) RefundTaskEscrow(ctx context.Context, taskId, requester string, refund sdk.Coin) error {
+	if err := k.subtractTaskEscrow(ctx, taskId, refund); err != nil {
+		return err
+	}
+
+	addr, err := sdk.AccAddressFromBech32(requester)
+	if err != nil {
+		return err
+	}
+	return k.BankKeeper.SendCoinsFromModuleToAccount(ctx, videoUpscaler.ModuleName, addr, sdk.NewCoins(refund))
+}
+
+// PayValidators shares the validators half of the reward of a thread between its validators, proportionally to the
+// frames each one validated. What is not paid, like when nobody validated the thread, is refunded to the requester
+func (k Keeper) PayValidators(ctx context.Context, task videoUpscaler.VideoUpscalerTask, thread *videoUpscaler.VideoUpscalerThread) error {
+	reward := task.GetValidatorsReward(thread)
+	paid := sdk.NewCoin(reward.Denom, math.ZeroInt())
+	for _, validation := range thread.Validations {
+		payment := thread.GetValidatorReward(validation.Validator, reward)
+		if !payment.IsPositive() {
+			continue
+		}
+
+		addr, err := sdk.AccAddressFromBech32(validation.Validator)
+		if err != nil {
+			return err
+		}
+		if err := k.BankKeeper.SendCoinsFromModuleToAccount(ctx, videoUpscaler.ModuleName, addr, sdk.NewCoins(payment)); err != nil {
+			return err
+		}
+		if err := k.PayFromTaskEscrow(ctx, task.TaskId, payment); err != nil {
+			return err
+		}
+		paid = paid.Add(payment)
+
+		if worker, err := k.Workers.Get(ctx, validation.Validator); err == nil {
+			worker.DeclareValidator(task.TaskId, thread.ThreadId, payment)
+			if err := k.Workers.Set(ctx, worker.Address, worker); err != nil {
+				return err
+			}
+		}
+	}
+
+	if paid.IsLT(reward) {
+		return k.RefundTaskEscrow(ctx, task.TaskId, task.Requester, reward.Sub(paid))
+	}
+	return nil
+}
+
+func (k Keeper) subtractTaskEscrow(ctx context.Context, taskId string, amount sdk.Coin) error {
+	escrowed, err := k.TaskEscrows.Get(ctx, taskId)
+	if err != nil {
+		return err
+	}
+
+	if escrowed.IsLT(amount) {
+		return videoUpscaler.ErrInsufficientEscrow.Wrapf("task %s has %s escrowed, can't pay %s", taskId, escrowed, amount)
+	}
+
+	escrowed = escrowed.Sub(amount)
+	if escrowed.IsZero() {
+		return k.TaskEscrows.Remove(ctx, taskId)
+	}
+	return k.TaskEscrows.Set(ctx, taskId, escrowed)
+}
+
+// AddWorkerStake records the coins a worker locked in the module account
+func (k Keeper) AddWorkerStake(ctx context.Context, worker string, amount sdk.Coin) error {
+	staked, err := k.WorkerStakes.Get(ctx, worker)
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+		staked = sdk.NewCoin(amount.Denom, math.ZeroInt())
+	}
+
+	return k.WorkerStakes.Set(ctx, worker, staked.Add(amount))
+}
+
+func (k Keeper) addPaidOut(ctx context.Context, payment sdk.Coin) error {
+	paid, err := k.PaidOut.Get(ctx, payment.Denom)
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+		paid = math.ZeroInt()
+	}
+
+	return k.PaidOut.Set(ctx, payment.Denom, paid.Add(payment.Amount))
+}
+
+// GetModuleAccounting gathers the accounting collections and the module account balance
+func (k Keeper) GetModuleAccounting(ctx context.Context) (videoUpscaler.ModuleAccounting, error) {
+	accounting := videoUpscaler.ModuleAccounting{TotalEscrowed: sdk.NewCoins(), TotalStaked: sdk.NewCoins(), TotalPaidOut: sdk.NewCoins()}
+
+	err := k.TaskEscrows.Walk(ctx, nil, func(taskId string, escrowed sdk.Coin) (bool, error) {
+		accounting.Escrows = append(accounting.Escrows, &videoUpscaler.ModuleAccounting_TaskEscrow{TaskId: taskId, Escrowed: escrowed})
+		accounting.TotalEscrowed = accounting.TotalEscrowed.Add(escrowed)
+		return false, nil
+	})
+	if err != nil {
+		return accounting, err
+	}
+
+	err = k.WorkerStakes.Walk(ctx, nil, func(worker string, staked sdk.Coin) (bool, error) {
+		accounting.Stakes = append(accounting.Stakes, &videoUpscaler.ModuleAccounting_WorkerStake{Worker: worker, Staked: staked})
+		accounting.TotalStaked = accounting.TotalStaked.Add(staked)
+		return false, nil
+	})
+	if err != nil {
+		return accounting, err
+	}
+
+	err = k.PaidOut.Walk(ctx, nil, func(denom string, amount math.Int) (bool, error) {
+		accounting.TotalPaidOut = accounting.TotalPaidOut.Add(sdk.NewCoin(denom, amount))
+		return false, nil
+	})
+	if err != nil {
+		return accounting, err
+	}
+
+	accounting.ModuleBalance = k.BankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(videoUpscaler.ModuleName))
+	return accounting, nil
+}
@@ -0,0 +1,171 @@
+package keeper
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/janction/videoUpscaler"
+)
+
+func jct(amount int64) sdk.Coin {
+	return sdk.NewCoin("jct", math.NewInt(amount))
+}
+
+func testAddress(name string) string {
+	return sdk.AccAddress([]byte(name)).String()
+}
+
+// newPaidTask returns a task of 10 frames with a reward of 1000 in a single thread, with the solution accepted
+func newPaidTask(requester, proposer string, validations ...*videoUpscaler.VideoUpscalerThread_Validation) videoUpscaler.VideoUpscalerTask {
+	reward := jct(1000)
+	return videoUpscaler.VideoUpscalerTask{TaskId: "1", Requester: requester, StartFrame: 0, EndFrame: 9, Reward: &reward, Threads: []*videoUpscaler.VideoUpscalerThread{
+		{ThreadId: "1-0", StartFrame: 0, EndFrame: 9, Solution: &videoUpscaler.VideoUpscalerThread_Solution{ProposedBy: proposer, Accepted: true}, Validations: validations},
+	}}
+}
+
+func validationOf(validator string, frames int) *videoUpscaler.VideoUpscalerThread_Validation {
+	validation := &videoUpscaler.VideoUpscalerThread_Validation{Validator: validator}
+	for i := 0; i < frames; i++ {
+		validation.Frames = append(validation.Frames, &videoUpscaler.VideoUpscalerThread_Frame{})
+	}
+	return validation
+}
+
+// --- Test for the task escrow ---
+func TestTaskEscrow_PayAndRefund(t *testing.T) {
+	// 1. Setup
+	k, ctx := setupKeeperWithBank(t, sdk.NewCoins(jct(1000)))
+	requester := testAddress("requester")
+	require.NoError(t, k.AddTaskEscrow(ctx, "1", jct(600)))
+	require.NoError(t, k.AddTaskEscrow(ctx, "1", jct(400)))
+
+	// 2. Execute and Assert: payments are discounted from the escrow, never more than escrowed
+	require.NoError(t, k.PayFromTaskEscrow(ctx, "1", jct(300)))
+	require.ErrorIs(t, k.PayFromTaskEscrow(ctx, "1", jct(800)), videoUpscaler.ErrInsufficientEscrow)
+	escrowed, err := k.TaskEscrows.Get(ctx, "1")
+	require.NoError(t, err)
+	require.Equal(t, jct(700), escrowed)
+
+	// a refund sends the coins back to the requester and an empty escrow is removed
+	require.NoError(t, k.RefundTaskEscrow(ctx, "1", requester, jct(700)))
+	has, err := k.TaskEscrows.Has(ctx, "1")
+	require.NoError(t, err)
+	require.False(t, has)
+	require.Equal(t, jct(700), k.BankKeeper.GetBalance(ctx, sdk.MustAccAddressFromBech32(requester), "jct"))
+	require.Error(t, k.RefundTaskEscrow(ctx, "1", requester, jct(1)))
+}
+
+// --- Test for GetModuleAccounting ---
+func TestGetModuleAccounting_Totals(t *testing.T) {
+	// 1. Setup: two escrowed tasks and two staked workers
+	k, ctx := setupKeeperWithBank(t, sdk.NewCoins(jct(1600)))
+	require.NoError(t, k.AddTaskEscrow(ctx, "1", jct(500)))
+	require.NoError(t, k.AddTaskEscrow(ctx, "2", jct(800)))
+	require.NoError(t, k.AddWorkerStake(ctx, "worker1", jct(100)))
+	require.NoError(t, k.AddWorkerStake(ctx, "worker2", jct(200)))
+	require.NoError(t, k.AddWorkerStake(ctx, "worker2", jct(50)))
+
+	// 2. Execute
+	require.NoError(t, k.PayFromTaskEscrow(ctx, "1", jct(200)))
+	require.NoError(t, k.PayFromTaskEscrow(ctx, "2", jct(100)))
+	accounting, err := k.GetModuleAccounting(ctx)
+	require.NoError(t, err)
+
+	// 3. Assert
+	require.Len(t, accounting.Escrows, 2)
+	require.Len(t, accounting.Stakes, 2)
+	require.Equal(t, sdk.NewCoins(jct(1000)), accounting.TotalEscrowed)
+	require.Equal(t, sdk.NewCoins(jct(350)), accounting.TotalStaked)
+	require.Equal(t, sdk.NewCoins(jct(300)), accounting.TotalPaidOut)
+	require.Equal(t, sdk.NewCoins(jct(1600)), accounting.ModuleBalance)
+}
+
+// --- Test for PayValidators ---
+func TestPayValidators_SharesByValidatedFrames(t *testing.T) {
+	// 1. Setup: the validators half of the thread reward is 500
+	k, ctx := setupKeeperWithBank(t, sdk.NewCoins(jct(1000)))
+	requester, validator1, validator2 := testAddress("requester"), testAddress("validator1"), testAddress("validator2")
+	task := newPaidTask(requester, testAddress("proposer"), validationOf(validator1, 3), validationOf(validator2, 1))
+	require.NoError(t, k.AddTaskEscrow(ctx, task.TaskId, jct(1000)))
+
+	// 2. Execute
+	require.NoError(t, k.PayValidators(ctx, task, task.Threads[0]))
+
+	// 3. Assert
+	require.Equal(t, jct(375), k.BankKeeper.GetBalance(ctx, sdk.MustAccAddressFromBech32(validator1), "jct"))
+	require.Equal(t, jct(125), k.BankKeeper.GetBalance(ctx, sdk.MustAccAddressFromBech32(validator2), "jct"))
+	require.True(t, k.BankKeeper.GetBalance(ctx, sdk.MustAccAddressFromBech32(requester), "jct").IsZero())
+	escrowed, err := k.TaskEscrows.Get(ctx, task.TaskId)
+	require.NoError(t, err)
+	require.Equal(t, jct(500), escrowed)
+	paid, err := k.PaidOut.Get(ctx, "jct")
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(500), paid)
+}
+
+func TestPayValidators_RefundsWithoutValidations(t *testing.T) {
+	// 1. Setup
+	k, ctx := setupKeeperWithBank(t, sdk.NewCoins(jct(1000)))
+	requester := testAddress("requester")
+	task := newPaidTask(requester, testAddress("proposer"))
+	require.NoError(t, k.AddTaskEscrow(ctx, task.TaskId, jct(1000)))
+
+	// 2. Execute
+	require.NoError(t, k.PayValidators(ctx, task, task.Threads[0]))
+
+	// 3. Assert: nobody validated, so the validators half goes back to the requester
+	require.Equal(t, jct(500), k.BankKeeper.GetBalance(ctx, sdk.MustAccAddressFromBech32(requester), "jct"))
+	accounting, err := k.GetModuleAccounting(ctx)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(jct(500)), accounting.TotalEscrowed)
+	require.True(t, accounting.TotalPaidOut.IsZero())
+	require.Equal(t, sdk.NewCoins(jct(500)), accounting.ModuleBalance)
+}
+
+// --- Test for SubmitSolution ---
+func TestSubmitSolution_RejectsUnpayableThreads(t *testing.T) {
+	// 1. Setup: a thread without solution, one not accepted yet and one already submitted
+	k, ctx := setupKeeper(t, 100)
+	proposer := testAddress("proposer")
+	task := newPaidTask(testAddress("requester"), proposer)
+	task.Threads = append(task.Threads,
+		&videoUpscaler.VideoUpscalerThread{ThreadId: "1-1"},
+		&videoUpscaler.VideoUpscalerThread{ThreadId: "1-2", Solution: &videoUpscaler.VideoUpscalerThread_Solution{ProposedBy: proposer}},
+	)
+	task.Threads[0].Completed = true
+	require.NoError(t, k.VideoUpscalerTasks.Set(ctx, task.TaskId, task))
+
+	// 2. Execute and Assert: none of them pays the proposer
+	ms := NewMsgServerImpl(k)
+	for _, threadId := range []string{"1-0", "1-1", "1-2", "1-3"} {
+		_, err := ms.SubmitSolution(ctx, &videoUpscaler.MsgSubmitSolution{Creator: proposer, TaskId: task.TaskId, ThreadId: threadId, Dir: "cid"})
+		require.Error(t, err, threadId)
+	}
+}
+
+// --- Test for the accounting genesis ---
+func TestGenesis_ExportsAndImportsAccounting(t *testing.T) {
+	// 1. Setup
+	k, ctx := setupKeeperWithBank(t, sdk.NewCoins(jct(1000)))
+	require.NoError(t, k.AddTaskEscrow(ctx, "1", jct(500)))
+	require.NoError(t, k.AddWorkerStake(ctx, "worker1", jct(100)))
+	require.NoError(t, k.PayFromTaskEscrow(ctx, "1", jct(200)))
+
+	// 2. Execute
+	genesis, err := k.ExportGenesis(ctx)
+	require.NoError(t, err)
+	require.NoError(t, genesis.Validate())
+	imported, importedCtx := setupKeeperWithBank(t, sdk.NewCoins(jct(1000)))
+	require.NoError(t, imported.InitGenesis(importedCtx, genesis))
+
+	// 3. Assert
+	expected, err := k.GetModuleAccounting(ctx)
+	require.NoError(t, err)
+	accounting, err := imported.GetModuleAccounting(importedCtx)
+	require.NoError(t, err)
+	require.Equal(t, expected, accounting)
+	require.Equal(t, sdk.NewCoins(jct(200)), genesis.PaidOut)
+}
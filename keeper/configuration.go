@@ -5,19 +5,56 @@ import (
 	"io/fs"
 	"log"
 	"os"
+	"runtime"
+	"time"
 
 	"github.com/BurntSushi/toml"
+
+	"github.com/janction/videoUpscaler"
+	"github.com/janction/videoUpscaler/vm"
 )
 
 type VideoConfiguration struct {
-	Enabled           bool   `toml:"enabled"`
-	WorkerName        string `toml:"worker_name"`
-	WorkerAddress     string `toml:"worker_address"`
-	WorkerKeyLocation string `toml:"worker_key_location"`
-	MinReward         int64  `toml:"min_reward"`
-	GPUAmount         int64  `toml:"gpu_amount"`
-	ConfigPath        string
-	RootPath          string
+	Enabled           bool    `toml:"enabled"`
+	WorkerName        string  `toml:"worker_name"`
+	WorkerAddress     string  `toml:"worker_address"`
+	WorkerKeyLocation string  `toml:"worker_key_location"`
+	MinReward         int64   `toml:"min_reward"`
+	GPUAmount         int64   `toml:"gpu_amount"`
+	CPUCores          int32   `toml:"cpu_cores"`
+	MemoryMB          int64   `toml:"memory_mb"`
+	DiskMB            int64   `toml:"disk_mb"`
+	ScaleFactors      []int32 `toml:"scale_factors"`
+	// upscaling backends the worker renders, like waifu2x-cpu, realesrgan-ncnn-cpu, ffmpeg-lanczos or
+	// ffmpeg-bicubic. If empty, only the default waifu2x-cpu backend
+	Backends          []string `toml:"backends"`
+	Models            []string `toml:"models"`
+	MaxInputWidth     int32    `toml:"max_input_width"`
+	MaxInputHeight    int32    `toml:"max_input_height"`
+	HeartbeatInterval int64    `toml:"heartbeat_interval"`
+	// full multiaddrs other nodes use to reach our IPFS node. If empty, the public ip is looked up
+	AnnounceAddrs []string `toml:"announce_addrs"`
+	// frames upscaled per container. 0 or 1 starts a container for each frame
+	RenderBatchSize int `toml:"render_batch_size"`
+	// renderer used to upscale frames: docker (default), local or fake
+	Renderer string `toml:"renderer"`
+	// unix socket of the docker daemon. Defaults to /var/run/docker.sock
+	DockerHost string `toml:"docker_host"`
+	// cpus and memory each upscaler container can use. 0 means no limit
+	RenderCPUs     float64 `toml:"render_cpus"`
+	RenderMemoryMB int64   `toml:"render_memory_mb"`
+	// seconds to upscale a single frame before the container is stopped. 0 means no timeout
+	RenderFrameTimeout int64 `toml:"render_frame_timeout"`
+	// times a range of frames is upscaled before the thread is given up. Defaults to 3
+	RenderMaxAttempts int `toml:"render_max_attempts"`
+	// seconds to wait before the first retry, doubled on each retry. Defaults to 10
+	RenderRetryBackoff int64 `toml:"render_retry_backoff"`
+	// uid:gid the upscaler container runs as. Defaults to the user of the node, or nobody if it runs as root
+	SandboxUser string `toml:"sandbox_user"`
+	// path of a seccomp profile for the upscaler container. Defaults to the docker profile
+	SeccompProfile string `toml:"seccomp_profile"`
+	ConfigPath     string
+	RootPath       string
 }
 
 func GetVideoUpscalerConfiguration(rootPath string) (*VideoConfiguration, error) {
@@ -52,6 +89,53 @@ func GetVideoUpscalerConfiguration(rootPath string) (*VideoConfiguration, error)
 	return &conf, nil
 }
 
+// GetMaxThreads returns the amount of threads this node renders concurrently, based on gpu_amount
+func (c VideoConfiguration) GetMaxThreads() int64 {
+	if c.GPUAmount < 1 {
+		return 1
+	}
+	return c.GPUAmount
+}
+
+// GetHeartbeatInterval returns every how many blocks this node sends a heartbeat. Defaults to 10
+func (c VideoConfiguration) GetHeartbeatInterval() int64 {
+	if c.HeartbeatInterval < 1 {
+		return 10
+	}
+	return c.HeartbeatInterval
+}
+
+// GetCapabilities returns the capabilities this node advertises. cpu_cores defaults to the cores of the machine
+func (c VideoConfiguration) GetCapabilities() videoUpscaler.WorkerCapabilities {
+	cores := c.CPUCores
+	if cores < 1 {
+		cores = int32(runtime.NumCPU())
+	}
+	return videoUpscaler.WorkerCapabilities{CpuCores: cores, MemoryMb: c.MemoryMB, DiskMb: c.DiskMB, ScaleFactors: c.ScaleFactors, Backends: c.Backends, Models: c.Models, MaxInputWidth: c.MaxInputWidth, MaxInputHeight: c.MaxInputHeight}
+}
+
+// GetDockerLimits returns the resources upscaler containers can use
+func (c VideoConfiguration) GetDockerLimits() vm.DockerLimits {
+	return vm.DockerLimits{CPUs: c.RenderCPUs, MemoryMB: c.RenderMemoryMB, FrameTimeout: time.Duration(c.RenderFrameTimeout) * time.Second}
+}
+
+// GetRetryPolicy returns how many times and how often frames that failed are upscaled again
+func (c VideoConfiguration) GetRetryPolicy() vm.RetryPolicy {
+	policy := vm.DefaultRetryPolicy()
+	if c.RenderMaxAttempts > 0 {
+		policy.MaxAttempts = c.RenderMaxAttempts
+	}
+	if c.RenderRetryBackoff > 0 {
+		policy.Backoff = time.Duration(c.RenderRetryBackoff) * time.Second
+	}
+	return policy
+}
+
+// GetSandbox returns the restrictions of upscaler containers
+func (c VideoConfiguration) GetSandbox() (vm.Sandbox, error) {
+	return vm.NewSandbox(c.SandboxUser, c.SeccompProfile)
+}
+
 func (c *VideoConfiguration) SaveConf() error {
 	// we make sure the root path exists. It might not be initialized
 	_, err := os.Stat(c.ConfigPath)
@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/janction/videoUpscaler"
+	"github.com/janction/videoUpscaler/videoUpscalerLogger"
+)
+
+// ExpireTasks marks the tasks that reached their expiry height as expired, releases the workers
+// of the threads without a solution and refunds the unused escrow to the requester.
+// The reward of threads with a proposed solution is kept so the winner and its validators can still be paid
+func (k Keeper) ExpireTasks(ctx context.Context) error {
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+
+	// we collect them first, we can't write while walking the collection
+	var expired []videoUpscaler.VideoUpscalerTask
+	err := k.VideoUpscalerTasks.Walk(ctx, nil, func(taskId string, task videoUpscaler.VideoUpscalerTask) (bool, error) {
+		if !task.Completed && !task.Expired && task.IsExpiredAt(height) {
+			expired = append(expired, task)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, task := range expired {
+		videoUpscalerLogger.Logger.Info("task %s expired at block %v", task.TaskId, height)
+		if err := k.expireTask(ctx, task); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (k Keeper) expireTask(ctx context.Context, task videoUpscaler.VideoUpscalerTask) error {
+	reserved := sdk.NewCoin(task.Reward.Denom, math.ZeroInt())
+	for _, thread := range task.Threads {
+		if !thread.Completed && thread.Solution != nil {
+			reserved = reserved.Add(task.GetWinnerReward(thread)).Add(task.GetValidatorsReward(thread))
+		}
+	}
+
+	// we refund first, so if it fails the task is expired again on next block
+	escrowed, err := k.TaskEscrows.Get(ctx, task.TaskId)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	if err == nil && reserved.IsLT(escrowed) {
+		refund := escrowed.Sub(reserved)
+		videoUpscalerLogger.Logger.Info("refunding %s of task %s to %s", refund, task.TaskId, task.Requester)
+		if err := k.RefundTaskEscrow(ctx, task.TaskId, task.Requester, refund); err != nil {
+			return err
+		}
+	}
+
+	// nobody will pay for threads without a solution, so we release their workers
+	for _, thread := range task.Threads {
+		if thread.Completed || thread.Solution != nil {
+			continue
+		}
+		for _, address := range thread.Workers {
+			worker, err := k.Workers.Get(ctx, address)
+			if err != nil {
+				continue
+			}
+			worker.ReleaseThread(task.TaskId, thread.ThreadId)
+			if err := k.Workers.Set(ctx, address, worker); err != nil {
+				return err
+			}
+		}
+	}
+
+	task.Expired = true
+	return k.VideoUpscalerTasks.Set(ctx, task.TaskId, task)
+}
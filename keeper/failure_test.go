@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/janction/videoUpscaler"
+)
+
+// --- Test for ReportThreadFailure ---
+func TestReportThreadFailure_MediaMismatchNeedsMostWorkers(t *testing.T) {
+	// 1. Setup: four workers render the task, two of them controlled by the same party
+	k, ctx := setupKeeper(t, 100)
+	task := videoUpscaler.VideoUpscalerTask{TaskId: "1", Threads: []*videoUpscaler.VideoUpscalerThread{
+		{ThreadId: "10", Workers: []string{"sybil1", "honest1"}},
+		{ThreadId: "11", Workers: []string{"sybil2", "honest2"}},
+	}}
+	require.NoError(t, k.VideoUpscalerTasks.Set(ctx, task.TaskId, task))
+	for i, address := range []string{"sybil1", "sybil2"} {
+		worker := videoUpscaler.Worker{Address: address, Enabled: true}
+		worker.AssignThread("1", i, task.Threads[i].ThreadId)
+		require.NoError(t, k.Workers.Set(ctx, address, worker))
+	}
+
+	// 2. Execute: both report the input doesn't match the media info
+	ms := NewMsgServerImpl(k)
+	for i, address := range []string{"sybil1", "sybil2"} {
+		_, err := ms.ReportThreadFailure(ctx, &videoUpscaler.MsgReportThreadFailure{Creator: address, TaskId: "1", ThreadId: task.Threads[i].ThreadId,
+			Reason: videoUpscaler.ThreadFailureReason_THREAD_FAILURE_REASON_MEDIA_MISMATCH, Message: "duration doesn't match"})
+		require.NoError(t, err)
+	}
+
+	// 3. Assert: the reports are recorded, but the task is not refunded
+	task, err := k.VideoUpscalerTasks.Get(ctx, "1")
+	require.NoError(t, err)
+	require.Equal(t, 2, task.CountMediaMismatchReports())
+	require.False(t, task.Expired)
+	require.Equal(t, []string{"honest1"}, task.Threads[0].Workers)
+	require.Equal(t, []string{"honest2"}, task.Threads[1].Workers)
+}
@@ -16,6 +16,22 @@ func (k *Keeper) InitGenesis(ctx context.Context, data *videoUpscaler.GenesisSta
 		return err
 	}
 
+	for _, escrow := range data.TaskEscrows {
+		if err := k.TaskEscrows.Set(ctx, escrow.TaskId, escrow.Escrowed); err != nil {
+			return err
+		}
+	}
+	for _, stake := range data.WorkerStakes {
+		if err := k.WorkerStakes.Set(ctx, stake.Worker, stake.Staked); err != nil {
+			return err
+		}
+	}
+	for _, paid := range data.PaidOut {
+		if err := k.PaidOut.Set(ctx, paid.Denom, paid.Amount); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -26,9 +42,25 @@ func (k *Keeper) ExportGenesis(ctx context.Context) (*videoUpscaler.GenesisState
 		return nil, err
 	}
 
+	accounting, err := k.GetModuleAccounting(ctx)
+	if err != nil {
+		return nil, err
+	}
+	escrows := make([]videoUpscaler.ModuleAccounting_TaskEscrow, 0, len(accounting.Escrows))
+	for _, escrow := range accounting.Escrows {
+		escrows = append(escrows, *escrow)
+	}
+	stakes := make([]videoUpscaler.ModuleAccounting_WorkerStake, 0, len(accounting.Stakes))
+	for _, stake := range accounting.Stakes {
+		stakes = append(stakes, *stake)
+	}
+
 	return &videoUpscaler.GenesisState{
 		Params:                params,
 		VideoUpscalerTaskList: []videoUpscaler.IndexedVideoUpscalerTask{},
 		VideoUpscalerTaskInfo: videoUpscaler.VideoUpscalerTaskInfo{NextId: 1},
+		TaskEscrows:           escrows,
+		WorkerStakes:          stakes,
+		PaidOut:               accounting.TotalPaidOut,
 	}, nil
 }
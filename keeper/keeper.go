@@ -6,10 +6,14 @@ import (
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
 	storetypes "cosmossdk.io/core/store"
+	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	"github.com/janction/videoUpscaler"
 	"github.com/janction/videoUpscaler/db"
+	"github.com/janction/videoUpscaler/videoUpscalerLogger"
+	"github.com/janction/videoUpscaler/vm"
 )
 
 type Keeper struct {
@@ -27,8 +31,13 @@ type Keeper struct {
 	VideoUpscalerTaskInfo collections.Item[videoUpscaler.VideoUpscalerTaskInfo]
 	VideoUpscalerTasks    collections.Map[string, videoUpscaler.VideoUpscalerTask]
 	Workers               collections.Map[string, videoUpscaler.Worker]
+	TaskEscrows           collections.Map[string, sdk.Coin]
+	WorkerStakes          collections.Map[string, sdk.Coin]
+	PaidOut               collections.Map[string, math.Int]
+	WorkerGroups          collections.Map[string, videoUpscaler.WorkerGroup]
 	Configuration         VideoConfiguration
 	DB                    db.DB
+	Renderer              vm.Renderer
 }
 
 // NewKeeper creates a new Keeper instance
@@ -45,6 +54,26 @@ func NewKeeper(cdc codec.BinaryCodec, addressCodec address.Codec, storeService s
 
 	config, _ := GetVideoUpscalerConfiguration(path)
 
+	sandbox, err := config.GetSandbox()
+	if err != nil {
+		videoUpscalerLogger.Logger.Error("%s, using the default sandbox", err.Error())
+		sandbox = vm.DefaultSandbox()
+	}
+	renderer, err := vm.NewRenderer(config.Renderer, config.DockerHost, config.GetDockerLimits(), sandbox)
+	if err != nil {
+		videoUpscalerLogger.Logger.Error("%s, using docker", err.Error())
+		renderer = vm.NewDockerRenderer(config.DockerHost, config.GetDockerLimits(), sandbox)
+	}
+
+	k := newKeeper(cdc, addressCodec, storeService, authority, bankKeeper)
+	k.Configuration = *config
+	k.DB = *db
+	k.Renderer = renderer
+	return k
+}
+
+// newKeeper creates the keeper with the module state, without the local database, configuration and renderer of the node
+func newKeeper(cdc codec.BinaryCodec, addressCodec address.Codec, storeService storetypes.KVStoreService, authority string, bankKeeper bankkeeper.BaseKeeper) Keeper {
 	sb := collections.NewSchemaBuilder(storeService)
 	k := Keeper{
 		cdc:                   cdc,
@@ -54,8 +83,10 @@ func NewKeeper(cdc codec.BinaryCodec, addressCodec address.Codec, storeService s
 		VideoUpscalerTaskInfo: collections.NewItem(sb, videoUpscaler.TaskInfoKey, "taskInfo", codec.CollValue[videoUpscaler.VideoUpscalerTaskInfo](cdc)),
 		VideoUpscalerTasks:    collections.NewMap(sb, videoUpscaler.VideoUpscalerTaskKey, "videoUpscalerTasks", collections.StringKey, codec.CollValue[videoUpscaler.VideoUpscalerTask](cdc)),
 		Workers:               collections.NewMap(sb, videoUpscaler.WorkerKey, "workers", collections.StringKey, codec.CollValue[videoUpscaler.Worker](cdc)),
-		Configuration:         *config,
-		DB:                    *db,
+		TaskEscrows:           collections.NewMap(sb, videoUpscaler.TaskEscrowKey, "taskEscrows", collections.StringKey, codec.CollValue[sdk.Coin](cdc)),
+		WorkerStakes:          collections.NewMap(sb, videoUpscaler.WorkerStakeKey, "workerStakes", collections.StringKey, codec.CollValue[sdk.Coin](cdc)),
+		PaidOut:               collections.NewMap(sb, videoUpscaler.PaidOutKey, "paidOut", collections.StringKey, sdk.IntValue),
+		WorkerGroups:          collections.NewMap(sb, videoUpscaler.WorkerGroupKey, "workerGroups", collections.StringKey, codec.CollValue[videoUpscaler.WorkerGroup](cdc)),
 		BankKeeper:            bankKeeper,
 	}
 
@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/janction/videoUpscaler"
+)
+
+// setupKeeper returns a keeper with an in memory store and default params, at the given block height
+func setupKeeper(t *testing.T, height int64) (Keeper, context.Context) {
+	key := storetypes.NewKVStoreKey(videoUpscaler.ModuleName)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+	ctx := testCtx.Ctx.WithBlockHeight(height)
+
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+	k := newKeeper(cdc, addresscodec.NewBech32Codec("cosmos"), runtime.NewKVStoreService(key), "", bankkeeper.BaseKeeper{})
+	require.NoError(t, k.Params.Set(ctx, videoUpscaler.DefaultParams()))
+	return k, sdk.WrapSDKContext(ctx)
+}
+
+// setupKeeperWithBank returns a keeper backed by a working bank keeper, and the module account funded with the given coins
+func setupKeeperWithBank(t *testing.T, moduleFunds sdk.Coins) (Keeper, context.Context) {
+	keys := storetypes.NewKVStoreKeys(videoUpscaler.ModuleName, authtypes.StoreKey, banktypes.StoreKey)
+	ctx := testutil.DefaultContextWithKeys(keys, nil, nil)
+
+	cdc := moduletestutil.MakeTestEncodingConfig(auth.AppModuleBasic{}, bank.AppModuleBasic{}).Codec
+	addressCodec := addresscodec.NewBech32Codec("cosmos")
+	authority := authtypes.NewModuleAddress("gov").String()
+	maccPerms := map[string][]string{minttypes.ModuleName: {authtypes.Minter}, videoUpscaler.ModuleName: nil}
+	accountKeeper := authkeeper.NewAccountKeeper(cdc, runtime.NewKVStoreService(keys[authtypes.StoreKey]), authtypes.ProtoBaseAccount, maccPerms, addressCodec, "cosmos", authority)
+	bankKeeper := bankkeeper.NewBaseKeeper(cdc, runtime.NewKVStoreService(keys[banktypes.StoreKey]), accountKeeper, map[string]bool{}, authority, ctx.Logger())
+
+	k := newKeeper(cdc, addressCodec, runtime.NewKVStoreService(keys[videoUpscaler.ModuleName]), authority, bankKeeper)
+	require.NoError(t, k.Params.Set(ctx, videoUpscaler.DefaultParams()))
+	require.NoError(t, bankKeeper.MintCoins(ctx, minttypes.ModuleName, moduleFunds))
+	require.NoError(t, bankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, videoUpscaler.ModuleName, moduleFunds))
+	return k, sdk.WrapSDKContext(ctx)
+}
@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"context"
+	"slices"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/janction/videoUpscaler"
+	"github.com/janction/videoUpscaler/videoUpscalerLogger"
+)
+
+// DisableInactiveWorkers disables the workers that missed heartbeats for more than
+// MaxMissedHeartbeatBlocks and releases them from the threads they were working on
+func (k Keeper) DisableInactiveWorkers(ctx context.Context) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if params.MaxMissedHeartbeatBlocks <= 0 {
+		return nil
+	}
+
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+
+	// we collect them first, we can't write while walking the collection
+	var inactive []videoUpscaler.Worker
+	err = k.Workers.Walk(ctx, nil, func(address string, worker videoUpscaler.Worker) (bool, error) {
+		if worker.Enabled && height-worker.LastHeartbeat > params.MaxMissedHeartbeatBlocks {
+			inactive = append(inactive, worker)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, worker := range inactive {
+		videoUpscalerLogger.Logger.Info("disabling worker %s, last heartbeat at block %v", worker.Address, worker.LastHeartbeat)
+		// proposers keep their threads, so they can still reveal and submit their solution once enabled again
+		var kept []*videoUpscaler.Worker_Assignment
+		for _, assignment := range worker.Assignments {
+			released, err := k.releaseWorkerFromThread(ctx, worker.Address, assignment)
+			if err != nil {
+				return err
+			}
+			if !released {
+				kept = append(kept, assignment)
+			}
+		}
+
+		worker.Enabled = false
+		worker.Assignments = kept
+		if err := k.Workers.Set(ctx, worker.Address, worker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// releaseWorkerFromThread removes the worker from a thread still in progress so another worker can take its place.
+// Workers that already proposed a solution are kept, and false is returned
+func (k Keeper) releaseWorkerFromThread(ctx context.Context, address string, assignment *videoUpscaler.Worker_Assignment) (bool, error) {
+	task, err := k.VideoUpscalerTasks.Get(ctx, assignment.TaskId)
+	if err != nil {
+		return false, err
+	}
+	if int(assignment.ThreadIndex) >= len(task.Threads) {
+		return true, nil
+	}
+
+	thread := task.Threads[assignment.ThreadIndex]
+	if thread.Completed {
+		return true, nil
+	}
+	if thread.Solution != nil && thread.Solution.ProposedBy == address {
+		return false, nil
+	}
+
+	thread.Workers = slices.DeleteFunc(thread.Workers, func(w string) bool { return w == address })
+	return true, k.VideoUpscalerTasks.Set(ctx, task.TaskId, task)
+}
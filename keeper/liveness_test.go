@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/janction/videoUpscaler"
+)
+
+// --- Test for DisableInactiveWorkers ---
+func TestDisableInactiveWorkers_Proposer(t *testing.T) {
+	// 1. Setup: the proposer of thread 0 and a worker still rendering thread 1 stopped sending heartbeats
+	k, ctx := setupKeeper(t, 500)
+	task := videoUpscaler.VideoUpscalerTask{TaskId: "1", Threads: []*videoUpscaler.VideoUpscalerThread{
+		{ThreadId: "10", Workers: []string{"proposer", "validator"}, Solution: &videoUpscaler.VideoUpscalerThread_Solution{ProposedBy: "proposer",
+			Frames: []*videoUpscaler.VideoUpscalerThread_Frame{{Filename: "frame_000000.png", Signature: "signature"}}}},
+		{ThreadId: "11", Workers: []string{"proposer"}},
+	}}
+	require.NoError(t, k.VideoUpscalerTasks.Set(ctx, task.TaskId, task))
+
+	worker := videoUpscaler.Worker{Address: "proposer", Enabled: true, LastHeartbeat: 100, MaxThreads: 2}
+	worker.AssignThread("1", 0, "10")
+	worker.AssignThread("1", 1, "11")
+	require.NoError(t, k.Workers.Set(ctx, worker.Address, worker))
+
+	// 2. Execute
+	require.NoError(t, k.DisableInactiveWorkers(ctx))
+
+	// 3. Assert: the worker is disabled, released from thread 11 but still holds the thread it proposed a solution for
+	worker, err := k.Workers.Get(ctx, "proposer")
+	require.NoError(t, err)
+	require.False(t, worker.Enabled)
+	require.NotNil(t, worker.GetAssignment("1", "10"))
+	require.Nil(t, worker.GetAssignment("1", "11"))
+
+	task, err = k.VideoUpscalerTasks.Get(ctx, "1")
+	require.NoError(t, err)
+	require.Equal(t, []string{"proposer", "validator"}, task.Threads[0].Workers)
+	require.Empty(t, task.Threads[1].Workers)
+
+	// 4. Once enabled again, the proposer can still reveal its solution
+	ms := NewMsgServerImpl(k)
+	_, err = ms.WorkerHeartbeat(ctx, &videoUpscaler.MsgWorkerHeartbeat{Creator: "proposer"})
+	require.NoError(t, err)
+	_, err = ms.RevealSolution(ctx, &videoUpscaler.MsgRevealSolution{Creator: "proposer", TaskId: "1", ThreadId: "10", Frames: []string{"frame_000000.png=QmFrame:hash"}})
+	require.NoError(t, err)
+
+	task, err = k.VideoUpscalerTasks.Get(ctx, "1")
+	require.NoError(t, err)
+	require.Equal(t, "QmFrame", task.Threads[0].Solution.Frames[0].Cid)
+}
@@ -2,10 +2,13 @@ package keeper
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"slices"
 	"strconv"
 	"strings"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -30,7 +33,7 @@ func NewMsgServerImpl(keeper Keeper) videoUpscaler.MsgServer {
 
 // CreateGame defines the handler for the MsgCreateVideoUpscalerTask message.
 func (ms msgServer) CreateVideoUpscalerTask(ctx context.Context, msg *videoUpscaler.MsgCreateVideoUpscalerTask) (*videoUpscaler.MsgCreateVideoUpscalerTaskResponse, error) {
-	videoUpscalerLogger.Logger.Info("CreateVideoUpscalerTask -  creator: %s, cid: %s, startFrame: %v, endFrame: %v, threads: %v, scale: %v, reward: %s", msg.Creator, msg.Cid, msg.StartFrame, msg.EndFrame, msg.Threads,msg.Scale, msg.Reward)
+	videoUpscalerLogger.Logger.Info("CreateVideoUpscalerTask -  creator: %s, cid: %s, startFrame: %v, endFrame: %v, threads: %v, scale: %v, reward: %s", msg.Creator, msg.Cid, msg.StartFrame, msg.EndFrame, msg.Threads, msg.Scale, msg.Reward)
 
 	// TODO had validations about the parameters
 	taskInfo, err := ms.k.VideoUpscalerTaskInfo.Get(ctx)
@@ -48,6 +51,77 @@ func (ms msgServer) CreateVideoUpscalerTask(ctx context.Context, msg *videoUpsca
 		return nil, sdkerrors.ErrAppConfig.Wrapf(videoUpscaler.ErrInvalidVideoUpscalerTask.Error(), "cid %s is invalid", msg.Cid)
 	}
 
+	if msg.EndFrame < msg.StartFrame {
+		videoUpscalerLogger.Logger.Error("invalid frame range %v-%v", msg.StartFrame, msg.EndFrame)
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoUpscaler.ErrInvalidVideoUpscalerTask.Error(), "invalid frame range %v-%v", msg.StartFrame, msg.EndFrame)
+	}
+
+	// threads are only required if they are not sized on the fly
+	if msg.TargetThreadSeconds < 0 || (msg.TargetThreadSeconds == 0 && msg.Threads < 1) {
+		videoUpscalerLogger.Logger.Error("invalid threads %v or target thread seconds %v", msg.Threads, msg.TargetThreadSeconds)
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoUpscaler.ErrInvalidVideoUpscalerTask.Error(), "invalid threads %v or target thread seconds %v", msg.Threads, msg.TargetThreadSeconds)
+	}
+
+	options, err := videoUpscaler.NewUpscaleOptions(msg.Scale, msg.Requirements.GetBackend(), msg.Options)
+	if err != nil {
+		videoUpscalerLogger.Logger.Error("invalid upscale options: %s", err.Error())
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoUpscaler.ErrInvalidVideoUpscalerTask.Error(), "invalid upscale options: %s", err.Error())
+	}
+	if options.Validation == videoUpscaler.ValidationMode_VALIDATION_MODE_FINGERPRINT && options.MaxFingerprintDistance == 0 {
+		// the tolerance is fixed when the task is created, so later params changes don't affect it
+		params, err := ms.k.Params.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		options.MaxFingerprintDistance = params.MaxFingerprintDistance
+	}
+
+	if msg.Requirements != nil {
+		if err := msg.Requirements.Validate(); err != nil {
+			videoUpscalerLogger.Logger.Error("invalid requirements: %s", err.Error())
+			return nil, sdkerrors.ErrAppConfig.Wrapf(videoUpscaler.ErrInvalidVideoUpscalerTask.Error(), "invalid requirements: %s", err.Error())
+		}
+	}
+
+	if msg.Access != nil {
+		if err := ms.validateTaskAccess(ctx, msg.Access); err != nil {
+			videoUpscalerLogger.Logger.Error("invalid access: %s", err.Error())
+			return nil, sdkerrors.ErrAppConfig.Wrapf(videoUpscaler.ErrInvalidVideoUpscalerTask.Error(), "invalid access: %s", err.Error())
+		}
+	}
+
+	if msg.ExpiryHeight < 0 || (msg.ExpiryHeight > 0 && msg.ExpiryHeight <= types.UnwrapSDKContext(ctx).BlockHeight()) {
+		videoUpscalerLogger.Logger.Error("invalid expiry height %v", msg.ExpiryHeight)
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoUpscaler.ErrInvalidVideoUpscalerTask.Error(), "expiry height %v must be a future block", msg.ExpiryHeight)
+	}
+
+	// the priority fee is paid with the reward, so it must use the same denom
+	if msg.PriorityFee != nil && (msg.PriorityFee.Denom != msg.Reward.Denom || !msg.PriorityFee.IsValid()) {
+		videoUpscalerLogger.Logger.Error("invalid priority fee %s", msg.PriorityFee)
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoUpscaler.ErrInvalidVideoUpscalerTask.Error(), "priority fee %s must be a valid amount of %s", msg.PriorityFee, msg.Reward.Denom)
+	}
+
+	if err := msg.InputType.Validate(); err != nil {
+		videoUpscalerLogger.Logger.Error("invalid input type: %s", err.Error())
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoUpscaler.ErrInvalidVideoUpscalerTask.Error(), "invalid input type: %s", err.Error())
+	}
+	// workers can only probe video files
+	if msg.MediaInfo != nil && msg.InputType != videoUpscaler.InputType_INPUT_TYPE_VIDEO {
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoUpscaler.ErrInvalidVideoUpscalerTask.Error(), "media info is only supported for video inputs")
+	}
+
+	if msg.MediaInfo != nil {
+		if err := msg.MediaInfo.Validate(); err != nil {
+			videoUpscalerLogger.Logger.Error("invalid media info: %s", err.Error())
+			return nil, sdkerrors.ErrAppConfig.Wrapf(videoUpscaler.ErrInvalidVideoUpscalerTask.Error(), "invalid media info: %s", err.Error())
+		}
+		// threads past the end of the video would never produce output
+		if err := msg.MediaInfo.ValidateFrameRange(msg.StartFrame, msg.EndFrame); err != nil {
+			videoUpscalerLogger.Logger.Error("invalid frame range: %s", err.Error())
+			return nil, sdkerrors.ErrAppConfig.Wrapf(videoUpscaler.ErrInvalidVideoUpscalerTask.Error(), "invalid frame range: %s", err.Error())
+		}
+	}
+
 	var nextId = taskInfo.NextId
 	// we get the taskId in string
 	taskId := strconv.FormatInt(nextId, 10)
@@ -56,14 +130,25 @@ func (ms msgServer) CreateVideoUpscalerTask(ctx context.Context, msg *videoUpsca
 	nextId++
 	ms.k.VideoUpscalerTaskInfo.Set(ctx, videoUpscaler.VideoUpscalerTaskInfo{NextId: nextId})
 
-	videoTask := videoUpscaler.VideoUpscalerTask{TaskId: taskId, Requester: msg.Creator, Cid: msg.Cid, StartFrame: msg.StartFrame, EndFrame: msg.EndFrame, Scale: msg.Scale, Completed: false, ThreadAmount: msg.Threads, Reward: msg.Reward}
+	videoTask := videoUpscaler.VideoUpscalerTask{TaskId: taskId, Requester: msg.Creator, Cid: msg.Cid, StartFrame: msg.StartFrame, EndFrame: msg.EndFrame, Scale: options.Scale, Options: &options, Completed: false, ThreadAmount: msg.Threads, Reward: msg.Reward, TargetThreadSeconds: msg.TargetThreadSeconds, Requirements: msg.Requirements, Access: msg.Access, ExpiryHeight: msg.ExpiryHeight, PriorityFee: msg.PriorityFee, MediaInfo: msg.MediaInfo, InputType: msg.InputType}
 	threads := videoTask.GenerateThreads(taskId)
 	videoTask.Threads = threads
 
-	// the module will keep the reward to be distributed later
+	// the module will keep the reward and priority fee to be distributed later
 	// TODO Add validations for msg.Creator
 	addr, _ := types.AccAddressFromBech32(msg.Creator)
-	ms.k.BankKeeper.SendCoinsFromAccountToModule(ctx, addr, videoUpscaler.ModuleName, types.NewCoins(*msg.Reward))
+	escrow := videoTask.GetTotalReward()
+	err = ms.k.BankKeeper.SendCoinsFromAccountToModule(ctx, addr, videoUpscaler.ModuleName, types.NewCoins(escrow))
+	if err != nil {
+		videoUpscalerLogger.Logger.Error("Escrowing task reward: %s", err.Error())
+		return nil, err
+	}
+
+	// we keep track of the escrowed reward of the task
+	if err := ms.k.AddTaskEscrow(ctx, taskId, escrow); err != nil {
+		videoUpscalerLogger.Logger.Error("Recording task escrow: %s", err.Error())
+		return nil, err
+	}
 
 	// we create the task
 	if err := ms.k.VideoUpscalerTasks.Set(ctx, taskId, videoTask); err != nil {
@@ -112,9 +197,31 @@ func (ms msgServer) AddWorker(ctx context.Context, msg *videoUpscaler.MsgAddWork
 		return &videoUpscaler.MsgAddWorkerResponse{Ok: false, Message: error.Error()}, error
 	}
 
+	if msg.MaxThreads < 0 {
+		error := sdkerrors.ErrAppConfig.Wrapf(videoUpscaler.ErrWorkerNotAvailable.Error(), "max threads %v is invalid", msg.MaxThreads)
+		videoUpscalerLogger.Logger.Error(error.Error())
+		return &videoUpscaler.MsgAddWorkerResponse{Ok: false, Message: error.Error()}, error
+	}
+
+	for _, addr := range msg.AnnounceAddrs {
+		if err := ipfs.ValidateAnnounceAddr(addr); err != nil {
+			error := sdkerrors.ErrAppConfig.Wrapf(videoUpscaler.ErrInvalidWorkerAddress.Error(), err.Error())
+			videoUpscalerLogger.Logger.Error(error.Error())
+			return &videoUpscaler.MsgAddWorkerResponse{Ok: false, Message: error.Error()}, error
+		}
+	}
+
+	if msg.Capabilities != nil {
+		if err := msg.Capabilities.Validate(); err != nil {
+			error := sdkerrors.ErrAppConfig.Wrapf(videoUpscaler.ErrWorkerCapabilities.Error(), "invalid capabilities: %s", err.Error())
+			videoUpscalerLogger.Logger.Error(error.Error())
+			return &videoUpscaler.MsgAddWorkerResponse{Ok: false, Message: error.Error()}, error
+		}
+	}
+
 	// worker is not previously registered, so we move on
 	reputation := videoUpscaler.Worker_Reputation{Points: 0, Staked: &msg.Stake, Validations: 0, Solutions: 0, Winnings: types.NewCoin(params.MinWorkerStaking.Denom, math.NewInt(0))}
-	worker := videoUpscaler.Worker{Address: msg.Creator, Reputation: &reputation, Enabled: true, PublicIp: msg.PublicIp, IpfsId: msg.IpfsId}
+	worker := videoUpscaler.Worker{Address: msg.Creator, Reputation: &reputation, Enabled: true, PublicIp: msg.PublicIp, IpfsId: msg.IpfsId, MaxThreads: msg.MaxThreads, Capabilities: msg.Capabilities, LastHeartbeat: types.UnwrapSDKContext(ctx).BlockHeight(), AnnounceAddrs: msg.AnnounceAddrs}
 
 	err = ms.k.Workers.Set(ctx, msg.Creator, worker)
 	if err != nil {
@@ -129,9 +236,163 @@ func (ms msgServer) AddWorker(ctx context.Context, msg *videoUpscaler.MsgAddWork
 		return &videoUpscaler.MsgAddWorkerResponse{Ok: false, Message: err.Error()}, err
 	}
 
+	err = ms.k.AddWorkerStake(ctx, msg.Creator, msg.Stake)
+	if err != nil {
+		videoUpscalerLogger.Logger.Error("Recording worker's stake: %s", err.Error())
+		return &videoUpscaler.MsgAddWorkerResponse{Ok: false, Message: err.Error()}, err
+	}
+
 	return &videoUpscaler.MsgAddWorkerResponse{Ok: true, Message: "Worker added correctly"}, nil
 }
 
+// validateTaskAccess makes sure the addresses are valid and the worker group exists
+func (ms msgServer) validateTaskAccess(ctx context.Context, access *videoUpscaler.TaskAccess) error {
+	if err := ms.k.validateAddresses(access.AllowedWorkers); err != nil {
+		return err
+	}
+	if err := ms.k.validateAddresses(access.DeniedWorkers); err != nil {
+		return err
+	}
+
+	if access.WorkerGroup != "" {
+		found, err := ms.k.WorkerGroups.Has(ctx, access.WorkerGroup)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("worker group %s doesn't exists", access.WorkerGroup)
+		}
+	}
+	return nil
+}
+
+// SetWorkerGroup creates a worker group, or replaces its members if the sender is the owner
+func (ms msgServer) SetWorkerGroup(ctx context.Context, msg *videoUpscaler.MsgSetWorkerGroup) (*videoUpscaler.MsgSetWorkerGroupResponse, error) {
+	videoUpscalerLogger.Logger.Info("SetWorkerGroup - creator: %s, name: %s, members: %s", msg.Creator, msg.Name, msg.Members)
+
+	if msg.Name == "" {
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoUpscaler.ErrInvalidWorkerGroup.Error(), "group name can't be empty")
+	}
+
+	if err := ms.k.validateAddresses(msg.Members); err != nil {
+		videoUpscalerLogger.Logger.Error("invalid group members: %s", err.Error())
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoUpscaler.ErrInvalidWorkerGroup.Error(), "invalid members: %s", err.Error())
+	}
+
+	group, err := ms.k.WorkerGroups.Get(ctx, msg.Name)
+	if err == nil && group.Owner != msg.Creator {
+		videoUpscalerLogger.Logger.Error("group %s is owned by %s", msg.Name, group.Owner)
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoUpscaler.ErrInvalidWorkerGroup.Error(), "group %s is owned by %s", msg.Name, group.Owner)
+	}
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+
+	group = videoUpscaler.WorkerGroup{Name: msg.Name, Owner: msg.Creator, Members: msg.Members}
+	if err := ms.k.WorkerGroups.Set(ctx, msg.Name, group); err != nil {
+		return nil, err
+	}
+	return &videoUpscaler.MsgSetWorkerGroupResponse{}, nil
+}
+
+// UpdateWorker replaces the network details of a registered worker
+func (ms msgServer) UpdateWorker(ctx context.Context, msg *videoUpscaler.MsgUpdateWorker) (*videoUpscaler.MsgUpdateWorkerResponse, error) {
+	videoUpscalerLogger.Logger.Info("UpdateWorker - creator: %s, ipfsId: %s, publicIp: %s, announceAddrs: %s", msg.Creator, msg.IpfsId, msg.PublicIp, msg.AnnounceAddrs)
+
+	worker, err := ms.k.Workers.Get(ctx, msg.Creator)
+	if err != nil {
+		videoUpscalerLogger.Logger.Error("Getting Worker: %s", err.Error())
+		return nil, err
+	}
+
+	if msg.PublicIp == "" && len(msg.AnnounceAddrs) == 0 {
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoUpscaler.ErrInvalidWorkerAddress.Error(), "worker %s needs a public ip or announce addresses", msg.Creator)
+	}
+
+	for _, addr := range msg.AnnounceAddrs {
+		if err := ipfs.ValidateAnnounceAddr(addr); err != nil {
+			videoUpscalerLogger.Logger.Error(err.Error())
+			return nil, sdkerrors.ErrAppConfig.Wrapf(videoUpscaler.ErrInvalidWorkerAddress.Error(), err.Error())
+		}
+	}
+
+	worker.PublicIp = msg.PublicIp
+	worker.AnnounceAddrs = msg.AnnounceAddrs
+	// the ipfs id is kept if not provided
+	if msg.IpfsId != "" {
+		worker.IpfsId = msg.IpfsId
+	}
+
+	if err := ms.k.Workers.Set(ctx, msg.Creator, worker); err != nil {
+		return nil, err
+	}
+	return &videoUpscaler.MsgUpdateWorkerResponse{}, nil
+}
+
+// UpdateWorkerCapabilities replaces the capabilities of a registered worker
+func (ms msgServer) UpdateWorkerCapabilities(ctx context.Context, msg *videoUpscaler.MsgUpdateWorkerCapabilities) (*videoUpscaler.MsgUpdateWorkerCapabilitiesResponse, error) {
+	videoUpscalerLogger.Logger.Info("UpdateWorkerCapabilities - creator: %s, capabilities: %s", msg.Creator, msg.Capabilities)
+
+	worker, err := ms.k.Workers.Get(ctx, msg.Creator)
+	if err != nil {
+		videoUpscalerLogger.Logger.Error("Getting Worker: %s", err.Error())
+		return nil, err
+	}
+
+	if msg.Capabilities == nil {
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoUpscaler.ErrWorkerCapabilities.Error(), "capabilities of worker %s are missing", msg.Creator)
+	}
+
+	if err := msg.Capabilities.Validate(); err != nil {
+		videoUpscalerLogger.Logger.Error("invalid capabilities: %s", err.Error())
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoUpscaler.ErrWorkerCapabilities.Error(), "invalid capabilities: %s", err.Error())
+	}
+
+	// threads already assigned are kept, new capabilities apply to the next subscriptions
+	worker.Capabilities = msg.Capabilities
+	if err := ms.k.Workers.Set(ctx, msg.Creator, worker); err != nil {
+		return nil, err
+	}
+	return &videoUpscaler.MsgUpdateWorkerCapabilitiesResponse{}, nil
+}
+
+// WorkerHeartbeat records the block of the last heartbeat of the worker, enabling it if it was disabled
+func (ms msgServer) WorkerHeartbeat(ctx context.Context, msg *videoUpscaler.MsgWorkerHeartbeat) (*videoUpscaler.MsgWorkerHeartbeatResponse, error) {
+	worker, err := ms.k.Workers.Get(ctx, msg.Creator)
+	if err != nil {
+		videoUpscalerLogger.Logger.Error("Getting Worker: %s", err.Error())
+		return nil, err
+	}
+
+	if !worker.Enabled {
+		videoUpscalerLogger.Logger.Info("re-enabling worker %s after heartbeat", msg.Creator)
+	}
+
+	worker.LastHeartbeat = types.UnwrapSDKContext(ctx).BlockHeight()
+	worker.Enabled = true
+	if err := ms.k.Workers.Set(ctx, msg.Creator, worker); err != nil {
+		return nil, err
+	}
+	return &videoUpscaler.MsgWorkerHeartbeatResponse{}, nil
+}
+
+// UpdateParams replaces the module params. Only the module authority, typically governance, can update them
+func (ms msgServer) UpdateParams(ctx context.Context, msg *videoUpscaler.MsgUpdateParams) (*videoUpscaler.MsgUpdateParamsResponse, error) {
+	if msg.Authority != ms.k.GetAuthority() {
+		videoUpscalerLogger.Logger.Error("%s is not the authority to update params", msg.Authority)
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoUpscaler.ErrInvalidParams.Error(), "%s is not the authority, expected %s", msg.Authority, ms.k.GetAuthority())
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoUpscaler.ErrInvalidParams.Error(), "%s", err.Error())
+	}
+
+	if err := ms.k.Params.Set(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+	return &videoUpscaler.MsgUpdateParamsResponse{}, nil
+}
+
 func (ms msgServer) SubscribeWorkerToTask(ctx context.Context, msg *videoUpscaler.MsgSubscribeWorkerToTask) (*videoUpscaler.MsgSubscribeWorkerToTaskResponse, error) {
 	videoUpscalerLogger.Logger.Info("SubscribeWorkerToTask - address: %s, taskId: %s, threadId: %s", msg.Address, msg.TaskId, msg.ThreadId)
 
@@ -145,6 +406,11 @@ func (ms msgServer) SubscribeWorkerToTask(ctx context.Context, msg *videoUpscale
 		videoUpscalerLogger.Logger.Debug("Worker not enabled: %s", worker.String())
 		return nil, sdkerrors.ErrAppConfig.Wrapf(videoUpscaler.ErrWorkerNotAvailable.Error(), "worker (%s) it nos enabled or doesn't exists", msg.Address)
 	}
+
+	if !worker.HasCapacity() {
+		videoUpscalerLogger.Logger.Debug("Worker %s is already working on %v threads", worker.Address, len(worker.Assignments))
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoUpscaler.ErrWorkerNotAvailable.Error(), "worker (%s) is already working on %v threads", msg.Address, worker.ThreadCapacity())
+	}
 	task, err := ms.k.VideoUpscalerTasks.Get(ctx, msg.TaskId)
 	if err != nil {
 		videoUpscalerLogger.Logger.Error("Getting task: %s", err.Error())
@@ -154,9 +420,52 @@ func (ms msgServer) SubscribeWorkerToTask(ctx context.Context, msg *videoUpscale
 		videoUpscalerLogger.Logger.Debug("Task is completed: %s", task.String())
 		return nil, sdkerrors.ErrAppConfig.Wrapf(videoUpscaler.ErrWorkerTaskNotAvailable.Error(), "task (%s) is already completed. Can't subscribe worker", msg.TaskId)
 	}
+	if task.Expired {
+		videoUpscalerLogger.Logger.Debug("Task is expired: %s", task.TaskId)
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoUpscaler.ErrWorkerTaskNotAvailable.Error(), "task (%s) is expired. Can't subscribe worker", msg.TaskId)
+	}
+
+	if err := ms.k.CanWorkerTakeTask(ctx, worker.Address, task); err != nil {
+		videoUpscalerLogger.Logger.Debug("Worker %s can't take task %s: %s", worker.Address, task.TaskId, err.Error())
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoUpscaler.ErrWorkerNotAllowed.Error(), "worker (%s) can't take task (%s): %s", msg.Address, msg.TaskId, err.Error())
+	}
+
+	if err := worker.CanProcess(task); err != nil {
+		videoUpscalerLogger.Logger.Debug("Worker %s can't process task %s: %s", worker.Address, task.TaskId, err.Error())
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoUpscaler.ErrWorkerCapabilities.Error(), "worker (%s) can't process task (%s): %s", msg.Address, msg.TaskId, err.Error())
+	}
 
 	// we get the params to get the MaxWorkersPerThread value
 	params, _ := ms.k.Params.Get(ctx)
+
+	// on adaptive tasks, an empty thread asks for a new thread sized for this worker
+	if msg.ThreadId == "" && task.IsAdaptive() {
+		if !task.HasPendingFrames() {
+			return nil, sdkerrors.ErrAppConfig.Wrapf(videoUpscaler.ErrWorkerTaskNotAvailable.Error(), "task (%s) has no frames left to assign", msg.TaskId)
+		}
+
+		frameSeconds := worker.EstimateFrameSeconds()
+		if frameSeconds == 0 {
+			frameSeconds = task.GetAverageRenderSeconds()
+		}
+		if frameSeconds == 0 {
+			frameSeconds = params.DefaultFrameRenderSeconds
+		}
+
+		i, thread := task.CutThread(frameSeconds)
+		videoUpscalerLogger.Logger.Info("cut thread %s with frames %v-%v for worker %s (%v seconds per frame)", thread.ThreadId, thread.StartFrame, thread.EndFrame, worker.Address, frameSeconds)
+		thread.Workers = append(thread.Workers, msg.Address)
+
+		worker.AssignThread(task.TaskId, i, thread.ThreadId)
+		if err := ms.k.Workers.Set(ctx, msg.Address, worker); err != nil {
+			return nil, err
+		}
+		if err := ms.k.VideoUpscalerTasks.Set(ctx, task.TaskId, task); err != nil {
+			return nil, err
+		}
+		return &videoUpscaler.MsgSubscribeWorkerToTaskResponse{ThreadId: thread.ThreadId}, nil
+	}
+
 	for i, v := range task.Threads {
 		if v.ThreadId == msg.ThreadId {
 			if len(v.Workers) < int(params.MaxWorkersPerThread) && !v.Completed {
@@ -165,11 +474,13 @@ func (ms msgServer) SubscribeWorkerToTask(ctx context.Context, msg *videoUpscale
 					videoUpscalerLogger.Logger.Info("worker %s is already working at thread %s, skipping...", worker.Address, v.ThreadId)
 					return nil, nil
 				}
+				if v.HasFailed(worker.Address) {
+					return nil, sdkerrors.ErrAppConfig.Wrapf(videoUpscaler.ErrWorkerNotAvailable.Error(), "worker (%s) already gave up thread (%s)", msg.Address, v.ThreadId)
+				}
 
 				v.Workers = append(v.Workers, msg.Address)
 
-				worker.CurrentTaskId = task.TaskId
-				worker.CurrentThreadIndex = int32(i)
+				worker.AssignThread(task.TaskId, i, v.ThreadId)
 				ms.k.Workers.Set(ctx, msg.Address, worker)
 
 				err := ms.k.VideoUpscalerTasks.Set(ctx, task.TaskId, task)
@@ -206,11 +517,16 @@ func (ms msgServer) ProposeSolution(ctx context.Context, msg *videoUpscaler.MsgP
 	}
 
 	// task must exists and be in progress
-	if task.Completed {
+	if task.Completed || task.Expired {
 		videoUpscalerLogger.Logger.Error("Task %s is not valid to accept solutions", msg.TaskId)
 		return nil, sdkerrors.ErrAppConfig.Wrapf(videoUpscaler.ErrInvalidSolution.Error(), "Task %s is not valid to accept solutions", msg.TaskId)
 	}
 
+	params, err := ms.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	for i, v := range task.Threads {
 		// TODO threads might be better as map instead of slice
 		if v.ThreadId == msg.ThreadId {
@@ -224,6 +540,12 @@ func (ms msgServer) ProposeSolution(ctx context.Context, msg *videoUpscaler.MsgP
 				return nil, sdkerrors.ErrAppConfig.Wrapf(videoUpscaler.ErrInvalidSolution.Error(), "Worker %s is not valid at thread %s", msg.Creator, msg.ThreadId)
 			}
 
+			// frames must be rendered with the image pinned for the task backend
+			if image, found := params.GetUpscalerImage(task.GetBackend()); found && image.Digest() != msg.ImageDigest {
+				videoUpscalerLogger.Logger.Error("solution was rendered with image %s, %s is required", msg.ImageDigest, image.Digest())
+				return nil, sdkerrors.ErrAppConfig.Wrapf(videoUpscaler.ErrInvalidSolution.Error(), "solution was rendered with image %s, %s is required", msg.ImageDigest, image.Digest())
+			}
+
 			// solution len must be equal to the frames generated
 			if len(msg.Signatures) != (int(v.EndFrame) - int(v.StartFrame) + 1) {
 				videoUpscalerLogger.Logger.Error("amount of files in solution is incorrect, %v ", len(msg.Signatures))
@@ -251,7 +573,7 @@ func (ms msgServer) ProposeSolution(ctx context.Context, msg *videoUpscaler.MsgP
 				videoUpscalerLogger.Logger.Error("unable to decode publicKey from msg %s: %s", msg.PublicKey, err.Error())
 				return nil, err
 			}
-			task.Threads[i].Solution = &videoUpscaler.VideoUpscalerThread_Solution{ProposedBy: msg.Creator, Frames: frames, PublicKey: msg.PublicKey}
+			task.Threads[i].Solution = &videoUpscaler.VideoUpscalerThread_Solution{ProposedBy: msg.Creator, Frames: frames, PublicKey: msg.PublicKey, ImageDigest: msg.ImageDigest}
 			err = ms.k.VideoUpscalerTasks.Set(ctx, msg.TaskId, task)
 
 			if err != nil {
@@ -283,7 +605,8 @@ func (ms msgServer) RevealSolution(ctx context.Context, msg *videoUpscaler.MsgRe
 		return nil, err
 	}
 
-	if worker.CurrentTaskId != msg.TaskId {
+	assignment := worker.GetAssignment(msg.TaskId, msg.ThreadId)
+	if assignment == nil {
 		videoUpscalerLogger.Logger.Error("worker is not working on task")
 		return nil, sdkerrors.ErrAppConfig.Wrapf(videoUpscaler.ErrInvalidVerification.Error(), "worker is not working on task")
 	}
@@ -293,7 +616,7 @@ func (ms msgServer) RevealSolution(ctx context.Context, msg *videoUpscaler.MsgRe
 		return nil, sdkerrors.ErrAppConfig.Wrapf(videoUpscaler.ErrInvalidVerification.Error(), "task is already completed. No more validations accepted")
 	}
 
-	thread := task.Threads[worker.CurrentThreadIndex]
+	thread := task.Threads[assignment.ThreadIndex]
 
 	if thread.Solution.Accepted {
 		videoUpscalerLogger.Logger.Error("solution has already been accepted")
@@ -332,6 +655,7 @@ func (ms msgServer) RevealSolution(ctx context.Context, msg *videoUpscaler.MsgRe
 		// we reveal the solution
 		thread.Solution.Frames[idx].Cid = frame.Cid
 		thread.Solution.Frames[idx].Hash = frame.Hash
+		thread.Solution.Frames[idx].Fingerprint = frame.Fingerprint
 	}
 
 	// We verify all frames in the solution have a CID revealed
@@ -342,7 +666,15 @@ func (ms msgServer) RevealSolution(ctx context.Context, msg *videoUpscaler.MsgRe
 		}
 	}
 
-	task.Threads[worker.CurrentThreadIndex] = thread
+	// fingerprints are compared with tolerance, so they must be the ones committed with the signatures of the proposal
+	if thread.IsFingerprintValidated() {
+		if err := thread.VerifyRevealedFingerprints(); err != nil {
+			videoUpscalerLogger.Logger.Error("invalid fingerprints revealed for thread %s: %s", thread.ThreadId, err.Error())
+			return nil, sdkerrors.ErrAppConfig.Wrapf(videoUpscaler.ErrInvalidVerification.Error(), "invalid fingerprints revealed: %s", err.Error())
+		}
+	}
+
+	task.Threads[assignment.ThreadIndex] = thread
 	ms.k.VideoUpscalerTasks.Set(ctx, msg.TaskId, task)
 	return nil, nil
 }
@@ -370,7 +702,8 @@ func (ms msgServer) SubmitValidation(ctx context.Context, msg *videoUpscaler.Msg
 		return nil, sdkerrors.ErrAppConfig.Wrapf(videoUpscaler.ErrInvalidVerification.Error(), "worker is not allowed to validate solutions")
 	}
 
-	if worker.CurrentTaskId != msg.TaskId {
+	assignment := worker.GetAssignment(msg.TaskId, msg.ThreadId)
+	if assignment == nil {
 		videoUpscalerLogger.Logger.Error("worker is not working on task")
 		return nil, sdkerrors.ErrAppConfig.Wrapf(videoUpscaler.ErrInvalidVerification.Error(), "worker is not working on task")
 	}
@@ -380,7 +713,7 @@ func (ms msgServer) SubmitValidation(ctx context.Context, msg *videoUpscaler.Msg
 		return nil, sdkerrors.ErrAppConfig.Wrapf(videoUpscaler.ErrInvalidVerification.Error(), "task is already completed. No more validations accepted")
 	}
 
-	thread := task.Threads[worker.CurrentThreadIndex]
+	thread := task.Threads[assignment.ThreadIndex]
 	if thread.ThreadId != msg.ThreadId {
 		videoUpscalerLogger.Logger.Error("worker is not working on thread")
 		return nil, sdkerrors.ErrAppConfig.Wrapf(videoUpscaler.ErrInvalidVerification.Error(), "worker is not working on thread")
@@ -396,23 +729,65 @@ func (ms msgServer) SubmitValidation(ctx context.Context, msg *videoUpscaler.Msg
 	for _, signatures := range msg.Signatures {
 		parts := strings.SplitN(signatures, "=", 2)
 
+		// validators of tasks validated by fingerprint only sign their fingerprints here, and reveal them with RevealValidation
 		frame := videoUpscaler.VideoUpscalerThread_Frame{Filename: parts[0], Signature: parts[1]}
 		frames = append(frames, &frame)
 	}
 
 	validation := videoUpscaler.VideoUpscalerThread_Validation{Validator: msg.Creator, IsReverse: thread.IsReverse(worker.Address), Frames: frames, PublicKey: msg.PublicKey}
-	task.Threads[worker.CurrentThreadIndex].Validations = append(thread.Validations, &validation)
+	task.Threads[assignment.ThreadIndex].Validations = append(thread.Validations, &validation)
 	ms.k.VideoUpscalerTasks.Set(ctx, msg.TaskId, task)
 
 	// we release the worker since there is nothing else for him to do on this thread
 	if worker.Address != thread.Solution.ProposedBy {
-		worker.ReleaseValidator()
+		worker.ReleaseThread(msg.TaskId, msg.ThreadId)
 		ms.k.Workers.Set(ctx, msg.Creator, worker)
 	}
 
 	return &videoUpscaler.MsgSubmitValidationResponse{}, nil
 }
 
+// RevealValidation records the fingerprints a validator signed with its validation, once the solution of the thread is revealed
+func (ms msgServer) RevealValidation(ctx context.Context, msg *videoUpscaler.MsgRevealValidation) (*videoUpscaler.MsgRevealValidationResponse, error) {
+	videoUpscalerLogger.Logger.Info("RevealValidation - creator: %s, taskId: %s, threadId: %s, fingerprints: %s", msg.Creator, msg.TaskId, msg.ThreadId, msg.Fingerprints)
+
+	task, err := ms.k.VideoUpscalerTasks.Get(ctx, msg.TaskId)
+	if err != nil {
+		videoUpscalerLogger.Logger.Error("Getting Task: %s", err.Error())
+		return nil, err
+	}
+
+	if task.Completed {
+		videoUpscalerLogger.Logger.Error("task is already completed. No more validations accepted")
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoUpscaler.ErrInvalidVerification.Error(), "task is already completed. No more validations accepted")
+	}
+
+	i := slices.IndexFunc(task.Threads, func(t *videoUpscaler.VideoUpscalerThread) bool { return t.ThreadId == msg.ThreadId })
+	if i < 0 {
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoUpscaler.ErrInvalidVerification.Error(), "thread %s doesn't exist", msg.ThreadId)
+	}
+	thread := task.Threads[i]
+	if !thread.IsFingerprintValidated() {
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoUpscaler.ErrInvalidVerification.Error(), "thread %s is not validated by fingerprint", msg.ThreadId)
+	}
+
+	fingerprints, err := videoUpscaler.TransformSliceToMap(msg.Fingerprints)
+	if err != nil {
+		videoUpscalerLogger.Logger.Error("invalid fingerprints: %s", err.Error())
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoUpscaler.ErrInvalidVerification.Error(), "invalid fingerprints: %s", err.Error())
+	}
+
+	if err := thread.RevealValidatorFingerprints(msg.Creator, fingerprints); err != nil {
+		videoUpscalerLogger.Logger.Error("invalid validation revealed for thread %s: %s", thread.ThreadId, err.Error())
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoUpscaler.ErrInvalidVerification.Error(), "invalid validation revealed: %s", err.Error())
+	}
+
+	if err := ms.k.VideoUpscalerTasks.Set(ctx, msg.TaskId, task); err != nil {
+		return nil, err
+	}
+	return &videoUpscaler.MsgRevealValidationResponse{}, nil
+}
+
 func (ms msgServer) SubmitSolution(ctx context.Context, msg *videoUpscaler.MsgSubmitSolution) (*videoUpscaler.MsgSubmitSolutionResponse, error) {
 	videoUpscalerLogger.Logger.Info("SubmitSolution - creator: %s, taskId: %s, threadId: %s, Dir: %s, AverageRenderSeconds: %v", msg.Creator, msg.TaskId, msg.ThreadId, msg.Dir, msg.AverageRenderSeconds)
 
@@ -421,56 +796,164 @@ func (ms msgServer) SubmitSolution(ctx context.Context, msg *videoUpscaler.MsgSu
 		videoUpscalerLogger.Logger.Error("Getting Task: %s", err.Error())
 		return nil, sdkerrors.ErrAppConfig.Wrapf(videoUpscaler.ErrInvalidSolution.Error(), "provided task doesn't exists")
 	}
-	for i, thread := range task.Threads {
-		if thread.ThreadId == msg.ThreadId {
+	i := slices.IndexFunc(task.Threads, func(t *videoUpscaler.VideoUpscalerThread) bool { return t.ThreadId == msg.ThreadId })
+	if i < 0 {
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoUpscaler.ErrInvalidSolution.Error(), "thread %s doesn't exist", msg.ThreadId)
+	}
+	thread := task.Threads[i]
+	if thread.Solution == nil {
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoUpscaler.ErrInvalidSolution.Error(), "thread %s has no proposed solution", msg.ThreadId)
+	}
+	if thread.Solution.ProposedBy != msg.Creator {
+		error := sdkerrors.ErrAppConfig.Wrapf(videoUpscaler.ErrInvalidSolution.Error(), "only the provider of the solution can upload it")
+		videoUpscalerLogger.Logger.Error(error.Error())
+		return nil, error
+	}
+	// the winner is paid only once, after the validators accepted the solution
+	if thread.Completed {
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoUpscaler.ErrInvalidSolution.Error(), "solution of thread %s was already submitted", msg.ThreadId)
+	}
+	if !thread.Solution.Accepted {
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoUpscaler.ErrInvalidSolution.Error(), "solution of thread %s is not accepted yet", msg.ThreadId)
+	}
 
-			if thread.Solution.ProposedBy != msg.Creator {
-				error := sdkerrors.ErrAppConfig.Wrapf(videoUpscaler.ErrInvalidSolution.Error(), "only the provider of the solution can upload it")
-				videoUpscalerLogger.Logger.Error(error.Error())
-				return nil, error
-			}
+	// we make sure ipfs is running
+	ipfs.EnsureIPFSRunning()
 
-			// we make sure ipfs is running
-			ipfs.EnsureIPFSRunning()
-
-			// we verify the solution
-			// err := thread.VerifySubmittedSolution(msg.Dir)
-			// if err != nil {
-			// 	return nil, sdkerrors.ErrAppConfig.Wrapf(videoUpscaler.ErrInvalidSolution.Error(), "submited solution is incorrect")
-			// }
-
-			// solution is verified so we pay the winner
-			addr, _ := types.AccAddressFromBech32(msg.Creator)
-			payment := task.GetWinnerReward()
-			ms.k.BankKeeper.SendCoinsFromModuleToAccount(ctx, videoUpscaler.ModuleName, addr, types.NewCoins(payment))
-			task.Threads[i].Solution.Dir = msg.Dir
-			task.Threads[i].AverageRenderSeconds = msg.AverageRenderSeconds
-			task.Threads[i].Completed = true
-			ms.k.VideoUpscalerTasks.Set(ctx, msg.TaskId, task)
-
-			// should we pay here the validators?
-			// TODO Implement
-
-			// a worker which didn't submit a validation might still be working on this task
-			// we release them
-			for _, val := range thread.Workers {
-				worker, _ := ms.k.Workers.Get(ctx, val)
-				if task.TaskId == worker.CurrentTaskId && thread.ThreadId == task.Threads[worker.CurrentThreadIndex].ThreadId {
-					// this worker is still active but work is completed. we release him
-					worker.CurrentTaskId = ""
-					worker.CurrentThreadIndex = 0
-					ms.k.Workers.Set(ctx, worker.Address, worker)
-				}
-			}
+	// we verify the solution
+	// err := thread.VerifySubmittedSolution(msg.Dir)
+	// if err != nil {
+	// 	return nil, sdkerrors.ErrAppConfig.Wrapf(videoUpscaler.ErrInvalidSolution.Error(), "submited solution is incorrect")
+	// }
+
+	// solution is verified so we pay the winner
+	addr, _ := types.AccAddressFromBech32(msg.Creator)
+	payment := task.GetWinnerReward(thread)
+	if err := ms.k.BankKeeper.SendCoinsFromModuleToAccount(ctx, videoUpscaler.ModuleName, addr, types.NewCoins(payment)); err != nil {
+		videoUpscalerLogger.Logger.Error("Paying winner %s: %s", msg.Creator, err.Error())
+		return nil, err
+	}
+	if err := ms.k.PayFromTaskEscrow(ctx, msg.TaskId, payment); err != nil {
+		videoUpscalerLogger.Logger.Error("Recording payment of task %s: %s", msg.TaskId, err.Error())
+		return nil, err
+	}
 
-			// we increase the reputation of the winner
-			worker, _ := ms.k.Workers.Get(ctx, msg.Creator)
-			worker.DeclareWinner(payment)
-			// we added this duration to the slice of average durations.
-			worker.Reputation.RenderDurations = append(worker.Reputation.RenderDurations, msg.AverageRenderSeconds)
-			ms.k.Workers.Set(ctx, msg.Creator, worker)
-			break
+	// and the validators, with the other half of the reward of the thread
+	if err := ms.k.PayValidators(ctx, task, thread); err != nil {
+		videoUpscalerLogger.Logger.Error("Paying validators of thread %s: %s", msg.ThreadId, err.Error())
+		return nil, err
+	}
+
+	thread.Solution.Dir = msg.Dir
+	thread.AverageRenderSeconds = msg.AverageRenderSeconds
+	thread.Completed = true
+	if err := ms.k.VideoUpscalerTasks.Set(ctx, msg.TaskId, task); err != nil {
+		return nil, err
+	}
+
+	// a worker which didn't submit a validation might still be working on this task
+	// we release them
+	for _, val := range thread.Workers {
+		worker, _ := ms.k.Workers.Get(ctx, val)
+		if worker.IsWorkingOn(task.TaskId, thread.ThreadId) {
+			// this worker is still active but work is completed. we release him
+			worker.ReleaseThread(task.TaskId, thread.ThreadId)
+			ms.k.Workers.Set(ctx, worker.Address, worker)
 		}
 	}
+
+	// we increase the reputation of the winner
+	worker, _ := ms.k.Workers.Get(ctx, msg.Creator)
+	worker.DeclareWinner(task.TaskId, thread.ThreadId, payment)
+	// we added this duration to the slice of average durations.
+	worker.Reputation.RenderDurations = append(worker.Reputation.RenderDurations, msg.AverageRenderSeconds)
+	ms.k.Workers.Set(ctx, msg.Creator, worker)
 	return &videoUpscaler.MsgSubmitSolutionResponse{}, nil
 }
+
+// ReportThreadFailure releases a worker that couldn't render a thread after retrying, without penalty.
+// The failure is recorded in the thread, so the worker is not assigned to it again and another worker takes its place
+func (ms msgServer) ReportThreadFailure(ctx context.Context, msg *videoUpscaler.MsgReportThreadFailure) (*videoUpscaler.MsgReportThreadFailureResponse, error) {
+	videoUpscalerLogger.Logger.Info("ReportThreadFailure - creator: %s, taskId: %s, threadId: %s, reason: %s", msg.Creator, msg.TaskId, msg.ThreadId, msg.Reason)
+
+	if err := msg.Reason.Validate(); err != nil {
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoUpscaler.ErrInvalidThreadFailure.Error(), "%s", err.Error())
+	}
+	if len(msg.Message) > videoUpscaler.MaxFailureMessageLength {
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoUpscaler.ErrInvalidThreadFailure.Error(), "message is longer than %v", videoUpscaler.MaxFailureMessageLength)
+	}
+
+	worker, err := ms.k.Workers.Get(ctx, msg.Creator)
+	if err != nil {
+		videoUpscalerLogger.Logger.Error("Getting Worker: %s", err.Error())
+		return nil, err
+	}
+
+	task, err := ms.k.VideoUpscalerTasks.Get(ctx, msg.TaskId)
+	if err != nil {
+		videoUpscalerLogger.Logger.Error("Getting Task: %s", err.Error())
+		return nil, err
+	}
+
+	i := slices.IndexFunc(task.Threads, func(t *videoUpscaler.VideoUpscalerThread) bool { return t.ThreadId == msg.ThreadId })
+	if i < 0 {
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoUpscaler.ErrInvalidThreadFailure.Error(), "thread %s doesn't exist", msg.ThreadId)
+	}
+	thread := task.Threads[i]
+	if thread.Completed || !slices.Contains(thread.Workers, msg.Creator) {
+		videoUpscalerLogger.Logger.Error("Worker %s is not working at thread %s", msg.Creator, msg.ThreadId)
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoUpscaler.ErrInvalidThreadFailure.Error(), "worker %s is not working at thread %s", msg.Creator, msg.ThreadId)
+	}
+	// the proposer of a solution must reveal it, it can't give up
+	if thread.Solution != nil && thread.Solution.ProposedBy == msg.Creator {
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoUpscaler.ErrInvalidThreadFailure.Error(), "worker %s already proposed a solution to thread %s", msg.Creator, msg.ThreadId)
+	}
+
+	thread.Workers = slices.DeleteFunc(thread.Workers, func(w string) bool { return w == msg.Creator })
+	thread.Failures = append(thread.Failures, &videoUpscaler.VideoUpscalerThread_Failure{Worker: msg.Creator, Reason: msg.Reason, Message: msg.Message, Height: types.UnwrapSDKContext(ctx).BlockHeight()})
+	if err := ms.k.VideoUpscalerTasks.Set(ctx, task.TaskId, task); err != nil {
+		return nil, err
+	}
+
+	worker.ReleaseThread(msg.TaskId, msg.ThreadId)
+	if err := ms.k.Workers.Set(ctx, worker.Address, worker); err != nil {
+		return nil, err
+	}
+
+	// the input is not what the requester described, so no worker will render it. We refund the task
+	params, err := ms.k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if msg.Reason == videoUpscaler.ThreadFailureReason_THREAD_FAILURE_REASON_MEDIA_MISMATCH && !task.Completed && !task.Expired && task.IsMediaMismatch(params.GetMediaMismatchReportsRequired()) {
+		videoUpscalerLogger.Logger.Info("input of task %s doesn't match its media info, refunding", task.TaskId)
+		if err := ms.k.expireTask(ctx, task); err != nil {
+			return nil, err
+		}
+	}
+	return &videoUpscaler.MsgReportThreadFailureResponse{}, nil
+}
+
+// SubmitFinalVideo records the final video of a completed task sent by its assembler, and its verification sent by a second worker
+func (ms msgServer) SubmitFinalVideo(ctx context.Context, msg *videoUpscaler.MsgSubmitFinalVideo) (*videoUpscaler.MsgSubmitFinalVideoResponse, error) {
+	videoUpscalerLogger.Logger.Info("SubmitFinalVideo - creator: %s, taskId: %s, cid: %s, hash: %s", msg.Creator, msg.TaskId, msg.Cid, msg.Hash)
+
+	if _, err := cid.Decode(msg.Cid); err != nil {
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoUpscaler.ErrInvalidFinalVideo.Error(), "cid %s is invalid", msg.Cid)
+	}
+
+	task, err := ms.k.VideoUpscalerTasks.Get(ctx, msg.TaskId)
+	if err != nil {
+		videoUpscalerLogger.Logger.Error("Getting Task: %s", err.Error())
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoUpscaler.ErrInvalidFinalVideo.Error(), "provided task doesn't exists")
+	}
+
+	if err := task.SubmitFinalVideo(msg.Creator, msg.Cid, msg.Hash, types.UnwrapSDKContext(ctx).BlockHeight()); err != nil {
+		videoUpscalerLogger.Logger.Error(err.Error())
+		return nil, sdkerrors.ErrAppConfig.Wrapf(videoUpscaler.ErrInvalidFinalVideo.Error(), "%s", err.Error())
+	}
+	if err := ms.k.VideoUpscalerTasks.Set(ctx, task.TaskId, task); err != nil {
+		return nil, err
+	}
+	return &videoUpscaler.MsgSubmitFinalVideoResponse{}, nil
+}
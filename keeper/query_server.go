@@ -3,13 +3,14 @@ package keeper
 import (
 	"context"
 	"errors"
-	"log"
+	"strconv"
 
 	"cosmossdk.io/collections"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/janction/videoUpscaler"
+	"github.com/janction/videoUpscaler/videoUpscalerLogger"
 )
 
 var _ videoUpscaler.QueryServer = queryServer{}
@@ -61,9 +62,9 @@ func (qs queryServer) GetPendingVideoUpscalerTasks(ctx context.Context, req *vid
 
 	var result []*videoUpscaler.VideoUpscalerTask
 	for i := 0; i < int(nextId); i++ {
-		task, err := qs.k.VideoUpscalerTasks.Get(ctx, string(i))
+		task, err := qs.k.VideoUpscalerTasks.Get(ctx, strconv.Itoa(i))
 		if err != nil {
-			log.Fatalf("unable to retrieve task with id %v. Error: %v", string(i), err.Error())
+			videoUpscalerLogger.Logger.Debug("unable to retrieve task with id %v. Error: %v", i, err.Error())
 			continue
 		}
 
@@ -82,3 +83,42 @@ func (qs queryServer) GetWorker(ctx context.Context, req *videoUpscaler.QueryGet
 
 	return &videoUpscaler.QueryGetWorkerResponse{Worker: &worker}, nil
 }
+
+func (qs queryServer) GetTaskOutputManifest(ctx context.Context, req *videoUpscaler.QueryGetTaskOutputManifestRequest) (*videoUpscaler.QueryGetTaskOutputManifestResponse, error) {
+	task, err := qs.k.VideoUpscalerTasks.Get(ctx, req.TaskId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "task %s not found", req.TaskId)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	manifest := task.GetOutputManifest()
+	json, err := manifest.ToJSON()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &videoUpscaler.QueryGetTaskOutputManifestResponse{Manifest: &manifest, Json: json}, nil
+}
+
+func (qs queryServer) GetModuleAccounting(ctx context.Context, req *videoUpscaler.QueryGetModuleAccountingRequest) (*videoUpscaler.QueryGetModuleAccountingResponse, error) {
+	accounting, err := qs.k.GetModuleAccounting(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &videoUpscaler.QueryGetModuleAccountingResponse{Accounting: &accounting}, nil
+}
+
+func (qs queryServer) GetWorkerGroup(ctx context.Context, req *videoUpscaler.QueryGetWorkerGroupRequest) (*videoUpscaler.QueryGetWorkerGroupResponse, error) {
+	group, err := qs.k.WorkerGroups.Get(ctx, req.Name)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "worker group %s not found", req.Name)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &videoUpscaler.QueryGetWorkerGroupResponse{Group: &group}, nil
+}
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/stretchr/testify/require"
+
+	"github.com/janction/videoUpscaler"
+	videoUpscalerCrypto "github.com/janction/videoUpscaler/crypto"
+)
+
+// sign returns the signature of the value for the worker, like workers sign their work
+func sign(t *testing.T, key *secp256k1.PrivKey, value, worker string) string {
+	message, err := videoUpscalerCrypto.GenerateSignableMessage(value, worker)
+	require.NoError(t, err)
+	signature, err := key.Sign(message)
+	require.NoError(t, err)
+	return videoUpscalerCrypto.EncodeSignatureForCLI(signature)
+}
+
+// --- Test for RevealValidation ---
+func TestRevealValidation_Fingerprint(t *testing.T) {
+	// 1. Setup: a thread validated by fingerprint with a proposed solution
+	k, ctx := setupKeeper(t, 100)
+	ms := NewMsgServerImpl(k)
+	// any well formed fingerprint, the keeper doesn't decode frames
+	fingerprint := strings.Repeat("1020", 68)
+	proposerKey, validatorKey := secp256k1.GenPrivKey(), secp256k1.GenPrivKey()
+	task := videoUpscaler.VideoUpscalerTask{TaskId: "1", Threads: []*videoUpscaler.VideoUpscalerThread{{
+		ThreadId: "10", Workers: []string{"proposer", "validator"},
+		Options: &videoUpscaler.UpscaleOptions{Validation: videoUpscaler.ValidationMode_VALIDATION_MODE_FINGERPRINT, MaxFingerprintDistance: 3},
+		Solution: &videoUpscaler.VideoUpscalerThread_Solution{ProposedBy: "proposer", PublicKey: videoUpscalerCrypto.EncodePublicKeyForCLI(proposerKey.PubKey()),
+			Frames: []*videoUpscaler.VideoUpscalerThread_Frame{{Filename: "frame_000000.png", Signature: sign(t, proposerKey, fingerprint, "proposer")}}},
+	}}}
+	require.NoError(t, k.VideoUpscalerTasks.Set(ctx, task.TaskId, task))
+	for _, address := range []string{"proposer", "validator"} {
+		worker := videoUpscaler.Worker{Address: address, Enabled: true}
+		worker.AssignThread("1", 0, "10")
+		require.NoError(t, k.Workers.Set(ctx, address, worker))
+	}
+
+	// 2. Execute: the validator only commits to its fingerprint
+	_, err := ms.SubmitValidation(ctx, &videoUpscaler.MsgSubmitValidation{Creator: "validator", TaskId: "1", ThreadId: "10",
+		PublicKey: videoUpscalerCrypto.EncodePublicKeyForCLI(validatorKey.PubKey()), Signatures: []string{"frame_000000.png=" + sign(t, validatorKey, fingerprint, "validator")}})
+	require.NoError(t, err)
+
+	task, err = k.VideoUpscalerTasks.Get(ctx, "1")
+	require.NoError(t, err)
+	require.Empty(t, task.Threads[0].GetValidation("validator").Frames[0].Fingerprint)
+
+	// 3. Assert: fingerprints can't be revealed before the solution
+	reveal := &videoUpscaler.MsgRevealValidation{Creator: "validator", TaskId: "1", ThreadId: "10", Fingerprints: []string{"frame_000000.png=" + fingerprint}}
+	_, err = ms.RevealValidation(ctx, reveal)
+	require.Error(t, err)
+
+	_, err = ms.RevealSolution(ctx, &videoUpscaler.MsgRevealSolution{Creator: "proposer", TaskId: "1", ThreadId: "10", Frames: []string{"frame_000000.png=QmFrame:hash:" + fingerprint}})
+	require.NoError(t, err)
+
+	// a fingerprint other than the signed one is rejected
+	_, err = ms.RevealValidation(ctx, &videoUpscaler.MsgRevealValidation{Creator: "validator", TaskId: "1", ThreadId: "10", Fingerprints: []string{"frame_000000.png=" + fingerprint[:len(fingerprint)-2] + "ff"}})
+	require.Error(t, err)
+
+	_, err = ms.RevealValidation(ctx, reveal)
+	require.NoError(t, err)
+	task, err = k.VideoUpscalerTasks.Get(ctx, "1")
+	require.NoError(t, err)
+	require.Equal(t, fingerprint, task.Threads[0].GetValidation("validator").Frames[0].Fingerprint)
+}
@@ -10,4 +10,8 @@ var (
 	WorkerKey                    = collections.NewPrefix("Worker")
 	TaskInfoKey                  = collections.NewPrefix(0)
 	PendingVideoUpscalerTasksKey = collections.NewPrefix(1)
+	TaskEscrowKey                = collections.NewPrefix(2)
+	WorkerStakeKey               = collections.NewPrefix(3)
+	PaidOutKey                   = collections.NewPrefix(4)
+	WorkerGroupKey               = collections.NewPrefix(5)
 )
@@ -32,17 +32,42 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "worker"},
 					},
 				},
+				{
+					RpcMethod: "GetTaskOutputManifest",
+					Use:       "get-task-output-manifest taskId",
+					Short:     "Gets the ordered list of upscaled frames of a task",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "taskId"},
+					},
+				},
+				{
+					RpcMethod: "GetWorkerGroup",
+					Use:       "get-worker-group name",
+					Short:     "Gets the members of a worker group",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "name"},
+					},
+				},
+				{
+					RpcMethod: "GetModuleAccounting",
+					Use:       "get-module-accounting",
+					Short:     "Gets the escrowed rewards, worker stakes and payouts held by the module",
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
 			Service: videoUpscalerv1.Msg_ServiceDesc.ServiceName,
+			// the probe command of GetTxCmd runs locally, the rest are generated
+			EnhanceCustomCommand: true,
 			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
 				{
 					RpcMethod: "CreateVideoUpscalerTask",
 					Use:       "create-video-upscaler-task [cid] [startFrame] [endFrame] [threads] [reward]",
 					Short:     "Creates a new video Upscaler task",
-					Long:      "", // TODO Add long
-					Example:   "", // TODO add exampe
+					Long:      "The cid is a video file, or with --input-type image-sequence a directory with an image per frame, like shot.1001.exr to shot.1100.exr. Frames are counted from 0 in both cases",
+					Example: `create-video-upscaler-task QmVideo 0 1439 4 100jct --media-info "$(janctiond tx videoUpscaler probe video.mp4)" --from requester
+create-video-upscaler-task QmShotDir 0 99 2 100jct --input-type image-sequence --from requester
+create-video-upscaler-task QmVideo 0 1439 4 100jct --options '{"validation":"VALIDATION_MODE_FINGERPRINT","max_fingerprint_distance":4}' --from requester`,
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
 						{ProtoField: "cid"},
 						{ProtoField: "startFrame"},
@@ -53,7 +78,7 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 				},
 				{
 					RpcMethod: "AddWorker",
-					Use:       "add-worker [public_ip] [ipfs_id] [stake]--from [workerAddress]",
+					Use:       "add-worker [public_ip] [ipfs_id] [stake] --max-threads [n] --capabilities [json] --announce-addrs [multiaddr] --from [workerAddress]",
 					Short:     "Registers a new worker that will perform video upscaler tasks",
 					Long:      "", // TODO Add long
 					Example:   "", // TODO add exampe
@@ -63,6 +88,40 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "stake"},
 					},
 				},
+				{
+					RpcMethod: "SetWorkerGroup",
+					Use:       "set-worker-group [name] [members] --from [ownerAddress]",
+					Short:     "Creates a worker group, or replaces its members if you own it",
+					Long:      "", // TODO Add long
+					Example:   "", // TODO add exampe
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "name"},
+						{ProtoField: "members", Varargs: true},
+					},
+				},
+				{
+					RpcMethod: "UpdateWorker",
+					Use:       "update-worker [public_ip] [ipfs_id] --announce-addrs [multiaddr] --from [workerAddress]",
+					Short:     "Updates the network details of a registered worker",
+					Long:      "", // TODO Add long
+					Example:   "update-worker \"\" 12D3KooW... --announce-addrs /dns4/worker.example.com/tcp/4001 --announce-addrs /ip6/2001:db8::1/udp/4001/quic-v1 --from worker",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "public_ip"},
+						{ProtoField: "ipfs_id"},
+					},
+				},
+				{
+					RpcMethod: "UpdateWorkerCapabilities",
+					Use:       "update-worker-capabilities --capabilities [json] --from [workerAddress]",
+					Short:     "Updates the capabilities advertised by a registered worker",
+					Long:      "", // TODO Add long
+					Example:   `update-worker-capabilities --capabilities '{"cpu_cores":8,"memory_mb":16384,"scale_factors":[2,4],"backends":["realesrgan"]}' --from worker`,
+				},
+				{
+					RpcMethod: "WorkerHeartbeat",
+					Use:       "worker-heartbeat --from [workerAddress]",
+					Short:     "Signals the worker is alive, re-enabling it if it was disabled",
+				},
 				{
 					RpcMethod: "SubscribeWorkerToTask",
 					Use:       "subscribe-worker-to-task [address] [taskId] [threadId] --from [workerAddress]",
@@ -77,7 +136,7 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 				},
 				{
 					RpcMethod: "ProposeSolution",
-					Use:       "propose-solution [taskId] [threadId] [publicKey] [signatures] --from [workerAddress]",
+					Use:       "propose-solution [taskId] [threadId] [publicKey] [signatures] --image-digest [digest] --from [workerAddress]",
 					Short:     "Proposes a solution to a thread.",
 					Long:      "", // TODO Add long
 					Example:   "", // TODO add exampe
@@ -105,7 +164,7 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "SubmitValidation",
 					Use:       "submit-validation [taskId] [threadId] [publicKey] [signatures] --from [workerAddress]",
 					Short:     "Submit a validation to a proposed solution",
-					Long:      "", // TODO Add long
+					Long:      "Tasks validated by fingerprint sign the fingerprints instead of the hashes, and reveal them with reveal-validation once the solution is revealed",
 					Example:   "", // TODO add exampe
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
 						{ProtoField: "taskId"},
@@ -126,6 +185,48 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "frames", Varargs: true},
 					},
 				},
+				{
+					RpcMethod: "RevealValidation",
+					Use:       "reveal-validation [taskId] [threadId] [fingerprints] --from [workerAddress]",
+					Short:     "Reveals the fingerprints signed with a validation, once the solution is revealed",
+					Long:      "Only for tasks validated by fingerprint. Fingerprints are filename=fingerprint, one for each frame of the validation",
+					Example:   "reveal-validation 1 10 frame_000000.png=0000050000000438... --from worker",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "taskId"},
+						{ProtoField: "threadId"},
+						{ProtoField: "fingerprints", Varargs: true},
+					},
+				},
+				{
+					RpcMethod: "ReportThreadFailure",
+					Use:       "report-thread-failure [taskId] [threadId] [reason] [message] --from [workerAddress]",
+					Short:     "Gives up a thread the worker couldn't render, so another worker can take it",
+					Long:      "Reason is one of input-decode, container-start, timeout, missing-output, resource-limit or media-mismatch",
+					Example:   `report-thread-failure 1 10 timeout "frames 0 to 9 timed out" --from worker`,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "taskId"},
+						{ProtoField: "threadId"},
+						{ProtoField: "reason"},
+						{ProtoField: "message"},
+					},
+				},
+				{
+					RpcMethod: "SubmitFinalVideo",
+					Use:       "submit-final-video [taskId] [cid] [hash] --from [workerAddress]",
+					Short:     "Submits the final video of a completed task, or verifies it",
+					Long:      "Sent by the assembler with the sha256 of the encoded container, and then by the verifier with the cid and hash of the video it assembled again from the frames of the threads. A different cid or hash rejects the video",
+					Example:   "submit-final-video 1 QmVideo 3a7bd3e2360a3d29eea436fcfb7e44c735d117c42d1c1835420b6b9942dd4f1b --from worker",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "taskId"},
+						{ProtoField: "cid"},
+						{ProtoField: "hash"},
+					},
+				},
+				{
+					// executed through governance
+					RpcMethod: "UpdateParams",
+					Skip:      true,
+				},
 			},
 		},
 	}
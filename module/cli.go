@@ -0,0 +1,57 @@
+package module
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/spf13/cobra"
+
+	"github.com/janction/videoUpscaler"
+)
+
+// GetTxCmd returns the tx commands autocli can't generate, because they run locally. autocli adds the rest of the commands to it
+func (am AppModule) GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        videoUpscaler.ModuleName,
+		Short:                      fmt.Sprintf("Transactions commands for the %s module", videoUpscaler.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	cmd.AddCommand(ProbeCmd())
+	return cmd
+}
+
+// ProbeCmd prints the media info of a video file, to be attached to a new task
+func ProbeCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:     "probe [file]",
+		Short:   "Probes a video with ffprobe and prints its media info",
+		Long:    "The output is passed to create-video-upscaler-task with --media-info, so the frame range is checked against the video and workers can check the input once downloaded",
+		Example: `create-video-upscaler-task QmVideo 0 1439 4 100jct --media-info "$(janctiond tx videoUpscaler probe video.mp4)" --from requester`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+			if ctx == nil {
+				ctx = context.Background()
+			}
+
+			info, err := videoUpscaler.ProbeMediaInfo(ctx, args[0])
+			if err != nil {
+				return err
+			}
+			if err := info.Validate(); err != nil {
+				return err
+			}
+
+			output, err := codec.ProtoMarshalJSON(&info, nil)
+			if err != nil {
+				return err
+			}
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), string(output))
+			return err
+		},
+	}
+}
@@ -122,7 +122,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 	return cdc.MustMarshalJSON(gs)
 }
 
-func (am AppModule) getPendingVideoUpscalerTask(ctx context.Context) (bool, videoUpscaler.VideoUpscalerTask) {
+func (am AppModule) getPendingVideoUpscalerTask(ctx context.Context, worker videoUpscaler.Worker) (bool, videoUpscaler.VideoUpscalerTask) {
 	params, _ := am.keeper.Params.Get(ctx)
 	ti, err := am.keeper.VideoUpscalerTaskInfo.Get(ctx)
 
@@ -130,6 +130,7 @@ func (am AppModule) getPendingVideoUpscalerTask(ctx context.Context) (bool, vide
 		panic(err)
 	}
 	nextId := int(ti.NextId)
+	var candidates []videoUpscaler.VideoUpscalerTask
 	for i := 0; i < nextId; i++ {
 		task, err := am.keeper.VideoUpscalerTasks.Get(ctx, strconv.Itoa(i))
 		if err != nil {
@@ -137,15 +138,30 @@ func (am AppModule) getPendingVideoUpscalerTask(ctx context.Context) (bool, vide
 		}
 
 		// we only search for in progress and with the reward this node will accept
-		if !task.Completed && task.Reward.Amount.GTE(math.NewInt(am.keeper.Configuration.MinReward)) {
+		// and that we are capable and allowed of processing
+		if !task.Completed && !task.Expired && task.GetTotalReward().Amount.GTE(math.NewInt(am.keeper.Configuration.MinReward)) && worker.CanProcess(task) == nil && am.keeper.CanWorkerTakeTask(ctx, worker.Address, task) == nil {
+			// adaptive tasks can still cut a new thread for us
+			available := task.HasPendingFrames()
 			for _, value := range task.Threads {
-				if !value.Completed && len(value.Workers) < int(params.MaxWorkersPerThread) {
-					return true, task
+				if !value.Completed && len(value.Workers) < int(params.MaxWorkersPerThread) && !slices.Contains(value.Workers, worker.Address) && !value.HasFailed(worker.Address) {
+					available = true
+					break
 				}
 			}
+
+			if available {
+				candidates = append(candidates, task)
+			}
 		}
 	}
-	return false, videoUpscaler.VideoUpscalerTask{}
+
+	if len(candidates) == 0 {
+		return false, videoUpscaler.VideoUpscalerTask{}
+	}
+
+	// urgent and better paid tasks go first
+	videoUpscaler.SortTasksByPriority(candidates)
+	return true, candidates[0]
 }
 
 func (am AppModule) BeginBlock(ctx context.Context) error {
@@ -168,60 +184,18 @@ func (am AppModule) BeginBlock(ctx context.Context) error {
 
 	if k.Configuration.Enabled && k.Configuration.WorkerAddress != "" {
 		worker, _ := k.Workers.Get(ctx, k.Configuration.WorkerAddress)
-		if worker.Enabled && worker.CurrentTaskId != "" {
-			// we have to start some work!
-			task, err := k.VideoUpscalerTasks.Get(ctx, worker.CurrentTaskId)
-			if err != nil {
-				videoUpscalerLogger.Logger.Error("error processing task %v. %v", task.TaskId, err.Error())
-				return nil
-			}
-			thread := *task.Threads[worker.CurrentThreadIndex]
-			dbThread, _ := k.DB.ReadThread(thread.ThreadId)
-			videoUpscalerLogger.Logger.Info("local thread %s is: downloadStarted: %s, downloadCompleted: %s, workStarted: %s, workCompleted: %s, solutionProposed: %s, verificationStarted: %s, solutionRevealed: %s, submitionStarted: %s", dbThread.ID, strconv.FormatBool(dbThread.DownloadStarted), strconv.FormatBool(dbThread.DownloadCompleted), strconv.FormatBool(dbThread.WorkStarted), strconv.FormatBool(dbThread.WorkCompleted), strconv.FormatBool(dbThread.SolutionProposed), strconv.FormatBool(dbThread.VerificationStarted), strconv.FormatBool(dbThread.SolutionRevealed), strconv.FormatBool(dbThread.SubmitionStarted))
-
-			workPath := filepath.Join(k.Configuration.RootPath, "upscales", thread.ThreadId)
-
-			if !thread.Completed && !dbThread.DownloadStarted {
-				videoUpscalerLogger.Logger.Info("thread %v of task %v started", thread.ThreadId, task.TaskId)
-				go thread.StartWork(ctx, worker.Address, task.Cid, workPath, &k.DB)
-			} else {
-				if dbThread.WorkStarted {
-					// if we are already working but the container is exited, it means there was an error, so we trigger it again
-					isExited, err := vm.IsContainerExited(thread.ThreadId)
-					if err != nil {
-						videoUpscalerLogger.Logger.Error("unable to determine if container upscaler-cpu%s is running: %s", thread.ThreadId, err.Error())
-					}
-					if isExited {
-						videoUpscalerLogger.Logger.Info("container upscaler-cpu%s is existed. We restarted", thread.ThreadId)
-						go thread.StartWork(ctx, worker.Address, task.Cid, workPath, &k.DB)
-					}
-
-				}
-
-				// if ipfs didn't download yet, then we make sure we are still downloading a file at least
-				if !dbThread.DownloadCompleted {
-					if !ipfs.IsDownloadStarted(workPath) {
-						videoUpscalerLogger.Logger.Info("IPFS hasn't downloaded any file. Resetting work...")
-						k.DB.UpdateThread(thread.ThreadId, false, false, false, false, false, false, false, false)
-					} else {
-						videoUpscalerLogger.Logger.Debug("ipfs download in progress...")
-					}
-				}
-			}
-
-			// we completed the work, so lets propose a solution
-			if thread.Solution == nil && dbThread.WorkCompleted && !dbThread.SolutionProposed {
-				videoUpscalerLogger.Logger.Info("thread %v of task %v started", thread.ThreadId, task.TaskId)
-				go thread.ProposeSolution(am.cdc, k.Configuration.WorkerName, worker.Address, k.Configuration.RootPath, &k.DB)
-			}
-
-			// someone already submited solution, lets submit our verification
-			if thread.Solution != nil && thread.Solution.ProposedBy != "" && !dbThread.VerificationStarted {
-				// start verification
-				videoUpscalerLogger.Logger.Info("Started verification for thread %s", thread.ThreadId)
-				go thread.SubmitVerification(am.cdc, k.Configuration.WorkerName, k.Configuration.WorkerAddress, k.Configuration.RootPath, &k.DB)
+		if worker.Enabled {
+			// each assigned thread runs its own pipeline, so up to max threads are processed in parallel
+			for _, assignment := range worker.Assignments {
+				am.processAssignment(ctx, worker, assignment)
 			}
 		}
+
+		// completed tasks are assembled into the final video by a designated worker and verified by a second one
+		k.VideoUpscalerTasks.Walk(ctx, nil, func(key string, task videoUpscaler.VideoUpscalerTask) (bool, error) {
+			am.processFinalVideo(ctx, task)
+			return false, nil
+		})
 	}
 
 	// Thread validationwork  can be executed by any node, being worker or not
@@ -260,6 +234,93 @@ func (am AppModule) BeginBlock(ctx context.Context) error {
 	return nil
 }
 
+// processAssignment moves forward the local work of one of the threads the worker is subscribed to
+func (am AppModule) processAssignment(ctx context.Context, worker videoUpscaler.Worker, assignment *videoUpscaler.Worker_Assignment) {
+	k := am.keeper
+
+	// we have to start some work!
+	task, err := k.VideoUpscalerTasks.Get(ctx, assignment.TaskId)
+	if err != nil {
+		videoUpscalerLogger.Logger.Error("error processing task %v. %v", assignment.TaskId, err.Error())
+		return
+	}
+	thread := *task.Threads[assignment.ThreadIndex]
+	dbThread, _ := k.DB.ReadThread(thread.ThreadId)
+	videoUpscalerLogger.Logger.Info("local thread %s is: downloadStarted: %s, downloadCompleted: %s, workStarted: %s, workCompleted: %s, solutionProposed: %s, verificationStarted: %s, solutionRevealed: %s, submitionStarted: %s", dbThread.ID, strconv.FormatBool(dbThread.DownloadStarted), strconv.FormatBool(dbThread.DownloadCompleted), strconv.FormatBool(dbThread.WorkStarted), strconv.FormatBool(dbThread.WorkCompleted), strconv.FormatBool(dbThread.SolutionProposed), strconv.FormatBool(dbThread.VerificationStarted), strconv.FormatBool(dbThread.SolutionRevealed), strconv.FormatBool(dbThread.SubmitionStarted))
+
+	workPath := filepath.Join(k.Configuration.RootPath, "upscales", thread.ThreadId)
+
+	// docker renders only with the image pinned for the task backend, and its digest goes with the solution
+	params, _ := k.Params.Get(ctx)
+	image, found := params.GetUpscalerImage(task.GetBackend())
+	if !found {
+		videoUpscalerLogger.Logger.Error("no upscaler image pinned for backend %s", task.GetBackend())
+	}
+	imageDigest := ""
+	if _, ok := k.Renderer.(*vm.DockerRenderer); ok {
+		imageDigest = image.Digest()
+	}
+
+	if !thread.Completed && !dbThread.DownloadStarted {
+		videoUpscalerLogger.Logger.Info("thread %v of task %v started", thread.ThreadId, task.TaskId)
+		go thread.StartWork(ctx, worker.Address, task.Cid, task.InputType, workPath, image.Image, task.MediaInfo, k.Renderer, k.Configuration.RenderBatchSize, k.Configuration.GetRetryPolicy(), &k.DB)
+	} else {
+		// if we were working but the work stopped, because of an error or a restart of the node, we trigger it again
+		if !thread.Completed && dbThread.WorkStarted && !dbThread.WorkCompleted && !videoUpscaler.IsWorkInProgress(thread.ThreadId) && !k.Renderer.IsRunning(ctx, thread.ThreadId) {
+			videoUpscalerLogger.Logger.Info("work of thread %s stopped. We restarted", thread.ThreadId)
+			go thread.StartWork(ctx, worker.Address, task.Cid, task.InputType, workPath, image.Image, task.MediaInfo, k.Renderer, k.Configuration.RenderBatchSize, k.Configuration.GetRetryPolicy(), &k.DB)
+		}
+
+		// if ipfs didn't download yet, then we make sure we are still downloading a file at least
+		if !dbThread.DownloadCompleted {
+			if !ipfs.IsDownloadStarted(workPath) {
+				videoUpscalerLogger.Logger.Info("IPFS hasn't downloaded any file. Resetting work...")
+				k.DB.UpdateThread(thread.ThreadId, false, false, false, false, false, false, false, false)
+			} else {
+				videoUpscalerLogger.Logger.Debug("ipfs download in progress...")
+			}
+		}
+	}
+
+	// we completed the work, so lets propose a solution
+	if thread.Solution == nil && dbThread.WorkCompleted && !dbThread.SolutionProposed {
+		videoUpscalerLogger.Logger.Info("thread %v of task %v started", thread.ThreadId, task.TaskId)
+		go thread.ProposeSolution(am.cdc, k.Configuration.WorkerName, worker.Address, k.Configuration.RootPath, imageDigest, &k.DB)
+	}
+
+	// someone already submited solution, lets submit our verification
+	if thread.Solution != nil && thread.Solution.ProposedBy != "" && !dbThread.VerificationStarted {
+		// start verification
+		videoUpscalerLogger.Logger.Info("Started verification for thread %s", thread.ThreadId)
+		go thread.SubmitVerification(am.cdc, k.Configuration.WorkerName, k.Configuration.WorkerAddress, k.Configuration.RootPath, &k.DB)
+	}
+}
+
+// processFinalVideo assembles or verifies the final video of the task, if this worker is the one designated for it
+func (am AppModule) processFinalVideo(ctx context.Context, task videoUpscaler.VideoUpscalerTask) {
+	k := am.keeper
+	address := k.Configuration.WorkerAddress
+	attempt := task.GetAssemblyAttempt()
+
+	if task.IsFinalVideoPending() && task.GetAssembler() == address {
+		assembly, err := k.DB.ReadAssembly(task.TaskId, attempt)
+		if err == nil && !assembly.AssemblyStarted {
+			videoUpscalerLogger.Logger.Info("Assembling final video of task %s", task.TaskId)
+			k.DB.UpdateAssembly(task.TaskId, attempt, true, false)
+			go task.AssembleFinalVideo(ctx, address, k.Configuration.RootPath, &k.DB)
+		}
+	}
+
+	if task.IsFinalVideoUnverified() && task.GetAssemblyVerifier() == address {
+		assembly, err := k.DB.ReadAssembly(task.TaskId, attempt)
+		if err == nil && !assembly.VerificationStarted {
+			videoUpscalerLogger.Logger.Info("Verifying final video %s of task %s", task.FinalVideo.Cid, task.TaskId)
+			k.DB.UpdateAssembly(task.TaskId, attempt, false, true)
+			go task.VerifyFinalVideo(ctx, address, k.Configuration.RootPath, &k.DB)
+		}
+	}
+}
+
 // EndBlock contains the logic that is automatically triggered at the end of each block.
 // The end block implementation is optional.
 func (am AppModule) EndBlock(ctx context.Context) error {
@@ -276,36 +337,67 @@ func (am AppModule) EndBlock(ctx context.Context) error {
 				// the worker is not registered, so we do it with the stake
 				params, _ := am.keeper.Params.Get(ctx)
 				videoUpscalerLogger.Logger.Info("Registering Worker %s", k.Configuration.WorkerAddress)
-				go worker.RegisterWorker(k.Configuration.WorkerAddress, *params.MinWorkerStaking, &k.DB)
+				go worker.RegisterWorker(k.Configuration.WorkerAddress, *params.MinWorkerStaking, k.Configuration.GetMaxThreads(), k.Configuration.GetCapabilities(), k.Configuration.AnnounceAddrs, &k.DB)
 			}
 		}
 
-		if worker.Enabled && worker.CurrentTaskId == "" {
+		// registered workers keep sending heartbeats, which also re-enables them if they were disabled
+		height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+		if worker.Address != "" && height%k.Configuration.GetHeartbeatInterval() == 0 {
+			go worker.SendHeartbeat(worker.Address)
+
+			// we also keep our network details in sync, so a new public ip or new announced addresses reach the other nodes
+			go worker.SyncNetwork(k.Configuration.AnnounceAddrs)
+		}
+
+		if worker.Enabled && worker.HasCapacity() {
 			// we find any task in progress that has enought reward
-			videoUpscalerLogger.Logger.Info(" worker %v is idle ", worker.Address)
-			found, task := am.getPendingVideoUpscalerTask(ctx)
+			videoUpscalerLogger.Logger.Info(" worker %v is working on %v of %v threads ", worker.Address, len(worker.Assignments), worker.ThreadCapacity())
+			found, task := am.getPendingVideoUpscalerTask(ctx, worker)
 			videoUpscalerLogger.Logger.Debug("Found task: %v, taskId: %s", found, task.TaskId)
 			if found {
 				params, _ := am.keeper.Params.Get(ctx)
+				subscribed := false
 				for _, value := range task.Threads {
 
-					if !value.Completed && len(value.Workers) < int(params.MaxWorkersPerThread) && !slices.Contains(value.Workers, worker.Address) {
+					if !value.Completed && len(value.Workers) < int(params.MaxWorkersPerThread) && !slices.Contains(value.Workers, worker.Address) && !value.HasFailed(worker.Address) {
 						//we found our next thread
 						dbTask, _ := k.DB.ReadTask(task.TaskId, value.ThreadId)
 						if !dbTask.WorkerSubscribed {
 							videoUpscalerLogger.Logger.Info(" registering worker %v in task %s thread %s ", worker.Address, task.TaskId, value.ThreadId)
 							k.DB.UpdateTask(task.TaskId, value.ThreadId, true)
 							go task.SubscribeWorkerToTask(ctx, worker.Address, task.TaskId, value.ThreadId, &k.DB)
+							subscribed = true
 							break
 						}
 					}
 				}
+
+				// no thread to join, so we ask the chain to cut a new one sized for us
+				if !subscribed && task.HasPendingFrames() {
+					dbTask, _ := k.DB.ReadTask(task.TaskId, "")
+					if !dbTask.WorkerSubscribed {
+						videoUpscalerLogger.Logger.Info(" requesting new thread for worker %v in task %s ", worker.Address, task.TaskId)
+						k.DB.UpdateTask(task.TaskId, "", true)
+						go task.SubscribeWorkerToTask(ctx, worker.Address, task.TaskId, "", &k.DB)
+					}
+				}
 			} else {
 				videoUpscalerLogger.Logger.Info("No video upscaler tasks available for me to work on")
 			}
 		}
 	}
 
+	// expired tasks refund the unused reward to the requester
+	if err := k.ExpireTasks(ctx); err != nil {
+		videoUpscalerLogger.Logger.Error("expiring tasks: %s", err.Error())
+	}
+
+	// workers that stopped sending heartbeats are disabled and released from their threads
+	if err := k.DisableInactiveWorkers(ctx); err != nil {
+		videoUpscalerLogger.Logger.Error("disabling inactive workers: %s", err.Error())
+	}
+
 	maxId, _ := k.VideoUpscalerTaskInfo.Get(ctx)
 	for i := 0; i < int(maxId.NextId); i++ {
 		task, _ := k.VideoUpscalerTasks.Get(ctx, strconv.Itoa(i))
@@ -322,14 +414,30 @@ func (am AppModule) EndBlock(ctx context.Context) error {
 						}
 					}
 				}
+
+				// once the solution is revealed, validators of tasks validated by fingerprint reveal the fingerprints they signed
+				address := am.keeper.Configuration.WorkerAddress
+				if address != "" && thread.IsFingerprintValidated() && thread.IsSolutionRevealed() && !thread.Completed {
+					if validation := thread.GetValidation(address); validation != nil && !validation.IsValidationRevealed() {
+						started, _ := k.DB.IsValidationRevealStarted(thread.ThreadId)
+						if !started {
+							videoUpscalerLogger.Logger.Info("Revealing validation of thread %s", thread.ThreadId)
+							go thread.RevealValidation(address, am.keeper.Configuration.RootPath, &k.DB)
+						}
+					}
+				}
 			}
 		}
 	}
 
 	for i := 0; i < int(maxId.NextId); i++ {
-		task, _ := k.VideoUpscalerTasks.Get(ctx, strconv.Itoa(i))
-		if !task.Completed {
-			completed := true
+		task, err := k.VideoUpscalerTasks.Get(ctx, strconv.Itoa(i))
+		if err != nil {
+			continue
+		}
+		if !task.Completed && !task.Expired {
+			// adaptive tasks with frames left to assign can't be completed
+			completed := !task.HasPendingFrames()
 			for _, thread := range task.Threads {
 				if !thread.Completed {
 					// we found at least one thread not completed, so task isn't complete
@@ -347,14 +455,15 @@ func (am AppModule) EndBlock(ctx context.Context) error {
 
 	// we now will connect to the IPFS nodes of new workers
 	k.Workers.Walk(ctx, nil, func(address string, worker videoUpscaler.Worker) (stop bool, err error) {
-		isAdded, _ := k.DB.IsIPFSWorkerAdded(address)
-		if worker.IpfsId != "" && worker.PublicIp != "" && !isAdded {
-			videoUpscalerLogger.Logger.Info("Connecting to IPFS node %s at %s", worker.IpfsId, worker.PublicIp)
+		// workers that changed their network details are connected again
+		isAdded, _ := k.DB.IsIPFSWorkerAdded(worker.GetNetworkKey())
+		if worker.IpfsId != "" && (worker.PublicIp != "" || len(worker.AnnounceAddrs) > 0) && !isAdded {
+			videoUpscalerLogger.Logger.Info("Connecting to IPFS node %s at %s %s", worker.IpfsId, worker.PublicIp, worker.AnnounceAddrs)
 			ipfs.EnsureIPFSRunning()
-			go ipfs.ConnectToIPFSNode(worker.PublicIp, worker.IpfsId)
+			go ipfs.ConnectToIPFSNode(worker.PublicIp, worker.IpfsId, worker.AnnounceAddrs)
 
 			// ✅ Mark worker as processed
-			am.keeper.DB.AddIPFSWorker(address)
+			am.keeper.DB.AddIPFSWorker(worker.GetNetworkKey())
 			return true, nil
 		}
 		return false, nil // Continue iterating
@@ -370,13 +479,12 @@ func (am AppModule) EvaluateCompletedThread(ctx context.Context, task *videoUpsc
 	for _, worker := range thread.Workers {
 		// we reset all workers
 		worker, _ := am.keeper.Workers.Get(ctx, worker)
-		worker.CurrentTaskId = ""
-		worker.CurrentThreadIndex = 0
+		worker.ReleaseThread(task.TaskId, thread.ThreadId)
 		// we increase reputation
 		worker.Reputation.Points = worker.Reputation.Points + 1
 		worker.Reputation.Validations = worker.Reputation.Validations + 1
 		// we pay for the validation
-		winning := thread.GetValidatorReward(worker.Address, task.GetValidatorsReward())
+		winning := thread.GetValidatorReward(worker.Address, task.GetValidatorsReward(thread))
 		worker.Reputation.Winnings = worker.Reputation.Winnings.Add(winning)
 		am.keeper.Workers.Set(ctx, worker.Address, worker)
 	}
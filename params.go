@@ -1,10 +1,16 @@
 package videoUpscaler
 
 import (
+	"fmt"
+
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// DefaultMinMediaMismatchReports is used when the params don't set min media mismatch reports, like params stored
+// before it existed
+const DefaultMinMediaMismatchReports = 3
+
 // DefaultParams returns default module parameters.
 func DefaultParams() Params {
 	return Params{
@@ -12,6 +18,16 @@ func DefaultParams() Params {
 		MinWorkerStaking:    &sdk.Coin{Denom: "jct", Amount: math.NewInt(1000000)},
 		MaxWorkersPerThread: 2,
 		MinValidators:       1,
+		// used to cut adaptive threads for workers without render history
+		DefaultFrameRenderSeconds: 30,
+		// workers are disabled after this amount of blocks without a heartbeat
+		MaxMissedHeartbeatBlocks: 100,
+		// no image is pinned by default, governance must pin one per backend before workers can render with docker
+		UpscalerImages: []UpscalerImage{},
+		// about 5% of the tiles of the fingerprint, enough for the differences of non deterministic renders
+		MaxFingerprintDistance: 3,
+		// a task is refunded once this amount of workers, and most of the workers of the task, report its input is not the described one
+		MinMediaMismatchReports: DefaultMinMediaMismatchReports,
 	}
 }
 
@@ -24,6 +40,35 @@ func (p Params) Validate() error {
 		// error
 	}
 
+	// upscaler images must be pinned by digest, with a single image per backend
+	backends := make(map[string]bool)
+	for _, image := range p.UpscalerImages {
+		if err := image.Validate(); err != nil {
+			return err
+		}
+		if backends[image.Backend] {
+			return fmt.Errorf("backend %s has more than one image", image.Backend)
+		}
+		backends[image.Backend] = true
+	}
+
+	if p.MaxFingerprintDistance < 0 || p.MaxFingerprintDistance > FingerprintTiles {
+		return fmt.Errorf("max fingerprint distance %v must be between 0 and %v", p.MaxFingerprintDistance, FingerprintTiles)
+	}
+
+	// 0 means not set, so the default applies
+	if p.MinMediaMismatchReports < 0 {
+		return fmt.Errorf("min media mismatch reports %v can't be negative", p.MinMediaMismatchReports)
+	}
+
 	// if any of the values is zero thats another mistake
 	return nil
 }
+
+// GetMediaMismatchReportsRequired returns the min media mismatch reports, or the default one if not set
+func (p Params) GetMediaMismatchReportsRequired() int64 {
+	if p.MinMediaMismatchReports == 0 {
+		return DefaultMinMediaMismatchReports
+	}
+	return p.MinMediaMismatchReports
+}
@@ -388,6 +388,271 @@ func (m *QueryGetWorkerResponse) GetWorker() *Worker {
 	return nil
 }
 
+type QueryGetTaskOutputManifestRequest struct {
+	TaskId string `protobuf:"bytes,1,opt,name=taskId,proto3" json:"taskId,omitempty"`
+}
+
+func (m *QueryGetTaskOutputManifestRequest) Reset()         { *m = QueryGetTaskOutputManifestRequest{} }
+func (m *QueryGetTaskOutputManifestRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetTaskOutputManifestRequest) ProtoMessage()    {}
+func (*QueryGetTaskOutputManifestRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7aa663478f7b9c9b, []int{8}
+}
+func (m *QueryGetTaskOutputManifestRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetTaskOutputManifestRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetTaskOutputManifestRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetTaskOutputManifestRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetTaskOutputManifestRequest.Merge(m, src)
+}
+func (m *QueryGetTaskOutputManifestRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetTaskOutputManifestRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetTaskOutputManifestRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetTaskOutputManifestRequest proto.InternalMessageInfo
+
+func (m *QueryGetTaskOutputManifestRequest) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
+	}
+	return ""
+}
+
+type QueryGetTaskOutputManifestResponse struct {
+	Manifest *TaskOutputManifest `protobuf:"bytes,1,opt,name=manifest,proto3" json:"manifest,omitempty"`
+	// same manifest encoded as json, ready to be used by download tools
+	Json string `protobuf:"bytes,2,opt,name=json,proto3" json:"json,omitempty"`
+}
+
+func (m *QueryGetTaskOutputManifestResponse) Reset()         { *m = QueryGetTaskOutputManifestResponse{} }
+func (m *QueryGetTaskOutputManifestResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetTaskOutputManifestResponse) ProtoMessage()    {}
+func (*QueryGetTaskOutputManifestResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7aa663478f7b9c9b, []int{9}
+}
+func (m *QueryGetTaskOutputManifestResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetTaskOutputManifestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetTaskOutputManifestResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetTaskOutputManifestResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetTaskOutputManifestResponse.Merge(m, src)
+}
+func (m *QueryGetTaskOutputManifestResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetTaskOutputManifestResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetTaskOutputManifestResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetTaskOutputManifestResponse proto.InternalMessageInfo
+
+func (m *QueryGetTaskOutputManifestResponse) GetManifest() *TaskOutputManifest {
+	if m != nil {
+		return m.Manifest
+	}
+	return nil
+}
+
+func (m *QueryGetTaskOutputManifestResponse) GetJson() string {
+	if m != nil {
+		return m.Json
+	}
+	return ""
+}
+
+type QueryGetModuleAccountingRequest struct {
+}
+
+func (m *QueryGetModuleAccountingRequest) Reset()         { *m = QueryGetModuleAccountingRequest{} }
+func (m *QueryGetModuleAccountingRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetModuleAccountingRequest) ProtoMessage()    {}
+func (*QueryGetModuleAccountingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7aa663478f7b9c9b, []int{10}
+}
+func (m *QueryGetModuleAccountingRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetModuleAccountingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetModuleAccountingRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetModuleAccountingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetModuleAccountingRequest.Merge(m, src)
+}
+func (m *QueryGetModuleAccountingRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetModuleAccountingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetModuleAccountingRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetModuleAccountingRequest proto.InternalMessageInfo
+
+type QueryGetModuleAccountingResponse struct {
+	Accounting *ModuleAccounting `protobuf:"bytes,1,opt,name=accounting,proto3" json:"accounting,omitempty"`
+}
+
+func (m *QueryGetModuleAccountingResponse) Reset()         { *m = QueryGetModuleAccountingResponse{} }
+func (m *QueryGetModuleAccountingResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetModuleAccountingResponse) ProtoMessage()    {}
+func (*QueryGetModuleAccountingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7aa663478f7b9c9b, []int{11}
+}
+func (m *QueryGetModuleAccountingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetModuleAccountingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetModuleAccountingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetModuleAccountingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetModuleAccountingResponse.Merge(m, src)
+}
+func (m *QueryGetModuleAccountingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetModuleAccountingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetModuleAccountingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetModuleAccountingResponse proto.InternalMessageInfo
+
+func (m *QueryGetModuleAccountingResponse) GetAccounting() *ModuleAccounting {
+	if m != nil {
+		return m.Accounting
+	}
+	return nil
+}
+
+type QueryGetWorkerGroupRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *QueryGetWorkerGroupRequest) Reset()         { *m = QueryGetWorkerGroupRequest{} }
+func (m *QueryGetWorkerGroupRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetWorkerGroupRequest) ProtoMessage()    {}
+func (*QueryGetWorkerGroupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7aa663478f7b9c9b, []int{12}
+}
+func (m *QueryGetWorkerGroupRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetWorkerGroupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetWorkerGroupRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetWorkerGroupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetWorkerGroupRequest.Merge(m, src)
+}
+func (m *QueryGetWorkerGroupRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetWorkerGroupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetWorkerGroupRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetWorkerGroupRequest proto.InternalMessageInfo
+
+func (m *QueryGetWorkerGroupRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type QueryGetWorkerGroupResponse struct {
+	Group *WorkerGroup `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+}
+
+func (m *QueryGetWorkerGroupResponse) Reset()         { *m = QueryGetWorkerGroupResponse{} }
+func (m *QueryGetWorkerGroupResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetWorkerGroupResponse) ProtoMessage()    {}
+func (*QueryGetWorkerGroupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7aa663478f7b9c9b, []int{13}
+}
+func (m *QueryGetWorkerGroupResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetWorkerGroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetWorkerGroupResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetWorkerGroupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetWorkerGroupResponse.Merge(m, src)
+}
+func (m *QueryGetWorkerGroupResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetWorkerGroupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetWorkerGroupResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetWorkerGroupResponse proto.InternalMessageInfo
+
+func (m *QueryGetWorkerGroupResponse) GetGroup() *WorkerGroup {
+	if m != nil {
+		return m.Group
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryGetVideoUpscalerTaskRequest)(nil), "janction.videoUpscaler.v1.QueryGetVideoUpscalerTaskRequest")
 	proto.RegisterType((*QueryGetVideoUpscalerTaskResponse)(nil), "janction.videoUpscaler.v1.QueryGetVideoUpscalerTaskResponse")
@@ -397,6 +662,12 @@ func init() {
 	proto.RegisterType((*QueryGetPendingVideoUpscalerTaskResponse)(nil), "janction.videoUpscaler.v1.QueryGetPendingVideoUpscalerTaskResponse")
 	proto.RegisterType((*QueryGetWorkerRequest)(nil), "janction.videoUpscaler.v1.QueryGetWorkerRequest")
 	proto.RegisterType((*QueryGetWorkerResponse)(nil), "janction.videoUpscaler.v1.QueryGetWorkerResponse")
+	proto.RegisterType((*QueryGetTaskOutputManifestRequest)(nil), "janction.videoUpscaler.v1.QueryGetTaskOutputManifestRequest")
+	proto.RegisterType((*QueryGetTaskOutputManifestResponse)(nil), "janction.videoUpscaler.v1.QueryGetTaskOutputManifestResponse")
+	proto.RegisterType((*QueryGetModuleAccountingRequest)(nil), "janction.videoUpscaler.v1.QueryGetModuleAccountingRequest")
+	proto.RegisterType((*QueryGetModuleAccountingResponse)(nil), "janction.videoUpscaler.v1.QueryGetModuleAccountingResponse")
+	proto.RegisterType((*QueryGetWorkerGroupRequest)(nil), "janction.videoUpscaler.v1.QueryGetWorkerGroupRequest")
+	proto.RegisterType((*QueryGetWorkerGroupResponse)(nil), "janction.videoUpscaler.v1.QueryGetWorkerGroupResponse")
 }
 
 func init() {
@@ -404,41 +675,56 @@ func init() {
 }
 
 var fileDescriptor_7aa663478f7b9c9b = []byte{
-	// 538 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcd, 0x4a, 0xcc, 0x4b,
-	0x2e, 0xc9, 0xcc, 0xcf, 0xd3, 0x2f, 0xcb, 0x4c, 0x49, 0xcd, 0x0f, 0x2d, 0x28, 0x4e, 0x4e, 0xcc,
-	0x49, 0x2d, 0xd2, 0x2f, 0x33, 0xd4, 0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0x92, 0x84, 0x29, 0xd3, 0x43, 0x51, 0xa6, 0x57, 0x66, 0x28, 0x85, 0xc7, 0x84, 0x92,
-	0xca, 0x82, 0xd4, 0x62, 0x88, 0x09, 0x52, 0x32, 0xe9, 0xf9, 0xf9, 0xe9, 0x39, 0xa9, 0xfa, 0x89,
-	0x05, 0x99, 0xfa, 0x89, 0x79, 0x79, 0xf9, 0x25, 0x89, 0x20, 0x3d, 0x30, 0x59, 0xe9, 0xe4, 0xfc,
-	0xe2, 0xdc, 0xfc, 0x62, 0x88, 0x9d, 0x68, 0x96, 0x4b, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x99,
-	0xfa, 0x20, 0x16, 0x44, 0x54, 0xc9, 0x82, 0x4b, 0x21, 0x10, 0xa4, 0xc8, 0x3d, 0xb5, 0x24, 0x0c,
-	0xd9, 0xe2, 0x90, 0xc4, 0xe2, 0xec, 0xa0, 0xd4, 0xc2, 0xd2, 0xd4, 0xe2, 0x12, 0x21, 0x11, 0x2e,
-	0xd6, 0xcc, 0xbc, 0x94, 0xd4, 0x0a, 0x09, 0x46, 0x05, 0x46, 0x0d, 0xce, 0x20, 0x08, 0x47, 0xa9,
-	0x91, 0x91, 0x4b, 0x11, 0x8f, 0xd6, 0xe2, 0x82, 0xfc, 0xbc, 0xe2, 0x54, 0xa1, 0x18, 0x2e, 0x61,
-	0xb0, 0x87, 0xe2, 0x4b, 0xa1, 0xb2, 0xf1, 0x25, 0x89, 0xc5, 0xd9, 0x60, 0x93, 0xb8, 0x8d, 0x74,
-	0xf4, 0x70, 0x06, 0x88, 0x1e, 0xa6, 0x91, 0x82, 0x65, 0xe8, 0x42, 0x4a, 0x76, 0x38, 0x5c, 0xef,
-	0x93, 0x9f, 0x5e, 0x0c, 0x73, 0xbd, 0x14, 0x17, 0x47, 0x49, 0x46, 0x51, 0x6a, 0x62, 0x8a, 0x67,
-	0x0a, 0xd4, 0x03, 0x70, 0x3e, 0x6e, 0x3f, 0x40, 0x0c, 0xc0, 0xe9, 0x87, 0x9c, 0xfc, 0xf4, 0x62,
-	0x52, 0xfd, 0x00, 0x36, 0x12, 0xd5, 0x0f, 0x20, 0x21, 0x25, 0x4d, 0x2e, 0x75, 0x98, 0x13, 0x02,
-	0x52, 0xf3, 0x52, 0x32, 0xf3, 0xd2, 0x71, 0x45, 0x84, 0x52, 0x17, 0x23, 0x97, 0x06, 0x61, 0xb5,
-	0x50, 0x57, 0xc7, 0x71, 0x89, 0x60, 0x09, 0x79, 0x90, 0xb3, 0x99, 0x49, 0x0e, 0x7a, 0x21, 0x8c,
-	0xa0, 0x2f, 0x56, 0xd2, 0xe7, 0x12, 0x85, 0xb9, 0x25, 0x3c, 0xbf, 0x28, 0x3b, 0xb5, 0x08, 0x16,
-	0xe0, 0x62, 0x5c, 0x6c, 0xe5, 0x60, 0x01, 0x68, 0x70, 0x43, 0x79, 0x4a, 0xc1, 0x5c, 0x62, 0xe8,
-	0x1a, 0xa0, 0x4e, 0xb5, 0x44, 0xd1, 0xc1, 0x6d, 0xa4, 0x88, 0xc7, 0x71, 0x50, 0xad, 0x50, 0x0d,
-	0x46, 0x4f, 0x59, 0xb9, 0x58, 0xc1, 0xa6, 0x0a, 0x1d, 0x62, 0xe4, 0x12, 0xc1, 0x96, 0x14, 0x85,
-	0xac, 0xf1, 0x98, 0x46, 0x28, 0xed, 0x4b, 0xd9, 0x90, 0xa7, 0x19, 0xe2, 0x31, 0x25, 0xfd, 0x8e,
-	0xe7, 0x1b, 0xb4, 0x18, 0x9b, 0x2e, 0x3f, 0x99, 0xcc, 0xa4, 0x22, 0xa4, 0xa4, 0x8f, 0x3b, 0x8f,
-	0x57, 0x83, 0xf3, 0x54, 0xad, 0xd0, 0x51, 0x2c, 0x9e, 0x00, 0xa5, 0x12, 0xd2, 0x3d, 0x81, 0x94,
-	0x05, 0x48, 0xf7, 0x04, 0x72, 0xf2, 0x57, 0x32, 0x42, 0x78, 0x42, 0x5d, 0x48, 0x15, 0x9f, 0x27,
-	0x60, 0xf9, 0xaa, 0x56, 0x68, 0x11, 0x23, 0x17, 0x27, 0x3c, 0x9e, 0x85, 0x0c, 0x88, 0xb0, 0x1f,
-	0x25, 0x0d, 0x49, 0x19, 0x92, 0xa0, 0x03, 0xea, 0x4c, 0x03, 0x84, 0x33, 0x55, 0x85, 0x94, 0xf1,
-	0x39, 0x13, 0x92, 0x74, 0x6a, 0x85, 0x56, 0x32, 0x72, 0xc9, 0xe0, 0xc9, 0x49, 0xc5, 0x42, 0x4e,
-	0x44, 0xb8, 0x82, 0x40, 0x9e, 0x95, 0x72, 0xa6, 0xc8, 0x0c, 0xa8, 0xdf, 0x18, 0x9c, 0xac, 0x4f,
-	0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18,
-	0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x31, 0x3d, 0xb3, 0x24, 0xa3, 0x34,
-	0x49, 0x2f, 0x39, 0x3f, 0x17, 0x87, 0xa7, 0x93, 0xd8, 0xc0, 0x65, 0xbd, 0x31, 0x20, 0x00, 0x00,
-	0xff, 0xff, 0x97, 0x87, 0x92, 0xf2, 0xa7, 0x06, 0x00, 0x00,
+	// 776 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x96, 0xcd, 0x4f, 0x13, 0x4f,
+	0x18, 0xc7, 0x3b, 0xfc, 0x80, 0xc0, 0x43, 0xf2, 0x4b, 0x18, 0x0a, 0xc1, 0x85, 0x54, 0x3a, 0x8a,
+	0x80, 0x2f, 0x5d, 0x5a, 0xd4, 0xa8, 0x45, 0x12, 0xf1, 0x40, 0x88, 0x12, 0x15, 0xdf, 0x12, 0x35,
+	0x92, 0xa5, 0x1d, 0x97, 0x85, 0x76, 0x67, 0xd9, 0xd9, 0xad, 0x12, 0xd2, 0x83, 0x7a, 0x31, 0x9e,
+	0x4c, 0xfc, 0x0f, 0xbc, 0x79, 0xf3, 0xe2, 0xc1, 0x2b, 0x89, 0x89, 0x47, 0x12, 0x3d, 0x78, 0x34,
+	0x60, 0xe2, 0xbf, 0x61, 0x76, 0x77, 0xb6, 0x6f, 0xec, 0x6e, 0x5f, 0xbc, 0xcd, 0x4c, 0xe7, 0xfb,
+	0xcc, 0xe7, 0xfb, 0x3c, 0x9b, 0x6f, 0x0a, 0x93, 0x9b, 0x8a, 0x9e, 0xb3, 0x34, 0xa6, 0xcb, 0x25,
+	0x2d, 0x4f, 0xd9, 0x7d, 0x83, 0xe7, 0x94, 0x02, 0x35, 0xe5, 0x52, 0x5a, 0xde, 0xb6, 0xa9, 0xb9,
+	0x93, 0x32, 0x4c, 0x66, 0x31, 0x7c, 0xcc, 0xbf, 0x96, 0xaa, 0xbb, 0x96, 0x2a, 0xa5, 0xa5, 0x88,
+	0x0a, 0xd6, 0x8e, 0x41, 0xb9, 0x57, 0x41, 0x1a, 0x57, 0x19, 0x53, 0x0b, 0x54, 0x56, 0x0c, 0x4d,
+	0x56, 0x74, 0x9d, 0x59, 0x8a, 0xa3, 0xf1, 0x7f, 0x1d, 0xcb, 0x31, 0x5e, 0x64, 0xdc, 0x7b, 0xb3,
+	0xe1, 0x71, 0x29, 0xae, 0x32, 0x95, 0xb9, 0x4b, 0xd9, 0x59, 0x79, 0xa7, 0xe4, 0x12, 0x4c, 0xdc,
+	0x71, 0x2e, 0x2d, 0x51, 0xeb, 0x41, 0xed, 0xc3, 0xf7, 0x14, 0xbe, 0xb5, 0x4a, 0xb7, 0x6d, 0xca,
+	0x2d, 0x1c, 0x87, 0x1e, 0x4d, 0xcf, 0xd3, 0x17, 0xa3, 0x68, 0x02, 0x4d, 0xf7, 0xaf, 0x7a, 0x1b,
+	0xf2, 0x12, 0x41, 0x32, 0x42, 0xca, 0x0d, 0xa6, 0x73, 0x8a, 0x9f, 0xc0, 0x90, 0x6b, 0x68, 0xcd,
+	0x16, 0xbf, 0xae, 0x59, 0x0a, 0xdf, 0x72, 0x2b, 0x0d, 0x64, 0xce, 0xa6, 0x42, 0x1b, 0x92, 0x3a,
+	0x5a, 0x72, 0xb0, 0xd4, 0x78, 0x44, 0x16, 0x42, 0xe8, 0x6f, 0x32, 0x95, 0xfb, 0xf4, 0x12, 0xf4,
+	0x59, 0x1b, 0x26, 0x55, 0xf2, 0xcb, 0x79, 0x61, 0xa0, 0xb2, 0x0f, 0xf7, 0xe0, 0x15, 0x08, 0xf5,
+	0x50, 0x60, 0x2a, 0x6f, 0xd7, 0x83, 0x5b, 0x72, 0xb0, 0xd4, 0x78, 0x44, 0x66, 0x60, 0xca, 0x47,
+	0xb8, 0x4d, 0xf5, 0xbc, 0xa6, 0xab, 0x61, 0x83, 0x20, 0x6f, 0x11, 0x4c, 0x37, 0xbf, 0x2b, 0xa8,
+	0x9f, 0x42, 0x3c, 0xa0, 0xf3, 0x0e, 0xf6, 0x7f, 0x6d, 0xb7, 0x1e, 0x1f, 0x69, 0x3d, 0x27, 0x32,
+	0x0c, 0xfb, 0x2c, 0x0f, 0x99, 0xb9, 0x45, 0x4d, 0xbf, 0xe1, 0x23, 0xd0, 0xfb, 0xdc, 0x3d, 0x10,
+	0xed, 0x16, 0x3b, 0x72, 0x17, 0x46, 0x1a, 0x05, 0x02, 0xf5, 0x72, 0x9d, 0x62, 0x20, 0x93, 0x8c,
+	0x80, 0x13, 0x52, 0xbf, 0x68, 0xb6, 0x3a, 0x40, 0x07, 0xeb, 0x96, 0x6d, 0x19, 0xb6, 0xb5, 0xa2,
+	0xe8, 0xda, 0x33, 0xca, 0xad, 0x1a, 0x22, 0xc7, 0x7b, 0xe5, 0x03, 0x10, 0x3b, 0xf2, 0x1a, 0x01,
+	0x89, 0x52, 0x0b, 0xbc, 0x65, 0xe8, 0x2b, 0x8a, 0x33, 0x01, 0x78, 0x2e, 0x02, 0x30, 0xa0, 0x50,
+	0x45, 0x8e, 0x31, 0x74, 0x6f, 0x72, 0xa6, 0x8f, 0x76, 0xb9, 0x1c, 0xee, 0x9a, 0x24, 0xe1, 0xb8,
+	0x0f, 0xb1, 0xc2, 0xf2, 0x76, 0x81, 0x5e, 0xcb, 0xe5, 0x98, 0xad, 0x5b, 0x9a, 0xae, 0xfa, 0x83,
+	0x67, 0x30, 0x11, 0x7e, 0x45, 0x50, 0xde, 0x00, 0x50, 0x2a, 0xa7, 0x82, 0xf3, 0x4c, 0x04, 0xe7,
+	0x91, 0x42, 0x35, 0x72, 0x32, 0x0b, 0x52, 0xfd, 0xac, 0x96, 0x4c, 0x66, 0x1b, 0x7e, 0x3f, 0x31,
+	0x74, 0xeb, 0x4a, 0x91, 0x8a, 0x6e, 0xba, 0x6b, 0xf2, 0x18, 0xc6, 0x02, 0x15, 0x82, 0x6e, 0x1e,
+	0x7a, 0x54, 0xe7, 0x40, 0x80, 0x9d, 0x6a, 0x3a, 0x61, 0x4f, 0xee, 0x89, 0x32, 0x5f, 0x00, 0x7a,
+	0xdc, 0xea, 0x78, 0x0f, 0x41, 0x3c, 0x28, 0x70, 0x70, 0x36, 0xa2, 0x62, 0xb3, 0x84, 0x93, 0xe6,
+	0x3b, 0x13, 0x7b, 0xde, 0x88, 0xfc, 0xe6, 0xcf, 0xa7, 0xd3, 0xe8, 0xd5, 0xf7, 0xdf, 0xef, 0xbb,
+	0x4e, 0x62, 0x22, 0x87, 0x27, 0xf9, 0xae, 0x9b, 0x9c, 0x65, 0xfc, 0x35, 0xc0, 0x84, 0x93, 0x05,
+	0xed, 0x9b, 0xa8, 0x09, 0x3a, 0x69, 0xbe, 0x33, 0xb1, 0x30, 0x91, 0xa9, 0x9a, 0x98, 0xc2, 0x93,
+	0x51, 0x26, 0xfc, 0xf4, 0x2c, 0xe3, 0x0f, 0x08, 0xfa, 0x2b, 0xf3, 0xc6, 0xb3, 0x2d, 0xbc, 0x5f,
+	0x97, 0x14, 0x52, 0xba, 0x0d, 0x85, 0xc0, 0x9c, 0xad, 0x62, 0x4e, 0xe2, 0x13, 0x51, 0x98, 0x5e,
+	0x40, 0x94, 0xf1, 0x47, 0x04, 0xe3, 0x11, 0x79, 0xc9, 0xf1, 0x62, 0x0b, 0x14, 0x4d, 0x92, 0x59,
+	0xba, 0xfe, 0x4f, 0x35, 0x84, 0xb7, 0x18, 0xfe, 0x81, 0x60, 0x38, 0x30, 0x8b, 0x70, 0x2b, 0xc3,
+	0x0d, 0x0d, 0x40, 0xe9, 0x6a, 0x87, 0x6a, 0x01, 0xb6, 0x50, 0x6d, 0xfa, 0x1c, 0x4e, 0x47, 0x34,
+	0xdd, 0xc9, 0x55, 0x79, 0xd7, 0x4b, 0xd7, 0xb2, 0x5c, 0x49, 0xbd, 0x3d, 0x04, 0x43, 0x01, 0xd1,
+	0x85, 0xaf, 0xb4, 0x80, 0x15, 0x12, 0x89, 0x52, 0xb6, 0x23, 0x6d, 0xbb, 0x1f, 0x7b, 0x35, 0x12,
+	0xf1, 0x67, 0x04, 0xff, 0xd7, 0x87, 0x1b, 0xbe, 0xd0, 0xf2, 0xf7, 0x5b, 0x1b, 0x9f, 0xd2, 0xc5,
+	0x76, 0x65, 0x82, 0xfa, 0x7c, 0x95, 0x7a, 0x06, 0x4f, 0x45, 0x50, 0xbb, 0xa1, 0x29, 0xef, 0x3a,
+	0xb9, 0x5c, 0x5e, 0xcc, 0x7e, 0x3b, 0x48, 0xa0, 0xfd, 0x83, 0x04, 0xfa, 0x75, 0x90, 0x40, 0xef,
+	0x0e, 0x13, 0xb1, 0xfd, 0xc3, 0x44, 0xec, 0xe7, 0x61, 0x22, 0xf6, 0x28, 0xa9, 0x6a, 0xd6, 0x86,
+	0xbd, 0x9e, 0xca, 0xb1, 0x62, 0x48, 0xb1, 0xf5, 0x5e, 0xf7, 0x5f, 0xe2, 0xdc, 0xdf, 0x01, 0x00,
+	0x6c, 0x8a, 0x9a, 0x2b, 0xe1, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -458,6 +744,12 @@ type QueryClient interface {
 	GetVideoUpscalerLogs(ctx context.Context, in *QueryGetVideoUpscalerLogsRequest, opts ...grpc.CallOption) (*QueryGetVideoUpscalerLogsResponse, error)
 	GetWorker(ctx context.Context, in *QueryGetWorkerRequest, opts ...grpc.CallOption) (*QueryGetWorkerResponse, error)
 	GetPendingVideoUpscalerTasks(ctx context.Context, in *QueryGetPendingVideoUpscalerTaskRequest, opts ...grpc.CallOption) (*QueryGetPendingVideoUpscalerTaskResponse, error)
+	// GetTaskOutputManifest returns the ordered list of upscaled frames of a task
+	GetTaskOutputManifest(ctx context.Context, in *QueryGetTaskOutputManifestRequest, opts ...grpc.CallOption) (*QueryGetTaskOutputManifestResponse, error)
+	// GetModuleAccounting returns the escrowed rewards, worker stakes and payouts of the module
+	GetModuleAccounting(ctx context.Context, in *QueryGetModuleAccountingRequest, opts ...grpc.CallOption) (*QueryGetModuleAccountingResponse, error)
+	// GetWorkerGroup returns the members of a worker group
+	GetWorkerGroup(ctx context.Context, in *QueryGetWorkerGroupRequest, opts ...grpc.CallOption) (*QueryGetWorkerGroupResponse, error)
 }
 
 type queryClient struct {
@@ -504,6 +796,33 @@ func (c *queryClient) GetPendingVideoUpscalerTasks(ctx context.Context, in *Quer
 	return out, nil
 }
 
+func (c *queryClient) GetTaskOutputManifest(ctx context.Context, in *QueryGetTaskOutputManifestRequest, opts ...grpc.CallOption) (*QueryGetTaskOutputManifestResponse, error) {
+	out := new(QueryGetTaskOutputManifestResponse)
+	err := c.cc.Invoke(ctx, "/janction.videoUpscaler.v1.Query/GetTaskOutputManifest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetModuleAccounting(ctx context.Context, in *QueryGetModuleAccountingRequest, opts ...grpc.CallOption) (*QueryGetModuleAccountingResponse, error) {
+	out := new(QueryGetModuleAccountingResponse)
+	err := c.cc.Invoke(ctx, "/janction.videoUpscaler.v1.Query/GetModuleAccounting", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetWorkerGroup(ctx context.Context, in *QueryGetWorkerGroupRequest, opts ...grpc.CallOption) (*QueryGetWorkerGroupResponse, error) {
+	out := new(QueryGetWorkerGroupResponse)
+	err := c.cc.Invoke(ctx, "/janction.videoUpscaler.v1.Query/GetWorkerGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// GetVideoUpscalerTask returns the task based on the taskId
@@ -511,6 +830,12 @@ type QueryServer interface {
 	GetVideoUpscalerLogs(context.Context, *QueryGetVideoUpscalerLogsRequest) (*QueryGetVideoUpscalerLogsResponse, error)
 	GetWorker(context.Context, *QueryGetWorkerRequest) (*QueryGetWorkerResponse, error)
 	GetPendingVideoUpscalerTasks(context.Context, *QueryGetPendingVideoUpscalerTaskRequest) (*QueryGetPendingVideoUpscalerTaskResponse, error)
+	// GetTaskOutputManifest returns the ordered list of upscaled frames of a task
+	GetTaskOutputManifest(context.Context, *QueryGetTaskOutputManifestRequest) (*QueryGetTaskOutputManifestResponse, error)
+	// GetModuleAccounting returns the escrowed rewards, worker stakes and payouts of the module
+	GetModuleAccounting(context.Context, *QueryGetModuleAccountingRequest) (*QueryGetModuleAccountingResponse, error)
+	// GetWorkerGroup returns the members of a worker group
+	GetWorkerGroup(context.Context, *QueryGetWorkerGroupRequest) (*QueryGetWorkerGroupResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -529,6 +854,15 @@ func (*UnimplementedQueryServer) GetWorker(ctx context.Context, req *QueryGetWor
 func (*UnimplementedQueryServer) GetPendingVideoUpscalerTasks(ctx context.Context, req *QueryGetPendingVideoUpscalerTaskRequest) (*QueryGetPendingVideoUpscalerTaskResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPendingVideoUpscalerTasks not implemented")
 }
+func (*UnimplementedQueryServer) GetTaskOutputManifest(ctx context.Context, req *QueryGetTaskOutputManifestRequest) (*QueryGetTaskOutputManifestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTaskOutputManifest not implemented")
+}
+func (*UnimplementedQueryServer) GetModuleAccounting(ctx context.Context, req *QueryGetModuleAccountingRequest) (*QueryGetModuleAccountingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetModuleAccounting not implemented")
+}
+func (*UnimplementedQueryServer) GetWorkerGroup(ctx context.Context, req *QueryGetWorkerGroupRequest) (*QueryGetWorkerGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkerGroup not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -606,6 +940,61 @@ func _Query_GetPendingVideoUpscalerTasks_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetTaskOutputManifest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetTaskOutputManifestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetTaskOutputManifest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/janction.videoUpscaler.v1.Query/GetTaskOutputManifest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTaskOutputManifest(ctx, req.(*QueryGetTaskOutputManifestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetModuleAccounting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetModuleAccountingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetModuleAccounting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/janction.videoUpscaler.v1.Query/GetModuleAccounting",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetModuleAccounting(ctx, req.(*QueryGetModuleAccountingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetWorkerGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetWorkerGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetWorkerGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/janction.videoUpscaler.v1.Query/GetWorkerGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetWorkerGroup(ctx, req.(*QueryGetWorkerGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "janction.videoUpscaler.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -626,6 +1015,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetPendingVideoUpscalerTasks",
 			Handler:    _Query_GetPendingVideoUpscalerTasks_Handler,
 		},
+		{
+			MethodName: "GetTaskOutputManifest",
+			Handler:    _Query_GetTaskOutputManifest_Handler,
+		},
+		{
+			MethodName: "GetModuleAccounting",
+			Handler:    _Query_GetModuleAccounting_Handler,
+		},
+		{
+			MethodName: "GetWorkerGroup",
+			Handler:    _Query_GetWorkerGroup_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "janction/videoUpscaler/v1/query.proto",
@@ -886,31 +1287,226 @@ func (m *QueryGetWorkerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryGetTaskOutputManifestRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryGetVideoUpscalerTaskRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryGetTaskOutputManifestRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetTaskOutputManifestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetVideoUpscalerTaskResponse) Size() (n int) {
+func (m *QueryGetTaskOutputManifestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetTaskOutputManifestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetTaskOutputManifestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Json) > 0 {
+		i -= len(m.Json)
+		copy(dAtA[i:], m.Json)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Json)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Manifest != nil {
+		{
+			size, err := m.Manifest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetModuleAccountingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetModuleAccountingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetModuleAccountingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetModuleAccountingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetModuleAccountingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetModuleAccountingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Accounting != nil {
+		{
+			size, err := m.Accounting.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetWorkerGroupRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetWorkerGroupRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetWorkerGroupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetWorkerGroupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetWorkerGroupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetWorkerGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Group != nil {
+		{
+			size, err := m.Group.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryGetVideoUpscalerTaskRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetVideoUpscalerTaskResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -970,42 +1566,590 @@ func (m *QueryGetPendingVideoUpscalerTaskResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func (m *QueryGetWorkerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Worker)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetWorkerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Worker != nil {
+		l = m.Worker.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetTaskOutputManifestRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetTaskOutputManifestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Manifest != nil {
+		l = m.Manifest.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Json)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetModuleAccountingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetModuleAccountingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Accounting != nil {
+		l = m.Accounting.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetWorkerGroupRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetWorkerGroupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Group != nil {
+		l = m.Group.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryGetVideoUpscalerTaskRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetVideoUpscalerTaskRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetVideoUpscalerTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetVideoUpscalerTaskResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetVideoUpscalerTaskResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetVideoUpscalerTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VideoUpscalerTask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VideoUpscalerTask == nil {
+				m.VideoUpscalerTask = &VideoUpscalerTask{}
+			}
+			if err := m.VideoUpscalerTask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetVideoUpscalerLogsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetVideoUpscalerLogsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetVideoUpscalerLogsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThreadId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ThreadId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetVideoUpscalerLogsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetVideoUpscalerLogsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetVideoUpscalerLogsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VideoUpscalerLogs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VideoUpscalerLogs == nil {
+				m.VideoUpscalerLogs = &VideoUpscalerLogs{}
+			}
+			if err := m.VideoUpscalerLogs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryGetWorkerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Worker)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryGetWorkerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetPendingVideoUpscalerTaskRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPendingVideoUpscalerTaskRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPendingVideoUpscalerTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	if m.Worker != nil {
-		l = m.Worker.Size()
-		n += 1 + l + sovQuery(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *QueryGetPendingVideoUpscalerTaskResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPendingVideoUpscalerTaskResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPendingVideoUpscalerTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VideoUpscalerTasks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VideoUpscalerTasks = append(m.VideoUpscalerTasks, &VideoUpscalerTask{})
+			if err := m.VideoUpscalerTasks[len(m.VideoUpscalerTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryGetVideoUpscalerTaskRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetWorkerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1028,15 +2172,15 @@ func (m *QueryGetVideoUpscalerTaskRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetVideoUpscalerTaskRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetWorkerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetVideoUpscalerTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetWorkerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1064,7 +2208,7 @@ func (m *QueryGetVideoUpscalerTaskRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Index = string(dAtA[iNdEx:postIndex])
+			m.Worker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1087,7 +2231,7 @@ func (m *QueryGetVideoUpscalerTaskRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetVideoUpscalerTaskResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetWorkerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1110,15 +2254,15 @@ func (m *QueryGetVideoUpscalerTaskResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetVideoUpscalerTaskResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetWorkerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetVideoUpscalerTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetWorkerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VideoUpscalerTask", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1145,10 +2289,10 @@ func (m *QueryGetVideoUpscalerTaskResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VideoUpscalerTask == nil {
-				m.VideoUpscalerTask = &VideoUpscalerTask{}
+			if m.Worker == nil {
+				m.Worker = &Worker{}
 			}
-			if err := m.VideoUpscalerTask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Worker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1173,7 +2317,7 @@ func (m *QueryGetVideoUpscalerTaskResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetVideoUpscalerLogsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetTaskOutputManifestRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1196,15 +2340,15 @@ func (m *QueryGetVideoUpscalerLogsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetVideoUpscalerLogsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetTaskOutputManifestRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetVideoUpscalerLogsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetTaskOutputManifestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ThreadId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1232,7 +2376,7 @@ func (m *QueryGetVideoUpscalerLogsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ThreadId = string(dAtA[iNdEx:postIndex])
+			m.TaskId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1255,7 +2399,7 @@ func (m *QueryGetVideoUpscalerLogsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetVideoUpscalerLogsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetTaskOutputManifestResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1278,15 +2422,15 @@ func (m *QueryGetVideoUpscalerLogsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetVideoUpscalerLogsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetTaskOutputManifestResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetVideoUpscalerLogsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetTaskOutputManifestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VideoUpscalerLogs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Manifest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1313,13 +2457,45 @@ func (m *QueryGetVideoUpscalerLogsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VideoUpscalerLogs == nil {
-				m.VideoUpscalerLogs = &VideoUpscalerLogs{}
+			if m.Manifest == nil {
+				m.Manifest = &TaskOutputManifest{}
 			}
-			if err := m.VideoUpscalerLogs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Manifest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Json", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Json = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1341,7 +2517,7 @@ func (m *QueryGetVideoUpscalerLogsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetPendingVideoUpscalerTaskRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetModuleAccountingRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1364,10 +2540,10 @@ func (m *QueryGetPendingVideoUpscalerTaskRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPendingVideoUpscalerTaskRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetModuleAccountingRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPendingVideoUpscalerTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetModuleAccountingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1391,7 +2567,7 @@ func (m *QueryGetPendingVideoUpscalerTaskRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetPendingVideoUpscalerTaskResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetModuleAccountingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1414,15 +2590,15 @@ func (m *QueryGetPendingVideoUpscalerTaskResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPendingVideoUpscalerTaskResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetModuleAccountingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPendingVideoUpscalerTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetModuleAccountingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VideoUpscalerTasks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounting", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1449,8 +2625,10 @@ func (m *QueryGetPendingVideoUpscalerTaskResponse) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VideoUpscalerTasks = append(m.VideoUpscalerTasks, &VideoUpscalerTask{})
-			if err := m.VideoUpscalerTasks[len(m.VideoUpscalerTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Accounting == nil {
+				m.Accounting = &ModuleAccounting{}
+			}
+			if err := m.Accounting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1475,7 +2653,7 @@ func (m *QueryGetPendingVideoUpscalerTaskResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryGetWorkerRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetWorkerGroupRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1498,15 +2676,15 @@ func (m *QueryGetWorkerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetWorkerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetWorkerGroupRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetWorkerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetWorkerGroupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1534,7 +2712,7 @@ func (m *QueryGetWorkerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Worker = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1557,7 +2735,7 @@ func (m *QueryGetWorkerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetWorkerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetWorkerGroupResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1580,15 +2758,15 @@ func (m *QueryGetWorkerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetWorkerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetWorkerGroupResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetWorkerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetWorkerGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1615,10 +2793,10 @@ func (m *QueryGetWorkerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Worker == nil {
-				m.Worker = &Worker{}
+			if m.Group == nil {
+				m.Group = &WorkerGroup{}
 			}
-			if err := m.Worker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Group.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -42,6 +42,22 @@ type MsgCreateVideoUpscalerTask struct {
 	Threads    int32       `protobuf:"varint,5,opt,name=threads,proto3" json:"threads,omitempty"`
 	Scale      int32       `protobuf:"varint,6,opt,name=scale,proto3" json:"scale,omitempty"`
 	Reward     *types.Coin `protobuf:"bytes,7,opt,name=reward,proto3" json:"reward,omitempty"`
+	// if set, threads are sized on the fly from the render speed of each worker
+	TargetThreadSeconds int64 `protobuf:"varint,8,opt,name=target_thread_seconds,json=targetThreadSeconds,proto3" json:"target_thread_seconds,omitempty"`
+	// workers must match these requirements to subscribe
+	Requirements *TaskRequirements `protobuf:"bytes,9,opt,name=requirements,proto3" json:"requirements,omitempty"`
+	// optional allowlist, denylist or worker group of the workers that can process the task
+	Access *TaskAccess `protobuf:"bytes,10,opt,name=access,proto3" json:"access,omitempty"`
+	// block height after which unused reward is refunded. Zero never expires
+	ExpiryHeight int64 `protobuf:"varint,11,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+	// optional fee, in the reward denom, so workers pick the task first
+	PriorityFee *types.Coin `protobuf:"bytes,12,opt,name=priority_fee,json=priorityFee,proto3" json:"priority_fee,omitempty"`
+	// scale, denoise, model, deterministic mode and validation mode. The scale must match the scale field if both are set
+	Options *UpscaleOptions `protobuf:"bytes,13,opt,name=options,proto3" json:"options,omitempty"`
+	// properties of the input, from the probe command. The frame range must be within the video
+	MediaInfo *MediaInfo `protobuf:"bytes,14,opt,name=media_info,json=mediaInfo,proto3" json:"media_info,omitempty"`
+	// video file or image sequence directory. Defaults to a video file
+	InputType InputType `protobuf:"varint,15,opt,name=input_type,json=inputType,proto3,enum=janction.videoUpscaler.v1.InputType" json:"input_type,omitempty"`
 }
 
 func (m *MsgCreateVideoUpscalerTask) Reset()         { *m = MsgCreateVideoUpscalerTask{} }
@@ -126,6 +142,62 @@ func (m *MsgCreateVideoUpscalerTask) GetReward() *types.Coin {
 	return nil
 }
 
+func (m *MsgCreateVideoUpscalerTask) GetTargetThreadSeconds() int64 {
+	if m != nil {
+		return m.TargetThreadSeconds
+	}
+	return 0
+}
+
+func (m *MsgCreateVideoUpscalerTask) GetRequirements() *TaskRequirements {
+	if m != nil {
+		return m.Requirements
+	}
+	return nil
+}
+
+func (m *MsgCreateVideoUpscalerTask) GetAccess() *TaskAccess {
+	if m != nil {
+		return m.Access
+	}
+	return nil
+}
+
+func (m *MsgCreateVideoUpscalerTask) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+func (m *MsgCreateVideoUpscalerTask) GetPriorityFee() *types.Coin {
+	if m != nil {
+		return m.PriorityFee
+	}
+	return nil
+}
+
+func (m *MsgCreateVideoUpscalerTask) GetOptions() *UpscaleOptions {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+func (m *MsgCreateVideoUpscalerTask) GetMediaInfo() *MediaInfo {
+	if m != nil {
+		return m.MediaInfo
+	}
+	return nil
+}
+
+func (m *MsgCreateVideoUpscalerTask) GetInputType() InputType {
+	if m != nil {
+		return m.InputType
+	}
+	return InputType_INPUT_TYPE_VIDEO
+}
+
 // MsgCreateGameResponse defines the Msg/CreateGame response type.
 type MsgCreateVideoUpscalerTaskResponse struct {
 	TaskId string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
@@ -176,6 +248,11 @@ type MsgAddWorker struct {
 	PublicIp string     `protobuf:"bytes,2,opt,name=public_ip,json=publicIp,proto3" json:"public_ip,omitempty"`
 	IpfsId   string     `protobuf:"bytes,3,opt,name=ipfs_id,json=ipfsId,proto3" json:"ipfs_id,omitempty"`
 	Stake    types.Coin `protobuf:"bytes,4,opt,name=stake,proto3" json:"stake"`
+	// amount of threads the worker will process concurrently. Defaults to 1
+	MaxThreads   int32               `protobuf:"varint,5,opt,name=max_threads,json=maxThreads,proto3" json:"max_threads,omitempty"`
+	Capabilities *WorkerCapabilities `protobuf:"bytes,6,opt,name=capabilities,proto3" json:"capabilities,omitempty"`
+	// full multiaddrs of the IPFS node, like /ip6/::1/udp/4001/quic-v1 or /dns4/worker.example.com/tcp/4001
+	AnnounceAddrs []string `protobuf:"bytes,7,rep,name=announce_addrs,json=announceAddrs,proto3" json:"announce_addrs,omitempty"`
 }
 
 func (m *MsgAddWorker) Reset()         { *m = MsgAddWorker{} }
@@ -239,6 +316,27 @@ func (m *MsgAddWorker) GetStake() types.Coin {
 	return types.Coin{}
 }
 
+func (m *MsgAddWorker) GetMaxThreads() int32 {
+	if m != nil {
+		return m.MaxThreads
+	}
+	return 0
+}
+
+func (m *MsgAddWorker) GetCapabilities() *WorkerCapabilities {
+	if m != nil {
+		return m.Capabilities
+	}
+	return nil
+}
+
+func (m *MsgAddWorker) GetAnnounceAddrs() []string {
+	if m != nil {
+		return m.AnnounceAddrs
+	}
+	return nil
+}
+
 type MsgAddWorkerResponse struct {
 	Ok      bool   `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
 	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
@@ -291,24 +389,24 @@ func (m *MsgAddWorkerResponse) GetMessage() string {
 	return ""
 }
 
-type MsgSubscribeWorkerToTask struct {
-	Address  string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	TaskId   string `protobuf:"bytes,2,opt,name=taskId,proto3" json:"taskId,omitempty"`
-	ThreadId string `protobuf:"bytes,3,opt,name=threadId,proto3" json:"threadId,omitempty"`
+type MsgSetWorkerGroup struct {
+	Creator string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Name    string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Members []string `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`
 }
 
-func (m *MsgSubscribeWorkerToTask) Reset()         { *m = MsgSubscribeWorkerToTask{} }
-func (m *MsgSubscribeWorkerToTask) String() string { return proto.CompactTextString(m) }
-func (*MsgSubscribeWorkerToTask) ProtoMessage()    {}
-func (*MsgSubscribeWorkerToTask) Descriptor() ([]byte, []int) {
+func (m *MsgSetWorkerGroup) Reset()         { *m = MsgSetWorkerGroup{} }
+func (m *MsgSetWorkerGroup) String() string { return proto.CompactTextString(m) }
+func (*MsgSetWorkerGroup) ProtoMessage()    {}
+func (*MsgSetWorkerGroup) Descriptor() ([]byte, []int) {
 	return fileDescriptor_915e0f75aba824d0, []int{4}
 }
-func (m *MsgSubscribeWorkerToTask) XXX_Unmarshal(b []byte) error {
+func (m *MsgSetWorkerGroup) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSubscribeWorkerToTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSetWorkerGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSubscribeWorkerToTask.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSetWorkerGroup.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -318,55 +416,54 @@ func (m *MsgSubscribeWorkerToTask) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *MsgSubscribeWorkerToTask) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSubscribeWorkerToTask.Merge(m, src)
+func (m *MsgSetWorkerGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetWorkerGroup.Merge(m, src)
 }
-func (m *MsgSubscribeWorkerToTask) XXX_Size() int {
+func (m *MsgSetWorkerGroup) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSubscribeWorkerToTask) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSubscribeWorkerToTask.DiscardUnknown(m)
+func (m *MsgSetWorkerGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetWorkerGroup.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSubscribeWorkerToTask proto.InternalMessageInfo
+var xxx_messageInfo_MsgSetWorkerGroup proto.InternalMessageInfo
 
-func (m *MsgSubscribeWorkerToTask) GetAddress() string {
+func (m *MsgSetWorkerGroup) GetCreator() string {
 	if m != nil {
-		return m.Address
+		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgSubscribeWorkerToTask) GetTaskId() string {
+func (m *MsgSetWorkerGroup) GetName() string {
 	if m != nil {
-		return m.TaskId
+		return m.Name
 	}
 	return ""
 }
 
-func (m *MsgSubscribeWorkerToTask) GetThreadId() string {
+func (m *MsgSetWorkerGroup) GetMembers() []string {
 	if m != nil {
-		return m.ThreadId
+		return m.Members
 	}
-	return ""
+	return nil
 }
 
-type MsgSubscribeWorkerToTaskResponse struct {
-	ThreadId string `protobuf:"bytes,1,opt,name=threadId,proto3" json:"threadId,omitempty"`
+type MsgSetWorkerGroupResponse struct {
 }
 
-func (m *MsgSubscribeWorkerToTaskResponse) Reset()         { *m = MsgSubscribeWorkerToTaskResponse{} }
-func (m *MsgSubscribeWorkerToTaskResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgSubscribeWorkerToTaskResponse) ProtoMessage()    {}
-func (*MsgSubscribeWorkerToTaskResponse) Descriptor() ([]byte, []int) {
+func (m *MsgSetWorkerGroupResponse) Reset()         { *m = MsgSetWorkerGroupResponse{} }
+func (m *MsgSetWorkerGroupResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetWorkerGroupResponse) ProtoMessage()    {}
+func (*MsgSetWorkerGroupResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_915e0f75aba824d0, []int{5}
 }
-func (m *MsgSubscribeWorkerToTaskResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgSetWorkerGroupResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSubscribeWorkerToTaskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSetWorkerGroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSubscribeWorkerToTaskResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSetWorkerGroupResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -376,47 +473,38 @@ func (m *MsgSubscribeWorkerToTaskResponse) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *MsgSubscribeWorkerToTaskResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSubscribeWorkerToTaskResponse.Merge(m, src)
+func (m *MsgSetWorkerGroupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetWorkerGroupResponse.Merge(m, src)
 }
-func (m *MsgSubscribeWorkerToTaskResponse) XXX_Size() int {
+func (m *MsgSetWorkerGroupResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSubscribeWorkerToTaskResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSubscribeWorkerToTaskResponse.DiscardUnknown(m)
+func (m *MsgSetWorkerGroupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetWorkerGroupResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSubscribeWorkerToTaskResponse proto.InternalMessageInfo
-
-func (m *MsgSubscribeWorkerToTaskResponse) GetThreadId() string {
-	if m != nil {
-		return m.ThreadId
-	}
-	return ""
-}
+var xxx_messageInfo_MsgSetWorkerGroupResponse proto.InternalMessageInfo
 
-// Msg to Propose a solution to an specific thread
-// Actual solution is a map of hashes
-type MsgProposeSolution struct {
-	Creator    string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	TaskId     string   `protobuf:"bytes,2,opt,name=taskId,proto3" json:"taskId,omitempty"`
-	ThreadId   string   `protobuf:"bytes,3,opt,name=threadId,proto3" json:"threadId,omitempty"`
-	PublicKey  string   `protobuf:"bytes,4,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
-	Signatures []string `protobuf:"bytes,5,rep,name=signatures,proto3" json:"signatures,omitempty"`
+// replaces the network details of the worker
+type MsgUpdateWorker struct {
+	Creator       string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	PublicIp      string   `protobuf:"bytes,2,opt,name=public_ip,json=publicIp,proto3" json:"public_ip,omitempty"`
+	IpfsId        string   `protobuf:"bytes,3,opt,name=ipfs_id,json=ipfsId,proto3" json:"ipfs_id,omitempty"`
+	AnnounceAddrs []string `protobuf:"bytes,4,rep,name=announce_addrs,json=announceAddrs,proto3" json:"announce_addrs,omitempty"`
 }
 
-func (m *MsgProposeSolution) Reset()         { *m = MsgProposeSolution{} }
-func (m *MsgProposeSolution) String() string { return proto.CompactTextString(m) }
-func (*MsgProposeSolution) ProtoMessage()    {}
-func (*MsgProposeSolution) Descriptor() ([]byte, []int) {
+func (m *MsgUpdateWorker) Reset()         { *m = MsgUpdateWorker{} }
+func (m *MsgUpdateWorker) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateWorker) ProtoMessage()    {}
+func (*MsgUpdateWorker) Descriptor() ([]byte, []int) {
 	return fileDescriptor_915e0f75aba824d0, []int{6}
 }
-func (m *MsgProposeSolution) XXX_Unmarshal(b []byte) error {
+func (m *MsgUpdateWorker) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgProposeSolution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgUpdateWorker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgProposeSolution.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgUpdateWorker.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -426,69 +514,61 @@ func (m *MsgProposeSolution) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *MsgProposeSolution) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgProposeSolution.Merge(m, src)
+func (m *MsgUpdateWorker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateWorker.Merge(m, src)
 }
-func (m *MsgProposeSolution) XXX_Size() int {
+func (m *MsgUpdateWorker) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgProposeSolution) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgProposeSolution.DiscardUnknown(m)
+func (m *MsgUpdateWorker) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateWorker.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgProposeSolution proto.InternalMessageInfo
+var xxx_messageInfo_MsgUpdateWorker proto.InternalMessageInfo
 
-func (m *MsgProposeSolution) GetCreator() string {
+func (m *MsgUpdateWorker) GetCreator() string {
 	if m != nil {
 		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgProposeSolution) GetTaskId() string {
-	if m != nil {
-		return m.TaskId
-	}
-	return ""
-}
-
-func (m *MsgProposeSolution) GetThreadId() string {
+func (m *MsgUpdateWorker) GetPublicIp() string {
 	if m != nil {
-		return m.ThreadId
+		return m.PublicIp
 	}
 	return ""
 }
 
-func (m *MsgProposeSolution) GetPublicKey() string {
+func (m *MsgUpdateWorker) GetIpfsId() string {
 	if m != nil {
-		return m.PublicKey
+		return m.IpfsId
 	}
 	return ""
 }
 
-func (m *MsgProposeSolution) GetSignatures() []string {
+func (m *MsgUpdateWorker) GetAnnounceAddrs() []string {
 	if m != nil {
-		return m.Signatures
+		return m.AnnounceAddrs
 	}
 	return nil
 }
 
-// no response needed to a proposed solution
-type MsgProposeSolutionResponse struct {
+type MsgUpdateWorkerResponse struct {
 }
 
-func (m *MsgProposeSolutionResponse) Reset()         { *m = MsgProposeSolutionResponse{} }
-func (m *MsgProposeSolutionResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgProposeSolutionResponse) ProtoMessage()    {}
-func (*MsgProposeSolutionResponse) Descriptor() ([]byte, []int) {
+func (m *MsgUpdateWorkerResponse) Reset()         { *m = MsgUpdateWorkerResponse{} }
+func (m *MsgUpdateWorkerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateWorkerResponse) ProtoMessage()    {}
+func (*MsgUpdateWorkerResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_915e0f75aba824d0, []int{7}
 }
-func (m *MsgProposeSolutionResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgUpdateWorkerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgProposeSolutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgUpdateWorkerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgProposeSolutionResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgUpdateWorkerResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -498,39 +578,36 @@ func (m *MsgProposeSolutionResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *MsgProposeSolutionResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgProposeSolutionResponse.Merge(m, src)
+func (m *MsgUpdateWorkerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateWorkerResponse.Merge(m, src)
 }
-func (m *MsgProposeSolutionResponse) XXX_Size() int {
+func (m *MsgUpdateWorkerResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgProposeSolutionResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgProposeSolutionResponse.DiscardUnknown(m)
+func (m *MsgUpdateWorkerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateWorkerResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgProposeSolutionResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgUpdateWorkerResponse proto.InternalMessageInfo
 
-// Msg to Propose a solution to an specific thread
-// Actual solution is a map of hashes
-type MsgRevealSolution struct {
-	Creator  string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	TaskId   string   `protobuf:"bytes,2,opt,name=taskId,proto3" json:"taskId,omitempty"`
-	ThreadId string   `protobuf:"bytes,3,opt,name=threadId,proto3" json:"threadId,omitempty"`
-	Frames   []string `protobuf:"bytes,4,rep,name=frames,proto3" json:"frames,omitempty"`
+// replaces the capabilities of the worker after hardware changes
+type MsgUpdateWorkerCapabilities struct {
+	Creator      string              `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Capabilities *WorkerCapabilities `protobuf:"bytes,2,opt,name=capabilities,proto3" json:"capabilities,omitempty"`
 }
 
-func (m *MsgRevealSolution) Reset()         { *m = MsgRevealSolution{} }
-func (m *MsgRevealSolution) String() string { return proto.CompactTextString(m) }
-func (*MsgRevealSolution) ProtoMessage()    {}
-func (*MsgRevealSolution) Descriptor() ([]byte, []int) {
+func (m *MsgUpdateWorkerCapabilities) Reset()         { *m = MsgUpdateWorkerCapabilities{} }
+func (m *MsgUpdateWorkerCapabilities) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateWorkerCapabilities) ProtoMessage()    {}
+func (*MsgUpdateWorkerCapabilities) Descriptor() ([]byte, []int) {
 	return fileDescriptor_915e0f75aba824d0, []int{8}
 }
-func (m *MsgRevealSolution) XXX_Unmarshal(b []byte) error {
+func (m *MsgUpdateWorkerCapabilities) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRevealSolution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgUpdateWorkerCapabilities) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRevealSolution.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgUpdateWorkerCapabilities.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -540,62 +617,47 @@ func (m *MsgRevealSolution) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *MsgRevealSolution) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRevealSolution.Merge(m, src)
+func (m *MsgUpdateWorkerCapabilities) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateWorkerCapabilities.Merge(m, src)
 }
-func (m *MsgRevealSolution) XXX_Size() int {
+func (m *MsgUpdateWorkerCapabilities) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRevealSolution) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRevealSolution.DiscardUnknown(m)
+func (m *MsgUpdateWorkerCapabilities) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateWorkerCapabilities.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRevealSolution proto.InternalMessageInfo
+var xxx_messageInfo_MsgUpdateWorkerCapabilities proto.InternalMessageInfo
 
-func (m *MsgRevealSolution) GetCreator() string {
+func (m *MsgUpdateWorkerCapabilities) GetCreator() string {
 	if m != nil {
 		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgRevealSolution) GetTaskId() string {
-	if m != nil {
-		return m.TaskId
-	}
-	return ""
-}
-
-func (m *MsgRevealSolution) GetThreadId() string {
-	if m != nil {
-		return m.ThreadId
-	}
-	return ""
-}
-
-func (m *MsgRevealSolution) GetFrames() []string {
+func (m *MsgUpdateWorkerCapabilities) GetCapabilities() *WorkerCapabilities {
 	if m != nil {
-		return m.Frames
+		return m.Capabilities
 	}
 	return nil
 }
 
-// no response needed to a proposed solution
-type MsgRevealSolutionResponse struct {
+type MsgUpdateWorkerCapabilitiesResponse struct {
 }
 
-func (m *MsgRevealSolutionResponse) Reset()         { *m = MsgRevealSolutionResponse{} }
-func (m *MsgRevealSolutionResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgRevealSolutionResponse) ProtoMessage()    {}
-func (*MsgRevealSolutionResponse) Descriptor() ([]byte, []int) {
+func (m *MsgUpdateWorkerCapabilitiesResponse) Reset()         { *m = MsgUpdateWorkerCapabilitiesResponse{} }
+func (m *MsgUpdateWorkerCapabilitiesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateWorkerCapabilitiesResponse) ProtoMessage()    {}
+func (*MsgUpdateWorkerCapabilitiesResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_915e0f75aba824d0, []int{9}
 }
-func (m *MsgRevealSolutionResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgUpdateWorkerCapabilitiesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRevealSolutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgUpdateWorkerCapabilitiesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRevealSolutionResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgUpdateWorkerCapabilitiesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -605,38 +667,34 @@ func (m *MsgRevealSolutionResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *MsgRevealSolutionResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRevealSolutionResponse.Merge(m, src)
+func (m *MsgUpdateWorkerCapabilitiesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateWorkerCapabilitiesResponse.Merge(m, src)
 }
-func (m *MsgRevealSolutionResponse) XXX_Size() int {
+func (m *MsgUpdateWorkerCapabilitiesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRevealSolutionResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRevealSolutionResponse.DiscardUnknown(m)
+func (m *MsgUpdateWorkerCapabilitiesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateWorkerCapabilitiesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRevealSolutionResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgUpdateWorkerCapabilitiesResponse proto.InternalMessageInfo
 
-type MsgSubmitValidation struct {
-	Creator    string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	TaskId     string   `protobuf:"bytes,2,opt,name=taskId,proto3" json:"taskId,omitempty"`
-	ThreadId   string   `protobuf:"bytes,3,opt,name=threadId,proto3" json:"threadId,omitempty"`
-	PublicKey  string   `protobuf:"bytes,4,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
-	Signatures []string `protobuf:"bytes,5,rep,name=signatures,proto3" json:"signatures,omitempty"`
+type MsgWorkerHeartbeat struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 }
 
-func (m *MsgSubmitValidation) Reset()         { *m = MsgSubmitValidation{} }
-func (m *MsgSubmitValidation) String() string { return proto.CompactTextString(m) }
-func (*MsgSubmitValidation) ProtoMessage()    {}
-func (*MsgSubmitValidation) Descriptor() ([]byte, []int) {
+func (m *MsgWorkerHeartbeat) Reset()         { *m = MsgWorkerHeartbeat{} }
+func (m *MsgWorkerHeartbeat) String() string { return proto.CompactTextString(m) }
+func (*MsgWorkerHeartbeat) ProtoMessage()    {}
+func (*MsgWorkerHeartbeat) Descriptor() ([]byte, []int) {
 	return fileDescriptor_915e0f75aba824d0, []int{10}
 }
-func (m *MsgSubmitValidation) XXX_Unmarshal(b []byte) error {
+func (m *MsgWorkerHeartbeat) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSubmitValidation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgWorkerHeartbeat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSubmitValidation.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgWorkerHeartbeat.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -646,68 +704,129 @@ func (m *MsgSubmitValidation) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *MsgSubmitValidation) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSubmitValidation.Merge(m, src)
+func (m *MsgWorkerHeartbeat) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWorkerHeartbeat.Merge(m, src)
 }
-func (m *MsgSubmitValidation) XXX_Size() int {
+func (m *MsgWorkerHeartbeat) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSubmitValidation) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSubmitValidation.DiscardUnknown(m)
+func (m *MsgWorkerHeartbeat) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWorkerHeartbeat.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSubmitValidation proto.InternalMessageInfo
+var xxx_messageInfo_MsgWorkerHeartbeat proto.InternalMessageInfo
 
-func (m *MsgSubmitValidation) GetCreator() string {
+func (m *MsgWorkerHeartbeat) GetCreator() string {
 	if m != nil {
 		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgSubmitValidation) GetTaskId() string {
-	if m != nil {
-		return m.TaskId
-	}
-	return ""
+type MsgWorkerHeartbeatResponse struct {
 }
 
-func (m *MsgSubmitValidation) GetThreadId() string {
-	if m != nil {
-		return m.ThreadId
-	}
-	return ""
+func (m *MsgWorkerHeartbeatResponse) Reset()         { *m = MsgWorkerHeartbeatResponse{} }
+func (m *MsgWorkerHeartbeatResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWorkerHeartbeatResponse) ProtoMessage()    {}
+func (*MsgWorkerHeartbeatResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_915e0f75aba824d0, []int{11}
 }
-
-func (m *MsgSubmitValidation) GetPublicKey() string {
-	if m != nil {
-		return m.PublicKey
+func (m *MsgWorkerHeartbeatResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWorkerHeartbeatResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWorkerHeartbeatResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *MsgWorkerHeartbeatResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWorkerHeartbeatResponse.Merge(m, src)
+}
+func (m *MsgWorkerHeartbeatResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWorkerHeartbeatResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWorkerHeartbeatResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSubmitValidation) GetSignatures() []string {
+var xxx_messageInfo_MsgWorkerHeartbeatResponse proto.InternalMessageInfo
+
+// MsgUpdateParams replaces the module params, typically through a governance proposal
+type MsgUpdateParams struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Params    Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_915e0f75aba824d0, []int{12}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
 	if m != nil {
-		return m.Signatures
+		return m.Authority
 	}
-	return nil
+	return ""
 }
 
-type MsgSubmitValidationResponse struct {
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
 }
 
-func (m *MsgSubmitValidationResponse) Reset()         { *m = MsgSubmitValidationResponse{} }
-func (m *MsgSubmitValidationResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgSubmitValidationResponse) ProtoMessage()    {}
-func (*MsgSubmitValidationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_915e0f75aba824d0, []int{11}
+type MsgUpdateParamsResponse struct {
 }
-func (m *MsgSubmitValidationResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_915e0f75aba824d0, []int{13}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSubmitValidationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSubmitValidationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -717,38 +836,37 @@ func (m *MsgSubmitValidationResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *MsgSubmitValidationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSubmitValidationResponse.Merge(m, src)
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
 }
-func (m *MsgSubmitValidationResponse) XXX_Size() int {
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSubmitValidationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSubmitValidationResponse.DiscardUnknown(m)
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSubmitValidationResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
-type MsgSubmitSolution struct {
-	Creator              string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	TaskId               string `protobuf:"bytes,2,opt,name=taskId,proto3" json:"taskId,omitempty"`
-	ThreadId             string `protobuf:"bytes,3,opt,name=threadId,proto3" json:"threadId,omitempty"`
-	Dir                  string `protobuf:"bytes,4,opt,name=dir,proto3" json:"dir,omitempty"`
-	AverageRenderSeconds int64  `protobuf:"varint,5,opt,name=average_render_seconds,json=averageRenderSeconds,proto3" json:"average_render_seconds,omitempty"`
+// an empty threadId on an adaptive task cuts a new thread for the worker
+type MsgSubscribeWorkerToTask struct {
+	Address  string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	TaskId   string `protobuf:"bytes,2,opt,name=taskId,proto3" json:"taskId,omitempty"`
+	ThreadId string `protobuf:"bytes,3,opt,name=threadId,proto3" json:"threadId,omitempty"`
 }
 
-func (m *MsgSubmitSolution) Reset()         { *m = MsgSubmitSolution{} }
-func (m *MsgSubmitSolution) String() string { return proto.CompactTextString(m) }
-func (*MsgSubmitSolution) ProtoMessage()    {}
-func (*MsgSubmitSolution) Descriptor() ([]byte, []int) {
-	return fileDescriptor_915e0f75aba824d0, []int{12}
+func (m *MsgSubscribeWorkerToTask) Reset()         { *m = MsgSubscribeWorkerToTask{} }
+func (m *MsgSubscribeWorkerToTask) String() string { return proto.CompactTextString(m) }
+func (*MsgSubscribeWorkerToTask) ProtoMessage()    {}
+func (*MsgSubscribeWorkerToTask) Descriptor() ([]byte, []int) {
+	return fileDescriptor_915e0f75aba824d0, []int{14}
 }
-func (m *MsgSubmitSolution) XXX_Unmarshal(b []byte) error {
+func (m *MsgSubscribeWorkerToTask) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSubmitSolution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSubscribeWorkerToTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSubmitSolution.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSubscribeWorkerToTask.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -758,68 +876,107 @@ func (m *MsgSubmitSolution) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *MsgSubmitSolution) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSubmitSolution.Merge(m, src)
+func (m *MsgSubscribeWorkerToTask) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubscribeWorkerToTask.Merge(m, src)
 }
-func (m *MsgSubmitSolution) XXX_Size() int {
+func (m *MsgSubscribeWorkerToTask) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSubmitSolution) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSubmitSolution.DiscardUnknown(m)
+func (m *MsgSubscribeWorkerToTask) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubscribeWorkerToTask.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSubmitSolution proto.InternalMessageInfo
+var xxx_messageInfo_MsgSubscribeWorkerToTask proto.InternalMessageInfo
 
-func (m *MsgSubmitSolution) GetCreator() string {
+func (m *MsgSubscribeWorkerToTask) GetAddress() string {
 	if m != nil {
-		return m.Creator
+		return m.Address
 	}
 	return ""
 }
 
-func (m *MsgSubmitSolution) GetTaskId() string {
+func (m *MsgSubscribeWorkerToTask) GetTaskId() string {
 	if m != nil {
 		return m.TaskId
 	}
 	return ""
 }
 
-func (m *MsgSubmitSolution) GetThreadId() string {
+func (m *MsgSubscribeWorkerToTask) GetThreadId() string {
 	if m != nil {
 		return m.ThreadId
 	}
 	return ""
 }
 
-func (m *MsgSubmitSolution) GetDir() string {
-	if m != nil {
-		return m.Dir
+type MsgSubscribeWorkerToTaskResponse struct {
+	ThreadId string `protobuf:"bytes,1,opt,name=threadId,proto3" json:"threadId,omitempty"`
+}
+
+func (m *MsgSubscribeWorkerToTaskResponse) Reset()         { *m = MsgSubscribeWorkerToTaskResponse{} }
+func (m *MsgSubscribeWorkerToTaskResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubscribeWorkerToTaskResponse) ProtoMessage()    {}
+func (*MsgSubscribeWorkerToTaskResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_915e0f75aba824d0, []int{15}
+}
+func (m *MsgSubscribeWorkerToTaskResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubscribeWorkerToTaskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubscribeWorkerToTaskResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *MsgSubscribeWorkerToTaskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubscribeWorkerToTaskResponse.Merge(m, src)
+}
+func (m *MsgSubscribeWorkerToTaskResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubscribeWorkerToTaskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubscribeWorkerToTaskResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSubmitSolution) GetAverageRenderSeconds() int64 {
+var xxx_messageInfo_MsgSubscribeWorkerToTaskResponse proto.InternalMessageInfo
+
+func (m *MsgSubscribeWorkerToTaskResponse) GetThreadId() string {
 	if m != nil {
-		return m.AverageRenderSeconds
+		return m.ThreadId
 	}
-	return 0
+	return ""
 }
 
-type MsgSubmitSolutionResponse struct {
+// Msg to Propose a solution to an specific thread
+// Actual solution is a map of hashes
+type MsgProposeSolution struct {
+	Creator    string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TaskId     string   `protobuf:"bytes,2,opt,name=taskId,proto3" json:"taskId,omitempty"`
+	ThreadId   string   `protobuf:"bytes,3,opt,name=threadId,proto3" json:"threadId,omitempty"`
+	PublicKey  string   `protobuf:"bytes,4,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Signatures []string `protobuf:"bytes,5,rep,name=signatures,proto3" json:"signatures,omitempty"`
+	// digest of the upscaler image used to render the frames
+	ImageDigest string `protobuf:"bytes,6,opt,name=image_digest,json=imageDigest,proto3" json:"image_digest,omitempty"`
 }
 
-func (m *MsgSubmitSolutionResponse) Reset()         { *m = MsgSubmitSolutionResponse{} }
-func (m *MsgSubmitSolutionResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgSubmitSolutionResponse) ProtoMessage()    {}
-func (*MsgSubmitSolutionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_915e0f75aba824d0, []int{13}
+func (m *MsgProposeSolution) Reset()         { *m = MsgProposeSolution{} }
+func (m *MsgProposeSolution) String() string { return proto.CompactTextString(m) }
+func (*MsgProposeSolution) ProtoMessage()    {}
+func (*MsgProposeSolution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_915e0f75aba824d0, []int{16}
 }
-func (m *MsgSubmitSolutionResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgProposeSolution) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSubmitSolutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgProposeSolution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSubmitSolutionResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgProposeSolution.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -829,1284 +986,5371 @@ func (m *MsgSubmitSolutionResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *MsgSubmitSolutionResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSubmitSolutionResponse.Merge(m, src)
+func (m *MsgProposeSolution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposeSolution.Merge(m, src)
 }
-func (m *MsgSubmitSolutionResponse) XXX_Size() int {
+func (m *MsgProposeSolution) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSubmitSolutionResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSubmitSolutionResponse.DiscardUnknown(m)
+func (m *MsgProposeSolution) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposeSolution.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSubmitSolutionResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgProposeSolution proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgCreateVideoUpscalerTask)(nil), "janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask")
-	proto.RegisterType((*MsgCreateVideoUpscalerTaskResponse)(nil), "janction.videoUpscaler.v1.MsgCreateVideoUpscalerTaskResponse")
-	proto.RegisterType((*MsgAddWorker)(nil), "janction.videoUpscaler.v1.MsgAddWorker")
-	proto.RegisterType((*MsgAddWorkerResponse)(nil), "janction.videoUpscaler.v1.MsgAddWorkerResponse")
-	proto.RegisterType((*MsgSubscribeWorkerToTask)(nil), "janction.videoUpscaler.v1.MsgSubscribeWorkerToTask")
-	proto.RegisterType((*MsgSubscribeWorkerToTaskResponse)(nil), "janction.videoUpscaler.v1.MsgSubscribeWorkerToTaskResponse")
-	proto.RegisterType((*MsgProposeSolution)(nil), "janction.videoUpscaler.v1.MsgProposeSolution")
-	proto.RegisterType((*MsgProposeSolutionResponse)(nil), "janction.videoUpscaler.v1.MsgProposeSolutionResponse")
-	proto.RegisterType((*MsgRevealSolution)(nil), "janction.videoUpscaler.v1.MsgRevealSolution")
-	proto.RegisterType((*MsgRevealSolutionResponse)(nil), "janction.videoUpscaler.v1.MsgRevealSolutionResponse")
-	proto.RegisterType((*MsgSubmitValidation)(nil), "janction.videoUpscaler.v1.MsgSubmitValidation")
-	proto.RegisterType((*MsgSubmitValidationResponse)(nil), "janction.videoUpscaler.v1.MsgSubmitValidationResponse")
-	proto.RegisterType((*MsgSubmitSolution)(nil), "janction.videoUpscaler.v1.MsgSubmitSolution")
-	proto.RegisterType((*MsgSubmitSolutionResponse)(nil), "janction.videoUpscaler.v1.MsgSubmitSolutionResponse")
+func (m *MsgProposeSolution) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
 }
 
-func init() {
-	proto.RegisterFile("janction/videoUpscaler/v1/tx.proto", fileDescriptor_915e0f75aba824d0)
+func (m *MsgProposeSolution) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
+	}
+	return ""
 }
 
-var fileDescriptor_915e0f75aba824d0 = []byte{
-	// 873 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x41, 0x8f, 0xdb, 0x44,
-	0x14, 0xde, 0xd9, 0x6c, 0xb2, 0x9b, 0x47, 0x55, 0x16, 0xb3, 0xec, 0x7a, 0xbd, 0xad, 0x59, 0x2c,
-	0x21, 0xa2, 0xaa, 0xd8, 0x4a, 0xdb, 0xe5, 0x40, 0x55, 0x04, 0xad, 0x84, 0x14, 0xa1, 0x48, 0xc8,
-	0x5b, 0x8a, 0xc4, 0x25, 0x9a, 0xd8, 0x53, 0x77, 0x48, 0xec, 0xb1, 0x66, 0x26, 0x29, 0xe1, 0x84,
-	0xb8, 0x20, 0x4e, 0xf0, 0x1f, 0xf8, 0x01, 0xec, 0x8d, 0xbf, 0xd0, 0x63, 0x8f, 0x9c, 0x10, 0xda,
-	0x3d, 0xf4, 0x8e, 0xc4, 0x1d, 0xd9, 0x33, 0x36, 0x89, 0x9b, 0x98, 0xdd, 0x95, 0x2a, 0x71, 0x9b,
-	0x37, 0xef, 0x9b, 0xf7, 0xbe, 0xef, 0x3d, 0xbf, 0x97, 0x80, 0xf3, 0x35, 0x4e, 0x02, 0x49, 0x59,
-	0xe2, 0x4d, 0x69, 0x48, 0xd8, 0x17, 0xa9, 0x08, 0xf0, 0x98, 0x70, 0x6f, 0xda, 0xf5, 0xe4, 0x37,
-	0x6e, 0xca, 0x99, 0x64, 0xc6, 0x7e, 0x81, 0x71, 0x17, 0x30, 0xee, 0xb4, 0x6b, 0xed, 0x05, 0x4c,
-	0xc4, 0x4c, 0x78, 0xb1, 0x88, 0xb2, 0x27, 0xb1, 0x88, 0xd4, 0x1b, 0xcb, 0xd6, 0x8e, 0x21, 0x16,
-	0xc4, 0x9b, 0x76, 0x87, 0x44, 0xe2, 0xae, 0x17, 0x30, 0x9a, 0x68, 0xff, 0x4e, 0xc4, 0x22, 0x96,
-	0x1f, 0xbd, 0xec, 0xa4, 0x6f, 0xdf, 0xad, 0x61, 0x33, 0x4b, 0x89, 0xd0, 0xb0, 0x7d, 0x15, 0x7c,
-	0xa0, 0xde, 0x2b, 0x43, 0xbb, 0xae, 0x49, 0x92, 0x84, 0x84, 0xc7, 0x34, 0x91, 0x5e, 0xc0, 0x67,
-	0xa9, 0x64, 0xde, 0x88, 0xcc, 0xb4, 0xd7, 0xf9, 0x1b, 0x81, 0xd5, 0x17, 0xd1, 0x03, 0x4e, 0xb0,
-	0x24, 0x8f, 0xe6, 0x53, 0x3c, 0xc4, 0x62, 0x64, 0x98, 0xb0, 0x19, 0x64, 0x2e, 0xc6, 0x4d, 0x74,
-	0x88, 0x3a, 0x6d, 0xbf, 0x30, 0x8d, 0x6d, 0x68, 0x04, 0x34, 0x34, 0xd7, 0xf3, 0xdb, 0xec, 0x68,
-	0xd8, 0x00, 0x42, 0x62, 0x2e, 0x3f, 0xe5, 0x38, 0x26, 0x66, 0xe3, 0x10, 0x75, 0x9a, 0xfe, 0xdc,
-	0x8d, 0x61, 0xc1, 0x16, 0x49, 0x42, 0xe5, 0xdd, 0xc8, 0xbd, 0xa5, 0x9d, 0xe5, 0x91, 0x4f, 0x38,
-	0xc1, 0xa1, 0x30, 0x9b, 0xb9, 0xab, 0x30, 0x8d, 0x1d, 0x68, 0xe6, 0x7c, 0xcc, 0x56, 0x7e, 0xaf,
-	0x0c, 0xa3, 0x0b, 0x2d, 0x4e, 0x9e, 0x62, 0x1e, 0x9a, 0x9b, 0x87, 0xa8, 0xf3, 0xda, 0xad, 0x7d,
-	0x57, 0x6b, 0xce, 0xaa, 0xeb, 0xea, 0xea, 0xba, 0x0f, 0x18, 0x4d, 0x7c, 0x0d, 0xfc, 0xf0, 0xca,
-	0xf7, 0x2f, 0x4e, 0x6e, 0x14, 0xf4, 0x9d, 0x7b, 0xe0, 0xac, 0x96, 0xed, 0x13, 0x91, 0xb2, 0x44,
-	0x10, 0x63, 0x0f, 0x36, 0x25, 0x16, 0xa3, 0x01, 0x0d, 0xb5, 0xfc, 0x56, 0x66, 0xf6, 0x42, 0xe7,
-	0x17, 0x04, 0x57, 0xfa, 0x22, 0xfa, 0x24, 0x0c, 0xbf, 0x64, 0x7c, 0x44, 0x78, 0x4d, 0xa1, 0x0e,
-	0xa0, 0x9d, 0x4e, 0x86, 0x63, 0x1a, 0x0c, 0x68, 0xaa, 0xcb, 0xb5, 0xa5, 0x2e, 0x7a, 0x69, 0x96,
-	0x80, 0xa6, 0x8f, 0x45, 0x96, 0xa0, 0xa1, 0x12, 0x64, 0x66, 0x2f, 0x34, 0x8e, 0xa0, 0x29, 0x24,
-	0x1e, 0xa9, 0x4a, 0xd5, 0xe9, 0xbb, 0xbf, 0xf1, 0xec, 0x8f, 0xb7, 0xd7, 0x7c, 0x85, 0xae, 0x88,
-	0xfc, 0x18, 0x76, 0xe6, 0x49, 0x96, 0xb2, 0xae, 0xc2, 0x3a, 0x1b, 0xe5, 0x3c, 0xb7, 0xfc, 0x75,
-	0x96, 0x77, 0x39, 0x26, 0x42, 0xe0, 0x88, 0x68, 0x82, 0x85, 0xe9, 0x4c, 0xc1, 0xec, 0x8b, 0xe8,
-	0x78, 0x32, 0x14, 0x01, 0xa7, 0x43, 0xa2, 0xe2, 0x3c, 0x64, 0xc5, 0xb7, 0x81, 0xc3, 0x90, 0x13,
-	0x21, 0x0a, 0xc9, 0xda, 0x34, 0x76, 0x41, 0xd7, 0x49, 0x87, 0xd3, 0x56, 0xf6, 0x05, 0xa8, 0xb6,
-	0xf6, 0x0a, 0xb9, 0xa5, 0xad, 0x99, 0xeb, 0x08, 0xce, 0x47, 0x70, 0xb8, 0x2a, 0x6f, 0xa9, 0x62,
-	0x3e, 0x1a, 0x5a, 0x8c, 0xe6, 0xfc, 0x8a, 0xc0, 0xe8, 0x8b, 0xe8, 0x73, 0xce, 0x52, 0x26, 0xc8,
-	0x31, 0x1b, 0x4f, 0xb2, 0x19, 0xaa, 0xe9, 0xd2, 0x25, 0x28, 0x1b, 0xd7, 0x01, 0x74, 0x67, 0x47,
-	0x64, 0x96, 0x37, 0xaa, 0xed, 0xeb, 0x5e, 0x7f, 0x46, 0x66, 0xf9, 0x3c, 0xd0, 0x28, 0xc1, 0x72,
-	0xc2, 0x49, 0xf6, 0x59, 0x37, 0x3a, 0x6d, 0x7f, 0xee, 0xa6, 0xd2, 0xab, 0x6b, 0xf9, 0x1c, 0x56,
-	0x08, 0x17, 0x5a, 0x9d, 0x1f, 0x10, 0xbc, 0xd1, 0x17, 0x91, 0x4f, 0xa6, 0x04, 0x8f, 0x5f, 0x91,
-	0x9c, 0x5d, 0x68, 0x3d, 0xce, 0x86, 0x51, 0x98, 0x1b, 0x39, 0x57, 0x6d, 0x55, 0x78, 0x1e, 0xc0,
-	0xfe, 0x4b, 0x44, 0x4a, 0x9a, 0x27, 0x08, 0xde, 0x54, 0x7d, 0x8b, 0xa9, 0x7c, 0x84, 0xc7, 0x34,
-	0xc4, 0xff, 0xf7, 0xba, 0x5f, 0x87, 0x83, 0x25, 0x8c, 0x4b, 0x45, 0xbf, 0xa9, 0xc2, 0x2b, 0xff,
-	0x2b, 0x2a, 0xfc, 0x36, 0x34, 0x42, 0xca, 0xb5, 0x90, 0xec, 0x68, 0xdc, 0x81, 0x5d, 0x3c, 0x25,
-	0x1c, 0x47, 0x64, 0xc0, 0xf3, 0xed, 0x3d, 0x10, 0x24, 0x60, 0x89, 0xde, 0x8e, 0x0d, 0x7f, 0x47,
-	0x7b, 0xfd, 0xdc, 0x79, 0xac, 0x7c, 0x4b, 0x1b, 0xb5, 0x48, 0xbc, 0x90, 0x75, 0xeb, 0xaf, 0x16,
-	0x34, 0xfa, 0x22, 0x32, 0x7e, 0x42, 0xb0, 0xb7, 0x6a, 0xf7, 0x1f, 0xb9, 0x2b, 0x7f, 0xe5, 0xdc,
-	0xd5, 0xbb, 0xd3, 0xba, 0x77, 0xa9, 0x67, 0xe5, 0x54, 0x13, 0x68, 0xff, 0xbb, 0x55, 0xdf, 0xab,
-	0x8f, 0x55, 0x02, 0x2d, 0xef, 0x9c, 0xc0, 0x32, 0xcd, 0x8f, 0x08, 0xde, 0x5a, 0xbe, 0xd6, 0x6e,
-	0xd7, 0x87, 0x5a, 0xfa, 0xc8, 0xba, 0x7b, 0x89, 0x47, 0x25, 0x97, 0xa7, 0xf0, 0x7a, 0x75, 0x51,
-	0xbd, 0x5f, 0x1f, 0xaf, 0x02, 0xb7, 0x8e, 0x2e, 0x04, 0x2f, 0x13, 0x7f, 0x0b, 0xdb, 0x2f, 0x8d,
-	0xaa, 0xfb, 0x9f, 0x4a, 0x16, 0xf0, 0xd6, 0x07, 0x17, 0xc3, 0x97, 0xb9, 0x25, 0x5c, 0xad, 0x6c,
-	0xb3, 0x9b, 0xf5, 0x91, 0x16, 0xd1, 0xd6, 0x9d, 0x8b, 0xa0, 0xe7, 0xb3, 0x56, 0x46, 0xf9, 0xe6,
-	0x79, 0xf8, 0x9f, 0x37, 0xeb, 0xf2, 0x69, 0xb3, 0x9a, 0xdf, 0xbd, 0x38, 0xb9, 0x81, 0xee, 0xdf,
-	0x7d, 0x76, 0x6a, 0xa3, 0xe7, 0xa7, 0x36, 0xfa, 0xf3, 0xd4, 0x46, 0x3f, 0x9f, 0xd9, 0x6b, 0xcf,
-	0xcf, 0xec, 0xb5, 0xdf, 0xcf, 0xec, 0xb5, 0xaf, 0xde, 0x89, 0xa8, 0x7c, 0x32, 0x19, 0xba, 0x01,
-	0x8b, 0xbd, 0xe5, 0x7f, 0xf8, 0x86, 0xad, 0xfc, 0xff, 0xda, 0xed, 0x7f, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0xfe, 0xf0, 0x3a, 0xa5, 0x9f, 0x0a, 0x00, 0x00,
+func (m *MsgProposeSolution) GetThreadId() string {
+	if m != nil {
+		return m.ThreadId
+	}
+	return ""
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// CreateGame create a game.
-	CreateVideoUpscalerTask(ctx context.Context, in *MsgCreateVideoUpscalerTask, opts ...grpc.CallOption) (*MsgCreateVideoUpscalerTaskResponse, error)
-	// Adds a new worker
-	AddWorker(ctx context.Context, in *MsgAddWorker, opts ...grpc.CallOption) (*MsgAddWorkerResponse, error)
-	SubscribeWorkerToTask(ctx context.Context, in *MsgSubscribeWorkerToTask, opts ...grpc.CallOption) (*MsgSubscribeWorkerToTaskResponse, error)
-	// Propose a solution for the test of the nodes to validate
-	ProposeSolution(ctx context.Context, in *MsgProposeSolution, opts ...grpc.CallOption) (*MsgProposeSolutionResponse, error)
-	// Propose a solution for the test of the nodes to validate
-	SubmitValidation(ctx context.Context, in *MsgSubmitValidation, opts ...grpc.CallOption) (*MsgSubmitValidationResponse, error)
-	// Propose a solution for the test of the nodes to validate
-	RevealSolution(ctx context.Context, in *MsgRevealSolution, opts ...grpc.CallOption) (*MsgRevealSolutionResponse, error)
-	// Submits the solution to IPFS
-	SubmitSolution(ctx context.Context, in *MsgSubmitSolution, opts ...grpc.CallOption) (*MsgSubmitSolutionResponse, error)
+func (m *MsgProposeSolution) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgProposeSolution) GetSignatures() []string {
+	if m != nil {
+		return m.Signatures
+	}
+	return nil
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgProposeSolution) GetImageDigest() string {
+	if m != nil {
+		return m.ImageDigest
+	}
+	return ""
 }
 
-func (c *msgClient) CreateVideoUpscalerTask(ctx context.Context, in *MsgCreateVideoUpscalerTask, opts ...grpc.CallOption) (*MsgCreateVideoUpscalerTaskResponse, error) {
-	out := new(MsgCreateVideoUpscalerTaskResponse)
-	err := c.cc.Invoke(ctx, "/janction.videoUpscaler.v1.Msg/CreateVideoUpscalerTask", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// no response needed to a proposed solution
+type MsgProposeSolutionResponse struct {
 }
 
-func (c *msgClient) AddWorker(ctx context.Context, in *MsgAddWorker, opts ...grpc.CallOption) (*MsgAddWorkerResponse, error) {
-	out := new(MsgAddWorkerResponse)
-	err := c.cc.Invoke(ctx, "/janction.videoUpscaler.v1.Msg/AddWorker", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgProposeSolutionResponse) Reset()         { *m = MsgProposeSolutionResponse{} }
+func (m *MsgProposeSolutionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgProposeSolutionResponse) ProtoMessage()    {}
+func (*MsgProposeSolutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_915e0f75aba824d0, []int{17}
+}
+func (m *MsgProposeSolutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProposeSolutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProposeSolutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgProposeSolutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposeSolutionResponse.Merge(m, src)
+}
+func (m *MsgProposeSolutionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProposeSolutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposeSolutionResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) SubscribeWorkerToTask(ctx context.Context, in *MsgSubscribeWorkerToTask, opts ...grpc.CallOption) (*MsgSubscribeWorkerToTaskResponse, error) {
-	out := new(MsgSubscribeWorkerToTaskResponse)
-	err := c.cc.Invoke(ctx, "/janction.videoUpscaler.v1.Msg/SubscribeWorkerToTask", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgProposeSolutionResponse proto.InternalMessageInfo
+
+// Msg to Propose a solution to an specific thread
+// Actual solution is a map of hashes
+type MsgRevealSolution struct {
+	Creator  string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TaskId   string   `protobuf:"bytes,2,opt,name=taskId,proto3" json:"taskId,omitempty"`
+	ThreadId string   `protobuf:"bytes,3,opt,name=threadId,proto3" json:"threadId,omitempty"`
+	Frames   []string `protobuf:"bytes,4,rep,name=frames,proto3" json:"frames,omitempty"`
 }
 
-func (c *msgClient) ProposeSolution(ctx context.Context, in *MsgProposeSolution, opts ...grpc.CallOption) (*MsgProposeSolutionResponse, error) {
-	out := new(MsgProposeSolutionResponse)
-	err := c.cc.Invoke(ctx, "/janction.videoUpscaler.v1.Msg/ProposeSolution", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRevealSolution) Reset()         { *m = MsgRevealSolution{} }
+func (m *MsgRevealSolution) String() string { return proto.CompactTextString(m) }
+func (*MsgRevealSolution) ProtoMessage()    {}
+func (*MsgRevealSolution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_915e0f75aba824d0, []int{18}
+}
+func (m *MsgRevealSolution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevealSolution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevealSolution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgRevealSolution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevealSolution.Merge(m, src)
+}
+func (m *MsgRevealSolution) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevealSolution) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevealSolution.DiscardUnknown(m)
 }
 
-func (c *msgClient) SubmitValidation(ctx context.Context, in *MsgSubmitValidation, opts ...grpc.CallOption) (*MsgSubmitValidationResponse, error) {
-	out := new(MsgSubmitValidationResponse)
-	err := c.cc.Invoke(ctx, "/janction.videoUpscaler.v1.Msg/SubmitValidation", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRevealSolution proto.InternalMessageInfo
+
+func (m *MsgRevealSolution) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) RevealSolution(ctx context.Context, in *MsgRevealSolution, opts ...grpc.CallOption) (*MsgRevealSolutionResponse, error) {
-	out := new(MsgRevealSolutionResponse)
-	err := c.cc.Invoke(ctx, "/janction.videoUpscaler.v1.Msg/RevealSolution", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRevealSolution) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) SubmitSolution(ctx context.Context, in *MsgSubmitSolution, opts ...grpc.CallOption) (*MsgSubmitSolutionResponse, error) {
-	out := new(MsgSubmitSolutionResponse)
-	err := c.cc.Invoke(ctx, "/janction.videoUpscaler.v1.Msg/SubmitSolution", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRevealSolution) GetThreadId() string {
+	if m != nil {
+		return m.ThreadId
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// CreateGame create a game.
-	CreateVideoUpscalerTask(context.Context, *MsgCreateVideoUpscalerTask) (*MsgCreateVideoUpscalerTaskResponse, error)
-	// Adds a new worker
-	AddWorker(context.Context, *MsgAddWorker) (*MsgAddWorkerResponse, error)
-	SubscribeWorkerToTask(context.Context, *MsgSubscribeWorkerToTask) (*MsgSubscribeWorkerToTaskResponse, error)
-	// Propose a solution for the test of the nodes to validate
-	ProposeSolution(context.Context, *MsgProposeSolution) (*MsgProposeSolutionResponse, error)
-	// Propose a solution for the test of the nodes to validate
-	SubmitValidation(context.Context, *MsgSubmitValidation) (*MsgSubmitValidationResponse, error)
-	// Propose a solution for the test of the nodes to validate
-	RevealSolution(context.Context, *MsgRevealSolution) (*MsgRevealSolutionResponse, error)
-	// Submits the solution to IPFS
-	SubmitSolution(context.Context, *MsgSubmitSolution) (*MsgSubmitSolutionResponse, error)
+func (m *MsgRevealSolution) GetFrames() []string {
+	if m != nil {
+		return m.Frames
+	}
+	return nil
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+// no response needed to a proposed solution
+type MsgRevealSolutionResponse struct {
 }
 
-func (*UnimplementedMsgServer) CreateVideoUpscalerTask(ctx context.Context, req *MsgCreateVideoUpscalerTask) (*MsgCreateVideoUpscalerTaskResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateVideoUpscalerTask not implemented")
+func (m *MsgRevealSolutionResponse) Reset()         { *m = MsgRevealSolutionResponse{} }
+func (m *MsgRevealSolutionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevealSolutionResponse) ProtoMessage()    {}
+func (*MsgRevealSolutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_915e0f75aba824d0, []int{19}
 }
-func (*UnimplementedMsgServer) AddWorker(ctx context.Context, req *MsgAddWorker) (*MsgAddWorkerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddWorker not implemented")
+func (m *MsgRevealSolutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) SubscribeWorkerToTask(ctx context.Context, req *MsgSubscribeWorkerToTask) (*MsgSubscribeWorkerToTaskResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubscribeWorkerToTask not implemented")
+func (m *MsgRevealSolutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevealSolutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) ProposeSolution(ctx context.Context, req *MsgProposeSolution) (*MsgProposeSolutionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ProposeSolution not implemented")
+func (m *MsgRevealSolutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevealSolutionResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) SubmitValidation(ctx context.Context, req *MsgSubmitValidation) (*MsgSubmitValidationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitValidation not implemented")
+func (m *MsgRevealSolutionResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) RevealSolution(ctx context.Context, req *MsgRevealSolution) (*MsgRevealSolutionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RevealSolution not implemented")
+func (m *MsgRevealSolutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevealSolutionResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) SubmitSolution(ctx context.Context, req *MsgSubmitSolution) (*MsgSubmitSolutionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitSolution not implemented")
+
+var xxx_messageInfo_MsgRevealSolutionResponse proto.InternalMessageInfo
+
+type MsgSubmitValidation struct {
+	Creator    string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TaskId     string   `protobuf:"bytes,2,opt,name=taskId,proto3" json:"taskId,omitempty"`
+	ThreadId   string   `protobuf:"bytes,3,opt,name=threadId,proto3" json:"threadId,omitempty"`
+	PublicKey  string   `protobuf:"bytes,4,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Signatures []string `protobuf:"bytes,5,rep,name=signatures,proto3" json:"signatures,omitempty"`
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgSubmitValidation) Reset()         { *m = MsgSubmitValidation{} }
+func (m *MsgSubmitValidation) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitValidation) ProtoMessage()    {}
+func (*MsgSubmitValidation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_915e0f75aba824d0, []int{20}
+}
+func (m *MsgSubmitValidation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitValidation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitValidation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitValidation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitValidation.Merge(m, src)
+}
+func (m *MsgSubmitValidation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitValidation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitValidation.DiscardUnknown(m)
 }
 
-func _Msg_CreateVideoUpscalerTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateVideoUpscalerTask)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSubmitValidation proto.InternalMessageInfo
+
+func (m *MsgSubmitValidation) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateVideoUpscalerTask(ctx, in)
+	return ""
+}
+
+func (m *MsgSubmitValidation) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/janction.videoUpscaler.v1.Msg/CreateVideoUpscalerTask",
+	return ""
+}
+
+func (m *MsgSubmitValidation) GetThreadId() string {
+	if m != nil {
+		return m.ThreadId
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateVideoUpscalerTask(ctx, req.(*MsgCreateVideoUpscalerTask))
+	return ""
+}
+
+func (m *MsgSubmitValidation) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_AddWorker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddWorker)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgSubmitValidation) GetSignatures() []string {
+	if m != nil {
+		return m.Signatures
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddWorker(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/janction.videoUpscaler.v1.Msg/AddWorker",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddWorker(ctx, req.(*MsgAddWorker))
-	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_SubscribeWorkerToTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubscribeWorkerToTask)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubscribeWorkerToTask(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/janction.videoUpscaler.v1.Msg/SubscribeWorkerToTask",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubscribeWorkerToTask(ctx, req.(*MsgSubscribeWorkerToTask))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgSubmitValidationResponse struct {
 }
 
-func _Msg_ProposeSolution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgProposeSolution)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ProposeSolution(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/janction.videoUpscaler.v1.Msg/ProposeSolution",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ProposeSolution(ctx, req.(*MsgProposeSolution))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgSubmitValidationResponse) Reset()         { *m = MsgSubmitValidationResponse{} }
+func (m *MsgSubmitValidationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitValidationResponse) ProtoMessage()    {}
+func (*MsgSubmitValidationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_915e0f75aba824d0, []int{21}
 }
-
-func _Msg_SubmitValidation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitValidation)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitValidation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/janction.videoUpscaler.v1.Msg/SubmitValidation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitValidation(ctx, req.(*MsgSubmitValidation))
+func (m *MsgSubmitValidationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitValidationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitValidationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgSubmitValidationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitValidationResponse.Merge(m, src)
+}
+func (m *MsgSubmitValidationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitValidationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitValidationResponse.DiscardUnknown(m)
 }
 
-func _Msg_RevealSolution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRevealSolution)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RevealSolution(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/janction.videoUpscaler.v1.Msg/RevealSolution",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RevealSolution(ctx, req.(*MsgRevealSolution))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgSubmitValidationResponse proto.InternalMessageInfo
+
+// Sent by the validators of tasks validated by fingerprint once the solution is revealed, with the
+// filename=fingerprint of each frame they signed
+type MsgRevealValidation struct {
+	Creator      string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TaskId       string   `protobuf:"bytes,2,opt,name=taskId,proto3" json:"taskId,omitempty"`
+	ThreadId     string   `protobuf:"bytes,3,opt,name=threadId,proto3" json:"threadId,omitempty"`
+	Fingerprints []string `protobuf:"bytes,4,rep,name=fingerprints,proto3" json:"fingerprints,omitempty"`
 }
 
-func _Msg_SubmitSolution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitSolution)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitSolution(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/janction.videoUpscaler.v1.Msg/SubmitSolution",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitSolution(ctx, req.(*MsgSubmitSolution))
+func (m *MsgRevealValidation) Reset()         { *m = MsgRevealValidation{} }
+func (m *MsgRevealValidation) String() string { return proto.CompactTextString(m) }
+func (*MsgRevealValidation) ProtoMessage()    {}
+func (*MsgRevealValidation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_915e0f75aba824d0, []int{22}
+}
+func (m *MsgRevealValidation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevealValidation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevealValidation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "janction.videoUpscaler.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateVideoUpscalerTask",
-			Handler:    _Msg_CreateVideoUpscalerTask_Handler,
-		},
-		{
-			MethodName: "AddWorker",
-			Handler:    _Msg_AddWorker_Handler,
-		},
-		{
-			MethodName: "SubscribeWorkerToTask",
-			Handler:    _Msg_SubscribeWorkerToTask_Handler,
-		},
-		{
-			MethodName: "ProposeSolution",
-			Handler:    _Msg_ProposeSolution_Handler,
-		},
-		{
-			MethodName: "SubmitValidation",
-			Handler:    _Msg_SubmitValidation_Handler,
-		},
-		{
-			MethodName: "RevealSolution",
-			Handler:    _Msg_RevealSolution_Handler,
-		},
-		{
-			MethodName: "SubmitSolution",
-			Handler:    _Msg_SubmitSolution_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "janction/videoUpscaler/v1/tx.proto",
+func (m *MsgRevealValidation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevealValidation.Merge(m, src)
+}
+func (m *MsgRevealValidation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevealValidation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevealValidation.DiscardUnknown(m)
 }
 
-func (m *MsgCreateVideoUpscalerTask) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRevealValidation proto.InternalMessageInfo
+
+func (m *MsgRevealValidation) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCreateVideoUpscalerTask) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRevealValidation) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
+	}
+	return ""
 }
 
-func (m *MsgCreateVideoUpscalerTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Reward != nil {
-		{
-			size, err := m.Reward.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.Scale != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Scale))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.Threads != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Threads))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.EndFrame != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.EndFrame))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.StartFrame != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.StartFrame))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Cid) > 0 {
-		i -= len(m.Cid)
-		copy(dAtA[i:], m.Cid)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Cid)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgRevealValidation) GetThreadId() string {
+	if m != nil {
+		return m.ThreadId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgCreateVideoUpscalerTaskResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRevealValidation) GetFingerprints() []string {
+	if m != nil {
+		return m.Fingerprints
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgCreateVideoUpscalerTaskResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgRevealValidationResponse struct {
 }
 
-func (m *MsgCreateVideoUpscalerTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TaskId) > 0 {
-		i -= len(m.TaskId)
-		copy(dAtA[i:], m.TaskId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TaskId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgRevealValidationResponse) Reset()         { *m = MsgRevealValidationResponse{} }
+func (m *MsgRevealValidationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevealValidationResponse) ProtoMessage()    {}
+func (*MsgRevealValidationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_915e0f75aba824d0, []int{23}
 }
-
-func (m *MsgAddWorker) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRevealValidationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevealValidationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevealValidationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgRevealValidationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevealValidationResponse.Merge(m, src)
+}
+func (m *MsgRevealValidationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevealValidationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevealValidationResponse.DiscardUnknown(m)
 }
 
-func (m *MsgAddWorker) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRevealValidationResponse proto.InternalMessageInfo
+
+type MsgSubmitSolution struct {
+	Creator              string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TaskId               string `protobuf:"bytes,2,opt,name=taskId,proto3" json:"taskId,omitempty"`
+	ThreadId             string `protobuf:"bytes,3,opt,name=threadId,proto3" json:"threadId,omitempty"`
+	Dir                  string `protobuf:"bytes,4,opt,name=dir,proto3" json:"dir,omitempty"`
+	AverageRenderSeconds int64  `protobuf:"varint,5,opt,name=average_render_seconds,json=averageRenderSeconds,proto3" json:"average_render_seconds,omitempty"`
 }
 
-func (m *MsgAddWorker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Stake.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgSubmitSolution) Reset()         { *m = MsgSubmitSolution{} }
+func (m *MsgSubmitSolution) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitSolution) ProtoMessage()    {}
+func (*MsgSubmitSolution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_915e0f75aba824d0, []int{24}
+}
+func (m *MsgSubmitSolution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitSolution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitSolution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.IpfsId) > 0 {
-		i -= len(m.IpfsId)
-		copy(dAtA[i:], m.IpfsId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.IpfsId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.PublicIp) > 0 {
-		i -= len(m.PublicIp)
-		copy(dAtA[i:], m.PublicIp)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PublicIp)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgAddWorkerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSubmitSolution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitSolution.Merge(m, src)
 }
-
-func (m *MsgAddWorkerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSubmitSolution) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitSolution) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitSolution.DiscardUnknown(m)
 }
 
-func (m *MsgAddWorkerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Message) > 0 {
-		i -= len(m.Message)
-		copy(dAtA[i:], m.Message)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Message)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Ok {
-		i--
-		if m.Ok {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_MsgSubmitSolution proto.InternalMessageInfo
+
+func (m *MsgSubmitSolution) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgSubscribeWorkerToTask) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitSolution) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgSubscribeWorkerToTask) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSubmitSolution) GetThreadId() string {
+	if m != nil {
+		return m.ThreadId
+	}
+	return ""
 }
 
-func (m *MsgSubscribeWorkerToTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ThreadId) > 0 {
-		i -= len(m.ThreadId)
-		copy(dAtA[i:], m.ThreadId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ThreadId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.TaskId) > 0 {
-		i -= len(m.TaskId)
-		copy(dAtA[i:], m.TaskId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TaskId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgSubmitSolution) GetDir() string {
+	if m != nil {
+		return m.Dir
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgSubscribeWorkerToTaskResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitSolution) GetAverageRenderSeconds() int64 {
+	if m != nil {
+		return m.AverageRenderSeconds
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgSubscribeWorkerToTaskResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgSubmitSolutionResponse struct {
 }
 
-func (m *MsgSubscribeWorkerToTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ThreadId) > 0 {
-		i -= len(m.ThreadId)
-		copy(dAtA[i:], m.ThreadId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ThreadId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgSubmitSolutionResponse) Reset()         { *m = MsgSubmitSolutionResponse{} }
+func (m *MsgSubmitSolutionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitSolutionResponse) ProtoMessage()    {}
+func (*MsgSubmitSolutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_915e0f75aba824d0, []int{25}
 }
-
-func (m *MsgProposeSolution) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitSolutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitSolutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitSolutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgProposeSolution) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSubmitSolutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitSolutionResponse.Merge(m, src)
+}
+func (m *MsgSubmitSolutionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitSolutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitSolutionResponse.DiscardUnknown(m)
 }
 
-func (m *MsgProposeSolution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signatures) > 0 {
-		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Signatures[iNdEx])
-			copy(dAtA[i:], m.Signatures[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Signatures[iNdEx])))
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.PublicKey) > 0 {
-		i -= len(m.PublicKey)
-		copy(dAtA[i:], m.PublicKey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PublicKey)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ThreadId) > 0 {
-		i -= len(m.ThreadId)
-		copy(dAtA[i:], m.ThreadId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ThreadId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.TaskId) > 0 {
-		i -= len(m.TaskId)
-		copy(dAtA[i:], m.TaskId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TaskId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgSubmitSolutionResponse proto.InternalMessageInfo
+
+// Msg of a worker that couldn't render a thread after retrying
+type MsgReportThreadFailure struct {
+	Creator  string              `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TaskId   string              `protobuf:"bytes,2,opt,name=taskId,proto3" json:"taskId,omitempty"`
+	ThreadId string              `protobuf:"bytes,3,opt,name=threadId,proto3" json:"threadId,omitempty"`
+	Reason   ThreadFailureReason `protobuf:"varint,4,opt,name=reason,proto3,enum=janction.videoUpscaler.v1.ThreadFailureReason" json:"reason,omitempty"`
+	Message  string              `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (m *MsgProposeSolutionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgReportThreadFailure) Reset()         { *m = MsgReportThreadFailure{} }
+func (m *MsgReportThreadFailure) String() string { return proto.CompactTextString(m) }
+func (*MsgReportThreadFailure) ProtoMessage()    {}
+func (*MsgReportThreadFailure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_915e0f75aba824d0, []int{26}
+}
+func (m *MsgReportThreadFailure) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReportThreadFailure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReportThreadFailure.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgProposeSolutionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgReportThreadFailure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReportThreadFailure.Merge(m, src)
 }
-
-func (m *MsgProposeSolutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgReportThreadFailure) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReportThreadFailure) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReportThreadFailure.DiscardUnknown(m)
 }
 
-func (m *MsgRevealSolution) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgReportThreadFailure proto.InternalMessageInfo
+
+func (m *MsgReportThreadFailure) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgRevealSolution) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgReportThreadFailure) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
+	}
+	return ""
 }
 
-func (m *MsgRevealSolution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Frames) > 0 {
-		for iNdEx := len(m.Frames) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Frames[iNdEx])
-			copy(dAtA[i:], m.Frames[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Frames[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.ThreadId) > 0 {
-		i -= len(m.ThreadId)
-		copy(dAtA[i:], m.ThreadId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ThreadId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.TaskId) > 0 {
-		i -= len(m.TaskId)
-		copy(dAtA[i:], m.TaskId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TaskId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgReportThreadFailure) GetThreadId() string {
+	if m != nil {
+		return m.ThreadId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgRevealSolutionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgReportThreadFailure) GetReason() ThreadFailureReason {
+	if m != nil {
+		return m.Reason
 	}
-	return dAtA[:n], nil
+	return ThreadFailureReason_THREAD_FAILURE_REASON_UNSPECIFIED
 }
 
-func (m *MsgRevealSolutionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgReportThreadFailure) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
 }
 
-func (m *MsgRevealSolutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgReportThreadFailureResponse struct {
 }
 
-func (m *MsgSubmitValidation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgReportThreadFailureResponse) Reset()         { *m = MsgReportThreadFailureResponse{} }
+func (m *MsgReportThreadFailureResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReportThreadFailureResponse) ProtoMessage()    {}
+func (*MsgReportThreadFailureResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_915e0f75aba824d0, []int{27}
+}
+func (m *MsgReportThreadFailureResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReportThreadFailureResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReportThreadFailureResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgReportThreadFailureResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReportThreadFailureResponse.Merge(m, src)
+}
+func (m *MsgReportThreadFailureResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReportThreadFailureResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReportThreadFailureResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSubmitValidation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgReportThreadFailureResponse proto.InternalMessageInfo
+
+// Sent by the assembler with the final video of the task, and then by the verifier with the cid and hash
+// of the video it assembled again from the frames of the threads. A different cid or hash rejects the video
+type MsgSubmitFinalVideo struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TaskId  string `protobuf:"bytes,2,opt,name=taskId,proto3" json:"taskId,omitempty"`
+	Cid     string `protobuf:"bytes,3,opt,name=cid,proto3" json:"cid,omitempty"`
+	Hash    string `protobuf:"bytes,4,opt,name=hash,proto3" json:"hash,omitempty"`
 }
 
-func (m *MsgSubmitValidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signatures) > 0 {
-		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Signatures[iNdEx])
-			copy(dAtA[i:], m.Signatures[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Signatures[iNdEx])))
-			i--
-			dAtA[i] = 0x2a
+func (m *MsgSubmitFinalVideo) Reset()         { *m = MsgSubmitFinalVideo{} }
+func (m *MsgSubmitFinalVideo) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitFinalVideo) ProtoMessage()    {}
+func (*MsgSubmitFinalVideo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_915e0f75aba824d0, []int{28}
+}
+func (m *MsgSubmitFinalVideo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitFinalVideo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitFinalVideo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.PublicKey) > 0 {
-		i -= len(m.PublicKey)
-		copy(dAtA[i:], m.PublicKey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PublicKey)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ThreadId) > 0 {
-		i -= len(m.ThreadId)
-		copy(dAtA[i:], m.ThreadId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ThreadId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.TaskId) > 0 {
-		i -= len(m.TaskId)
-		copy(dAtA[i:], m.TaskId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TaskId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgSubmitFinalVideo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitFinalVideo.Merge(m, src)
+}
+func (m *MsgSubmitFinalVideo) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitFinalVideo) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitFinalVideo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitFinalVideo proto.InternalMessageInfo
+
+func (m *MsgSubmitFinalVideo) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgSubmitValidationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitFinalVideo) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgSubmitValidationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSubmitFinalVideo) GetCid() string {
+	if m != nil {
+		return m.Cid
+	}
+	return ""
 }
 
-func (m *MsgSubmitValidationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSubmitFinalVideo) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
 }
 
-func (m *MsgSubmitSolution) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+type MsgSubmitFinalVideoResponse struct {
+}
+
+func (m *MsgSubmitFinalVideoResponse) Reset()         { *m = MsgSubmitFinalVideoResponse{} }
+func (m *MsgSubmitFinalVideoResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitFinalVideoResponse) ProtoMessage()    {}
+func (*MsgSubmitFinalVideoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_915e0f75aba824d0, []int{29}
+}
+func (m *MsgSubmitFinalVideoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitFinalVideoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitFinalVideoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSubmitFinalVideoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitFinalVideoResponse.Merge(m, src)
+}
+func (m *MsgSubmitFinalVideoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitFinalVideoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitFinalVideoResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSubmitSolution) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSubmitFinalVideoResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgCreateVideoUpscalerTask)(nil), "janction.videoUpscaler.v1.MsgCreateVideoUpscalerTask")
+	proto.RegisterType((*MsgCreateVideoUpscalerTaskResponse)(nil), "janction.videoUpscaler.v1.MsgCreateVideoUpscalerTaskResponse")
+	proto.RegisterType((*MsgAddWorker)(nil), "janction.videoUpscaler.v1.MsgAddWorker")
+	proto.RegisterType((*MsgAddWorkerResponse)(nil), "janction.videoUpscaler.v1.MsgAddWorkerResponse")
+	proto.RegisterType((*MsgSetWorkerGroup)(nil), "janction.videoUpscaler.v1.MsgSetWorkerGroup")
+	proto.RegisterType((*MsgSetWorkerGroupResponse)(nil), "janction.videoUpscaler.v1.MsgSetWorkerGroupResponse")
+	proto.RegisterType((*MsgUpdateWorker)(nil), "janction.videoUpscaler.v1.MsgUpdateWorker")
+	proto.RegisterType((*MsgUpdateWorkerResponse)(nil), "janction.videoUpscaler.v1.MsgUpdateWorkerResponse")
+	proto.RegisterType((*MsgUpdateWorkerCapabilities)(nil), "janction.videoUpscaler.v1.MsgUpdateWorkerCapabilities")
+	proto.RegisterType((*MsgUpdateWorkerCapabilitiesResponse)(nil), "janction.videoUpscaler.v1.MsgUpdateWorkerCapabilitiesResponse")
+	proto.RegisterType((*MsgWorkerHeartbeat)(nil), "janction.videoUpscaler.v1.MsgWorkerHeartbeat")
+	proto.RegisterType((*MsgWorkerHeartbeatResponse)(nil), "janction.videoUpscaler.v1.MsgWorkerHeartbeatResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "janction.videoUpscaler.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "janction.videoUpscaler.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgSubscribeWorkerToTask)(nil), "janction.videoUpscaler.v1.MsgSubscribeWorkerToTask")
+	proto.RegisterType((*MsgSubscribeWorkerToTaskResponse)(nil), "janction.videoUpscaler.v1.MsgSubscribeWorkerToTaskResponse")
+	proto.RegisterType((*MsgProposeSolution)(nil), "janction.videoUpscaler.v1.MsgProposeSolution")
+	proto.RegisterType((*MsgProposeSolutionResponse)(nil), "janction.videoUpscaler.v1.MsgProposeSolutionResponse")
+	proto.RegisterType((*MsgRevealSolution)(nil), "janction.videoUpscaler.v1.MsgRevealSolution")
+	proto.RegisterType((*MsgRevealSolutionResponse)(nil), "janction.videoUpscaler.v1.MsgRevealSolutionResponse")
+	proto.RegisterType((*MsgSubmitValidation)(nil), "janction.videoUpscaler.v1.MsgSubmitValidation")
+	proto.RegisterType((*MsgSubmitValidationResponse)(nil), "janction.videoUpscaler.v1.MsgSubmitValidationResponse")
+	proto.RegisterType((*MsgRevealValidation)(nil), "janction.videoUpscaler.v1.MsgRevealValidation")
+	proto.RegisterType((*MsgRevealValidationResponse)(nil), "janction.videoUpscaler.v1.MsgRevealValidationResponse")
+	proto.RegisterType((*MsgSubmitSolution)(nil), "janction.videoUpscaler.v1.MsgSubmitSolution")
+	proto.RegisterType((*MsgSubmitSolutionResponse)(nil), "janction.videoUpscaler.v1.MsgSubmitSolutionResponse")
+	proto.RegisterType((*MsgReportThreadFailure)(nil), "janction.videoUpscaler.v1.MsgReportThreadFailure")
+	proto.RegisterType((*MsgReportThreadFailureResponse)(nil), "janction.videoUpscaler.v1.MsgReportThreadFailureResponse")
+	proto.RegisterType((*MsgSubmitFinalVideo)(nil), "janction.videoUpscaler.v1.MsgSubmitFinalVideo")
+	proto.RegisterType((*MsgSubmitFinalVideoResponse)(nil), "janction.videoUpscaler.v1.MsgSubmitFinalVideoResponse")
 }
 
-func (m *MsgSubmitSolution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AverageRenderSeconds != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.AverageRenderSeconds))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Dir) > 0 {
-		i -= len(m.Dir)
-		copy(dAtA[i:], m.Dir)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Dir)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ThreadId) > 0 {
-		i -= len(m.ThreadId)
-		copy(dAtA[i:], m.ThreadId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ThreadId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.TaskId) > 0 {
-		i -= len(m.TaskId)
-		copy(dAtA[i:], m.TaskId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TaskId)))
-		i--
-		dAtA[i] = 0x12
+func init() {
+	proto.RegisterFile("janction/videoUpscaler/v1/tx.proto", fileDescriptor_915e0f75aba824d0)
+}
+
+var fileDescriptor_915e0f75aba824d0 = []byte{
+	// 1596 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcf, 0x6f, 0x1b, 0x4f,
+	0x15, 0xcf, 0xc6, 0xb1, 0x13, 0xbf, 0xb8, 0x6e, 0xd8, 0xa6, 0xc9, 0x66, 0xd3, 0xba, 0xae, 0x4b,
+	0x44, 0x28, 0xad, 0xad, 0xa4, 0x4d, 0x24, 0xfa, 0x0b, 0xda, 0xa0, 0xd0, 0x80, 0xa2, 0x96, 0x4d,
+	0x5a, 0x24, 0x2e, 0xd6, 0xd8, 0x3b, 0xd9, 0x0c, 0xf1, 0xfe, 0x60, 0x66, 0x9c, 0xc6, 0x70, 0x41,
+	0x70, 0x40, 0x9c, 0x40, 0x48, 0x20, 0xc4, 0x5f, 0xd1, 0x03, 0x82, 0x1b, 0xe7, 0x1e, 0xab, 0x0a,
+	0x21, 0x4e, 0x08, 0xb5, 0x87, 0x1e, 0xf8, 0x27, 0xd0, 0xce, 0xce, 0x6e, 0x76, 0xd7, 0xf6, 0xda,
+	0xc9, 0xf7, 0x1b, 0xe9, 0x7b, 0x9b, 0x99, 0xf7, 0x79, 0xbf, 0xdf, 0x9b, 0x79, 0xbb, 0x50, 0xfb,
+	0x29, 0x72, 0xda, 0x9c, 0xb8, 0x4e, 0xe3, 0x98, 0x98, 0xd8, 0x7d, 0xe5, 0xb1, 0x36, 0xea, 0x60,
+	0xda, 0x38, 0x5e, 0x6b, 0xf0, 0x93, 0xba, 0x47, 0x5d, 0xee, 0xaa, 0x4b, 0x21, 0xa6, 0x9e, 0xc0,
+	0xd4, 0x8f, 0xd7, 0xf4, 0xc5, 0xb6, 0xcb, 0x6c, 0x97, 0x35, 0x6c, 0x66, 0xf9, 0x2c, 0x36, 0xb3,
+	0x02, 0x1e, 0xbd, 0x22, 0x09, 0x2d, 0xc4, 0x70, 0xe3, 0x78, 0xad, 0x85, 0x39, 0x5a, 0x6b, 0xb4,
+	0x5d, 0xe2, 0x48, 0xfa, 0xbc, 0xe5, 0x5a, 0xae, 0x58, 0x36, 0xfc, 0x95, 0x3c, 0x5d, 0xc9, 0xb0,
+	0xa6, 0xe7, 0x61, 0x26, 0x61, 0x4b, 0x81, 0xf0, 0x66, 0xc0, 0x1f, 0x6c, 0x24, 0xe9, 0x1a, 0xc7,
+	0x8e, 0x89, 0xa9, 0x4d, 0x1c, 0xde, 0x68, 0xd3, 0x9e, 0xc7, 0xdd, 0xc6, 0x11, 0xee, 0x49, 0x6a,
+	0xed, 0x7f, 0x79, 0xd0, 0x77, 0x99, 0xb5, 0x45, 0x31, 0xe2, 0xf8, 0x75, 0x5c, 0xc5, 0x3e, 0x62,
+	0x47, 0xaa, 0x06, 0xd3, 0x6d, 0x9f, 0xe4, 0x52, 0x4d, 0xa9, 0x2a, 0xab, 0x45, 0x23, 0xdc, 0xaa,
+	0x73, 0x90, 0x6b, 0x13, 0x53, 0x9b, 0x14, 0xa7, 0xfe, 0x52, 0xad, 0x00, 0x30, 0x8e, 0x28, 0xdf,
+	0xa6, 0xc8, 0xc6, 0x5a, 0xae, 0xaa, 0xac, 0xe6, 0x8d, 0xd8, 0x89, 0xaa, 0xc3, 0x0c, 0x76, 0xcc,
+	0x80, 0x3a, 0x25, 0xa8, 0xd1, 0xde, 0xd7, 0xc3, 0x0f, 0x29, 0x46, 0x26, 0xd3, 0xf2, 0x82, 0x14,
+	0x6e, 0xd5, 0x79, 0xc8, 0x0b, 0x7b, 0xb4, 0x82, 0x38, 0x0f, 0x36, 0xea, 0x1a, 0x14, 0x28, 0x7e,
+	0x83, 0xa8, 0xa9, 0x4d, 0x57, 0x95, 0xd5, 0xd9, 0xf5, 0xa5, 0xba, 0xf4, 0xd9, 0x8f, 0x6e, 0x5d,
+	0x46, 0xb7, 0xbe, 0xe5, 0x12, 0xc7, 0x90, 0x40, 0x75, 0x1d, 0xae, 0x72, 0x44, 0x2d, 0xcc, 0x9b,
+	0x81, 0xe8, 0x26, 0xc3, 0x6d, 0xd7, 0x31, 0x99, 0x36, 0x53, 0x55, 0x56, 0x73, 0xc6, 0x95, 0x80,
+	0xb8, 0x2f, 0x68, 0x7b, 0x01, 0x49, 0x7d, 0x01, 0x25, 0x8a, 0x7f, 0xd6, 0x25, 0x14, 0xdb, 0xd8,
+	0xe1, 0x4c, 0x2b, 0x0a, 0x65, 0xdf, 0xaa, 0x0f, 0x4d, 0x7f, 0xdd, 0x8f, 0x9a, 0x11, 0x63, 0x31,
+	0x12, 0x02, 0xd4, 0xc7, 0x50, 0x40, 0xed, 0x36, 0x66, 0x4c, 0x03, 0x21, 0x6a, 0x65, 0x84, 0xa8,
+	0xa7, 0x02, 0x6c, 0x48, 0x26, 0xf5, 0x16, 0x5c, 0xc2, 0x27, 0x1e, 0xa1, 0xbd, 0xe6, 0x21, 0x26,
+	0xd6, 0x21, 0xd7, 0x66, 0x85, 0xed, 0xa5, 0xe0, 0xf0, 0xb9, 0x38, 0x53, 0x1f, 0x41, 0xc9, 0xa3,
+	0xc4, 0xa5, 0x84, 0xf7, 0x9a, 0x07, 0x18, 0x6b, 0xa5, 0x51, 0x11, 0x9a, 0x0d, 0xe1, 0xdb, 0x18,
+	0xab, 0x5b, 0x30, 0xed, 0x7a, 0xbe, 0x41, 0x4c, 0xbb, 0x24, 0x18, 0xbf, 0x99, 0x61, 0xa2, 0x5c,
+	0xbf, 0x08, 0x18, 0x8c, 0x90, 0x53, 0xdd, 0x02, 0xb0, 0xb1, 0x49, 0x50, 0x93, 0x38, 0x07, 0xae,
+	0x56, 0x16, 0x72, 0xbe, 0x9e, 0x21, 0x67, 0xd7, 0x07, 0xef, 0x38, 0x07, 0xae, 0x51, 0xb4, 0xc3,
+	0xa5, 0x2f, 0x84, 0x38, 0x5e, 0x97, 0x37, 0xfd, 0x42, 0xd7, 0x2e, 0x57, 0x95, 0xd5, 0x72, 0xa6,
+	0x90, 0x1d, 0x1f, 0xbc, 0xdf, 0xf3, 0xb0, 0x51, 0x24, 0xe1, 0xf2, 0x41, 0xe9, 0x57, 0x9f, 0xdf,
+	0xde, 0x0e, 0x8b, 0xb6, 0xf6, 0x18, 0x6a, 0xc3, 0x8b, 0xdd, 0xc0, 0xcc, 0x73, 0x1d, 0x86, 0xd5,
+	0x45, 0x98, 0xe6, 0x88, 0x1d, 0x35, 0x89, 0x29, 0x8b, 0xbe, 0xe0, 0x6f, 0x77, 0xcc, 0xda, 0x3f,
+	0x26, 0xa1, 0xb4, 0xcb, 0xac, 0xa7, 0xa6, 0xf9, 0x63, 0x97, 0x1e, 0x61, 0x9a, 0xd1, 0x1e, 0xcb,
+	0x50, 0xf4, 0xba, 0xad, 0x0e, 0x69, 0x37, 0x89, 0x27, 0x9b, 0x64, 0x26, 0x38, 0xd8, 0xf1, 0x7c,
+	0x05, 0xc4, 0x3b, 0x60, 0xbe, 0x82, 0x5c, 0xa0, 0xc0, 0xdf, 0xee, 0x98, 0xea, 0x06, 0xe4, 0x19,
+	0x47, 0x47, 0x41, 0x7f, 0x64, 0xe5, 0xec, 0xd9, 0xd4, 0xbb, 0xff, 0xdc, 0x98, 0x30, 0x02, 0xb4,
+	0x7a, 0x03, 0x66, 0x6d, 0x74, 0xd2, 0x4c, 0x76, 0x10, 0xd8, 0xe8, 0x64, 0x5f, 0x36, 0xd1, 0x8f,
+	0xa0, 0xd4, 0x46, 0x1e, 0x6a, 0x91, 0x0e, 0xe1, 0x04, 0x33, 0xd1, 0x4b, 0xb3, 0xeb, 0x77, 0x33,
+	0x82, 0x19, 0x38, 0xb8, 0x15, 0x63, 0x32, 0x12, 0x22, 0xd4, 0x15, 0x28, 0x23, 0xc7, 0x71, 0xbb,
+	0x4e, 0x1b, 0x37, 0x91, 0x69, 0x52, 0xa6, 0x4d, 0x57, 0x73, 0xab, 0x45, 0xe3, 0x52, 0x78, 0xfa,
+	0xd4, 0x3f, 0x4c, 0xc5, 0xff, 0xbb, 0x30, 0x1f, 0x8f, 0x5f, 0x14, 0xf1, 0x32, 0x4c, 0xba, 0x47,
+	0x22, 0x84, 0x33, 0xc6, 0xa4, 0x2b, 0xae, 0x1d, 0x1b, 0x33, 0x86, 0x2c, 0x2c, 0x63, 0x17, 0x6e,
+	0x6b, 0x04, 0xbe, 0xb6, 0xcb, 0xac, 0x3d, 0xcc, 0x03, 0x09, 0xdf, 0xa7, 0x6e, 0xd7, 0xcb, 0x48,
+	0x83, 0x0a, 0x53, 0x0e, 0xb2, 0x43, 0x29, 0x62, 0x1d, 0x08, 0xb7, 0x5b, 0x98, 0x32, 0x2d, 0x27,
+	0x4c, 0x0e, 0xb7, 0x29, 0x63, 0x97, 0x61, 0xa9, 0x4f, 0x55, 0x68, 0x71, 0xed, 0x4f, 0x0a, 0x5c,
+	0xde, 0x65, 0xd6, 0x2b, 0xcf, 0x44, 0x1c, 0x5f, 0x50, 0x35, 0xf4, 0x87, 0x78, 0x6a, 0x74, 0x88,
+	0x97, 0x60, 0x31, 0x65, 0x57, 0x64, 0xf3, 0x9f, 0x15, 0x58, 0x4e, 0xd1, 0xe2, 0x09, 0xce, 0xb0,
+	0x3f, 0x5d, 0x3f, 0x93, 0x5f, 0xb8, 0x7e, 0x52, 0x56, 0xaf, 0xc0, 0xad, 0x0c, 0xcb, 0x22, 0x0f,
+	0x1e, 0x81, 0xba, 0xcb, 0xac, 0x00, 0xf0, 0x1c, 0x23, 0xca, 0x5b, 0x18, 0xf1, 0xe1, 0x76, 0xa7,
+	0x94, 0x5c, 0x03, 0xbd, 0x9f, 0x3b, 0x92, 0xfd, 0x97, 0x78, 0x46, 0x5f, 0x22, 0x8a, 0x6c, 0xa6,
+	0x6e, 0x42, 0x11, 0x75, 0xf9, 0xa1, 0xb8, 0x1c, 0x03, 0xd9, 0xcf, 0xb4, 0x0f, 0x7f, 0xbd, 0x3b,
+	0x2f, 0xdb, 0xd2, 0x8f, 0x3f, 0x66, 0x6c, 0x8f, 0x53, 0xe2, 0x58, 0xc6, 0x29, 0x54, 0xfd, 0x0e,
+	0x14, 0x3c, 0x21, 0x41, 0x46, 0xea, 0x66, 0x46, 0xa4, 0x02, 0x55, 0xb2, 0xa1, 0x25, 0xdb, 0x83,
+	0xb2, 0x6f, 0xf8, 0xa9, 0xc0, 0x44, 0x56, 0x03, 0x86, 0xc8, 0xee, 0x63, 0xd0, 0xfc, 0x32, 0xed,
+	0xb6, 0x58, 0x9b, 0x92, 0x96, 0x8c, 0xde, 0xbe, 0x1b, 0x3e, 0xdf, 0x28, 0xb0, 0x31, 0x8c, 0x8c,
+	0xdc, 0xaa, 0x0b, 0x20, 0x2f, 0x35, 0x59, 0x8e, 0x72, 0xe7, 0x3f, 0xd2, 0xc1, 0x35, 0xb2, 0x13,
+	0x56, 0x63, 0xb4, 0x97, 0xd1, 0x94, 0x12, 0x6a, 0x4f, 0xa0, 0x3a, 0x4c, 0x6f, 0xd4, 0xd7, 0x71,
+	0x69, 0x4a, 0x52, 0x5a, 0xed, 0x83, 0x22, 0x92, 0xf9, 0x92, 0xba, 0x9e, 0xcb, 0xf0, 0x9e, 0xdb,
+	0xe9, 0xfa, 0xf1, 0xc9, 0x28, 0xc2, 0x73, 0x98, 0xac, 0x5e, 0x07, 0x90, 0x8d, 0x77, 0x84, 0x7b,
+	0xe2, 0x56, 0x2d, 0x1a, 0xb2, 0x15, 0x7f, 0x88, 0x7b, 0x62, 0x64, 0x21, 0x96, 0x83, 0x78, 0x97,
+	0x62, 0xff, 0xde, 0xf4, 0xbb, 0x2b, 0x76, 0xa2, 0xde, 0x84, 0x12, 0xb1, 0x91, 0x85, 0x9b, 0x26,
+	0xb1, 0x30, 0xe3, 0xe2, 0xde, 0x2c, 0x1a, 0xb3, 0xe2, 0xec, 0x7b, 0xe2, 0x68, 0x60, 0x89, 0xa5,
+	0x7c, 0x8a, 0x52, 0xf5, 0x1b, 0x45, 0xdc, 0x5e, 0x06, 0x3e, 0xc6, 0xa8, 0x73, 0x41, 0x1e, 0x2f,
+	0x40, 0xe1, 0xc0, 0x1f, 0xa9, 0xc2, 0xcb, 0x42, 0xee, 0x06, 0xde, 0x6d, 0x49, 0x43, 0x22, 0x33,
+	0xff, 0xa6, 0xc0, 0x95, 0x20, 0xb5, 0x36, 0xe1, 0xaf, 0x51, 0x87, 0x98, 0xe8, 0x2b, 0x97, 0x9a,
+	0xa4, 0x3f, 0x3f, 0x98, 0x9a, 0x29, 0xcc, 0x4d, 0xd7, 0xae, 0xc3, 0xf2, 0x00, 0xbb, 0x23, 0xbf,
+	0xfe, 0x18, 0xf8, 0x15, 0x78, 0x7d, 0x61, 0x7e, 0xd5, 0xa0, 0x74, 0x40, 0x1c, 0x0b, 0x53, 0x8f,
+	0x12, 0x87, 0x87, 0x69, 0x48, 0x9c, 0xa5, 0x92, 0x11, 0x98, 0x9d, 0x36, 0x2b, 0x32, 0xfb, 0xef,
+	0x41, 0xd5, 0x04, 0x6e, 0x5d, 0x50, 0xd5, 0xcc, 0x41, 0xce, 0x24, 0x54, 0x66, 0xc1, 0x5f, 0xaa,
+	0xf7, 0x61, 0x01, 0x1d, 0x63, 0xea, 0x17, 0x3f, 0x15, 0x1f, 0x10, 0xd1, 0xbc, 0x9c, 0x17, 0x33,
+	0xe7, 0xbc, 0xa4, 0x1a, 0x82, 0x28, 0x07, 0xe6, 0xc1, 0x2f, 0x68, 0xc2, 0xf0, 0xc8, 0xad, 0x7f,
+	0x29, 0xb0, 0x20, 0xdc, 0xf6, 0x5c, 0x2a, 0xc7, 0xee, 0x6d, 0x44, 0x3a, 0x5d, 0x8a, 0xbf, 0x64,
+	0xdf, 0xb6, 0xfd, 0x6f, 0x05, 0xc4, 0x5c, 0x47, 0xb8, 0x57, 0x5e, 0xaf, 0x67, 0xcd, 0xdc, 0x71,
+	0x3b, 0x0c, 0xc1, 0x65, 0x48, 0xee, 0xf8, 0x50, 0x92, 0x4f, 0x0c, 0x25, 0x29, 0xaf, 0xab, 0x50,
+	0x19, 0xec, 0x57, 0xe4, 0xfa, 0x2f, 0x62, 0xfd, 0xb5, 0x4d, 0x1c, 0xd4, 0x11, 0xb3, 0xe8, 0x39,
+	0xdc, 0x96, 0x1f, 0x61, 0xb9, 0xd3, 0x8f, 0x30, 0x15, 0xa6, 0x0e, 0x11, 0x3b, 0x94, 0x99, 0x14,
+	0xeb, 0x81, 0xd5, 0x96, 0x56, 0x1e, 0xda, 0xb6, 0xfe, 0xcf, 0x32, 0xe4, 0x76, 0x99, 0xa5, 0xfe,
+	0x4e, 0x81, 0xc5, 0x61, 0x5f, 0x85, 0x1b, 0x59, 0xa3, 0xfc, 0xd0, 0xf9, 0x5a, 0x7f, 0x7c, 0x2e,
+	0xb6, 0xe8, 0x31, 0xc1, 0x50, 0x3c, 0x9d, 0xbc, 0xbf, 0x91, 0x2d, 0x2b, 0x02, 0xea, 0x8d, 0x31,
+	0x81, 0x91, 0x1a, 0x0e, 0xe5, 0xd4, 0x78, 0x79, 0x27, 0x5b, 0x44, 0x12, 0xad, 0xdf, 0x3f, 0x0b,
+	0x3a, 0xd2, 0xea, 0x40, 0x29, 0x31, 0x4b, 0xde, 0xce, 0x96, 0x12, 0xc7, 0xea, 0xeb, 0xe3, 0x63,
+	0x23, 0x7d, 0x7f, 0x50, 0x40, 0x1b, 0x3a, 0x08, 0x6e, 0x8e, 0x2f, 0x30, 0xce, 0xa7, 0x3f, 0x39,
+	0x1f, 0x5f, 0x64, 0xd4, 0x1b, 0xb8, 0x9c, 0x9e, 0xed, 0xee, 0x66, 0x8b, 0x4c, 0xc1, 0xf5, 0x8d,
+	0x33, 0xc1, 0x23, 0xc5, 0xbf, 0x55, 0xe0, 0xea, 0xe0, 0x09, 0xea, 0xde, 0x88, 0x6c, 0x0e, 0x62,
+	0xd2, 0x1f, 0x9e, 0x83, 0x29, 0x1e, 0x84, 0xf4, 0x4c, 0x34, 0x22, 0x08, 0x29, 0xb8, 0xbe, 0x71,
+	0x26, 0x78, 0xa4, 0xf8, 0xe7, 0x30, 0xd7, 0xf7, 0xe4, 0xd7, 0x47, 0x7a, 0x92, 0xc0, 0xeb, 0x9b,
+	0x67, 0xc3, 0xc7, 0x9b, 0x2e, 0x35, 0x15, 0x8d, 0x68, 0xba, 0x24, 0x5a, 0xbf, 0x7f, 0x16, 0x74,
+	0xdc, 0xe3, 0xbe, 0x61, 0xa0, 0x3e, 0x8e, 0xa4, 0xf1, 0x3d, 0x1e, 0xf6, 0xaa, 0x8b, 0x6b, 0x26,
+	0xf9, 0xa2, 0xdf, 0x19, 0x27, 0x76, 0xe3, 0x7a, 0x3c, 0xf8, 0xd1, 0x55, 0x7f, 0xad, 0xc0, 0x95,
+	0x41, 0x2f, 0xee, 0xda, 0x28, 0x2f, 0xfa, 0x58, 0xf4, 0x6f, 0x9f, 0x99, 0xa5, 0xbf, 0xd2, 0x62,
+	0x8f, 0xdf, 0x58, 0x95, 0x76, 0x8a, 0xd7, 0x37, 0xcf, 0x86, 0xef, 0xbf, 0x68, 0xe5, 0x27, 0xde,
+	0x58, 0x17, 0x6d, 0x80, 0xd5, 0xd7, 0xc7, 0xc7, 0x86, 0xfa, 0xf4, 0xfc, 0x2f, 0x3f, 0xbf, 0xbd,
+	0xad, 0x3c, 0x7b, 0xf8, 0xee, 0x63, 0x45, 0x79, 0xff, 0xb1, 0xa2, 0xfc, 0xf7, 0x63, 0x45, 0xf9,
+	0xfd, 0xa7, 0xca, 0xc4, 0xfb, 0x4f, 0x95, 0x89, 0x7f, 0x7f, 0xaa, 0x4c, 0xfc, 0xe4, 0xa6, 0x45,
+	0xf8, 0x61, 0xb7, 0x55, 0x6f, 0xbb, 0x76, 0x63, 0xf0, 0xcf, 0xde, 0x56, 0x41, 0xfc, 0xab, 0xbd,
+	0xf7, 0xff, 0x01, 0x00, 0x38, 0x0f, 0x46, 0x89, 0x9b, 0x16, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// CreateGame create a game.
+	CreateVideoUpscalerTask(ctx context.Context, in *MsgCreateVideoUpscalerTask, opts ...grpc.CallOption) (*MsgCreateVideoUpscalerTaskResponse, error)
+	// Adds a new worker
+	AddWorker(ctx context.Context, in *MsgAddWorker, opts ...grpc.CallOption) (*MsgAddWorkerResponse, error)
+	// Creates a worker group, or replaces its members if the sender owns it
+	SetWorkerGroup(ctx context.Context, in *MsgSetWorkerGroup, opts ...grpc.CallOption) (*MsgSetWorkerGroupResponse, error)
+	// Updates the network details of a registered worker
+	UpdateWorker(ctx context.Context, in *MsgUpdateWorker, opts ...grpc.CallOption) (*MsgUpdateWorkerResponse, error)
+	// Updates the capabilities of a registered worker
+	UpdateWorkerCapabilities(ctx context.Context, in *MsgUpdateWorkerCapabilities, opts ...grpc.CallOption) (*MsgUpdateWorkerCapabilitiesResponse, error)
+	// Signals the worker is alive. Re-enables workers disabled for missing heartbeats
+	WorkerHeartbeat(ctx context.Context, in *MsgWorkerHeartbeat, opts ...grpc.CallOption) (*MsgWorkerHeartbeatResponse, error)
+	SubscribeWorkerToTask(ctx context.Context, in *MsgSubscribeWorkerToTask, opts ...grpc.CallOption) (*MsgSubscribeWorkerToTaskResponse, error)
+	// Propose a solution for the test of the nodes to validate
+	ProposeSolution(ctx context.Context, in *MsgProposeSolution, opts ...grpc.CallOption) (*MsgProposeSolutionResponse, error)
+	// Propose a solution for the test of the nodes to validate
+	SubmitValidation(ctx context.Context, in *MsgSubmitValidation, opts ...grpc.CallOption) (*MsgSubmitValidationResponse, error)
+	// Propose a solution for the test of the nodes to validate
+	RevealSolution(ctx context.Context, in *MsgRevealSolution, opts ...grpc.CallOption) (*MsgRevealSolutionResponse, error)
+	// Reveals the fingerprints a validator signed, once the solution is revealed
+	RevealValidation(ctx context.Context, in *MsgRevealValidation, opts ...grpc.CallOption) (*MsgRevealValidationResponse, error)
+	// Submits the solution to IPFS
+	SubmitSolution(ctx context.Context, in *MsgSubmitSolution, opts ...grpc.CallOption) (*MsgSubmitSolutionResponse, error)
+	// Gives up a thread the worker couldn't render, so it can be assigned to another worker
+	ReportThreadFailure(ctx context.Context, in *MsgReportThreadFailure, opts ...grpc.CallOption) (*MsgReportThreadFailureResponse, error)
+	// Records the final video of a completed task, or confirms it when sent by the verifier
+	SubmitFinalVideo(ctx context.Context, in *MsgSubmitFinalVideo, opts ...grpc.CallOption) (*MsgSubmitFinalVideoResponse, error)
+	// Updates the module params. Only the authority can execute it
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreateVideoUpscalerTask(ctx context.Context, in *MsgCreateVideoUpscalerTask, opts ...grpc.CallOption) (*MsgCreateVideoUpscalerTaskResponse, error) {
+	out := new(MsgCreateVideoUpscalerTaskResponse)
+	err := c.cc.Invoke(ctx, "/janction.videoUpscaler.v1.Msg/CreateVideoUpscalerTask", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) AddWorker(ctx context.Context, in *MsgAddWorker, opts ...grpc.CallOption) (*MsgAddWorkerResponse, error) {
+	out := new(MsgAddWorkerResponse)
+	err := c.cc.Invoke(ctx, "/janction.videoUpscaler.v1.Msg/AddWorker", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgSubmitSolutionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetWorkerGroup(ctx context.Context, in *MsgSetWorkerGroup, opts ...grpc.CallOption) (*MsgSetWorkerGroupResponse, error) {
+	out := new(MsgSetWorkerGroupResponse)
+	err := c.cc.Invoke(ctx, "/janction.videoUpscaler.v1.Msg/SetWorkerGroup", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgSubmitSolutionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateWorker(ctx context.Context, in *MsgUpdateWorker, opts ...grpc.CallOption) (*MsgUpdateWorkerResponse, error) {
+	out := new(MsgUpdateWorkerResponse)
+	err := c.cc.Invoke(ctx, "/janction.videoUpscaler.v1.Msg/UpdateWorker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgSubmitSolutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) UpdateWorkerCapabilities(ctx context.Context, in *MsgUpdateWorkerCapabilities, opts ...grpc.CallOption) (*MsgUpdateWorkerCapabilitiesResponse, error) {
+	out := new(MsgUpdateWorkerCapabilitiesResponse)
+	err := c.cc.Invoke(ctx, "/janction.videoUpscaler.v1.Msg/UpdateWorkerCapabilities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *msgClient) WorkerHeartbeat(ctx context.Context, in *MsgWorkerHeartbeat, opts ...grpc.CallOption) (*MsgWorkerHeartbeatResponse, error) {
+	out := new(MsgWorkerHeartbeatResponse)
+	err := c.cc.Invoke(ctx, "/janction.videoUpscaler.v1.Msg/WorkerHeartbeat", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *MsgCreateVideoUpscalerTask) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (c *msgClient) SubscribeWorkerToTask(ctx context.Context, in *MsgSubscribeWorkerToTask, opts ...grpc.CallOption) (*MsgSubscribeWorkerToTaskResponse, error) {
+	out := new(MsgSubscribeWorkerToTaskResponse)
+	err := c.cc.Invoke(ctx, "/janction.videoUpscaler.v1.Msg/SubscribeWorkerToTask", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) ProposeSolution(ctx context.Context, in *MsgProposeSolution, opts ...grpc.CallOption) (*MsgProposeSolutionResponse, error) {
+	out := new(MsgProposeSolutionResponse)
+	err := c.cc.Invoke(ctx, "/janction.videoUpscaler.v1.Msg/ProposeSolution", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Cid)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) SubmitValidation(ctx context.Context, in *MsgSubmitValidation, opts ...grpc.CallOption) (*MsgSubmitValidationResponse, error) {
+	out := new(MsgSubmitValidationResponse)
+	err := c.cc.Invoke(ctx, "/janction.videoUpscaler.v1.Msg/SubmitValidation", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.StartFrame != 0 {
-		n += 1 + sovTx(uint64(m.StartFrame))
+	return out, nil
+}
+
+func (c *msgClient) RevealSolution(ctx context.Context, in *MsgRevealSolution, opts ...grpc.CallOption) (*MsgRevealSolutionResponse, error) {
+	out := new(MsgRevealSolutionResponse)
+	err := c.cc.Invoke(ctx, "/janction.videoUpscaler.v1.Msg/RevealSolution", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.EndFrame != 0 {
-		n += 1 + sovTx(uint64(m.EndFrame))
+	return out, nil
+}
+
+func (c *msgClient) RevealValidation(ctx context.Context, in *MsgRevealValidation, opts ...grpc.CallOption) (*MsgRevealValidationResponse, error) {
+	out := new(MsgRevealValidationResponse)
+	err := c.cc.Invoke(ctx, "/janction.videoUpscaler.v1.Msg/RevealValidation", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Threads != 0 {
-		n += 1 + sovTx(uint64(m.Threads))
+	return out, nil
+}
+
+func (c *msgClient) SubmitSolution(ctx context.Context, in *MsgSubmitSolution, opts ...grpc.CallOption) (*MsgSubmitSolutionResponse, error) {
+	out := new(MsgSubmitSolutionResponse)
+	err := c.cc.Invoke(ctx, "/janction.videoUpscaler.v1.Msg/SubmitSolution", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Scale != 0 {
-		n += 1 + sovTx(uint64(m.Scale))
+	return out, nil
+}
+
+func (c *msgClient) ReportThreadFailure(ctx context.Context, in *MsgReportThreadFailure, opts ...grpc.CallOption) (*MsgReportThreadFailureResponse, error) {
+	out := new(MsgReportThreadFailureResponse)
+	err := c.cc.Invoke(ctx, "/janction.videoUpscaler.v1.Msg/ReportThreadFailure", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Reward != nil {
-		l = m.Reward.Size()
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) SubmitFinalVideo(ctx context.Context, in *MsgSubmitFinalVideo, opts ...grpc.CallOption) (*MsgSubmitFinalVideoResponse, error) {
+	out := new(MsgSubmitFinalVideoResponse)
+	err := c.cc.Invoke(ctx, "/janction.videoUpscaler.v1.Msg/SubmitFinalVideo", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgCreateVideoUpscalerTaskResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.TaskId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/janction.videoUpscaler.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgAddWorker) Size() (n int) {
-	if m == nil {
-		return 0
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// CreateGame create a game.
+	CreateVideoUpscalerTask(context.Context, *MsgCreateVideoUpscalerTask) (*MsgCreateVideoUpscalerTaskResponse, error)
+	// Adds a new worker
+	AddWorker(context.Context, *MsgAddWorker) (*MsgAddWorkerResponse, error)
+	// Creates a worker group, or replaces its members if the sender owns it
+	SetWorkerGroup(context.Context, *MsgSetWorkerGroup) (*MsgSetWorkerGroupResponse, error)
+	// Updates the network details of a registered worker
+	UpdateWorker(context.Context, *MsgUpdateWorker) (*MsgUpdateWorkerResponse, error)
+	// Updates the capabilities of a registered worker
+	UpdateWorkerCapabilities(context.Context, *MsgUpdateWorkerCapabilities) (*MsgUpdateWorkerCapabilitiesResponse, error)
+	// Signals the worker is alive. Re-enables workers disabled for missing heartbeats
+	WorkerHeartbeat(context.Context, *MsgWorkerHeartbeat) (*MsgWorkerHeartbeatResponse, error)
+	SubscribeWorkerToTask(context.Context, *MsgSubscribeWorkerToTask) (*MsgSubscribeWorkerToTaskResponse, error)
+	// Propose a solution for the test of the nodes to validate
+	ProposeSolution(context.Context, *MsgProposeSolution) (*MsgProposeSolutionResponse, error)
+	// Propose a solution for the test of the nodes to validate
+	SubmitValidation(context.Context, *MsgSubmitValidation) (*MsgSubmitValidationResponse, error)
+	// Propose a solution for the test of the nodes to validate
+	RevealSolution(context.Context, *MsgRevealSolution) (*MsgRevealSolutionResponse, error)
+	// Reveals the fingerprints a validator signed, once the solution is revealed
+	RevealValidation(context.Context, *MsgRevealValidation) (*MsgRevealValidationResponse, error)
+	// Submits the solution to IPFS
+	SubmitSolution(context.Context, *MsgSubmitSolution) (*MsgSubmitSolutionResponse, error)
+	// Gives up a thread the worker couldn't render, so it can be assigned to another worker
+	ReportThreadFailure(context.Context, *MsgReportThreadFailure) (*MsgReportThreadFailureResponse, error)
+	// Records the final video of a completed task, or confirms it when sent by the verifier
+	SubmitFinalVideo(context.Context, *MsgSubmitFinalVideo) (*MsgSubmitFinalVideoResponse, error)
+	// Updates the module params. Only the authority can execute it
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) CreateVideoUpscalerTask(ctx context.Context, req *MsgCreateVideoUpscalerTask) (*MsgCreateVideoUpscalerTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVideoUpscalerTask not implemented")
+}
+func (*UnimplementedMsgServer) AddWorker(ctx context.Context, req *MsgAddWorker) (*MsgAddWorkerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddWorker not implemented")
+}
+func (*UnimplementedMsgServer) SetWorkerGroup(ctx context.Context, req *MsgSetWorkerGroup) (*MsgSetWorkerGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetWorkerGroup not implemented")
+}
+func (*UnimplementedMsgServer) UpdateWorker(ctx context.Context, req *MsgUpdateWorker) (*MsgUpdateWorkerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWorker not implemented")
+}
+func (*UnimplementedMsgServer) UpdateWorkerCapabilities(ctx context.Context, req *MsgUpdateWorkerCapabilities) (*MsgUpdateWorkerCapabilitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWorkerCapabilities not implemented")
+}
+func (*UnimplementedMsgServer) WorkerHeartbeat(ctx context.Context, req *MsgWorkerHeartbeat) (*MsgWorkerHeartbeatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WorkerHeartbeat not implemented")
+}
+func (*UnimplementedMsgServer) SubscribeWorkerToTask(ctx context.Context, req *MsgSubscribeWorkerToTask) (*MsgSubscribeWorkerToTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubscribeWorkerToTask not implemented")
+}
+func (*UnimplementedMsgServer) ProposeSolution(ctx context.Context, req *MsgProposeSolution) (*MsgProposeSolutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeSolution not implemented")
+}
+func (*UnimplementedMsgServer) SubmitValidation(ctx context.Context, req *MsgSubmitValidation) (*MsgSubmitValidationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitValidation not implemented")
+}
+func (*UnimplementedMsgServer) RevealSolution(ctx context.Context, req *MsgRevealSolution) (*MsgRevealSolutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealSolution not implemented")
+}
+func (*UnimplementedMsgServer) RevealValidation(ctx context.Context, req *MsgRevealValidation) (*MsgRevealValidationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealValidation not implemented")
+}
+func (*UnimplementedMsgServer) SubmitSolution(ctx context.Context, req *MsgSubmitSolution) (*MsgSubmitSolutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitSolution not implemented")
+}
+func (*UnimplementedMsgServer) ReportThreadFailure(ctx context.Context, req *MsgReportThreadFailure) (*MsgReportThreadFailureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportThreadFailure not implemented")
+}
+func (*UnimplementedMsgServer) SubmitFinalVideo(ctx context.Context, req *MsgSubmitFinalVideo) (*MsgSubmitFinalVideoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitFinalVideo not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_CreateVideoUpscalerTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateVideoUpscalerTask)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).CreateVideoUpscalerTask(ctx, in)
 	}
-	l = len(m.PublicIp)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/janction.videoUpscaler.v1.Msg/CreateVideoUpscalerTask",
 	}
-	l = len(m.IpfsId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateVideoUpscalerTask(ctx, req.(*MsgCreateVideoUpscalerTask))
 	}
-	l = m.Stake.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgAddWorkerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_AddWorker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddWorker)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Ok {
-		n += 2
+	if interceptor == nil {
+		return srv.(MsgServer).AddWorker(ctx, in)
 	}
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/janction.videoUpscaler.v1.Msg/AddWorker",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddWorker(ctx, req.(*MsgAddWorker))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubscribeWorkerToTask) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SetWorkerGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetWorkerGroup)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).SetWorkerGroup(ctx, in)
 	}
-	l = len(m.TaskId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/janction.videoUpscaler.v1.Msg/SetWorkerGroup",
 	}
-	l = len(m.ThreadId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetWorkerGroup(ctx, req.(*MsgSetWorkerGroup))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubscribeWorkerToTaskResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateWorker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateWorker)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ThreadId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateWorker(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/janction.videoUpscaler.v1.Msg/UpdateWorker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateWorker(ctx, req.(*MsgUpdateWorker))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgProposeSolution) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateWorkerCapabilities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateWorkerCapabilities)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateWorkerCapabilities(ctx, in)
 	}
-	l = len(m.TaskId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/janction.videoUpscaler.v1.Msg/UpdateWorkerCapabilities",
 	}
-	l = len(m.ThreadId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateWorkerCapabilities(ctx, req.(*MsgUpdateWorkerCapabilities))
 	}
-	l = len(m.PublicKey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WorkerHeartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWorkerHeartbeat)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Signatures) > 0 {
-		for _, s := range m.Signatures {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).WorkerHeartbeat(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/janction.videoUpscaler.v1.Msg/WorkerHeartbeat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WorkerHeartbeat(ctx, req.(*MsgWorkerHeartbeat))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgProposeSolutionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SubscribeWorkerToTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubscribeWorkerToTask)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).SubscribeWorkerToTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/janction.videoUpscaler.v1.Msg/SubscribeWorkerToTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubscribeWorkerToTask(ctx, req.(*MsgSubscribeWorkerToTask))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRevealSolution) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func _Msg_ProposeSolution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProposeSolution)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.TaskId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).ProposeSolution(ctx, in)
 	}
-	l = len(m.ThreadId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/janction.videoUpscaler.v1.Msg/ProposeSolution",
 	}
-	if len(m.Frames) > 0 {
-		for _, s := range m.Frames {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProposeSolution(ctx, req.(*MsgProposeSolution))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRevealSolutionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SubmitValidation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitValidation)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitValidation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/janction.videoUpscaler.v1.Msg/SubmitValidation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitValidation(ctx, req.(*MsgSubmitValidation))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitValidation) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_RevealSolution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevealSolution)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).RevealSolution(ctx, in)
 	}
-	l = len(m.TaskId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/janction.videoUpscaler.v1.Msg/RevealSolution",
 	}
-	l = len(m.ThreadId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevealSolution(ctx, req.(*MsgRevealSolution))
 	}
-	l = len(m.PublicKey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevealValidation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevealValidation)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Signatures) > 0 {
-		for _, s := range m.Signatures {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).RevealValidation(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/janction.videoUpscaler.v1.Msg/RevealValidation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevealValidation(ctx, req.(*MsgRevealValidation))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitValidationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_SubmitSolution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitSolution)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitSolution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/janction.videoUpscaler.v1.Msg/SubmitSolution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitSolution(ctx, req.(*MsgSubmitSolution))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitSolution) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ReportThreadFailure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReportThreadFailure)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReportThreadFailure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/janction.videoUpscaler.v1.Msg/ReportThreadFailure",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReportThreadFailure(ctx, req.(*MsgReportThreadFailure))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitFinalVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitFinalVideo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitFinalVideo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/janction.videoUpscaler.v1.Msg/SubmitFinalVideo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitFinalVideo(ctx, req.(*MsgSubmitFinalVideo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/janction.videoUpscaler.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "janction.videoUpscaler.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateVideoUpscalerTask",
+			Handler:    _Msg_CreateVideoUpscalerTask_Handler,
+		},
+		{
+			MethodName: "AddWorker",
+			Handler:    _Msg_AddWorker_Handler,
+		},
+		{
+			MethodName: "SetWorkerGroup",
+			Handler:    _Msg_SetWorkerGroup_Handler,
+		},
+		{
+			MethodName: "UpdateWorker",
+			Handler:    _Msg_UpdateWorker_Handler,
+		},
+		{
+			MethodName: "UpdateWorkerCapabilities",
+			Handler:    _Msg_UpdateWorkerCapabilities_Handler,
+		},
+		{
+			MethodName: "WorkerHeartbeat",
+			Handler:    _Msg_WorkerHeartbeat_Handler,
+		},
+		{
+			MethodName: "SubscribeWorkerToTask",
+			Handler:    _Msg_SubscribeWorkerToTask_Handler,
+		},
+		{
+			MethodName: "ProposeSolution",
+			Handler:    _Msg_ProposeSolution_Handler,
+		},
+		{
+			MethodName: "SubmitValidation",
+			Handler:    _Msg_SubmitValidation_Handler,
+		},
+		{
+			MethodName: "RevealSolution",
+			Handler:    _Msg_RevealSolution_Handler,
+		},
+		{
+			MethodName: "RevealValidation",
+			Handler:    _Msg_RevealValidation_Handler,
+		},
+		{
+			MethodName: "SubmitSolution",
+			Handler:    _Msg_SubmitSolution_Handler,
+		},
+		{
+			MethodName: "ReportThreadFailure",
+			Handler:    _Msg_ReportThreadFailure_Handler,
+		},
+		{
+			MethodName: "SubmitFinalVideo",
+			Handler:    _Msg_SubmitFinalVideo_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "janction/videoUpscaler/v1/tx.proto",
+}
+
+func (m *MsgCreateVideoUpscalerTask) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateVideoUpscalerTask) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateVideoUpscalerTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.InputType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.InputType))
+		i--
+		dAtA[i] = 0x78
 	}
-	l = len(m.TaskId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.MediaInfo != nil {
+		{
+			size, err := m.MediaInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
 	}
-	l = len(m.ThreadId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Options != nil {
+		{
+			size, err := m.Options.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
 	}
-	l = len(m.Dir)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.PriorityFee != nil {
+		{
+			size, err := m.PriorityFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
 	}
-	if m.AverageRenderSeconds != 0 {
-		n += 1 + sovTx(uint64(m.AverageRenderSeconds))
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Access != nil {
+		{
+			size, err := m.Access.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Requirements != nil {
+		{
+			size, err := m.Requirements.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.TargetThreadSeconds != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TargetThreadSeconds))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Reward != nil {
+		{
+			size, err := m.Reward.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Scale != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Scale))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Threads != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Threads))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.EndFrame != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EndFrame))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartFrame != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartFrame))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Cid) > 0 {
+		i -= len(m.Cid)
+		copy(dAtA[i:], m.Cid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Cid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateVideoUpscalerTaskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateVideoUpscalerTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateVideoUpscalerTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddWorker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddWorker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddWorker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AnnounceAddrs) > 0 {
+		for iNdEx := len(m.AnnounceAddrs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AnnounceAddrs[iNdEx])
+			copy(dAtA[i:], m.AnnounceAddrs[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.AnnounceAddrs[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.Capabilities != nil {
+		{
+			size, err := m.Capabilities.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.MaxThreads != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxThreads))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.Stake.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.IpfsId) > 0 {
+		i -= len(m.IpfsId)
+		copy(dAtA[i:], m.IpfsId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.IpfsId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PublicIp) > 0 {
+		i -= len(m.PublicIp)
+		copy(dAtA[i:], m.PublicIp)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PublicIp)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddWorkerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddWorkerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddWorkerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Ok {
+		i--
+		if m.Ok {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetWorkerGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetWorkerGroup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetWorkerGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Members[iNdEx])
+			copy(dAtA[i:], m.Members[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Members[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetWorkerGroupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetWorkerGroupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetWorkerGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateWorker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateWorker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateWorker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AnnounceAddrs) > 0 {
+		for iNdEx := len(m.AnnounceAddrs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AnnounceAddrs[iNdEx])
+			copy(dAtA[i:], m.AnnounceAddrs[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.AnnounceAddrs[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.IpfsId) > 0 {
+		i -= len(m.IpfsId)
+		copy(dAtA[i:], m.IpfsId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.IpfsId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PublicIp) > 0 {
+		i -= len(m.PublicIp)
+		copy(dAtA[i:], m.PublicIp)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PublicIp)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateWorkerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateWorkerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateWorkerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateWorkerCapabilities) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateWorkerCapabilities) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateWorkerCapabilities) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Capabilities != nil {
+		{
+			size, err := m.Capabilities.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateWorkerCapabilitiesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateWorkerCapabilitiesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateWorkerCapabilitiesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWorkerHeartbeat) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWorkerHeartbeat) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWorkerHeartbeat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWorkerHeartbeatResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWorkerHeartbeatResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWorkerHeartbeatResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubscribeWorkerToTask) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubscribeWorkerToTask) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubscribeWorkerToTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ThreadId) > 0 {
+		i -= len(m.ThreadId)
+		copy(dAtA[i:], m.ThreadId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ThreadId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubscribeWorkerToTaskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubscribeWorkerToTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubscribeWorkerToTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ThreadId) > 0 {
+		i -= len(m.ThreadId)
+		copy(dAtA[i:], m.ThreadId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ThreadId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProposeSolution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposeSolution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposeSolution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ImageDigest) > 0 {
+		i -= len(m.ImageDigest)
+		copy(dAtA[i:], m.ImageDigest)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ImageDigest)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signatures[iNdEx])
+			copy(dAtA[i:], m.Signatures[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signatures[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ThreadId) > 0 {
+		i -= len(m.ThreadId)
+		copy(dAtA[i:], m.ThreadId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ThreadId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProposeSolutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposeSolutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposeSolutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevealSolution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevealSolution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevealSolution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Frames) > 0 {
+		for iNdEx := len(m.Frames) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Frames[iNdEx])
+			copy(dAtA[i:], m.Frames[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Frames[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ThreadId) > 0 {
+		i -= len(m.ThreadId)
+		copy(dAtA[i:], m.ThreadId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ThreadId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevealSolutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevealSolutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevealSolutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitValidation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitValidation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitValidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signatures[iNdEx])
+			copy(dAtA[i:], m.Signatures[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signatures[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ThreadId) > 0 {
+		i -= len(m.ThreadId)
+		copy(dAtA[i:], m.ThreadId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ThreadId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitValidationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitValidationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitValidationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevealValidation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevealValidation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevealValidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fingerprints) > 0 {
+		for iNdEx := len(m.Fingerprints) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Fingerprints[iNdEx])
+			copy(dAtA[i:], m.Fingerprints[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Fingerprints[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ThreadId) > 0 {
+		i -= len(m.ThreadId)
+		copy(dAtA[i:], m.ThreadId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ThreadId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevealValidationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevealValidationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevealValidationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitSolution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitSolution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitSolution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AverageRenderSeconds != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AverageRenderSeconds))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Dir) > 0 {
+		i -= len(m.Dir)
+		copy(dAtA[i:], m.Dir)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Dir)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ThreadId) > 0 {
+		i -= len(m.ThreadId)
+		copy(dAtA[i:], m.ThreadId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ThreadId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitSolutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitSolutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitSolutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReportThreadFailure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReportThreadFailure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReportThreadFailure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Reason != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ThreadId) > 0 {
+		i -= len(m.ThreadId)
+		copy(dAtA[i:], m.ThreadId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ThreadId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReportThreadFailureResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReportThreadFailureResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReportThreadFailureResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitFinalVideo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitFinalVideo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitFinalVideo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Cid) > 0 {
+		i -= len(m.Cid)
+		copy(dAtA[i:], m.Cid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Cid)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitFinalVideoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitFinalVideoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitFinalVideoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateVideoUpscalerTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Cid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StartFrame != 0 {
+		n += 1 + sovTx(uint64(m.StartFrame))
+	}
+	if m.EndFrame != 0 {
+		n += 1 + sovTx(uint64(m.EndFrame))
+	}
+	if m.Threads != 0 {
+		n += 1 + sovTx(uint64(m.Threads))
+	}
+	if m.Scale != 0 {
+		n += 1 + sovTx(uint64(m.Scale))
+	}
+	if m.Reward != nil {
+		l = m.Reward.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TargetThreadSeconds != 0 {
+		n += 1 + sovTx(uint64(m.TargetThreadSeconds))
+	}
+	if m.Requirements != nil {
+		l = m.Requirements.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Access != nil {
+		l = m.Access.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovTx(uint64(m.ExpiryHeight))
+	}
+	if m.PriorityFee != nil {
+		l = m.PriorityFee.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Options != nil {
+		l = m.Options.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MediaInfo != nil {
+		l = m.MediaInfo.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.InputType != 0 {
+		n += 1 + sovTx(uint64(m.InputType))
+	}
+	return n
+}
+
+func (m *MsgCreateVideoUpscalerTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddWorker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PublicIp)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.IpfsId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Stake.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.MaxThreads != 0 {
+		n += 1 + sovTx(uint64(m.MaxThreads))
+	}
+	if m.Capabilities != nil {
+		l = m.Capabilities.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.AnnounceAddrs) > 0 {
+		for _, s := range m.AnnounceAddrs {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddWorkerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Ok {
+		n += 2
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetWorkerGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Members) > 0 {
+		for _, s := range m.Members {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetWorkerGroupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateWorker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PublicIp)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.IpfsId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.AnnounceAddrs) > 0 {
+		for _, s := range m.AnnounceAddrs {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateWorkerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateWorkerCapabilities) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Capabilities != nil {
+		l = m.Capabilities.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateWorkerCapabilitiesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWorkerHeartbeat) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWorkerHeartbeatResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubscribeWorkerToTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ThreadId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubscribeWorkerToTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ThreadId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgProposeSolution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ThreadId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Signatures) > 0 {
+		for _, s := range m.Signatures {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.ImageDigest)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgProposeSolutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevealSolution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ThreadId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Frames) > 0 {
+		for _, s := range m.Frames {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRevealSolutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitValidation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ThreadId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Signatures) > 0 {
+		for _, s := range m.Signatures {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSubmitValidationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevealValidation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ThreadId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Fingerprints) > 0 {
+		for _, s := range m.Fingerprints {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRevealValidationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitSolution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ThreadId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Dir)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AverageRenderSeconds != 0 {
+		n += 1 + sovTx(uint64(m.AverageRenderSeconds))
+	}
+	return n
+}
+
+func (m *MsgSubmitSolutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReportThreadFailure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ThreadId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Reason != 0 {
+		n += 1 + sovTx(uint64(m.Reason))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReportThreadFailureResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitFinalVideo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Cid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitFinalVideoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateVideoUpscalerTask) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateVideoUpscalerTask: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateVideoUpscalerTask: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartFrame", wireType)
+			}
+			m.StartFrame = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartFrame |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndFrame", wireType)
+			}
+			m.EndFrame = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndFrame |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threads", wireType)
+			}
+			m.Threads = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threads |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scale", wireType)
+			}
+			m.Scale = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Scale |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Reward == nil {
+				m.Reward = &types.Coin{}
+			}
+			if err := m.Reward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetThreadSeconds", wireType)
+			}
+			m.TargetThreadSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetThreadSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requirements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Requirements == nil {
+				m.Requirements = &TaskRequirements{}
+			}
+			if err := m.Requirements.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Access", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Access == nil {
+				m.Access = &TaskAccess{}
+			}
+			if err := m.Access.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PriorityFee == nil {
+				m.PriorityFee = &types.Coin{}
+			}
+			if err := m.PriorityFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Options == nil {
+				m.Options = &UpscaleOptions{}
+			}
+			if err := m.Options.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MediaInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MediaInfo == nil {
+				m.MediaInfo = &MediaInfo{}
+			}
+			if err := m.MediaInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InputType", wireType)
+			}
+			m.InputType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InputType |= InputType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateVideoUpscalerTaskResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateVideoUpscalerTaskResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateVideoUpscalerTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddWorker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddWorker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddWorker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicIp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicIp = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IpfsId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IpfsId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stake", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxThreads", wireType)
+			}
+			m.MaxThreads = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxThreads |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capabilities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Capabilities == nil {
+				m.Capabilities = &WorkerCapabilities{}
+			}
+			if err := m.Capabilities.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnounceAddrs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AnnounceAddrs = append(m.AnnounceAddrs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddWorkerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddWorkerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddWorkerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ok", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Ok = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetWorkerGroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetWorkerGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetWorkerGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetWorkerGroupResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetWorkerGroupResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetWorkerGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateWorker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateWorker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateWorker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicIp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicIp = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IpfsId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IpfsId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnounceAddrs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AnnounceAddrs = append(m.AnnounceAddrs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateWorkerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateWorkerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateWorkerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateWorkerCapabilities) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateWorkerCapabilities: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateWorkerCapabilities: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capabilities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Capabilities == nil {
+				m.Capabilities = &WorkerCapabilities{}
+			}
+			if err := m.Capabilities.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateWorkerCapabilitiesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateWorkerCapabilitiesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateWorkerCapabilitiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWorkerHeartbeat) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWorkerHeartbeat: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWorkerHeartbeat: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWorkerHeartbeatResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWorkerHeartbeatResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWorkerHeartbeatResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubscribeWorkerToTask) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubscribeWorkerToTask: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubscribeWorkerToTask: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThreadId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ThreadId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubscribeWorkerToTaskResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubscribeWorkerToTaskResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubscribeWorkerToTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThreadId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ThreadId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgSubmitSolutionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgCreateVideoUpscalerTask) Unmarshal(dAtA []byte) error {
+func (m *MsgProposeSolution) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2129,10 +6373,10 @@ func (m *MsgCreateVideoUpscalerTask) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateVideoUpscalerTask: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgProposeSolution: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateVideoUpscalerTask: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgProposeSolution: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2169,7 +6413,7 @@ func (m *MsgCreateVideoUpscalerTask) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2197,13 +6441,13 @@ func (m *MsgCreateVideoUpscalerTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cid = string(dAtA[iNdEx:postIndex])
+			m.TaskId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartFrame", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThreadId", wireType)
 			}
-			m.StartFrame = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2213,16 +6457,29 @@ func (m *MsgCreateVideoUpscalerTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartFrame |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ThreadId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndFrame", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
 			}
-			m.EndFrame = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2232,16 +6489,29 @@ func (m *MsgCreateVideoUpscalerTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EndFrame |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Threads", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
 			}
-			m.Threads = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2251,35 +6521,29 @@ func (m *MsgCreateVideoUpscalerTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Threads |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scale", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			m.Scale = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Scale |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-		case 7:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signatures = append(m.Signatures, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageDigest", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2289,27 +6553,23 @@ func (m *MsgCreateVideoUpscalerTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Reward == nil {
-				m.Reward = &types.Coin{}
-			}
-			if err := m.Reward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ImageDigest = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2332,7 +6592,7 @@ func (m *MsgCreateVideoUpscalerTask) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateVideoUpscalerTaskResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgProposeSolutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2355,44 +6615,12 @@ func (m *MsgCreateVideoUpscalerTaskResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateVideoUpscalerTaskResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgProposeSolutionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateVideoUpscalerTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgProposeSolutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TaskId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2414,7 +6642,7 @@ func (m *MsgCreateVideoUpscalerTaskResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddWorker) Unmarshal(dAtA []byte) error {
+func (m *MsgRevealSolution) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2437,10 +6665,10 @@ func (m *MsgAddWorker) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddWorker: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevealSolution: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddWorker: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevealSolution: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2477,7 +6705,7 @@ func (m *MsgAddWorker) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicIp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2505,11 +6733,11 @@ func (m *MsgAddWorker) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PublicIp = string(dAtA[iNdEx:postIndex])
+			m.TaskId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IpfsId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ThreadId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2537,13 +6765,13 @@ func (m *MsgAddWorker) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IpfsId = string(dAtA[iNdEx:postIndex])
+			m.ThreadId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stake", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Frames", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2553,24 +6781,23 @@ func (m *MsgAddWorker) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Stake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Frames = append(m.Frames, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2593,7 +6820,7 @@ func (m *MsgAddWorker) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddWorkerResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRevealSolutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2612,68 +6839,16 @@ func (m *MsgAddWorkerResponse) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddWorkerResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddWorkerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ok", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Ok = bool(v != 0)
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Message = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevealSolutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevealSolutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2695,7 +6870,7 @@ func (m *MsgAddWorkerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubscribeWorkerToTask) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitValidation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2718,15 +6893,15 @@ func (m *MsgSubscribeWorkerToTask) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubscribeWorkerToTask: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitValidation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubscribeWorkerToTask: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitValidation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2754,7 +6929,7 @@ func (m *MsgSubscribeWorkerToTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -2820,6 +6995,70 @@ func (m *MsgSubscribeWorkerToTask) Unmarshal(dAtA []byte) error {
 			}
 			m.ThreadId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signatures = append(m.Signatures, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2841,7 +7080,7 @@ func (m *MsgSubscribeWorkerToTask) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubscribeWorkerToTaskResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitValidationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2864,44 +7103,12 @@ func (m *MsgSubscribeWorkerToTaskResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubscribeWorkerToTaskResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitValidationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubscribeWorkerToTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitValidationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ThreadId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ThreadId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2923,7 +7130,7 @@ func (m *MsgSubscribeWorkerToTaskResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgProposeSolution) Unmarshal(dAtA []byte) error {
+func (m *MsgRevealValidation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2946,10 +7153,10 @@ func (m *MsgProposeSolution) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgProposeSolution: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevealValidation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgProposeSolution: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevealValidation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3050,39 +7257,7 @@ func (m *MsgProposeSolution) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PublicKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fingerprints", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3110,7 +7285,7 @@ func (m *MsgProposeSolution) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signatures = append(m.Signatures, string(dAtA[iNdEx:postIndex]))
+			m.Fingerprints = append(m.Fingerprints, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3133,7 +7308,7 @@ func (m *MsgProposeSolution) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgProposeSolutionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRevealValidationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3156,10 +7331,10 @@ func (m *MsgProposeSolutionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgProposeSolutionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevealValidationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgProposeSolutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevealValidationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3183,7 +7358,7 @@ func (m *MsgProposeSolutionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRevealSolution) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitSolution) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3206,10 +7381,10 @@ func (m *MsgRevealSolution) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRevealSolution: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitSolution: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRevealSolution: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitSolution: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3310,7 +7485,7 @@ func (m *MsgRevealSolution) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Frames", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dir", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3338,8 +7513,27 @@ func (m *MsgRevealSolution) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Frames = append(m.Frames, string(dAtA[iNdEx:postIndex]))
+			m.Dir = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageRenderSeconds", wireType)
+			}
+			m.AverageRenderSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AverageRenderSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3361,7 +7555,7 @@ func (m *MsgRevealSolution) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRevealSolutionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitSolutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3384,10 +7578,10 @@ func (m *MsgRevealSolutionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRevealSolutionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitSolutionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRevealSolutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitSolutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3411,7 +7605,7 @@ func (m *MsgRevealSolutionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitValidation) Unmarshal(dAtA []byte) error {
+func (m *MsgReportThreadFailure) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3434,10 +7628,10 @@ func (m *MsgSubmitValidation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitValidation: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReportThreadFailure: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitValidation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReportThreadFailure: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3537,10 +7731,10 @@ func (m *MsgSubmitValidation) Unmarshal(dAtA []byte) error {
 			m.ThreadId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var stringLen uint64
+			m.Reason = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3550,27 +7744,14 @@ func (m *MsgSubmitValidation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Reason |= ThreadFailureReason(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PublicKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3598,7 +7779,7 @@ func (m *MsgSubmitValidation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signatures = append(m.Signatures, string(dAtA[iNdEx:postIndex]))
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3621,7 +7802,7 @@ func (m *MsgSubmitValidation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitValidationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgReportThreadFailureResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3644,10 +7825,10 @@ func (m *MsgSubmitValidationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitValidationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReportThreadFailureResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitValidationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReportThreadFailureResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3671,7 +7852,7 @@ func (m *MsgSubmitValidationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitSolution) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitFinalVideo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3694,10 +7875,10 @@ func (m *MsgSubmitSolution) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitSolution: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitFinalVideo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitSolution: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitFinalVideo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3766,7 +7947,7 @@ func (m *MsgSubmitSolution) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ThreadId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3794,11 +7975,11 @@ func (m *MsgSubmitSolution) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ThreadId = string(dAtA[iNdEx:postIndex])
+			m.Cid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dir", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3826,27 +8007,8 @@ func (m *MsgSubmitSolution) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Dir = string(dAtA[iNdEx:postIndex])
+			m.Hash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AverageRenderSeconds", wireType)
-			}
-			m.AverageRenderSeconds = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AverageRenderSeconds |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3868,7 +8030,7 @@ func (m *MsgSubmitSolution) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitSolutionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitFinalVideoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3891,10 +8053,10 @@ func (m *MsgSubmitSolutionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitSolutionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitFinalVideoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitSolutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitFinalVideoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6,6 +6,7 @@ package videoUpscaler
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -25,6 +26,143 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// How the frames of a task are stored at its cid
+type InputType int32
+
+const (
+	// a single video file. Frames are decoded with ffmpeg
+	InputType_INPUT_TYPE_VIDEO InputType = 0
+	// a directory with one image per frame, like shot.1001.exr to shot.1100.exr. Frame n of the task is
+	// the image with the nth lowest number. Each thread only downloads its own images, and the final video
+	// is encoded at 24 fps without audio
+	InputType_INPUT_TYPE_IMAGE_SEQUENCE InputType = 1
+)
+
+var InputType_name = map[int32]string{
+	0: "INPUT_TYPE_VIDEO",
+	1: "INPUT_TYPE_IMAGE_SEQUENCE",
+}
+
+var InputType_value = map[string]int32{
+	"INPUT_TYPE_VIDEO":          0,
+	"INPUT_TYPE_IMAGE_SEQUENCE": 1,
+}
+
+func (x InputType) String() string {
+	return proto.EnumName(InputType_name, int32(x))
+}
+
+func (InputType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_93c659a7257600d0, []int{0}
+}
+
+// Why a worker couldn't render the frames of a thread
+type ThreadFailureReason int32
+
+const (
+	ThreadFailureReason_THREAD_FAILURE_REASON_UNSPECIFIED ThreadFailureReason = 0
+	// the input couldn't be decoded
+	ThreadFailureReason_THREAD_FAILURE_REASON_INPUT_DECODE ThreadFailureReason = 1
+	// the upscaler couldn't be started
+	ThreadFailureReason_THREAD_FAILURE_REASON_CONTAINER_START ThreadFailureReason = 2
+	// the upscaler took longer than the frame timeout
+	ThreadFailureReason_THREAD_FAILURE_REASON_TIMEOUT ThreadFailureReason = 3
+	// the upscaler finished without writing all the frames
+	ThreadFailureReason_THREAD_FAILURE_REASON_MISSING_OUTPUT ThreadFailureReason = 4
+	// the upscaler ran out of memory or was killed
+	ThreadFailureReason_THREAD_FAILURE_REASON_RESOURCE_LIMIT ThreadFailureReason = 5
+	// the downloaded input doesn't match the media info of the task
+	ThreadFailureReason_THREAD_FAILURE_REASON_MEDIA_MISMATCH ThreadFailureReason = 6
+)
+
+var ThreadFailureReason_name = map[int32]string{
+	0: "THREAD_FAILURE_REASON_UNSPECIFIED",
+	1: "THREAD_FAILURE_REASON_INPUT_DECODE",
+	2: "THREAD_FAILURE_REASON_CONTAINER_START",
+	3: "THREAD_FAILURE_REASON_TIMEOUT",
+	4: "THREAD_FAILURE_REASON_MISSING_OUTPUT",
+	5: "THREAD_FAILURE_REASON_RESOURCE_LIMIT",
+	6: "THREAD_FAILURE_REASON_MEDIA_MISMATCH",
+}
+
+var ThreadFailureReason_value = map[string]int32{
+	"THREAD_FAILURE_REASON_UNSPECIFIED":     0,
+	"THREAD_FAILURE_REASON_INPUT_DECODE":    1,
+	"THREAD_FAILURE_REASON_CONTAINER_START": 2,
+	"THREAD_FAILURE_REASON_TIMEOUT":         3,
+	"THREAD_FAILURE_REASON_MISSING_OUTPUT":  4,
+	"THREAD_FAILURE_REASON_RESOURCE_LIMIT":  5,
+	"THREAD_FAILURE_REASON_MEDIA_MISMATCH":  6,
+}
+
+func (x ThreadFailureReason) String() string {
+	return proto.EnumName(ThreadFailureReason_name, int32(x))
+}
+
+func (ThreadFailureReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_93c659a7257600d0, []int{1}
+}
+
+// How the frames of the solution are compared with the frames of the validators
+type ValidationMode int32
+
+const (
+	// the pixel hashes must be equal. Meant for deterministic backends
+	ValidationMode_VALIDATION_MODE_STRICT ValidationMode = 0
+	// the perceptual fingerprints must be within the max fingerprint distance, so honest renders that differ
+	// slightly, like the ones of non deterministic multi-threading, are accepted. The proposer and the validators
+	// commit to their fingerprints with their signatures, and reveal them once every validation is sent
+	ValidationMode_VALIDATION_MODE_FINGERPRINT ValidationMode = 1
+)
+
+var ValidationMode_name = map[int32]string{
+	0: "VALIDATION_MODE_STRICT",
+	1: "VALIDATION_MODE_FINGERPRINT",
+}
+
+var ValidationMode_value = map[string]int32{
+	"VALIDATION_MODE_STRICT":      0,
+	"VALIDATION_MODE_FINGERPRINT": 1,
+}
+
+func (x ValidationMode) String() string {
+	return proto.EnumName(ValidationMode_name, int32(x))
+}
+
+func (ValidationMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_93c659a7257600d0, []int{2}
+}
+
+// Lossless image formats the upscaled frames can be delivered in
+type OutputFormat int32
+
+const (
+	OutputFormat_OUTPUT_FORMAT_PNG           OutputFormat = 0
+	OutputFormat_OUTPUT_FORMAT_WEBP_LOSSLESS OutputFormat = 1
+	// 16 bits per channel
+	OutputFormat_OUTPUT_FORMAT_TIFF_16 OutputFormat = 2
+)
+
+var OutputFormat_name = map[int32]string{
+	0: "OUTPUT_FORMAT_PNG",
+	1: "OUTPUT_FORMAT_WEBP_LOSSLESS",
+	2: "OUTPUT_FORMAT_TIFF_16",
+}
+
+var OutputFormat_value = map[string]int32{
+	"OUTPUT_FORMAT_PNG":           0,
+	"OUTPUT_FORMAT_WEBP_LOSSLESS": 1,
+	"OUTPUT_FORMAT_TIFF_16":       2,
+}
+
+func (x OutputFormat) String() string {
+	return proto.EnumName(OutputFormat_name, int32(x))
+}
+
+func (OutputFormat) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_93c659a7257600d0, []int{3}
+}
+
 type VideoUpscalerLogs_VideoUpscalerLog_SEVERITY int32
 
 const (
@@ -50,7 +188,7 @@ func (x VideoUpscalerLogs_VideoUpscalerLog_SEVERITY) String() string {
 }
 
 func (VideoUpscalerLogs_VideoUpscalerLog_SEVERITY) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_93c659a7257600d0, []int{7, 0, 0}
+	return fileDescriptor_93c659a7257600d0, []int{17, 0, 0}
 }
 
 // Params defines the parameters of the module.
@@ -58,6 +196,17 @@ type Params struct {
 	MinWorkerStaking    *types.Coin `protobuf:"bytes,1,opt,name=min_worker_staking,json=minWorkerStaking,proto3" json:"min_worker_staking,omitempty"`
 	MaxWorkersPerThread int64       `protobuf:"varint,2,opt,name=max_workers_per_thread,json=maxWorkersPerThread,proto3" json:"max_workers_per_thread,omitempty"`
 	MinValidators       int64       `protobuf:"varint,3,opt,name=min_validators,json=minValidators,proto3" json:"min_validators,omitempty"`
+	// seconds per frame assumed for workers without render history when cutting adaptive threads
+	DefaultFrameRenderSeconds int64 `protobuf:"varint,4,opt,name=default_frame_render_seconds,json=defaultFrameRenderSeconds,proto3" json:"default_frame_render_seconds,omitempty"`
+	// workers without a heartbeat for this amount of blocks are disabled. Zero never disables
+	MaxMissedHeartbeatBlocks int64 `protobuf:"varint,5,opt,name=max_missed_heartbeat_blocks,json=maxMissedHeartbeatBlocks,proto3" json:"max_missed_heartbeat_blocks,omitempty"`
+	// upscaler images workers are allowed to render with, pinned by digest, one per backend
+	UpscalerImages []UpscalerImage `protobuf:"bytes,6,rep,name=upscaler_images,json=upscalerImages,proto3" json:"upscaler_images"`
+	// tiles of the frame fingerprints that may differ on tasks validated by fingerprint that don't set their own
+	MaxFingerprintDistance int32 `protobuf:"varint,7,opt,name=max_fingerprint_distance,json=maxFingerprintDistance,proto3" json:"max_fingerprint_distance,omitempty"`
+	// workers that must report the input doesn't match the media info before a task is refunded. More than half
+	// of the workers of the task must report it too. 0 means not set, so the default of 3 applies
+	MinMediaMismatchReports int64 `protobuf:"varint,8,opt,name=min_media_mismatch_reports,json=minMediaMismatchReports,proto3" json:"min_media_mismatch_reports,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -114,6 +263,95 @@ func (m *Params) GetMinValidators() int64 {
 	return 0
 }
 
+func (m *Params) GetDefaultFrameRenderSeconds() int64 {
+	if m != nil {
+		return m.DefaultFrameRenderSeconds
+	}
+	return 0
+}
+
+func (m *Params) GetMaxMissedHeartbeatBlocks() int64 {
+	if m != nil {
+		return m.MaxMissedHeartbeatBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetUpscalerImages() []UpscalerImage {
+	if m != nil {
+		return m.UpscalerImages
+	}
+	return nil
+}
+
+func (m *Params) GetMaxFingerprintDistance() int32 {
+	if m != nil {
+		return m.MaxFingerprintDistance
+	}
+	return 0
+}
+
+func (m *Params) GetMinMediaMismatchReports() int64 {
+	if m != nil {
+		return m.MinMediaMismatchReports
+	}
+	return 0
+}
+
+// UpscalerImage is the docker image, pinned by digest, used to render tasks of a backend
+type UpscalerImage struct {
+	Backend string `protobuf:"bytes,1,opt,name=backend,proto3" json:"backend,omitempty"`
+	// image reference with digest, like repository@sha256:<hex>
+	Image string `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`
+}
+
+func (m *UpscalerImage) Reset()         { *m = UpscalerImage{} }
+func (m *UpscalerImage) String() string { return proto.CompactTextString(m) }
+func (*UpscalerImage) ProtoMessage()    {}
+func (*UpscalerImage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93c659a7257600d0, []int{1}
+}
+func (m *UpscalerImage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpscalerImage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpscalerImage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpscalerImage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpscalerImage.Merge(m, src)
+}
+func (m *UpscalerImage) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpscalerImage) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpscalerImage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpscalerImage proto.InternalMessageInfo
+
+func (m *UpscalerImage) GetBackend() string {
+	if m != nil {
+		return m.Backend
+	}
+	return ""
+}
+
+func (m *UpscalerImage) GetImage() string {
+	if m != nil {
+		return m.Image
+	}
+	return ""
+}
+
 // GenesisState is the state that must be provided at genesis.
 type GenesisState struct {
 	// params defines all the parameters of the module.
@@ -124,13 +362,17 @@ type GenesisState struct {
 	VideoUpscalerTaskList []IndexedVideoUpscalerTask `protobuf:"bytes,4,rep,name=videoUpscalerTaskList,proto3" json:"videoUpscalerTaskList"`
 	// List of Workers
 	Workers []Worker `protobuf:"bytes,5,rep,name=workers,proto3" json:"workers"`
+	// Accounting of the module account: escrowed rewards per task, stakes per worker and total paid out
+	TaskEscrows  []ModuleAccounting_TaskEscrow            `protobuf:"bytes,6,rep,name=task_escrows,json=taskEscrows,proto3" json:"task_escrows"`
+	WorkerStakes []ModuleAccounting_WorkerStake           `protobuf:"bytes,7,rep,name=worker_stakes,json=workerStakes,proto3" json:"worker_stakes"`
+	PaidOut      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=paid_out,json=paidOut,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"paid_out"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_93c659a7257600d0, []int{1}
+	return fileDescriptor_93c659a7257600d0, []int{2}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -187,21 +429,49 @@ func (m *GenesisState) GetWorkers() []Worker {
 	return nil
 }
 
+func (m *GenesisState) GetTaskEscrows() []ModuleAccounting_TaskEscrow {
+	if m != nil {
+		return m.TaskEscrows
+	}
+	return nil
+}
+
+func (m *GenesisState) GetWorkerStakes() []ModuleAccounting_WorkerStake {
+	if m != nil {
+		return m.WorkerStakes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPaidOut() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PaidOut
+	}
+	return nil
+}
+
 type Worker struct {
-	Address            string             `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Reputation         *Worker_Reputation `protobuf:"bytes,3,opt,name=reputation,proto3" json:"reputation,omitempty"`
-	Enabled            bool               `protobuf:"varint,4,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	CurrentTaskId      string             `protobuf:"bytes,5,opt,name=current_task_id,json=currentTaskId,proto3" json:"current_task_id,omitempty"`
-	CurrentThreadIndex int32              `protobuf:"varint,6,opt,name=current_thread_index,json=currentThreadIndex,proto3" json:"current_thread_index,omitempty"`
-	PublicIp           string             `protobuf:"bytes,7,opt,name=public_ip,json=publicIp,proto3" json:"public_ip,omitempty"`
-	IpfsId             string             `protobuf:"bytes,8,opt,name=ipfs_id,json=ipfsId,proto3" json:"ipfs_id,omitempty"`
+	Address     string               `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Reputation  *Worker_Reputation   `protobuf:"bytes,3,opt,name=reputation,proto3" json:"reputation,omitempty"`
+	Enabled     bool                 `protobuf:"varint,4,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	PublicIp    string               `protobuf:"bytes,7,opt,name=public_ip,json=publicIp,proto3" json:"public_ip,omitempty"`
+	IpfsId      string               `protobuf:"bytes,8,opt,name=ipfs_id,json=ipfsId,proto3" json:"ipfs_id,omitempty"`
+	Assignments []*Worker_Assignment `protobuf:"bytes,9,rep,name=assignments,proto3" json:"assignments,omitempty"`
+	// max amount of threads the worker can work on at the same time
+	MaxThreads int32 `protobuf:"varint,10,opt,name=max_threads,json=maxThreads,proto3" json:"max_threads,omitempty"`
+	// hardware and software profile used to match tasks
+	Capabilities *WorkerCapabilities `protobuf:"bytes,11,opt,name=capabilities,proto3" json:"capabilities,omitempty"`
+	// block height of the last heartbeat sent by the worker
+	LastHeartbeat int64 `protobuf:"varint,12,opt,name=last_heartbeat,json=lastHeartbeat,proto3" json:"last_heartbeat,omitempty"`
+	// multiaddrs other nodes use to reach the IPFS node of the worker. Preferred over public_ip
+	AnnounceAddrs []string `protobuf:"bytes,13,rep,name=announce_addrs,json=announceAddrs,proto3" json:"announce_addrs,omitempty"`
 }
 
 func (m *Worker) Reset()         { *m = Worker{} }
 func (m *Worker) String() string { return proto.CompactTextString(m) }
 func (*Worker) ProtoMessage()    {}
 func (*Worker) Descriptor() ([]byte, []int) {
-	return fileDescriptor_93c659a7257600d0, []int{2}
+	return fileDescriptor_93c659a7257600d0, []int{3}
 }
 func (m *Worker) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -251,32 +521,53 @@ func (m *Worker) GetEnabled() bool {
 	return false
 }
 
-func (m *Worker) GetCurrentTaskId() string {
+func (m *Worker) GetPublicIp() string {
+	if m != nil {
+		return m.PublicIp
+	}
+	return ""
+}
+
+func (m *Worker) GetIpfsId() string {
 	if m != nil {
-		return m.CurrentTaskId
+		return m.IpfsId
 	}
 	return ""
 }
 
-func (m *Worker) GetCurrentThreadIndex() int32 {
+func (m *Worker) GetAssignments() []*Worker_Assignment {
+	if m != nil {
+		return m.Assignments
+	}
+	return nil
+}
+
+func (m *Worker) GetMaxThreads() int32 {
 	if m != nil {
-		return m.CurrentThreadIndex
+		return m.MaxThreads
 	}
 	return 0
 }
 
-func (m *Worker) GetPublicIp() string {
+func (m *Worker) GetCapabilities() *WorkerCapabilities {
 	if m != nil {
-		return m.PublicIp
+		return m.Capabilities
 	}
-	return ""
+	return nil
 }
 
-func (m *Worker) GetIpfsId() string {
+func (m *Worker) GetLastHeartbeat() int64 {
 	if m != nil {
-		return m.IpfsId
+		return m.LastHeartbeat
 	}
-	return ""
+	return 0
+}
+
+func (m *Worker) GetAnnounceAddrs() []string {
+	if m != nil {
+		return m.AnnounceAddrs
+	}
+	return nil
 }
 
 type Worker_Reputation struct {
@@ -292,7 +583,7 @@ func (m *Worker_Reputation) Reset()         { *m = Worker_Reputation{} }
 func (m *Worker_Reputation) String() string { return proto.CompactTextString(m) }
 func (*Worker_Reputation) ProtoMessage()    {}
 func (*Worker_Reputation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_93c659a7257600d0, []int{2, 0}
+	return fileDescriptor_93c659a7257600d0, []int{3, 0}
 }
 func (m *Worker_Reputation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -363,33 +654,25 @@ func (m *Worker_Reputation) GetRenderDurations() []int64 {
 	return nil
 }
 
-// Video Upscaler Task
-// @cid the IPFS CID submitted by a task requester
-type VideoUpscalerTask struct {
-	TaskId       string                 `protobuf:"bytes,1,opt,name=taskId,proto3" json:"taskId,omitempty"`
-	Requester    string                 `protobuf:"bytes,2,opt,name=requester,proto3" json:"requester,omitempty"`
-	Cid          string                 `protobuf:"bytes,3,opt,name=cid,proto3" json:"cid,omitempty"`
-	StartFrame   int32                  `protobuf:"varint,4,opt,name=start_frame,json=startFrame,proto3" json:"start_frame,omitempty"`
-	EndFrame     int32                  `protobuf:"varint,5,opt,name=end_frame,json=endFrame,proto3" json:"end_frame,omitempty"`
-	ThreadAmount int32                  `protobuf:"varint,6,opt,name=threadAmount,proto3" json:"threadAmount,omitempty"`
-	Scale        int32                  `protobuf:"varint,7,opt,name=scale,proto3" json:"scale,omitempty"`
-	Completed    bool                   `protobuf:"varint,8,opt,name=completed,proto3" json:"completed,omitempty"`
-	Reward       *types.Coin            `protobuf:"bytes,9,opt,name=reward,proto3" json:"reward,omitempty"`
-	Threads      []*VideoUpscalerThread `protobuf:"bytes,10,rep,name=threads,proto3" json:"threads,omitempty"`
+// A thread the worker is currently working on
+type Worker_Assignment struct {
+	TaskId      string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	ThreadIndex int32  `protobuf:"varint,2,opt,name=thread_index,json=threadIndex,proto3" json:"thread_index,omitempty"`
+	ThreadId    string `protobuf:"bytes,3,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
 }
 
-func (m *VideoUpscalerTask) Reset()         { *m = VideoUpscalerTask{} }
-func (m *VideoUpscalerTask) String() string { return proto.CompactTextString(m) }
-func (*VideoUpscalerTask) ProtoMessage()    {}
-func (*VideoUpscalerTask) Descriptor() ([]byte, []int) {
-	return fileDescriptor_93c659a7257600d0, []int{3}
+func (m *Worker_Assignment) Reset()         { *m = Worker_Assignment{} }
+func (m *Worker_Assignment) String() string { return proto.CompactTextString(m) }
+func (*Worker_Assignment) ProtoMessage()    {}
+func (*Worker_Assignment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93c659a7257600d0, []int{3, 1}
 }
-func (m *VideoUpscalerTask) XXX_Unmarshal(b []byte) error {
+func (m *Worker_Assignment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *VideoUpscalerTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Worker_Assignment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_VideoUpscalerTask.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Worker_Assignment.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -399,115 +682,166 @@ func (m *VideoUpscalerTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *VideoUpscalerTask) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VideoUpscalerTask.Merge(m, src)
+func (m *Worker_Assignment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Worker_Assignment.Merge(m, src)
 }
-func (m *VideoUpscalerTask) XXX_Size() int {
+func (m *Worker_Assignment) XXX_Size() int {
 	return m.Size()
 }
-func (m *VideoUpscalerTask) XXX_DiscardUnknown() {
-	xxx_messageInfo_VideoUpscalerTask.DiscardUnknown(m)
+func (m *Worker_Assignment) XXX_DiscardUnknown() {
+	xxx_messageInfo_Worker_Assignment.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_VideoUpscalerTask proto.InternalMessageInfo
+var xxx_messageInfo_Worker_Assignment proto.InternalMessageInfo
 
-func (m *VideoUpscalerTask) GetTaskId() string {
+func (m *Worker_Assignment) GetTaskId() string {
 	if m != nil {
 		return m.TaskId
 	}
 	return ""
 }
 
-func (m *VideoUpscalerTask) GetRequester() string {
+func (m *Worker_Assignment) GetThreadIndex() int32 {
 	if m != nil {
-		return m.Requester
+		return m.ThreadIndex
 	}
-	return ""
+	return 0
 }
 
-func (m *VideoUpscalerTask) GetCid() string {
+func (m *Worker_Assignment) GetThreadId() string {
 	if m != nil {
-		return m.Cid
+		return m.ThreadId
 	}
 	return ""
 }
 
-func (m *VideoUpscalerTask) GetStartFrame() int32 {
-	if m != nil {
-		return m.StartFrame
+// Capabilities a worker advertises when registering
+type WorkerCapabilities struct {
+	CpuCores int32 `protobuf:"varint,1,opt,name=cpu_cores,json=cpuCores,proto3" json:"cpu_cores,omitempty"`
+	MemoryMb int64 `protobuf:"varint,2,opt,name=memory_mb,json=memoryMb,proto3" json:"memory_mb,omitempty"`
+	// supported scale factors. Empty accepts any scale
+	ScaleFactors []int32 `protobuf:"varint,3,rep,packed,name=scale_factors,json=scaleFactors,proto3" json:"scale_factors,omitempty"`
+	// upscaling backends the worker can run
+	Backends []string `protobuf:"bytes,4,rep,name=backends,proto3" json:"backends,omitempty"`
+	Models   []string `protobuf:"bytes,5,rep,name=models,proto3" json:"models,omitempty"`
+	// max resolution of the input video, in pixels. 0 means not declared, so there is no limit
+	MaxInputWidth  int32 `protobuf:"varint,6,opt,name=max_input_width,json=maxInputWidth,proto3" json:"max_input_width,omitempty"`
+	MaxInputHeight int32 `protobuf:"varint,7,opt,name=max_input_height,json=maxInputHeight,proto3" json:"max_input_height,omitempty"`
+	DiskMb         int64 `protobuf:"varint,8,opt,name=disk_mb,json=diskMb,proto3" json:"disk_mb,omitempty"`
+}
+
+func (m *WorkerCapabilities) Reset()         { *m = WorkerCapabilities{} }
+func (m *WorkerCapabilities) String() string { return proto.CompactTextString(m) }
+func (*WorkerCapabilities) ProtoMessage()    {}
+func (*WorkerCapabilities) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93c659a7257600d0, []int{4}
+}
+func (m *WorkerCapabilities) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkerCapabilities) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkerCapabilities.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return 0
+}
+func (m *WorkerCapabilities) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkerCapabilities.Merge(m, src)
+}
+func (m *WorkerCapabilities) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkerCapabilities) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkerCapabilities.DiscardUnknown(m)
 }
 
-func (m *VideoUpscalerTask) GetEndFrame() int32 {
+var xxx_messageInfo_WorkerCapabilities proto.InternalMessageInfo
+
+func (m *WorkerCapabilities) GetCpuCores() int32 {
 	if m != nil {
-		return m.EndFrame
+		return m.CpuCores
 	}
 	return 0
 }
 
-func (m *VideoUpscalerTask) GetThreadAmount() int32 {
+func (m *WorkerCapabilities) GetMemoryMb() int64 {
 	if m != nil {
-		return m.ThreadAmount
+		return m.MemoryMb
 	}
 	return 0
 }
 
-func (m *VideoUpscalerTask) GetScale() int32 {
+func (m *WorkerCapabilities) GetScaleFactors() []int32 {
 	if m != nil {
-		return m.Scale
+		return m.ScaleFactors
 	}
-	return 0
+	return nil
 }
 
-func (m *VideoUpscalerTask) GetCompleted() bool {
+func (m *WorkerCapabilities) GetBackends() []string {
 	if m != nil {
-		return m.Completed
+		return m.Backends
 	}
-	return false
+	return nil
 }
 
-func (m *VideoUpscalerTask) GetReward() *types.Coin {
+func (m *WorkerCapabilities) GetModels() []string {
 	if m != nil {
-		return m.Reward
+		return m.Models
 	}
 	return nil
 }
 
-func (m *VideoUpscalerTask) GetThreads() []*VideoUpscalerThread {
+func (m *WorkerCapabilities) GetMaxInputWidth() int32 {
 	if m != nil {
-		return m.Threads
+		return m.MaxInputWidth
 	}
-	return nil
+	return 0
 }
 
-// A Video Upscaler Thread is the smallest unit of work for a Task.
-// Workers will try to complete a thread as soon as possible to submit first a solution
-type VideoUpscalerThread struct {
-	ThreadId             string                            `protobuf:"bytes,1,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
-	TaskId               string                            `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	StartFrame           int64                             `protobuf:"varint,3,opt,name=start_frame,json=startFrame,proto3" json:"start_frame,omitempty"`
-	EndFrame             int64                             `protobuf:"varint,4,opt,name=end_frame,json=endFrame,proto3" json:"end_frame,omitempty"`
-	Scale                int64                             `protobuf:"varint,5,opt,name=scale,proto3" json:"scale,omitempty"`
-	Completed            bool                              `protobuf:"varint,6,opt,name=completed,proto3" json:"completed,omitempty"`
-	Workers              []string                          `protobuf:"bytes,7,rep,name=workers,proto3" json:"workers,omitempty"`
-	Solution             *VideoUpscalerThread_Solution     `protobuf:"bytes,8,opt,name=solution,proto3" json:"solution,omitempty"`
-	Validations          []*VideoUpscalerThread_Validation `protobuf:"bytes,9,rep,name=validations,proto3" json:"validations,omitempty"`
-	AverageRenderSeconds int64                             `protobuf:"varint,10,opt,name=average_render_seconds,json=averageRenderSeconds,proto3" json:"average_render_seconds,omitempty"`
+func (m *WorkerCapabilities) GetMaxInputHeight() int32 {
+	if m != nil {
+		return m.MaxInputHeight
+	}
+	return 0
 }
 
-func (m *VideoUpscalerThread) Reset()         { *m = VideoUpscalerThread{} }
-func (m *VideoUpscalerThread) String() string { return proto.CompactTextString(m) }
-func (*VideoUpscalerThread) ProtoMessage()    {}
-func (*VideoUpscalerThread) Descriptor() ([]byte, []int) {
-	return fileDescriptor_93c659a7257600d0, []int{4}
+func (m *WorkerCapabilities) GetDiskMb() int64 {
+	if m != nil {
+		return m.DiskMb
+	}
+	return 0
 }
-func (m *VideoUpscalerThread) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+
+// Requirements a task asks from the workers that process it. Zero values are not enforced
+type TaskRequirements struct {
+	MinCpuCores int32  `protobuf:"varint,1,opt,name=min_cpu_cores,json=minCpuCores,proto3" json:"min_cpu_cores,omitempty"`
+	MinMemoryMb int64  `protobuf:"varint,2,opt,name=min_memory_mb,json=minMemoryMb,proto3" json:"min_memory_mb,omitempty"`
+	Backend     string `protobuf:"bytes,3,opt,name=backend,proto3" json:"backend,omitempty"`
+	Model       string `protobuf:"bytes,4,opt,name=model,proto3" json:"model,omitempty"`
+	InputWidth  int32  `protobuf:"varint,5,opt,name=input_width,json=inputWidth,proto3" json:"input_width,omitempty"`
+	InputHeight int32  `protobuf:"varint,6,opt,name=input_height,json=inputHeight,proto3" json:"input_height,omitempty"`
+	MinDiskMb   int64  `protobuf:"varint,7,opt,name=min_disk_mb,json=minDiskMb,proto3" json:"min_disk_mb,omitempty"`
 }
-func (m *VideoUpscalerThread) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+
+func (m *TaskRequirements) Reset()         { *m = TaskRequirements{} }
+func (m *TaskRequirements) String() string { return proto.CompactTextString(m) }
+func (*TaskRequirements) ProtoMessage()    {}
+func (*TaskRequirements) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93c659a7257600d0, []int{5}
+}
+func (m *TaskRequirements) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskRequirements) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_VideoUpscalerThread.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TaskRequirements.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -517,108 +851,113 @@ func (m *VideoUpscalerThread) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *VideoUpscalerThread) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VideoUpscalerThread.Merge(m, src)
+func (m *TaskRequirements) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskRequirements.Merge(m, src)
 }
-func (m *VideoUpscalerThread) XXX_Size() int {
+func (m *TaskRequirements) XXX_Size() int {
 	return m.Size()
 }
-func (m *VideoUpscalerThread) XXX_DiscardUnknown() {
-	xxx_messageInfo_VideoUpscalerThread.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_VideoUpscalerThread proto.InternalMessageInfo
-
-func (m *VideoUpscalerThread) GetThreadId() string {
-	if m != nil {
-		return m.ThreadId
-	}
-	return ""
-}
-
-func (m *VideoUpscalerThread) GetTaskId() string {
-	if m != nil {
-		return m.TaskId
-	}
-	return ""
+func (m *TaskRequirements) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskRequirements.DiscardUnknown(m)
 }
 
-func (m *VideoUpscalerThread) GetStartFrame() int64 {
-	if m != nil {
-		return m.StartFrame
-	}
-	return 0
-}
+var xxx_messageInfo_TaskRequirements proto.InternalMessageInfo
 
-func (m *VideoUpscalerThread) GetEndFrame() int64 {
+func (m *TaskRequirements) GetMinCpuCores() int32 {
 	if m != nil {
-		return m.EndFrame
+		return m.MinCpuCores
 	}
 	return 0
 }
 
-func (m *VideoUpscalerThread) GetScale() int64 {
+func (m *TaskRequirements) GetMinMemoryMb() int64 {
 	if m != nil {
-		return m.Scale
+		return m.MinMemoryMb
 	}
 	return 0
 }
 
-func (m *VideoUpscalerThread) GetCompleted() bool {
+func (m *TaskRequirements) GetBackend() string {
 	if m != nil {
-		return m.Completed
+		return m.Backend
 	}
-	return false
+	return ""
 }
 
-func (m *VideoUpscalerThread) GetWorkers() []string {
+func (m *TaskRequirements) GetModel() string {
 	if m != nil {
-		return m.Workers
+		return m.Model
 	}
-	return nil
+	return ""
 }
 
-func (m *VideoUpscalerThread) GetSolution() *VideoUpscalerThread_Solution {
+func (m *TaskRequirements) GetInputWidth() int32 {
 	if m != nil {
-		return m.Solution
+		return m.InputWidth
 	}
-	return nil
+	return 0
 }
 
-func (m *VideoUpscalerThread) GetValidations() []*VideoUpscalerThread_Validation {
+func (m *TaskRequirements) GetInputHeight() int32 {
 	if m != nil {
-		return m.Validations
+		return m.InputHeight
 	}
-	return nil
+	return 0
 }
 
-func (m *VideoUpscalerThread) GetAverageRenderSeconds() int64 {
+func (m *TaskRequirements) GetMinDiskMb() int64 {
 	if m != nil {
-		return m.AverageRenderSeconds
+		return m.MinDiskMb
 	}
 	return 0
 }
 
-type VideoUpscalerThread_Solution struct {
-	ProposedBy string                       `protobuf:"bytes,1,opt,name=proposed_by,json=proposedBy,proto3" json:"proposed_by,omitempty"`
-	Frames     []*VideoUpscalerThread_Frame `protobuf:"bytes,2,rep,name=frames,proto3" json:"frames,omitempty"`
-	PublicKey  string                       `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
-	Dir        string                       `protobuf:"bytes,4,opt,name=dir,proto3" json:"dir,omitempty"`
-	Accepted   bool                         `protobuf:"varint,5,opt,name=accepted,proto3" json:"accepted,omitempty"`
+// Video Upscaler Task
+// @cid the IPFS CID submitted by a task requester
+type VideoUpscalerTask struct {
+	TaskId       string                 `protobuf:"bytes,1,opt,name=taskId,proto3" json:"taskId,omitempty"`
+	Requester    string                 `protobuf:"bytes,2,opt,name=requester,proto3" json:"requester,omitempty"`
+	Cid          string                 `protobuf:"bytes,3,opt,name=cid,proto3" json:"cid,omitempty"`
+	StartFrame   int32                  `protobuf:"varint,4,opt,name=start_frame,json=startFrame,proto3" json:"start_frame,omitempty"`
+	EndFrame     int32                  `protobuf:"varint,5,opt,name=end_frame,json=endFrame,proto3" json:"end_frame,omitempty"`
+	ThreadAmount int32                  `protobuf:"varint,6,opt,name=threadAmount,proto3" json:"threadAmount,omitempty"`
+	Scale        int32                  `protobuf:"varint,7,opt,name=scale,proto3" json:"scale,omitempty"`
+	Completed    bool                   `protobuf:"varint,8,opt,name=completed,proto3" json:"completed,omitempty"`
+	Reward       *types.Coin            `protobuf:"bytes,9,opt,name=reward,proto3" json:"reward,omitempty"`
+	Threads      []*VideoUpscalerThread `protobuf:"bytes,10,rep,name=threads,proto3" json:"threads,omitempty"`
+	// when set, threads are cut as workers subscribe, sized to last this amount of seconds
+	TargetThreadSeconds int64 `protobuf:"varint,11,opt,name=target_thread_seconds,json=targetThreadSeconds,proto3" json:"target_thread_seconds,omitempty"`
+	// first frame not yet assigned to a thread on adaptive tasks
+	NextFrame    int32             `protobuf:"varint,12,opt,name=next_frame,json=nextFrame,proto3" json:"next_frame,omitempty"`
+	Requirements *TaskRequirements `protobuf:"bytes,13,opt,name=requirements,proto3" json:"requirements,omitempty"`
+	// restricts which workers can process the task
+	Access *TaskAccess `protobuf:"bytes,14,opt,name=access,proto3" json:"access,omitempty"`
+	// block height after which the task expires and the unused reward is refunded. Zero never expires
+	ExpiryHeight int64 `protobuf:"varint,15,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+	// paid on top of the reward so workers pick the task first
+	PriorityFee *types.Coin     `protobuf:"bytes,16,opt,name=priority_fee,json=priorityFee,proto3" json:"priority_fee,omitempty"`
+	Expired     bool            `protobuf:"varint,17,opt,name=expired,proto3" json:"expired,omitempty"`
+	Options     *UpscaleOptions `protobuf:"bytes,18,opt,name=options,proto3" json:"options,omitempty"`
+	// video encoded from the upscaled frames once every thread is submitted
+	FinalVideo *FinalVideo `protobuf:"bytes,19,opt,name=final_video,json=finalVideo,proto3" json:"final_video,omitempty"`
+	// properties of the input, probed by the requester
+	MediaInfo *MediaInfo `protobuf:"bytes,20,opt,name=media_info,json=mediaInfo,proto3" json:"media_info,omitempty"`
+	// the cid is a video file or a directory with an image per frame
+	InputType InputType `protobuf:"varint,21,opt,name=input_type,json=inputType,proto3,enum=janction.videoUpscaler.v1.InputType" json:"input_type,omitempty"`
 }
 
-func (m *VideoUpscalerThread_Solution) Reset()         { *m = VideoUpscalerThread_Solution{} }
-func (m *VideoUpscalerThread_Solution) String() string { return proto.CompactTextString(m) }
-func (*VideoUpscalerThread_Solution) ProtoMessage()    {}
-func (*VideoUpscalerThread_Solution) Descriptor() ([]byte, []int) {
-	return fileDescriptor_93c659a7257600d0, []int{4, 0}
+func (m *VideoUpscalerTask) Reset()         { *m = VideoUpscalerTask{} }
+func (m *VideoUpscalerTask) String() string { return proto.CompactTextString(m) }
+func (*VideoUpscalerTask) ProtoMessage()    {}
+func (*VideoUpscalerTask) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93c659a7257600d0, []int{6}
 }
-func (m *VideoUpscalerThread_Solution) XXX_Unmarshal(b []byte) error {
+func (m *VideoUpscalerTask) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *VideoUpscalerThread_Solution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *VideoUpscalerTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_VideoUpscalerThread_Solution.Marshal(b, m, deterministic)
+		return xxx_messageInfo_VideoUpscalerTask.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -628,142 +967,191 @@ func (m *VideoUpscalerThread_Solution) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *VideoUpscalerThread_Solution) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VideoUpscalerThread_Solution.Merge(m, src)
+func (m *VideoUpscalerTask) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VideoUpscalerTask.Merge(m, src)
 }
-func (m *VideoUpscalerThread_Solution) XXX_Size() int {
+func (m *VideoUpscalerTask) XXX_Size() int {
 	return m.Size()
 }
-func (m *VideoUpscalerThread_Solution) XXX_DiscardUnknown() {
-	xxx_messageInfo_VideoUpscalerThread_Solution.DiscardUnknown(m)
+func (m *VideoUpscalerTask) XXX_DiscardUnknown() {
+	xxx_messageInfo_VideoUpscalerTask.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_VideoUpscalerThread_Solution proto.InternalMessageInfo
+var xxx_messageInfo_VideoUpscalerTask proto.InternalMessageInfo
 
-func (m *VideoUpscalerThread_Solution) GetProposedBy() string {
+func (m *VideoUpscalerTask) GetTaskId() string {
 	if m != nil {
-		return m.ProposedBy
+		return m.TaskId
 	}
 	return ""
 }
 
-func (m *VideoUpscalerThread_Solution) GetFrames() []*VideoUpscalerThread_Frame {
+func (m *VideoUpscalerTask) GetRequester() string {
 	if m != nil {
-		return m.Frames
+		return m.Requester
 	}
-	return nil
+	return ""
 }
 
-func (m *VideoUpscalerThread_Solution) GetPublicKey() string {
+func (m *VideoUpscalerTask) GetCid() string {
 	if m != nil {
-		return m.PublicKey
+		return m.Cid
 	}
 	return ""
 }
 
-func (m *VideoUpscalerThread_Solution) GetDir() string {
+func (m *VideoUpscalerTask) GetStartFrame() int32 {
 	if m != nil {
-		return m.Dir
+		return m.StartFrame
 	}
-	return ""
+	return 0
 }
 
-func (m *VideoUpscalerThread_Solution) GetAccepted() bool {
+func (m *VideoUpscalerTask) GetEndFrame() int32 {
 	if m != nil {
-		return m.Accepted
+		return m.EndFrame
+	}
+	return 0
+}
+
+func (m *VideoUpscalerTask) GetThreadAmount() int32 {
+	if m != nil {
+		return m.ThreadAmount
+	}
+	return 0
+}
+
+func (m *VideoUpscalerTask) GetScale() int32 {
+	if m != nil {
+		return m.Scale
+	}
+	return 0
+}
+
+func (m *VideoUpscalerTask) GetCompleted() bool {
+	if m != nil {
+		return m.Completed
 	}
 	return false
 }
 
-type VideoUpscalerThread_Validation struct {
-	Validator string                       `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
-	Frames    []*VideoUpscalerThread_Frame `protobuf:"bytes,2,rep,name=frames,proto3" json:"frames,omitempty"`
-	PublicKey string                       `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
-	IsReverse bool                         `protobuf:"varint,4,opt,name=is_reverse,json=isReverse,proto3" json:"is_reverse,omitempty"`
+func (m *VideoUpscalerTask) GetReward() *types.Coin {
+	if m != nil {
+		return m.Reward
+	}
+	return nil
 }
 
-func (m *VideoUpscalerThread_Validation) Reset()         { *m = VideoUpscalerThread_Validation{} }
-func (m *VideoUpscalerThread_Validation) String() string { return proto.CompactTextString(m) }
-func (*VideoUpscalerThread_Validation) ProtoMessage()    {}
-func (*VideoUpscalerThread_Validation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_93c659a7257600d0, []int{4, 1}
+func (m *VideoUpscalerTask) GetThreads() []*VideoUpscalerThread {
+	if m != nil {
+		return m.Threads
+	}
+	return nil
 }
-func (m *VideoUpscalerThread_Validation) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+
+func (m *VideoUpscalerTask) GetTargetThreadSeconds() int64 {
+	if m != nil {
+		return m.TargetThreadSeconds
+	}
+	return 0
 }
-func (m *VideoUpscalerThread_Validation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_VideoUpscalerThread_Validation.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+
+func (m *VideoUpscalerTask) GetNextFrame() int32 {
+	if m != nil {
+		return m.NextFrame
 	}
+	return 0
 }
-func (m *VideoUpscalerThread_Validation) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VideoUpscalerThread_Validation.Merge(m, src)
+
+func (m *VideoUpscalerTask) GetRequirements() *TaskRequirements {
+	if m != nil {
+		return m.Requirements
+	}
+	return nil
 }
-func (m *VideoUpscalerThread_Validation) XXX_Size() int {
-	return m.Size()
+
+func (m *VideoUpscalerTask) GetAccess() *TaskAccess {
+	if m != nil {
+		return m.Access
+	}
+	return nil
 }
-func (m *VideoUpscalerThread_Validation) XXX_DiscardUnknown() {
-	xxx_messageInfo_VideoUpscalerThread_Validation.DiscardUnknown(m)
+
+func (m *VideoUpscalerTask) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
 }
 
-var xxx_messageInfo_VideoUpscalerThread_Validation proto.InternalMessageInfo
+func (m *VideoUpscalerTask) GetPriorityFee() *types.Coin {
+	if m != nil {
+		return m.PriorityFee
+	}
+	return nil
+}
 
-func (m *VideoUpscalerThread_Validation) GetValidator() string {
+func (m *VideoUpscalerTask) GetExpired() bool {
 	if m != nil {
-		return m.Validator
+		return m.Expired
 	}
-	return ""
+	return false
 }
 
-func (m *VideoUpscalerThread_Validation) GetFrames() []*VideoUpscalerThread_Frame {
+func (m *VideoUpscalerTask) GetOptions() *UpscaleOptions {
 	if m != nil {
-		return m.Frames
+		return m.Options
 	}
 	return nil
 }
 
-func (m *VideoUpscalerThread_Validation) GetPublicKey() string {
+func (m *VideoUpscalerTask) GetFinalVideo() *FinalVideo {
 	if m != nil {
-		return m.PublicKey
+		return m.FinalVideo
 	}
-	return ""
+	return nil
 }
 
-func (m *VideoUpscalerThread_Validation) GetIsReverse() bool {
+func (m *VideoUpscalerTask) GetMediaInfo() *MediaInfo {
 	if m != nil {
-		return m.IsReverse
+		return m.MediaInfo
 	}
-	return false
+	return nil
 }
 
-type VideoUpscalerThread_Frame struct {
-	Filename     string `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
-	Signature    string `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
-	Cid          string `protobuf:"bytes,3,opt,name=cid,proto3" json:"cid,omitempty"`
-	Hash         string `protobuf:"bytes,4,opt,name=hash,proto3" json:"hash,omitempty"`
-	ValidCount   int64  `protobuf:"varint,5,opt,name=validCount,proto3" json:"validCount,omitempty"`
-	InvalidCount int64  `protobuf:"varint,6,opt,name=invalidCount,proto3" json:"invalidCount,omitempty"`
+func (m *VideoUpscalerTask) GetInputType() InputType {
+	if m != nil {
+		return m.InputType
+	}
+	return InputType_INPUT_TYPE_VIDEO
 }
 
-func (m *VideoUpscalerThread_Frame) Reset()         { *m = VideoUpscalerThread_Frame{} }
-func (m *VideoUpscalerThread_Frame) String() string { return proto.CompactTextString(m) }
-func (*VideoUpscalerThread_Frame) ProtoMessage()    {}
-func (*VideoUpscalerThread_Frame) Descriptor() ([]byte, []int) {
-	return fileDescriptor_93c659a7257600d0, []int{4, 2}
+// Properties of the input video, probed by the requester with ffprobe before creating the task.
+// Workers probe the input again once downloaded, and give up the thread if it doesn't match
+type MediaInfo struct {
+	FrameCount int64 `protobuf:"varint,1,opt,name=frame_count,json=frameCount,proto3" json:"frame_count,omitempty"`
+	// frame rate of the video stream as a rational, like 30000/1001
+	FrameRate string `protobuf:"bytes,2,opt,name=frame_rate,json=frameRate,proto3" json:"frame_rate,omitempty"`
+	Width     int32  `protobuf:"varint,3,opt,name=width,proto3" json:"width,omitempty"`
+	Height    int32  `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// codec of the video stream, like h264
+	Codec        string                   `protobuf:"bytes,5,opt,name=codec,proto3" json:"codec,omitempty"`
+	DurationMs   int64                    `protobuf:"varint,6,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	AudioStreams []*MediaInfo_AudioStream `protobuf:"bytes,7,rep,name=audio_streams,json=audioStreams,proto3" json:"audio_streams,omitempty"`
 }
-func (m *VideoUpscalerThread_Frame) XXX_Unmarshal(b []byte) error {
+
+func (m *MediaInfo) Reset()         { *m = MediaInfo{} }
+func (m *MediaInfo) String() string { return proto.CompactTextString(m) }
+func (*MediaInfo) ProtoMessage()    {}
+func (*MediaInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93c659a7257600d0, []int{7}
+}
+func (m *MediaInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *VideoUpscalerThread_Frame) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MediaInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_VideoUpscalerThread_Frame.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MediaInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -773,77 +1161,87 @@ func (m *VideoUpscalerThread_Frame) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *VideoUpscalerThread_Frame) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VideoUpscalerThread_Frame.Merge(m, src)
+func (m *MediaInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MediaInfo.Merge(m, src)
 }
-func (m *VideoUpscalerThread_Frame) XXX_Size() int {
+func (m *MediaInfo) XXX_Size() int {
 	return m.Size()
 }
-func (m *VideoUpscalerThread_Frame) XXX_DiscardUnknown() {
-	xxx_messageInfo_VideoUpscalerThread_Frame.DiscardUnknown(m)
+func (m *MediaInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_MediaInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_VideoUpscalerThread_Frame proto.InternalMessageInfo
+var xxx_messageInfo_MediaInfo proto.InternalMessageInfo
 
-func (m *VideoUpscalerThread_Frame) GetFilename() string {
+func (m *MediaInfo) GetFrameCount() int64 {
 	if m != nil {
-		return m.Filename
+		return m.FrameCount
 	}
-	return ""
+	return 0
 }
 
-func (m *VideoUpscalerThread_Frame) GetSignature() string {
+func (m *MediaInfo) GetFrameRate() string {
 	if m != nil {
-		return m.Signature
+		return m.FrameRate
 	}
 	return ""
 }
 
-func (m *VideoUpscalerThread_Frame) GetCid() string {
+func (m *MediaInfo) GetWidth() int32 {
 	if m != nil {
-		return m.Cid
+		return m.Width
 	}
-	return ""
+	return 0
 }
 
-func (m *VideoUpscalerThread_Frame) GetHash() string {
+func (m *MediaInfo) GetHeight() int32 {
 	if m != nil {
-		return m.Hash
+		return m.Height
+	}
+	return 0
+}
+
+func (m *MediaInfo) GetCodec() string {
+	if m != nil {
+		return m.Codec
 	}
 	return ""
 }
 
-func (m *VideoUpscalerThread_Frame) GetValidCount() int64 {
+func (m *MediaInfo) GetDurationMs() int64 {
 	if m != nil {
-		return m.ValidCount
+		return m.DurationMs
 	}
 	return 0
 }
 
-func (m *VideoUpscalerThread_Frame) GetInvalidCount() int64 {
+func (m *MediaInfo) GetAudioStreams() []*MediaInfo_AudioStream {
 	if m != nil {
-		return m.InvalidCount
+		return m.AudioStreams
 	}
-	return 0
+	return nil
 }
 
-// Stores information about the Video Upscaler  task
-type VideoUpscalerTaskInfo struct {
-	NextId int64 `protobuf:"varint,1,opt,name=nextId,proto3" json:"nextId,omitempty"`
+type MediaInfo_AudioStream struct {
+	Index      int32  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Codec      string `protobuf:"bytes,2,opt,name=codec,proto3" json:"codec,omitempty"`
+	Channels   int32  `protobuf:"varint,3,opt,name=channels,proto3" json:"channels,omitempty"`
+	SampleRate int32  `protobuf:"varint,4,opt,name=sample_rate,json=sampleRate,proto3" json:"sample_rate,omitempty"`
+	Language   string `protobuf:"bytes,5,opt,name=language,proto3" json:"language,omitempty"`
 }
 
-func (m *VideoUpscalerTaskInfo) Reset()         { *m = VideoUpscalerTaskInfo{} }
-func (m *VideoUpscalerTaskInfo) String() string { return proto.CompactTextString(m) }
-func (*VideoUpscalerTaskInfo) ProtoMessage()    {}
-func (*VideoUpscalerTaskInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_93c659a7257600d0, []int{5}
+func (m *MediaInfo_AudioStream) Reset()         { *m = MediaInfo_AudioStream{} }
+func (m *MediaInfo_AudioStream) String() string { return proto.CompactTextString(m) }
+func (*MediaInfo_AudioStream) ProtoMessage()    {}
+func (*MediaInfo_AudioStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93c659a7257600d0, []int{7, 0}
 }
-func (m *VideoUpscalerTaskInfo) XXX_Unmarshal(b []byte) error {
+func (m *MediaInfo_AudioStream) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *VideoUpscalerTaskInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MediaInfo_AudioStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_VideoUpscalerTaskInfo.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MediaInfo_AudioStream.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -853,43 +1251,80 @@ func (m *VideoUpscalerTaskInfo) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *VideoUpscalerTaskInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VideoUpscalerTaskInfo.Merge(m, src)
+func (m *MediaInfo_AudioStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MediaInfo_AudioStream.Merge(m, src)
 }
-func (m *VideoUpscalerTaskInfo) XXX_Size() int {
+func (m *MediaInfo_AudioStream) XXX_Size() int {
 	return m.Size()
 }
-func (m *VideoUpscalerTaskInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_VideoUpscalerTaskInfo.DiscardUnknown(m)
+func (m *MediaInfo_AudioStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_MediaInfo_AudioStream.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_VideoUpscalerTaskInfo proto.InternalMessageInfo
+var xxx_messageInfo_MediaInfo_AudioStream proto.InternalMessageInfo
 
-func (m *VideoUpscalerTaskInfo) GetNextId() int64 {
+func (m *MediaInfo_AudioStream) GetIndex() int32 {
 	if m != nil {
-		return m.NextId
+		return m.Index
 	}
 	return 0
 }
 
-// Stores the Video Upscaler Task with the index
-type IndexedVideoUpscalerTask struct {
-	Index             string            `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
-	VideoUpscalerTask VideoUpscalerTask `protobuf:"bytes,2,opt,name=videoUpscalerTask,proto3" json:"videoUpscalerTask"`
+func (m *MediaInfo_AudioStream) GetCodec() string {
+	if m != nil {
+		return m.Codec
+	}
+	return ""
 }
 
-func (m *IndexedVideoUpscalerTask) Reset()         { *m = IndexedVideoUpscalerTask{} }
-func (m *IndexedVideoUpscalerTask) String() string { return proto.CompactTextString(m) }
-func (*IndexedVideoUpscalerTask) ProtoMessage()    {}
-func (*IndexedVideoUpscalerTask) Descriptor() ([]byte, []int) {
-	return fileDescriptor_93c659a7257600d0, []int{6}
+func (m *MediaInfo_AudioStream) GetChannels() int32 {
+	if m != nil {
+		return m.Channels
+	}
+	return 0
 }
-func (m *IndexedVideoUpscalerTask) XXX_Unmarshal(b []byte) error {
+
+func (m *MediaInfo_AudioStream) GetSampleRate() int32 {
+	if m != nil {
+		return m.SampleRate
+	}
+	return 0
+}
+
+func (m *MediaInfo_AudioStream) GetLanguage() string {
+	if m != nil {
+		return m.Language
+	}
+	return ""
+}
+
+// The upscaled frames of a task encoded into a single video, with the audio and subtitles of the input.
+// It is assembled by a designated worker and verified by a second one
+type FinalVideo struct {
+	AssembledBy string `protobuf:"bytes,1,opt,name=assembled_by,json=assembledBy,proto3" json:"assembled_by,omitempty"`
+	Cid         string `protobuf:"bytes,2,opt,name=cid,proto3" json:"cid,omitempty"`
+	// sha256 of the encoded container
+	Hash string `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	// block height of the submission
+	Height     int64  `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	VerifiedBy string `protobuf:"bytes,5,opt,name=verified_by,json=verifiedBy,proto3" json:"verified_by,omitempty"`
+	Verified   bool   `protobuf:"varint,6,opt,name=verified,proto3" json:"verified,omitempty"`
+	// submissions rejected by the verifier. Each one is assembled again
+	Rejections int32 `protobuf:"varint,7,opt,name=rejections,proto3" json:"rejections,omitempty"`
+}
+
+func (m *FinalVideo) Reset()         { *m = FinalVideo{} }
+func (m *FinalVideo) String() string { return proto.CompactTextString(m) }
+func (*FinalVideo) ProtoMessage()    {}
+func (*FinalVideo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93c659a7257600d0, []int{8}
+}
+func (m *FinalVideo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *IndexedVideoUpscalerTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *FinalVideo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_IndexedVideoUpscalerTask.Marshal(b, m, deterministic)
+		return xxx_messageInfo_FinalVideo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -899,49 +1334,103 @@ func (m *IndexedVideoUpscalerTask) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *IndexedVideoUpscalerTask) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_IndexedVideoUpscalerTask.Merge(m, src)
+func (m *FinalVideo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalVideo.Merge(m, src)
 }
-func (m *IndexedVideoUpscalerTask) XXX_Size() int {
+func (m *FinalVideo) XXX_Size() int {
 	return m.Size()
 }
-func (m *IndexedVideoUpscalerTask) XXX_DiscardUnknown() {
-	xxx_messageInfo_IndexedVideoUpscalerTask.DiscardUnknown(m)
+func (m *FinalVideo) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalVideo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_IndexedVideoUpscalerTask proto.InternalMessageInfo
+var xxx_messageInfo_FinalVideo proto.InternalMessageInfo
 
-func (m *IndexedVideoUpscalerTask) GetIndex() string {
+func (m *FinalVideo) GetAssembledBy() string {
 	if m != nil {
-		return m.Index
+		return m.AssembledBy
 	}
 	return ""
 }
 
-func (m *IndexedVideoUpscalerTask) GetVideoUpscalerTask() VideoUpscalerTask {
+func (m *FinalVideo) GetCid() string {
 	if m != nil {
-		return m.VideoUpscalerTask
+		return m.Cid
 	}
-	return VideoUpscalerTask{}
+	return ""
 }
 
-type VideoUpscalerLogs struct {
-	ThreadId string                                `protobuf:"bytes,1,opt,name=threadId,proto3" json:"threadId,omitempty"`
-	Logs     []*VideoUpscalerLogs_VideoUpscalerLog `protobuf:"bytes,2,rep,name=logs,proto3" json:"logs,omitempty"`
+func (m *FinalVideo) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
 }
 
-func (m *VideoUpscalerLogs) Reset()         { *m = VideoUpscalerLogs{} }
-func (m *VideoUpscalerLogs) String() string { return proto.CompactTextString(m) }
-func (*VideoUpscalerLogs) ProtoMessage()    {}
-func (*VideoUpscalerLogs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_93c659a7257600d0, []int{7}
+func (m *FinalVideo) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
 }
-func (m *VideoUpscalerLogs) XXX_Unmarshal(b []byte) error {
+
+func (m *FinalVideo) GetVerifiedBy() string {
+	if m != nil {
+		return m.VerifiedBy
+	}
+	return ""
+}
+
+func (m *FinalVideo) GetVerified() bool {
+	if m != nil {
+		return m.Verified
+	}
+	return false
+}
+
+func (m *FinalVideo) GetRejections() int32 {
+	if m != nil {
+		return m.Rejections
+	}
+	return 0
+}
+
+// UpscaleOptions controls how the frames of a task are upscaled
+type UpscaleOptions struct {
+	// upscale ratio. Zero defaults to 2
+	Scale   int32 `protobuf:"varint,1,opt,name=scale,proto3" json:"scale,omitempty"`
+	Denoise bool  `protobuf:"varint,2,opt,name=denoise,proto3" json:"denoise,omitempty"`
+	// denoise level from 0 to 3, only used with denoise
+	NoiseLevel int32 `protobuf:"varint,3,opt,name=noise_level,json=noiseLevel,proto3" json:"noise_level,omitempty"`
+	// upscaler model. Empty uses the default model of the backend
+	Model string `protobuf:"bytes,4,opt,name=model,proto3" json:"model,omitempty"`
+	// upscales with a single thread, so every worker gets the same pixels
+	Deterministic bool `protobuf:"varint,5,opt,name=deterministic,proto3" json:"deterministic,omitempty"`
+	// upscaling backend, like waifu2x-cpu, realesrgan-ncnn-cpu or ffmpeg-lanczos. Empty uses the backend
+	// of the task requirements, or waifu2x-cpu
+	Backend string `protobuf:"bytes,6,opt,name=backend,proto3" json:"backend,omitempty"`
+	// image format of the upscaled frames. Frames are hashed by their pixels, so the same pixels get the same
+	// hash in every format
+	OutputFormat OutputFormat `protobuf:"varint,7,opt,name=output_format,json=outputFormat,proto3,enum=janction.videoUpscaler.v1.OutputFormat" json:"output_format,omitempty"`
+	// how validators check the upscaled frames. Strict by default
+	Validation ValidationMode `protobuf:"varint,8,opt,name=validation,proto3,enum=janction.videoUpscaler.v1.ValidationMode" json:"validation,omitempty"`
+	// tiles of the 64 tile fingerprints of a frame that may differ, with fingerprint validation. Zero uses
+	// the max_fingerprint_distance of the params
+	MaxFingerprintDistance int32 `protobuf:"varint,9,opt,name=max_fingerprint_distance,json=maxFingerprintDistance,proto3" json:"max_fingerprint_distance,omitempty"`
+}
+
+func (m *UpscaleOptions) Reset()         { *m = UpscaleOptions{} }
+func (m *UpscaleOptions) String() string { return proto.CompactTextString(m) }
+func (*UpscaleOptions) ProtoMessage()    {}
+func (*UpscaleOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93c659a7257600d0, []int{9}
+}
+func (m *UpscaleOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *VideoUpscalerLogs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UpscaleOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_VideoUpscalerLogs.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UpscaleOptions.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -951,50 +1440,102 @@ func (m *VideoUpscalerLogs) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *VideoUpscalerLogs) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VideoUpscalerLogs.Merge(m, src)
+func (m *UpscaleOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpscaleOptions.Merge(m, src)
 }
-func (m *VideoUpscalerLogs) XXX_Size() int {
+func (m *UpscaleOptions) XXX_Size() int {
 	return m.Size()
 }
-func (m *VideoUpscalerLogs) XXX_DiscardUnknown() {
-	xxx_messageInfo_VideoUpscalerLogs.DiscardUnknown(m)
+func (m *UpscaleOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpscaleOptions.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_VideoUpscalerLogs proto.InternalMessageInfo
+var xxx_messageInfo_UpscaleOptions proto.InternalMessageInfo
 
-func (m *VideoUpscalerLogs) GetThreadId() string {
+func (m *UpscaleOptions) GetScale() int32 {
 	if m != nil {
-		return m.ThreadId
+		return m.Scale
+	}
+	return 0
+}
+
+func (m *UpscaleOptions) GetDenoise() bool {
+	if m != nil {
+		return m.Denoise
+	}
+	return false
+}
+
+func (m *UpscaleOptions) GetNoiseLevel() int32 {
+	if m != nil {
+		return m.NoiseLevel
+	}
+	return 0
+}
+
+func (m *UpscaleOptions) GetModel() string {
+	if m != nil {
+		return m.Model
 	}
 	return ""
 }
 
-func (m *VideoUpscalerLogs) GetLogs() []*VideoUpscalerLogs_VideoUpscalerLog {
+func (m *UpscaleOptions) GetDeterministic() bool {
 	if m != nil {
-		return m.Logs
+		return m.Deterministic
 	}
-	return nil
+	return false
 }
 
-type VideoUpscalerLogs_VideoUpscalerLog struct {
-	Log       string                                      `protobuf:"bytes,2,opt,name=log,proto3" json:"log,omitempty"`
-	Timestamp int64                                       `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Severity  VideoUpscalerLogs_VideoUpscalerLog_SEVERITY `protobuf:"varint,4,opt,name=severity,proto3,enum=janction.videoUpscaler.v1.VideoUpscalerLogs_VideoUpscalerLog_SEVERITY" json:"severity,omitempty"`
+func (m *UpscaleOptions) GetBackend() string {
+	if m != nil {
+		return m.Backend
+	}
+	return ""
 }
 
-func (m *VideoUpscalerLogs_VideoUpscalerLog) Reset()         { *m = VideoUpscalerLogs_VideoUpscalerLog{} }
-func (m *VideoUpscalerLogs_VideoUpscalerLog) String() string { return proto.CompactTextString(m) }
-func (*VideoUpscalerLogs_VideoUpscalerLog) ProtoMessage()    {}
-func (*VideoUpscalerLogs_VideoUpscalerLog) Descriptor() ([]byte, []int) {
-	return fileDescriptor_93c659a7257600d0, []int{7, 0}
+func (m *UpscaleOptions) GetOutputFormat() OutputFormat {
+	if m != nil {
+		return m.OutputFormat
+	}
+	return OutputFormat_OUTPUT_FORMAT_PNG
 }
-func (m *VideoUpscalerLogs_VideoUpscalerLog) XXX_Unmarshal(b []byte) error {
+
+func (m *UpscaleOptions) GetValidation() ValidationMode {
+	if m != nil {
+		return m.Validation
+	}
+	return ValidationMode_VALIDATION_MODE_STRICT
+}
+
+func (m *UpscaleOptions) GetMaxFingerprintDistance() int32 {
+	if m != nil {
+		return m.MaxFingerprintDistance
+	}
+	return 0
+}
+
+// Workers allowed to process a task. Without allowed workers or group any worker is accepted.
+// Denied workers are never accepted
+type TaskAccess struct {
+	AllowedWorkers []string `protobuf:"bytes,1,rep,name=allowed_workers,json=allowedWorkers,proto3" json:"allowed_workers,omitempty"`
+	DeniedWorkers  []string `protobuf:"bytes,2,rep,name=denied_workers,json=deniedWorkers,proto3" json:"denied_workers,omitempty"`
+	// name of a worker group whose members are allowed
+	WorkerGroup string `protobuf:"bytes,3,opt,name=worker_group,json=workerGroup,proto3" json:"worker_group,omitempty"`
+}
+
+func (m *TaskAccess) Reset()         { *m = TaskAccess{} }
+func (m *TaskAccess) String() string { return proto.CompactTextString(m) }
+func (*TaskAccess) ProtoMessage()    {}
+func (*TaskAccess) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93c659a7257600d0, []int{10}
+}
+func (m *TaskAccess) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *VideoUpscalerLogs_VideoUpscalerLog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TaskAccess) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_VideoUpscalerLogs_VideoUpscalerLog.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TaskAccess.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1004,1336 +1545,8177 @@ func (m *VideoUpscalerLogs_VideoUpscalerLog) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *VideoUpscalerLogs_VideoUpscalerLog) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VideoUpscalerLogs_VideoUpscalerLog.Merge(m, src)
+func (m *TaskAccess) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskAccess.Merge(m, src)
 }
-func (m *VideoUpscalerLogs_VideoUpscalerLog) XXX_Size() int {
+func (m *TaskAccess) XXX_Size() int {
 	return m.Size()
 }
-func (m *VideoUpscalerLogs_VideoUpscalerLog) XXX_DiscardUnknown() {
-	xxx_messageInfo_VideoUpscalerLogs_VideoUpscalerLog.DiscardUnknown(m)
+func (m *TaskAccess) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskAccess.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_VideoUpscalerLogs_VideoUpscalerLog proto.InternalMessageInfo
+var xxx_messageInfo_TaskAccess proto.InternalMessageInfo
 
-func (m *VideoUpscalerLogs_VideoUpscalerLog) GetLog() string {
+func (m *TaskAccess) GetAllowedWorkers() []string {
 	if m != nil {
-		return m.Log
+		return m.AllowedWorkers
 	}
-	return ""
+	return nil
 }
 
-func (m *VideoUpscalerLogs_VideoUpscalerLog) GetTimestamp() int64 {
+func (m *TaskAccess) GetDeniedWorkers() []string {
 	if m != nil {
-		return m.Timestamp
+		return m.DeniedWorkers
 	}
-	return 0
+	return nil
 }
 
-func (m *VideoUpscalerLogs_VideoUpscalerLog) GetSeverity() VideoUpscalerLogs_VideoUpscalerLog_SEVERITY {
+func (m *TaskAccess) GetWorkerGroup() string {
 	if m != nil {
-		return m.Severity
+		return m.WorkerGroup
 	}
-	return VideoUpscalerLogs_VideoUpscalerLog_INFO
+	return ""
 }
 
-func init() {
-	proto.RegisterEnum("janction.videoUpscaler.v1.VideoUpscalerLogs_VideoUpscalerLog_SEVERITY", VideoUpscalerLogs_VideoUpscalerLog_SEVERITY_name, VideoUpscalerLogs_VideoUpscalerLog_SEVERITY_value)
-	proto.RegisterType((*Params)(nil), "janction.videoUpscaler.v1.Params")
-	proto.RegisterType((*GenesisState)(nil), "janction.videoUpscaler.v1.GenesisState")
-	proto.RegisterType((*Worker)(nil), "janction.videoUpscaler.v1.Worker")
-	proto.RegisterType((*Worker_Reputation)(nil), "janction.videoUpscaler.v1.Worker.Reputation")
-	proto.RegisterType((*VideoUpscalerTask)(nil), "janction.videoUpscaler.v1.VideoUpscalerTask")
-	proto.RegisterType((*VideoUpscalerThread)(nil), "janction.videoUpscaler.v1.VideoUpscalerThread")
-	proto.RegisterType((*VideoUpscalerThread_Solution)(nil), "janction.videoUpscaler.v1.VideoUpscalerThread.Solution")
-	proto.RegisterType((*VideoUpscalerThread_Validation)(nil), "janction.videoUpscaler.v1.VideoUpscalerThread.Validation")
-	proto.RegisterType((*VideoUpscalerThread_Frame)(nil), "janction.videoUpscaler.v1.VideoUpscalerThread.Frame")
-	proto.RegisterType((*VideoUpscalerTaskInfo)(nil), "janction.videoUpscaler.v1.VideoUpscalerTaskInfo")
-	proto.RegisterType((*IndexedVideoUpscalerTask)(nil), "janction.videoUpscaler.v1.IndexedVideoUpscalerTask")
-	proto.RegisterType((*VideoUpscalerLogs)(nil), "janction.videoUpscaler.v1.VideoUpscalerLogs")
-	proto.RegisterType((*VideoUpscalerLogs_VideoUpscalerLog)(nil), "janction.videoUpscaler.v1.VideoUpscalerLogs.VideoUpscalerLog")
+// Named list of workers, managed by its owner, that tasks can reference
+type WorkerGroup struct {
+	Name    string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Owner   string   `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Members []string `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("janction/videoUpscaler/v1/types.proto", fileDescriptor_93c659a7257600d0)
+func (m *WorkerGroup) Reset()         { *m = WorkerGroup{} }
+func (m *WorkerGroup) String() string { return proto.CompactTextString(m) }
+func (*WorkerGroup) ProtoMessage()    {}
+func (*WorkerGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93c659a7257600d0, []int{11}
 }
-
-var fileDescriptor_93c659a7257600d0 = []byte{
-	// 1291 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xdb, 0x6e, 0x1b, 0x45,
-	0x18, 0xce, 0xc6, 0xa7, 0xf5, 0xef, 0x1e, 0xdc, 0x69, 0x5a, 0xb6, 0x86, 0xba, 0xae, 0xa5, 0x22,
-	0x23, 0x15, 0xbb, 0x49, 0x2b, 0x50, 0x55, 0x21, 0xd4, 0x84, 0xb4, 0x58, 0x44, 0xb4, 0x8c, 0xdb,
-	0x20, 0xe0, 0x62, 0x19, 0x7b, 0x27, 0xce, 0x10, 0x7b, 0x76, 0x99, 0x19, 0xbb, 0xc9, 0x1b, 0x70,
-	0x89, 0x78, 0x05, 0x2e, 0x11, 0x77, 0x88, 0x67, 0xe8, 0x65, 0x85, 0xb8, 0x80, 0x1b, 0x54, 0xb5,
-	0x4f, 0xc0, 0x13, 0x80, 0xe6, 0xb0, 0x76, 0x9c, 0x38, 0x49, 0x2b, 0x24, 0xee, 0x76, 0xbe, 0xff,
-	0x34, 0xff, 0xf7, 0x1f, 0x3c, 0x86, 0x6b, 0xdf, 0x10, 0xde, 0x53, 0x2c, 0xe6, 0xad, 0x31, 0x8b,
-	0x68, 0xfc, 0x38, 0x91, 0x3d, 0x32, 0xa0, 0xa2, 0x35, 0x5e, 0x6e, 0xa9, 0xbd, 0x84, 0xca, 0x66,
-	0x22, 0x62, 0x15, 0xa3, 0x4b, 0xa9, 0x5a, 0x73, 0x46, 0xad, 0x39, 0x5e, 0xae, 0x54, 0x7b, 0xb1,
-	0x1c, 0xc6, 0xb2, 0xd5, 0x25, 0x92, 0xb6, 0xc6, 0xcb, 0x5d, 0xaa, 0xc8, 0x72, 0xab, 0x17, 0x33,
-	0x6e, 0x4d, 0x2b, 0x97, 0xac, 0x3c, 0x34, 0xa7, 0x96, 0x3d, 0x38, 0xd1, 0x52, 0x3f, 0xee, 0xc7,
-	0x16, 0xd7, 0x5f, 0x16, 0xad, 0xff, 0xec, 0x41, 0xfe, 0x21, 0x11, 0x64, 0x28, 0xd1, 0x7d, 0x40,
-	0x43, 0xc6, 0xc3, 0x27, 0xb1, 0xd8, 0xa1, 0x22, 0x94, 0x8a, 0xec, 0x30, 0xde, 0x0f, 0xbc, 0x9a,
-	0xd7, 0x28, 0xad, 0x5c, 0x6a, 0x3a, 0x5f, 0x3a, 0x70, 0xd3, 0x05, 0x6e, 0xae, 0xc5, 0x8c, 0xe3,
-	0xf2, 0x90, 0xf1, 0xcf, 0x8d, 0x4d, 0xc7, 0x9a, 0xa0, 0x9b, 0x70, 0x71, 0x48, 0x76, 0x9d, 0x23,
-	0x19, 0x26, 0x54, 0x84, 0x6a, 0x5b, 0x50, 0x12, 0x05, 0x8b, 0x35, 0xaf, 0x91, 0xc1, 0xe7, 0x87,
-	0x64, 0xd7, 0x5a, 0xc8, 0x87, 0x54, 0x3c, 0x32, 0x22, 0x74, 0x0d, 0xce, 0xe8, 0xe8, 0x63, 0x32,
-	0x60, 0x11, 0x51, 0xb1, 0x90, 0x41, 0xc6, 0x28, 0x9f, 0x1e, 0x32, 0xbe, 0x39, 0x01, 0xeb, 0x7f,
-	0x2f, 0xc2, 0xa9, 0xfb, 0x94, 0x53, 0xc9, 0x64, 0x47, 0x11, 0x45, 0xd1, 0x87, 0x90, 0x4f, 0xcc,
-	0xfd, 0xdd, 0x4d, 0xaf, 0x36, 0x8f, 0x64, 0xaf, 0x69, 0x13, 0x5d, 0xcd, 0x3e, 0xfd, 0xeb, 0xca,
-	0x02, 0x76, 0x66, 0x68, 0x00, 0x17, 0x66, 0x14, 0x1f, 0x11, 0xb9, 0xd3, 0xe6, 0x5b, 0xb1, 0x89,
-	0x5f, 0x5a, 0xb9, 0x71, 0x8c, 0xbf, 0xcd, 0x79, 0x76, 0xce, 0xfd, 0x7c, 0xa7, 0x28, 0x9e, 0x13,
-	0x6d, 0x83, 0x49, 0x15, 0x64, 0x6b, 0x99, 0x46, 0x69, 0xe5, 0xe6, 0x31, 0xd1, 0xda, 0x3c, 0xa2,
-	0xbb, 0x34, 0x3a, 0x14, 0xf4, 0xc8, 0x80, 0xda, 0x2f, 0xba, 0x0b, 0x05, 0x57, 0x88, 0x20, 0x67,
-	0x42, 0x1c, 0x47, 0x90, 0xad, 0x8a, 0x73, 0x98, 0xda, 0xd5, 0x7f, 0xcd, 0x42, 0xde, 0x4a, 0xd0,
-	0x0a, 0x14, 0x48, 0x14, 0x09, 0x2a, 0x2d, 0xdd, 0xc5, 0xd5, 0xe0, 0xb7, 0x5f, 0xde, 0x5d, 0x72,
-	0xbd, 0x71, 0xd7, 0x4a, 0x3a, 0x4a, 0x30, 0xde, 0xc7, 0xa9, 0x22, 0xda, 0x00, 0x10, 0x34, 0x19,
-	0x29, 0xa2, 0x63, 0x3a, 0x56, 0xaf, 0x9f, 0x78, 0x89, 0x26, 0x9e, 0xd8, 0xe0, 0x7d, 0xf6, 0x28,
-	0x80, 0x02, 0xe5, 0xa4, 0x3b, 0xa0, 0x51, 0x90, 0xad, 0x79, 0x0d, 0x1f, 0xa7, 0x47, 0xf4, 0x36,
-	0x9c, 0xed, 0x8d, 0x84, 0xa0, 0x5c, 0x85, 0x8a, 0xc8, 0x9d, 0x90, 0x45, 0x41, 0x4e, 0xdf, 0x11,
-	0x9f, 0x76, 0xb0, 0x29, 0x42, 0x84, 0x6e, 0xc0, 0xd2, 0x44, 0xcf, 0xf4, 0x5e, 0xc8, 0x34, 0xb3,
-	0x41, 0xbe, 0xe6, 0x35, 0x72, 0x18, 0xa5, 0xca, 0x46, 0x64, 0x38, 0x47, 0x6f, 0x42, 0x31, 0x19,
-	0x75, 0x07, 0xac, 0x17, 0xb2, 0x24, 0x28, 0x18, 0x9f, 0xbe, 0x05, 0xda, 0x09, 0x7a, 0x03, 0x0a,
-	0x2c, 0xd9, 0x92, 0x3a, 0x9c, 0x6f, 0x44, 0x79, 0x7d, 0x6c, 0x47, 0x95, 0x7f, 0x3c, 0x80, 0x69,
-	0x12, 0x68, 0x19, 0xf2, 0x7a, 0xa6, 0x68, 0x74, 0xf2, 0x48, 0x39, 0x45, 0x74, 0x11, 0xf2, 0x49,
-	0xcc, 0xb8, 0x92, 0x6e, 0x70, 0xdc, 0x09, 0xd5, 0xa0, 0xe4, 0xe6, 0x84, 0xc5, 0xdc, 0x0e, 0x4a,
-	0x0e, 0xef, 0x87, 0xd0, 0x5b, 0x50, 0x94, 0xf1, 0x60, 0x64, 0xe5, 0x59, 0x23, 0x9f, 0x02, 0xe8,
-	0x0e, 0xf8, 0x4f, 0x18, 0xe7, 0x8c, 0xf7, 0xa5, 0xa1, 0xe8, 0xb8, 0xcb, 0xb8, 0x66, 0x98, 0x18,
-	0xa0, 0x77, 0xa0, 0x2c, 0x28, 0x8f, 0xa8, 0x08, 0xa3, 0x91, 0x70, 0x37, 0xc8, 0xd7, 0x32, 0x8d,
-	0x0c, 0x3e, 0x6b, 0xf1, 0x8f, 0x52, 0xb8, 0xfe, 0x5d, 0x06, 0xce, 0x1d, 0x6a, 0x57, 0x9d, 0x95,
-	0x32, 0x95, 0xb0, 0x2d, 0x84, 0xdd, 0x09, 0xbd, 0x07, 0x45, 0x41, 0xbf, 0x1d, 0x51, 0xa9, 0xa8,
-	0x30, 0x09, 0x1f, 0xd7, 0x5d, 0x53, 0x55, 0x54, 0x86, 0x4c, 0x8f, 0x45, 0x86, 0x85, 0x22, 0xd6,
-	0x9f, 0xe8, 0x0a, 0x94, 0xa4, 0x22, 0x42, 0x85, 0x5b, 0x82, 0x0c, 0xa9, 0xcb, 0x1f, 0x0c, 0x74,
-	0x4f, 0x23, 0xba, 0xa0, 0x94, 0x47, 0x4e, 0x9c, 0x33, 0x62, 0x9f, 0xf2, 0xc8, 0x0a, 0xeb, 0x70,
-	0xca, 0xf6, 0xc5, 0xdd, 0x61, 0x3c, 0xe2, 0xca, 0xf5, 0xc5, 0x0c, 0x86, 0x96, 0x20, 0x67, 0x32,
-	0x32, 0xdd, 0x90, 0xc3, 0xf6, 0xa0, 0x59, 0xef, 0xc5, 0xc3, 0x64, 0x40, 0x15, 0xb5, 0xcd, 0xe0,
-	0xe3, 0x29, 0xa0, 0x1b, 0x40, 0xd0, 0x27, 0x44, 0x44, 0x41, 0xf1, 0xc4, 0x06, 0xb0, 0x8a, 0xe8,
-	0x63, 0x28, 0xd8, 0xb0, 0x32, 0x00, 0x33, 0xbc, 0xcd, 0x57, 0xde, 0x46, 0xc6, 0x0c, 0xa7, 0xe6,
-	0xf5, 0x1f, 0x7d, 0x38, 0x3f, 0x47, 0x41, 0x33, 0x91, 0x0e, 0x41, 0x5a, 0x0f, 0xdf, 0x02, 0xed,
-	0x48, 0xb7, 0x76, 0x3a, 0x49, 0x8b, 0x33, 0xa5, 0x3a, 0x40, 0xb0, 0xdd, 0xd4, 0x47, 0x12, 0x9c,
-	0x35, 0xe2, 0x29, 0xc1, 0x13, 0xf2, 0x72, 0x46, 0x30, 0x8f, 0xbc, 0xfc, 0x41, 0xf2, 0x82, 0xe9,
-	0x1a, 0x2b, 0xd4, 0x32, 0x8d, 0xe2, 0x64, 0x3b, 0xa1, 0x0e, 0xf8, 0x69, 0x67, 0x1b, 0xce, 0x4b,
-	0x2b, 0xef, 0xbf, 0x1e, 0x49, 0xcd, 0x8e, 0x33, 0xc7, 0x13, 0x47, 0xe8, 0xab, 0xd9, 0x09, 0x2b,
-	0x1a, 0xf2, 0x6f, 0xbf, 0xa6, 0xdf, 0xcd, 0x89, 0x87, 0xd9, 0xe1, 0xbc, 0x05, 0x17, 0xc9, 0x98,
-	0x0a, 0xd2, 0xa7, 0xa1, 0x9b, 0x24, 0x49, 0x7b, 0x31, 0x37, 0x45, 0xd6, 0x84, 0x2c, 0x39, 0x29,
-	0x36, 0xc2, 0x8e, 0x95, 0x55, 0x9e, 0x7b, 0xe0, 0xa7, 0x37, 0x45, 0xb7, 0xa1, 0x94, 0x88, 0x38,
-	0x89, 0x25, 0x8d, 0xc2, 0xee, 0xde, 0x89, 0xbb, 0x18, 0x52, 0xe5, 0xd5, 0x3d, 0xb4, 0x01, 0x79,
-	0x53, 0x16, 0xbd, 0x54, 0x74, 0x56, 0xb7, 0x5e, 0x33, 0x2b, 0x53, 0x43, 0xec, 0x7c, 0xa0, 0xcb,
-	0x00, 0x6e, 0x35, 0xee, 0xd0, 0x3d, 0x37, 0x83, 0x6e, 0x59, 0x7e, 0x42, 0xf7, 0xf4, 0x6c, 0x46,
-	0x4c, 0x98, 0x0e, 0x28, 0x62, 0xfd, 0x89, 0x2a, 0xe0, 0x93, 0x5e, 0x8f, 0x26, 0xba, 0xca, 0x39,
-	0x53, 0xe5, 0xc9, 0xb9, 0xf2, 0xcc, 0x03, 0x98, 0x92, 0xa6, 0x17, 0xc2, 0xe4, 0x39, 0x70, 0x62,
-	0x8a, 0x53, 0xd5, 0xff, 0x37, 0xc3, 0xcb, 0x00, 0x4c, 0x86, 0x82, 0x8e, 0xa9, 0x90, 0xd4, 0xfd,
-	0x24, 0x15, 0x99, 0xc4, 0x16, 0xa8, 0xfc, 0xe4, 0x41, 0xce, 0x76, 0x7d, 0x05, 0xfc, 0x2d, 0x36,
-	0xa0, 0x5c, 0x4f, 0x84, 0x1b, 0xb4, 0xf4, 0x6c, 0xd6, 0x35, 0xeb, 0x73, 0xa2, 0x46, 0x82, 0xba,
-	0x51, 0x9b, 0x02, 0x73, 0x16, 0x1c, 0x82, 0xec, 0x36, 0x91, 0xdb, 0x8e, 0x57, 0xf3, 0x8d, 0xaa,
-	0x00, 0x86, 0x82, 0x35, 0xb3, 0xb4, 0xec, 0x68, 0xed, 0x43, 0xf4, 0x5a, 0x63, 0x7c, 0x9f, 0x46,
-	0xde, 0x68, 0xcc, 0x60, 0xf5, 0x16, 0x5c, 0x98, 0xfb, 0xa6, 0xd1, 0x3b, 0x9b, 0xd3, 0x5d, 0xe5,
-	0x76, 0x76, 0x06, 0xbb, 0x53, 0xfd, 0x07, 0x0f, 0x82, 0xa3, 0xde, 0x25, 0x7a, 0xce, 0xed, 0x2f,
-	0xab, 0x4d, 0xd7, 0x1e, 0xd0, 0xd7, 0x70, 0xee, 0xd0, 0x4b, 0xc5, 0xe4, 0x7c, 0xfc, 0xab, 0xe0,
-	0xa8, 0x67, 0xcf, 0x61, 0x67, 0xf5, 0x3f, 0x17, 0x0f, 0xfc, 0xec, 0x6c, 0xc4, 0x7d, 0xa9, 0xf9,
-	0x4f, 0x17, 0xdb, 0xa1, 0x45, 0xf7, 0x19, 0x64, 0x07, 0x71, 0x3f, 0xed, 0x97, 0x0f, 0x5e, 0xf5,
-	0x1a, 0xda, 0xef, 0x21, 0x04, 0x1b, 0x57, 0x95, 0xdf, 0x3d, 0x28, 0x1f, 0x14, 0xe9, 0x4a, 0x0e,
-	0xe2, 0xbe, 0xab, 0xb0, 0xfe, 0xd4, 0x95, 0x57, 0x6c, 0x48, 0xa5, 0x22, 0xc3, 0xc4, 0xed, 0xd1,
-	0x29, 0x80, 0xba, 0xe0, 0x4b, 0xdd, 0x48, 0x4c, 0xed, 0x99, 0x5a, 0x9f, 0x59, 0xb9, 0xf7, 0x9f,
-	0xee, 0xd6, 0xec, 0xac, 0x6f, 0xae, 0xe3, 0xf6, 0xa3, 0x2f, 0xf0, 0xc4, 0x6f, 0xfd, 0x3a, 0xf8,
-	0x29, 0x8a, 0x7c, 0xc8, 0xb6, 0x3f, 0xbd, 0xf7, 0xa0, 0xbc, 0x80, 0x4a, 0x50, 0xe8, 0x3c, 0x5e,
-	0x5b, 0x5b, 0xef, 0x74, 0xca, 0x1e, 0x2a, 0x42, 0x6e, 0x1d, 0xe3, 0x07, 0xb8, 0xbc, 0xb8, 0x7a,
-	0xe7, 0xe9, 0x8b, 0xaa, 0xf7, 0xec, 0x45, 0xd5, 0x7b, 0xfe, 0xa2, 0xea, 0x7d, 0xff, 0xb2, 0xba,
-	0xf0, 0xec, 0x65, 0x75, 0xe1, 0x8f, 0x97, 0xd5, 0x85, 0x2f, 0xaf, 0xf6, 0x99, 0xda, 0x1e, 0x75,
-	0x9b, 0xbd, 0x78, 0xd8, 0x9a, 0xff, 0x3f, 0xa7, 0x9b, 0x37, 0xff, 0x39, 0x6e, 0xfe, 0x1b, 0x00,
-	0x00, 0xff, 0xff, 0xd0, 0x6e, 0xf5, 0x88, 0x08, 0x0d, 0x00, 0x00,
+func (m *WorkerGroup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *WorkerGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkerGroup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *WorkerGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkerGroup.Merge(m, src)
+}
+func (m *WorkerGroup) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkerGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkerGroup.DiscardUnknown(m)
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_WorkerGroup proto.InternalMessageInfo
+
+func (m *WorkerGroup) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MinValidators != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.MinValidators))
-		i--
-		dAtA[i] = 0x18
+func (m *WorkerGroup) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	if m.MaxWorkersPerThread != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.MaxWorkersPerThread))
-		i--
-		dAtA[i] = 0x10
+	return ""
+}
+
+func (m *WorkerGroup) GetMembers() []string {
+	if m != nil {
+		return m.Members
 	}
-	if m.MinWorkerStaking != nil {
-		{
-			size, err := m.MinWorkerStaking.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// A Video Upscaler Thread is the smallest unit of work for a Task.
+// Workers will try to complete a thread as soon as possible to submit first a solution
+type VideoUpscalerThread struct {
+	ThreadId             string                            `protobuf:"bytes,1,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
+	TaskId               string                            `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	StartFrame           int64                             `protobuf:"varint,3,opt,name=start_frame,json=startFrame,proto3" json:"start_frame,omitempty"`
+	EndFrame             int64                             `protobuf:"varint,4,opt,name=end_frame,json=endFrame,proto3" json:"end_frame,omitempty"`
+	Scale                int64                             `protobuf:"varint,5,opt,name=scale,proto3" json:"scale,omitempty"`
+	Completed            bool                              `protobuf:"varint,6,opt,name=completed,proto3" json:"completed,omitempty"`
+	Workers              []string                          `protobuf:"bytes,7,rep,name=workers,proto3" json:"workers,omitempty"`
+	Solution             *VideoUpscalerThread_Solution     `protobuf:"bytes,8,opt,name=solution,proto3" json:"solution,omitempty"`
+	Validations          []*VideoUpscalerThread_Validation `protobuf:"bytes,9,rep,name=validations,proto3" json:"validations,omitempty"`
+	AverageRenderSeconds int64                             `protobuf:"varint,10,opt,name=average_render_seconds,json=averageRenderSeconds,proto3" json:"average_render_seconds,omitempty"`
+	Options              *UpscaleOptions                   `protobuf:"bytes,11,opt,name=options,proto3" json:"options,omitempty"`
+	// workers that gave up the thread. They are not assigned to it again
+	Failures []*VideoUpscalerThread_Failure `protobuf:"bytes,12,rep,name=failures,proto3" json:"failures,omitempty"`
 }
 
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *VideoUpscalerThread) Reset()         { *m = VideoUpscalerThread{} }
+func (m *VideoUpscalerThread) String() string { return proto.CompactTextString(m) }
+func (*VideoUpscalerThread) ProtoMessage()    {}
+func (*VideoUpscalerThread) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93c659a7257600d0, []int{12}
 }
-
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Workers) > 0 {
-		for iNdEx := len(m.Workers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Workers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTypes(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.VideoUpscalerTaskList) > 0 {
-		for iNdEx := len(m.VideoUpscalerTaskList) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.VideoUpscalerTaskList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTypes(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	{
-		size, err := m.VideoUpscalerTaskInfo.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTypes(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *VideoUpscalerThread) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VideoUpscalerThread) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VideoUpscalerThread.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTypes(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *VideoUpscalerThread) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VideoUpscalerThread.Merge(m, src)
+}
+func (m *VideoUpscalerThread) XXX_Size() int {
+	return m.Size()
+}
+func (m *VideoUpscalerThread) XXX_DiscardUnknown() {
+	xxx_messageInfo_VideoUpscalerThread.DiscardUnknown(m)
 }
 
-func (m *Worker) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_VideoUpscalerThread proto.InternalMessageInfo
+
+func (m *VideoUpscalerThread) GetThreadId() string {
+	if m != nil {
+		return m.ThreadId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Worker) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *VideoUpscalerThread) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
+	}
+	return ""
 }
 
-func (m *Worker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.IpfsId) > 0 {
-		i -= len(m.IpfsId)
-		copy(dAtA[i:], m.IpfsId)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.IpfsId)))
-		i--
-		dAtA[i] = 0x42
+func (m *VideoUpscalerThread) GetStartFrame() int64 {
+	if m != nil {
+		return m.StartFrame
 	}
-	if len(m.PublicIp) > 0 {
-		i -= len(m.PublicIp)
-		copy(dAtA[i:], m.PublicIp)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.PublicIp)))
-		i--
-		dAtA[i] = 0x3a
+	return 0
+}
+
+func (m *VideoUpscalerThread) GetEndFrame() int64 {
+	if m != nil {
+		return m.EndFrame
 	}
-	if m.CurrentThreadIndex != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.CurrentThreadIndex))
-		i--
-		dAtA[i] = 0x30
+	return 0
+}
+
+func (m *VideoUpscalerThread) GetScale() int64 {
+	if m != nil {
+		return m.Scale
 	}
-	if len(m.CurrentTaskId) > 0 {
-		i -= len(m.CurrentTaskId)
-		copy(dAtA[i:], m.CurrentTaskId)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.CurrentTaskId)))
-		i--
-		dAtA[i] = 0x2a
+	return 0
+}
+
+func (m *VideoUpscalerThread) GetCompleted() bool {
+	if m != nil {
+		return m.Completed
 	}
-	if m.Enabled {
-		i--
-		if m.Enabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
+	return false
+}
+
+func (m *VideoUpscalerThread) GetWorkers() []string {
+	if m != nil {
+		return m.Workers
 	}
-	if m.Reputation != nil {
-		{
-			size, err := m.Reputation.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	return nil
+}
+
+func (m *VideoUpscalerThread) GetSolution() *VideoUpscalerThread_Solution {
+	if m != nil {
+		return m.Solution
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *VideoUpscalerThread) GetValidations() []*VideoUpscalerThread_Validation {
+	if m != nil {
+		return m.Validations
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *Worker_Reputation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *VideoUpscalerThread) GetAverageRenderSeconds() int64 {
+	if m != nil {
+		return m.AverageRenderSeconds
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Worker_Reputation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *VideoUpscalerThread) GetOptions() *UpscaleOptions {
+	if m != nil {
+		return m.Options
+	}
+	return nil
 }
 
-func (m *Worker_Reputation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RenderDurations) > 0 {
-		dAtA6 := make([]byte, len(m.RenderDurations)*10)
-		var j5 int
-		for _, num1 := range m.RenderDurations {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j5++
-			}
-			dAtA6[j5] = uint8(num)
-			j5++
-		}
-		i -= j5
-		copy(dAtA[i:], dAtA6[:j5])
-		i = encodeVarintTypes(dAtA, i, uint64(j5))
-		i--
-		dAtA[i] = 0x32
+func (m *VideoUpscalerThread) GetFailures() []*VideoUpscalerThread_Failure {
+	if m != nil {
+		return m.Failures
 	}
-	{
-		size, err := m.Winnings.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTypes(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	if m.Solutions != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Solutions))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Validations != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Validations))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.Points != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Points))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Staked != nil {
-		{
-			size, err := m.Staked.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
+	return nil
+}
+
+// an honest failure reported by a worker, released from the thread without penalty
+type VideoUpscalerThread_Failure struct {
+	Worker  string              `protobuf:"bytes,1,opt,name=worker,proto3" json:"worker,omitempty"`
+	Reason  ThreadFailureReason `protobuf:"varint,2,opt,name=reason,proto3,enum=janction.videoUpscaler.v1.ThreadFailureReason" json:"reason,omitempty"`
+	Message string              `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Height  int64               `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *VideoUpscalerThread_Failure) Reset()         { *m = VideoUpscalerThread_Failure{} }
+func (m *VideoUpscalerThread_Failure) String() string { return proto.CompactTextString(m) }
+func (*VideoUpscalerThread_Failure) ProtoMessage()    {}
+func (*VideoUpscalerThread_Failure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93c659a7257600d0, []int{12, 0}
+}
+func (m *VideoUpscalerThread_Failure) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VideoUpscalerThread_Failure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VideoUpscalerThread_Failure.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *VideoUpscalerThread_Failure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VideoUpscalerThread_Failure.Merge(m, src)
+}
+func (m *VideoUpscalerThread_Failure) XXX_Size() int {
+	return m.Size()
+}
+func (m *VideoUpscalerThread_Failure) XXX_DiscardUnknown() {
+	xxx_messageInfo_VideoUpscalerThread_Failure.DiscardUnknown(m)
 }
 
-func (m *VideoUpscalerTask) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_VideoUpscalerThread_Failure proto.InternalMessageInfo
+
+func (m *VideoUpscalerThread_Failure) GetWorker() string {
+	if m != nil {
+		return m.Worker
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *VideoUpscalerTask) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *VideoUpscalerThread_Failure) GetReason() ThreadFailureReason {
+	if m != nil {
+		return m.Reason
+	}
+	return ThreadFailureReason_THREAD_FAILURE_REASON_UNSPECIFIED
 }
 
-func (m *VideoUpscalerTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Threads) > 0 {
-		for iNdEx := len(m.Threads) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Threads[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTypes(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x52
-		}
+func (m *VideoUpscalerThread_Failure) GetMessage() string {
+	if m != nil {
+		return m.Message
 	}
-	if m.Reward != nil {
-		{
-			size, err := m.Reward.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x4a
+	return ""
+}
+
+func (m *VideoUpscalerThread_Failure) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	if m.Completed {
-		i--
-		if m.Completed {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	return 0
+}
+
+type VideoUpscalerThread_Solution struct {
+	ProposedBy string                       `protobuf:"bytes,1,opt,name=proposed_by,json=proposedBy,proto3" json:"proposed_by,omitempty"`
+	Frames     []*VideoUpscalerThread_Frame `protobuf:"bytes,2,rep,name=frames,proto3" json:"frames,omitempty"`
+	PublicKey  string                       `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Dir        string                       `protobuf:"bytes,4,opt,name=dir,proto3" json:"dir,omitempty"`
+	Accepted   bool                         `protobuf:"varint,5,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	// digest of the upscaler image used to render the frames
+	ImageDigest string `protobuf:"bytes,6,opt,name=image_digest,json=imageDigest,proto3" json:"image_digest,omitempty"`
+}
+
+func (m *VideoUpscalerThread_Solution) Reset()         { *m = VideoUpscalerThread_Solution{} }
+func (m *VideoUpscalerThread_Solution) String() string { return proto.CompactTextString(m) }
+func (*VideoUpscalerThread_Solution) ProtoMessage()    {}
+func (*VideoUpscalerThread_Solution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93c659a7257600d0, []int{12, 1}
+}
+func (m *VideoUpscalerThread_Solution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VideoUpscalerThread_Solution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VideoUpscalerThread_Solution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.Scale != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Scale))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.ThreadAmount != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.ThreadAmount))
-		i--
-		dAtA[i] = 0x30
+		return b[:n], nil
 	}
-	if m.EndFrame != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.EndFrame))
-		i--
-		dAtA[i] = 0x28
+}
+func (m *VideoUpscalerThread_Solution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VideoUpscalerThread_Solution.Merge(m, src)
+}
+func (m *VideoUpscalerThread_Solution) XXX_Size() int {
+	return m.Size()
+}
+func (m *VideoUpscalerThread_Solution) XXX_DiscardUnknown() {
+	xxx_messageInfo_VideoUpscalerThread_Solution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VideoUpscalerThread_Solution proto.InternalMessageInfo
+
+func (m *VideoUpscalerThread_Solution) GetProposedBy() string {
+	if m != nil {
+		return m.ProposedBy
 	}
-	if m.StartFrame != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.StartFrame))
-		i--
-		dAtA[i] = 0x20
+	return ""
+}
+
+func (m *VideoUpscalerThread_Solution) GetFrames() []*VideoUpscalerThread_Frame {
+	if m != nil {
+		return m.Frames
 	}
-	if len(m.Cid) > 0 {
-		i -= len(m.Cid)
-		copy(dAtA[i:], m.Cid)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Cid)))
-		i--
-		dAtA[i] = 0x1a
+	return nil
+}
+
+func (m *VideoUpscalerThread_Solution) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
 	}
-	if len(m.Requester) > 0 {
-		i -= len(m.Requester)
-		copy(dAtA[i:], m.Requester)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Requester)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *VideoUpscalerThread_Solution) GetDir() string {
+	if m != nil {
+		return m.Dir
 	}
-	if len(m.TaskId) > 0 {
-		i -= len(m.TaskId)
-		copy(dAtA[i:], m.TaskId)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.TaskId)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *VideoUpscalerThread_Solution) GetAccepted() bool {
+	if m != nil {
+		return m.Accepted
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *VideoUpscalerThread) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *VideoUpscalerThread_Solution) GetImageDigest() string {
+	if m != nil {
+		return m.ImageDigest
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *VideoUpscalerThread) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type VideoUpscalerThread_Validation struct {
+	Validator string                       `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	Frames    []*VideoUpscalerThread_Frame `protobuf:"bytes,2,rep,name=frames,proto3" json:"frames,omitempty"`
+	PublicKey string                       `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	IsReverse bool                         `protobuf:"varint,4,opt,name=is_reverse,json=isReverse,proto3" json:"is_reverse,omitempty"`
 }
 
-func (m *VideoUpscalerThread) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AverageRenderSeconds != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.AverageRenderSeconds))
-		i--
-		dAtA[i] = 0x50
-	}
-	if len(m.Validations) > 0 {
-		for iNdEx := len(m.Validations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Validations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTypes(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4a
-		}
-	}
-	if m.Solution != nil {
-		{
-			size, err := m.Solution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.Workers) > 0 {
-		for iNdEx := len(m.Workers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Workers[iNdEx])
-			copy(dAtA[i:], m.Workers[iNdEx])
-			i = encodeVarintTypes(dAtA, i, uint64(len(m.Workers[iNdEx])))
-			i--
-			dAtA[i] = 0x3a
-		}
-	}
-	if m.Completed {
-		i--
-		if m.Completed {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *VideoUpscalerThread_Validation) Reset()         { *m = VideoUpscalerThread_Validation{} }
+func (m *VideoUpscalerThread_Validation) String() string { return proto.CompactTextString(m) }
+func (*VideoUpscalerThread_Validation) ProtoMessage()    {}
+func (*VideoUpscalerThread_Validation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93c659a7257600d0, []int{12, 2}
+}
+func (m *VideoUpscalerThread_Validation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VideoUpscalerThread_Validation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VideoUpscalerThread_Validation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.Scale != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Scale))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.EndFrame != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.EndFrame))
-		i--
-		dAtA[i] = 0x20
+		return b[:n], nil
 	}
-	if m.StartFrame != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.StartFrame))
-		i--
-		dAtA[i] = 0x18
+}
+func (m *VideoUpscalerThread_Validation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VideoUpscalerThread_Validation.Merge(m, src)
+}
+func (m *VideoUpscalerThread_Validation) XXX_Size() int {
+	return m.Size()
+}
+func (m *VideoUpscalerThread_Validation) XXX_DiscardUnknown() {
+	xxx_messageInfo_VideoUpscalerThread_Validation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VideoUpscalerThread_Validation proto.InternalMessageInfo
+
+func (m *VideoUpscalerThread_Validation) GetValidator() string {
+	if m != nil {
+		return m.Validator
 	}
-	if len(m.TaskId) > 0 {
-		i -= len(m.TaskId)
-		copy(dAtA[i:], m.TaskId)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.TaskId)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *VideoUpscalerThread_Validation) GetFrames() []*VideoUpscalerThread_Frame {
+	if m != nil {
+		return m.Frames
 	}
-	if len(m.ThreadId) > 0 {
-		i -= len(m.ThreadId)
-		copy(dAtA[i:], m.ThreadId)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.ThreadId)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *VideoUpscalerThread_Validation) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *VideoUpscalerThread_Solution) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *VideoUpscalerThread_Validation) GetIsReverse() bool {
+	if m != nil {
+		return m.IsReverse
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *VideoUpscalerThread_Solution) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type VideoUpscalerThread_Frame struct {
+	Filename     string `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	Signature    string `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	Cid          string `protobuf:"bytes,3,opt,name=cid,proto3" json:"cid,omitempty"`
+	Hash         string `protobuf:"bytes,4,opt,name=hash,proto3" json:"hash,omitempty"`
+	ValidCount   int64  `protobuf:"varint,5,opt,name=validCount,proto3" json:"validCount,omitempty"`
+	InvalidCount int64  `protobuf:"varint,6,opt,name=invalidCount,proto3" json:"invalidCount,omitempty"`
+	// perceptual fingerprint of the frame, with fingerprint validation
+	Fingerprint string `protobuf:"bytes,7,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
 }
 
-func (m *VideoUpscalerThread_Solution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Accepted {
-		i--
-		if m.Accepted {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *VideoUpscalerThread_Frame) Reset()         { *m = VideoUpscalerThread_Frame{} }
+func (m *VideoUpscalerThread_Frame) String() string { return proto.CompactTextString(m) }
+func (*VideoUpscalerThread_Frame) ProtoMessage()    {}
+func (*VideoUpscalerThread_Frame) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93c659a7257600d0, []int{12, 3}
+}
+func (m *VideoUpscalerThread_Frame) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VideoUpscalerThread_Frame) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VideoUpscalerThread_Frame.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x28
+		return b[:n], nil
 	}
-	if len(m.Dir) > 0 {
-		i -= len(m.Dir)
-		copy(dAtA[i:], m.Dir)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Dir)))
-		i--
-		dAtA[i] = 0x22
+}
+func (m *VideoUpscalerThread_Frame) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VideoUpscalerThread_Frame.Merge(m, src)
+}
+func (m *VideoUpscalerThread_Frame) XXX_Size() int {
+	return m.Size()
+}
+func (m *VideoUpscalerThread_Frame) XXX_DiscardUnknown() {
+	xxx_messageInfo_VideoUpscalerThread_Frame.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VideoUpscalerThread_Frame proto.InternalMessageInfo
+
+func (m *VideoUpscalerThread_Frame) GetFilename() string {
+	if m != nil {
+		return m.Filename
 	}
-	if len(m.PublicKey) > 0 {
-		i -= len(m.PublicKey)
-		copy(dAtA[i:], m.PublicKey)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.PublicKey)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *VideoUpscalerThread_Frame) GetSignature() string {
+	if m != nil {
+		return m.Signature
 	}
-	if len(m.Frames) > 0 {
-		for iNdEx := len(m.Frames) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Frames[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTypes(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	return ""
+}
+
+func (m *VideoUpscalerThread_Frame) GetCid() string {
+	if m != nil {
+		return m.Cid
 	}
-	if len(m.ProposedBy) > 0 {
-		i -= len(m.ProposedBy)
-		copy(dAtA[i:], m.ProposedBy)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.ProposedBy)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *VideoUpscalerThread_Frame) GetHash() string {
+	if m != nil {
+		return m.Hash
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *VideoUpscalerThread_Validation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *VideoUpscalerThread_Frame) GetValidCount() int64 {
+	if m != nil {
+		return m.ValidCount
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *VideoUpscalerThread_Validation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *VideoUpscalerThread_Frame) GetInvalidCount() int64 {
+	if m != nil {
+		return m.InvalidCount
+	}
+	return 0
 }
 
-func (m *VideoUpscalerThread_Validation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IsReverse {
-		i--
-		if m.IsReverse {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
+func (m *VideoUpscalerThread_Frame) GetFingerprint() string {
+	if m != nil {
+		return m.Fingerprint
 	}
-	if len(m.PublicKey) > 0 {
-		i -= len(m.PublicKey)
-		copy(dAtA[i:], m.PublicKey)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.PublicKey)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Frames) > 0 {
-		for iNdEx := len(m.Frames) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Frames[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTypes(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	return ""
+}
+
+// Output of a task, ordered by frame number.
+// Each entry points to the IPFS location of the upscaled frame
+type TaskOutputManifest struct {
+	TaskId    string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Cid       string `protobuf:"bytes,2,opt,name=cid,proto3" json:"cid,omitempty"`
+	Completed bool   `protobuf:"varint,3,opt,name=completed,proto3" json:"completed,omitempty"`
+	// frames of the completed or accepted threads, a single entry per frame
+	Entries []*TaskOutputManifest_Entry `protobuf:"bytes,4,rep,name=entries,proto3" json:"entries,omitempty"`
+	// frames of the task without an entry yet
+	MissingFrames []int64 `protobuf:"varint,5,rep,packed,name=missing_frames,json=missingFrames,proto3" json:"missing_frames,omitempty"`
+	// frames found in more than one thread. Only the first one has an entry
+	DuplicateFrames []int64 `protobuf:"varint,6,rep,packed,name=duplicate_frames,json=duplicateFrames,proto3" json:"duplicate_frames,omitempty"`
+	// files of a solution without a frame number of the task, left out of the entries
+	InvalidEntries []*TaskOutputManifest_InvalidEntry `protobuf:"bytes,7,rep,name=invalid_entries,json=invalidEntries,proto3" json:"invalid_entries,omitempty"`
+}
+
+func (m *TaskOutputManifest) Reset()         { *m = TaskOutputManifest{} }
+func (m *TaskOutputManifest) String() string { return proto.CompactTextString(m) }
+func (*TaskOutputManifest) ProtoMessage()    {}
+func (*TaskOutputManifest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93c659a7257600d0, []int{13}
+}
+func (m *TaskOutputManifest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskOutputManifest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskOutputManifest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Validator) > 0 {
-		i -= len(m.Validator)
-		copy(dAtA[i:], m.Validator)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Validator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *TaskOutputManifest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskOutputManifest.Merge(m, src)
+}
+func (m *TaskOutputManifest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskOutputManifest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskOutputManifest.DiscardUnknown(m)
 }
 
-func (m *VideoUpscalerThread_Frame) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_TaskOutputManifest proto.InternalMessageInfo
+
+func (m *TaskOutputManifest) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *VideoUpscalerThread_Frame) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *TaskOutputManifest) GetCid() string {
+	if m != nil {
+		return m.Cid
+	}
+	return ""
 }
 
-func (m *VideoUpscalerThread_Frame) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.InvalidCount != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.InvalidCount))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.ValidCount != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.ValidCount))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Cid) > 0 {
-		i -= len(m.Cid)
-		copy(dAtA[i:], m.Cid)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Cid)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Signature)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Filename) > 0 {
-		i -= len(m.Filename)
-		copy(dAtA[i:], m.Filename)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Filename)))
-		i--
-		dAtA[i] = 0xa
+func (m *TaskOutputManifest) GetCompleted() bool {
+	if m != nil {
+		return m.Completed
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *VideoUpscalerTaskInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TaskOutputManifest) GetEntries() []*TaskOutputManifest_Entry {
+	if m != nil {
+		return m.Entries
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *VideoUpscalerTaskInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *TaskOutputManifest) GetMissingFrames() []int64 {
+	if m != nil {
+		return m.MissingFrames
+	}
+	return nil
 }
 
-func (m *VideoUpscalerTaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.NextId != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.NextId))
-		i--
-		dAtA[i] = 0x8
+func (m *TaskOutputManifest) GetDuplicateFrames() []int64 {
+	if m != nil {
+		return m.DuplicateFrames
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *IndexedVideoUpscalerTask) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TaskOutputManifest) GetInvalidEntries() []*TaskOutputManifest_InvalidEntry {
+	if m != nil {
+		return m.InvalidEntries
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *IndexedVideoUpscalerTask) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type TaskOutputManifest_Entry struct {
+	Frame    int64  `protobuf:"varint,1,opt,name=frame,proto3" json:"frame,omitempty"`
+	Filename string `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	Cid      string `protobuf:"bytes,3,opt,name=cid,proto3" json:"cid,omitempty"`
+	Hash     string `protobuf:"bytes,4,opt,name=hash,proto3" json:"hash,omitempty"`
+	ThreadId string `protobuf:"bytes,5,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
+	Dir      string `protobuf:"bytes,6,opt,name=dir,proto3" json:"dir,omitempty"`
 }
 
-func (m *IndexedVideoUpscalerTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.VideoUpscalerTask.MarshalToSizedBuffer(dAtA[:i])
+func (m *TaskOutputManifest_Entry) Reset()         { *m = TaskOutputManifest_Entry{} }
+func (m *TaskOutputManifest_Entry) String() string { return proto.CompactTextString(m) }
+func (*TaskOutputManifest_Entry) ProtoMessage()    {}
+func (*TaskOutputManifest_Entry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93c659a7257600d0, []int{13, 0}
+}
+func (m *TaskOutputManifest_Entry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskOutputManifest_Entry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskOutputManifest_Entry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTypes(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Index)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *TaskOutputManifest_Entry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskOutputManifest_Entry.Merge(m, src)
+}
+func (m *TaskOutputManifest_Entry) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskOutputManifest_Entry) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskOutputManifest_Entry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskOutputManifest_Entry proto.InternalMessageInfo
+
+func (m *TaskOutputManifest_Entry) GetFrame() int64 {
+	if m != nil {
+		return m.Frame
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *VideoUpscalerLogs) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TaskOutputManifest_Entry) GetFilename() string {
+	if m != nil {
+		return m.Filename
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *VideoUpscalerLogs) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *TaskOutputManifest_Entry) GetCid() string {
+	if m != nil {
+		return m.Cid
+	}
+	return ""
 }
 
-func (m *VideoUpscalerLogs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Logs) > 0 {
-		for iNdEx := len(m.Logs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Logs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTypes(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+func (m *TaskOutputManifest_Entry) GetHash() string {
+	if m != nil {
+		return m.Hash
 	}
-	if len(m.ThreadId) > 0 {
-		i -= len(m.ThreadId)
-		copy(dAtA[i:], m.ThreadId)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.ThreadId)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *TaskOutputManifest_Entry) GetThreadId() string {
+	if m != nil {
+		return m.ThreadId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *VideoUpscalerLogs_VideoUpscalerLog) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TaskOutputManifest_Entry) GetDir() string {
+	if m != nil {
+		return m.Dir
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *VideoUpscalerLogs_VideoUpscalerLog) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type TaskOutputManifest_InvalidEntry struct {
+	ThreadId string `protobuf:"bytes,1,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
+	Filename string `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
 }
 
-func (m *VideoUpscalerLogs_VideoUpscalerLog) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Severity != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Severity))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Timestamp != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Timestamp))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Log) > 0 {
-		i -= len(m.Log)
-		copy(dAtA[i:], m.Log)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Log)))
-		i--
-		dAtA[i] = 0x12
+func (m *TaskOutputManifest_InvalidEntry) Reset()         { *m = TaskOutputManifest_InvalidEntry{} }
+func (m *TaskOutputManifest_InvalidEntry) String() string { return proto.CompactTextString(m) }
+func (*TaskOutputManifest_InvalidEntry) ProtoMessage()    {}
+func (*TaskOutputManifest_InvalidEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93c659a7257600d0, []int{13, 1}
+}
+func (m *TaskOutputManifest_InvalidEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskOutputManifest_InvalidEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskOutputManifest_InvalidEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *TaskOutputManifest_InvalidEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskOutputManifest_InvalidEntry.Merge(m, src)
+}
+func (m *TaskOutputManifest_InvalidEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskOutputManifest_InvalidEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskOutputManifest_InvalidEntry.DiscardUnknown(m)
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_TaskOutputManifest_InvalidEntry proto.InternalMessageInfo
+
+func (m *TaskOutputManifest_InvalidEntry) GetThreadId() string {
+	if m != nil {
+		return m.ThreadId
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MinWorkerStaking != nil {
-		l = m.MinWorkerStaking.Size()
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if m.MaxWorkersPerThread != 0 {
-		n += 1 + sovTypes(uint64(m.MaxWorkersPerThread))
-	}
-	if m.MinValidators != 0 {
-		n += 1 + sovTypes(uint64(m.MinValidators))
+
+func (m *TaskOutputManifest_InvalidEntry) GetFilename() string {
+	if m != nil {
+		return m.Filename
 	}
-	return n
+	return ""
 }
 
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = m.VideoUpscalerTaskInfo.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	if len(m.VideoUpscalerTaskList) > 0 {
-		for _, e := range m.VideoUpscalerTaskList {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
-		}
-	}
-	if len(m.Workers) > 0 {
-		for _, e := range m.Workers {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
+// Accounting of the funds held by the module account.
+// Rewards escrowed per task and stakes locked per worker, and what was already paid out.
+type ModuleAccounting struct {
+	Escrows       []*ModuleAccounting_TaskEscrow           `protobuf:"bytes,1,rep,name=escrows,proto3" json:"escrows,omitempty"`
+	Stakes        []*ModuleAccounting_WorkerStake          `protobuf:"bytes,2,rep,name=stakes,proto3" json:"stakes,omitempty"`
+	TotalEscrowed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=total_escrowed,json=totalEscrowed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_escrowed"`
+	TotalStaked   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=total_staked,json=totalStaked,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_staked"`
+	TotalPaidOut  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=total_paid_out,json=totalPaidOut,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_paid_out"`
+	ModuleBalance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=module_balance,json=moduleBalance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"module_balance"`
+}
+
+func (m *ModuleAccounting) Reset()         { *m = ModuleAccounting{} }
+func (m *ModuleAccounting) String() string { return proto.CompactTextString(m) }
+func (*ModuleAccounting) ProtoMessage()    {}
+func (*ModuleAccounting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93c659a7257600d0, []int{14}
+}
+func (m *ModuleAccounting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModuleAccounting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModuleAccounting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *ModuleAccounting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModuleAccounting.Merge(m, src)
+}
+func (m *ModuleAccounting) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModuleAccounting) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModuleAccounting.DiscardUnknown(m)
 }
 
-func (m *Worker) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if m.Reputation != nil {
-		l = m.Reputation.Size()
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if m.Enabled {
-		n += 2
-	}
-	l = len(m.CurrentTaskId)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if m.CurrentThreadIndex != 0 {
-		n += 1 + sovTypes(uint64(m.CurrentThreadIndex))
-	}
-	l = len(m.PublicIp)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.IpfsId)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+var xxx_messageInfo_ModuleAccounting proto.InternalMessageInfo
+
+func (m *ModuleAccounting) GetEscrows() []*ModuleAccounting_TaskEscrow {
+	if m != nil {
+		return m.Escrows
 	}
-	return n
+	return nil
 }
 
-func (m *Worker_Reputation) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ModuleAccounting) GetStakes() []*ModuleAccounting_WorkerStake {
+	if m != nil {
+		return m.Stakes
 	}
-	var l int
-	_ = l
-	if m.Staked != nil {
-		l = m.Staked.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	return nil
+}
+
+func (m *ModuleAccounting) GetTotalEscrowed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalEscrowed
 	}
-	if m.Points != 0 {
-		n += 1 + sovTypes(uint64(m.Points))
+	return nil
+}
+
+func (m *ModuleAccounting) GetTotalStaked() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalStaked
 	}
-	if m.Validations != 0 {
-		n += 1 + sovTypes(uint64(m.Validations))
+	return nil
+}
+
+func (m *ModuleAccounting) GetTotalPaidOut() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalPaidOut
 	}
-	if m.Solutions != 0 {
-		n += 1 + sovTypes(uint64(m.Solutions))
+	return nil
+}
+
+func (m *ModuleAccounting) GetModuleBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ModuleBalance
 	}
-	l = m.Winnings.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	if len(m.RenderDurations) > 0 {
-		l = 0
-		for _, e := range m.RenderDurations {
-			l += sovTypes(uint64(e))
+	return nil
+}
+
+type ModuleAccounting_TaskEscrow struct {
+	TaskId   string     `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Escrowed types.Coin `protobuf:"bytes,2,opt,name=escrowed,proto3" json:"escrowed"`
+}
+
+func (m *ModuleAccounting_TaskEscrow) Reset()         { *m = ModuleAccounting_TaskEscrow{} }
+func (m *ModuleAccounting_TaskEscrow) String() string { return proto.CompactTextString(m) }
+func (*ModuleAccounting_TaskEscrow) ProtoMessage()    {}
+func (*ModuleAccounting_TaskEscrow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93c659a7257600d0, []int{14, 0}
+}
+func (m *ModuleAccounting_TaskEscrow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModuleAccounting_TaskEscrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModuleAccounting_TaskEscrow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		n += 1 + sovTypes(uint64(l)) + l
+		return b[:n], nil
 	}
-	return n
+}
+func (m *ModuleAccounting_TaskEscrow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModuleAccounting_TaskEscrow.Merge(m, src)
+}
+func (m *ModuleAccounting_TaskEscrow) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModuleAccounting_TaskEscrow) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModuleAccounting_TaskEscrow.DiscardUnknown(m)
 }
 
-func (m *VideoUpscalerTask) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.TaskId)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Requester)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Cid)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if m.StartFrame != 0 {
-		n += 1 + sovTypes(uint64(m.StartFrame))
-	}
-	if m.EndFrame != 0 {
-		n += 1 + sovTypes(uint64(m.EndFrame))
-	}
-	if m.ThreadAmount != 0 {
-		n += 1 + sovTypes(uint64(m.ThreadAmount))
-	}
-	if m.Scale != 0 {
-		n += 1 + sovTypes(uint64(m.Scale))
-	}
-	if m.Completed {
-		n += 2
+var xxx_messageInfo_ModuleAccounting_TaskEscrow proto.InternalMessageInfo
+
+func (m *ModuleAccounting_TaskEscrow) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
 	}
-	if m.Reward != nil {
-		l = m.Reward.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	return ""
+}
+
+func (m *ModuleAccounting_TaskEscrow) GetEscrowed() types.Coin {
+	if m != nil {
+		return m.Escrowed
 	}
-	if len(m.Threads) > 0 {
-		for _, e := range m.Threads {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
+	return types.Coin{}
+}
+
+type ModuleAccounting_WorkerStake struct {
+	Worker string     `protobuf:"bytes,1,opt,name=worker,proto3" json:"worker,omitempty"`
+	Staked types.Coin `protobuf:"bytes,2,opt,name=staked,proto3" json:"staked"`
+}
+
+func (m *ModuleAccounting_WorkerStake) Reset()         { *m = ModuleAccounting_WorkerStake{} }
+func (m *ModuleAccounting_WorkerStake) String() string { return proto.CompactTextString(m) }
+func (*ModuleAccounting_WorkerStake) ProtoMessage()    {}
+func (*ModuleAccounting_WorkerStake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93c659a7257600d0, []int{14, 1}
+}
+func (m *ModuleAccounting_WorkerStake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModuleAccounting_WorkerStake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModuleAccounting_WorkerStake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *ModuleAccounting_WorkerStake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModuleAccounting_WorkerStake.Merge(m, src)
+}
+func (m *ModuleAccounting_WorkerStake) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModuleAccounting_WorkerStake) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModuleAccounting_WorkerStake.DiscardUnknown(m)
 }
 
-func (m *VideoUpscalerThread) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_ModuleAccounting_WorkerStake proto.InternalMessageInfo
+
+func (m *ModuleAccounting_WorkerStake) GetWorker() string {
+	if m != nil {
+		return m.Worker
 	}
-	var l int
-	_ = l
-	l = len(m.ThreadId)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.TaskId)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if m.StartFrame != 0 {
-		n += 1 + sovTypes(uint64(m.StartFrame))
-	}
-	if m.EndFrame != 0 {
-		n += 1 + sovTypes(uint64(m.EndFrame))
-	}
-	if m.Scale != 0 {
-		n += 1 + sovTypes(uint64(m.Scale))
-	}
-	if m.Completed {
-		n += 2
-	}
-	if len(m.Workers) > 0 {
-		for _, s := range m.Workers {
-			l = len(s)
-			n += 1 + l + sovTypes(uint64(l))
-		}
-	}
-	if m.Solution != nil {
-		l = m.Solution.Size()
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if len(m.Validations) > 0 {
-		for _, e := range m.Validations {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
-		}
-	}
-	if m.AverageRenderSeconds != 0 {
-		n += 1 + sovTypes(uint64(m.AverageRenderSeconds))
-	}
-	return n
+	return ""
 }
 
-func (m *VideoUpscalerThread_Solution) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ProposedBy)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if len(m.Frames) > 0 {
-		for _, e := range m.Frames {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
-		}
-	}
-	l = len(m.PublicKey)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Dir)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if m.Accepted {
-		n += 2
+func (m *ModuleAccounting_WorkerStake) GetStaked() types.Coin {
+	if m != nil {
+		return m.Staked
 	}
-	return n
+	return types.Coin{}
 }
 
-func (m *VideoUpscalerThread_Validation) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Validator)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if len(m.Frames) > 0 {
-		for _, e := range m.Frames {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
-		}
-	}
-	l = len(m.PublicKey)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if m.IsReverse {
-		n += 2
-	}
-	return n
+// Stores information about the Video Upscaler  task
+type VideoUpscalerTaskInfo struct {
+	NextId int64 `protobuf:"varint,1,opt,name=nextId,proto3" json:"nextId,omitempty"`
 }
 
-func (m *VideoUpscalerThread_Frame) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Filename)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Cid)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if m.ValidCount != 0 {
-		n += 1 + sovTypes(uint64(m.ValidCount))
-	}
-	if m.InvalidCount != 0 {
-		n += 1 + sovTypes(uint64(m.InvalidCount))
+func (m *VideoUpscalerTaskInfo) Reset()         { *m = VideoUpscalerTaskInfo{} }
+func (m *VideoUpscalerTaskInfo) String() string { return proto.CompactTextString(m) }
+func (*VideoUpscalerTaskInfo) ProtoMessage()    {}
+func (*VideoUpscalerTaskInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93c659a7257600d0, []int{15}
+}
+func (m *VideoUpscalerTaskInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VideoUpscalerTaskInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VideoUpscalerTaskInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *VideoUpscalerTaskInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VideoUpscalerTaskInfo.Merge(m, src)
+}
+func (m *VideoUpscalerTaskInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *VideoUpscalerTaskInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_VideoUpscalerTaskInfo.DiscardUnknown(m)
 }
 
-func (m *VideoUpscalerTaskInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.NextId != 0 {
-		n += 1 + sovTypes(uint64(m.NextId))
+var xxx_messageInfo_VideoUpscalerTaskInfo proto.InternalMessageInfo
+
+func (m *VideoUpscalerTaskInfo) GetNextId() int64 {
+	if m != nil {
+		return m.NextId
 	}
-	return n
+	return 0
 }
 
-func (m *IndexedVideoUpscalerTask) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = m.VideoUpscalerTask.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	return n
+// Stores the Video Upscaler Task with the index
+type IndexedVideoUpscalerTask struct {
+	Index             string            `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	VideoUpscalerTask VideoUpscalerTask `protobuf:"bytes,2,opt,name=videoUpscalerTask,proto3" json:"videoUpscalerTask"`
 }
 
-func (m *VideoUpscalerLogs) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ThreadId)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if len(m.Logs) > 0 {
-		for _, e := range m.Logs {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
+func (m *IndexedVideoUpscalerTask) Reset()         { *m = IndexedVideoUpscalerTask{} }
+func (m *IndexedVideoUpscalerTask) String() string { return proto.CompactTextString(m) }
+func (*IndexedVideoUpscalerTask) ProtoMessage()    {}
+func (*IndexedVideoUpscalerTask) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93c659a7257600d0, []int{16}
+}
+func (m *IndexedVideoUpscalerTask) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IndexedVideoUpscalerTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IndexedVideoUpscalerTask.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *IndexedVideoUpscalerTask) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexedVideoUpscalerTask.Merge(m, src)
+}
+func (m *IndexedVideoUpscalerTask) XXX_Size() int {
+	return m.Size()
+}
+func (m *IndexedVideoUpscalerTask) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexedVideoUpscalerTask.DiscardUnknown(m)
 }
 
-func (m *VideoUpscalerLogs_VideoUpscalerLog) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Log)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if m.Timestamp != 0 {
-		n += 1 + sovTypes(uint64(m.Timestamp))
-	}
-	if m.Severity != 0 {
-		n += 1 + sovTypes(uint64(m.Severity))
+var xxx_messageInfo_IndexedVideoUpscalerTask proto.InternalMessageInfo
+
+func (m *IndexedVideoUpscalerTask) GetIndex() string {
+	if m != nil {
+		return m.Index
 	}
-	return n
+	return ""
 }
 
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *IndexedVideoUpscalerTask) GetVideoUpscalerTask() VideoUpscalerTask {
+	if m != nil {
+		return m.VideoUpscalerTask
+	}
+	return VideoUpscalerTask{}
 }
-func sozTypes(x uint64) (n int) {
+
+type VideoUpscalerLogs struct {
+	ThreadId string                                `protobuf:"bytes,1,opt,name=threadId,proto3" json:"threadId,omitempty"`
+	Logs     []*VideoUpscalerLogs_VideoUpscalerLog `protobuf:"bytes,2,rep,name=logs,proto3" json:"logs,omitempty"`
+}
+
+func (m *VideoUpscalerLogs) Reset()         { *m = VideoUpscalerLogs{} }
+func (m *VideoUpscalerLogs) String() string { return proto.CompactTextString(m) }
+func (*VideoUpscalerLogs) ProtoMessage()    {}
+func (*VideoUpscalerLogs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93c659a7257600d0, []int{17}
+}
+func (m *VideoUpscalerLogs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VideoUpscalerLogs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VideoUpscalerLogs.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VideoUpscalerLogs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VideoUpscalerLogs.Merge(m, src)
+}
+func (m *VideoUpscalerLogs) XXX_Size() int {
+	return m.Size()
+}
+func (m *VideoUpscalerLogs) XXX_DiscardUnknown() {
+	xxx_messageInfo_VideoUpscalerLogs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VideoUpscalerLogs proto.InternalMessageInfo
+
+func (m *VideoUpscalerLogs) GetThreadId() string {
+	if m != nil {
+		return m.ThreadId
+	}
+	return ""
+}
+
+func (m *VideoUpscalerLogs) GetLogs() []*VideoUpscalerLogs_VideoUpscalerLog {
+	if m != nil {
+		return m.Logs
+	}
+	return nil
+}
+
+type VideoUpscalerLogs_VideoUpscalerLog struct {
+	Log       string                                      `protobuf:"bytes,2,opt,name=log,proto3" json:"log,omitempty"`
+	Timestamp int64                                       `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Severity  VideoUpscalerLogs_VideoUpscalerLog_SEVERITY `protobuf:"varint,4,opt,name=severity,proto3,enum=janction.videoUpscaler.v1.VideoUpscalerLogs_VideoUpscalerLog_SEVERITY" json:"severity,omitempty"`
+}
+
+func (m *VideoUpscalerLogs_VideoUpscalerLog) Reset()         { *m = VideoUpscalerLogs_VideoUpscalerLog{} }
+func (m *VideoUpscalerLogs_VideoUpscalerLog) String() string { return proto.CompactTextString(m) }
+func (*VideoUpscalerLogs_VideoUpscalerLog) ProtoMessage()    {}
+func (*VideoUpscalerLogs_VideoUpscalerLog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93c659a7257600d0, []int{17, 0}
+}
+func (m *VideoUpscalerLogs_VideoUpscalerLog) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VideoUpscalerLogs_VideoUpscalerLog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VideoUpscalerLogs_VideoUpscalerLog.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VideoUpscalerLogs_VideoUpscalerLog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VideoUpscalerLogs_VideoUpscalerLog.Merge(m, src)
+}
+func (m *VideoUpscalerLogs_VideoUpscalerLog) XXX_Size() int {
+	return m.Size()
+}
+func (m *VideoUpscalerLogs_VideoUpscalerLog) XXX_DiscardUnknown() {
+	xxx_messageInfo_VideoUpscalerLogs_VideoUpscalerLog.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VideoUpscalerLogs_VideoUpscalerLog proto.InternalMessageInfo
+
+func (m *VideoUpscalerLogs_VideoUpscalerLog) GetLog() string {
+	if m != nil {
+		return m.Log
+	}
+	return ""
+}
+
+func (m *VideoUpscalerLogs_VideoUpscalerLog) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *VideoUpscalerLogs_VideoUpscalerLog) GetSeverity() VideoUpscalerLogs_VideoUpscalerLog_SEVERITY {
+	if m != nil {
+		return m.Severity
+	}
+	return VideoUpscalerLogs_VideoUpscalerLog_INFO
+}
+
+func init() {
+	proto.RegisterEnum("janction.videoUpscaler.v1.InputType", InputType_name, InputType_value)
+	proto.RegisterEnum("janction.videoUpscaler.v1.ThreadFailureReason", ThreadFailureReason_name, ThreadFailureReason_value)
+	proto.RegisterEnum("janction.videoUpscaler.v1.ValidationMode", ValidationMode_name, ValidationMode_value)
+	proto.RegisterEnum("janction.videoUpscaler.v1.OutputFormat", OutputFormat_name, OutputFormat_value)
+	proto.RegisterEnum("janction.videoUpscaler.v1.VideoUpscalerLogs_VideoUpscalerLog_SEVERITY", VideoUpscalerLogs_VideoUpscalerLog_SEVERITY_name, VideoUpscalerLogs_VideoUpscalerLog_SEVERITY_value)
+	proto.RegisterType((*Params)(nil), "janction.videoUpscaler.v1.Params")
+	proto.RegisterType((*UpscalerImage)(nil), "janction.videoUpscaler.v1.UpscalerImage")
+	proto.RegisterType((*GenesisState)(nil), "janction.videoUpscaler.v1.GenesisState")
+	proto.RegisterType((*Worker)(nil), "janction.videoUpscaler.v1.Worker")
+	proto.RegisterType((*Worker_Reputation)(nil), "janction.videoUpscaler.v1.Worker.Reputation")
+	proto.RegisterType((*Worker_Assignment)(nil), "janction.videoUpscaler.v1.Worker.Assignment")
+	proto.RegisterType((*WorkerCapabilities)(nil), "janction.videoUpscaler.v1.WorkerCapabilities")
+	proto.RegisterType((*TaskRequirements)(nil), "janction.videoUpscaler.v1.TaskRequirements")
+	proto.RegisterType((*VideoUpscalerTask)(nil), "janction.videoUpscaler.v1.VideoUpscalerTask")
+	proto.RegisterType((*MediaInfo)(nil), "janction.videoUpscaler.v1.MediaInfo")
+	proto.RegisterType((*MediaInfo_AudioStream)(nil), "janction.videoUpscaler.v1.MediaInfo.AudioStream")
+	proto.RegisterType((*FinalVideo)(nil), "janction.videoUpscaler.v1.FinalVideo")
+	proto.RegisterType((*UpscaleOptions)(nil), "janction.videoUpscaler.v1.UpscaleOptions")
+	proto.RegisterType((*TaskAccess)(nil), "janction.videoUpscaler.v1.TaskAccess")
+	proto.RegisterType((*WorkerGroup)(nil), "janction.videoUpscaler.v1.WorkerGroup")
+	proto.RegisterType((*VideoUpscalerThread)(nil), "janction.videoUpscaler.v1.VideoUpscalerThread")
+	proto.RegisterType((*VideoUpscalerThread_Failure)(nil), "janction.videoUpscaler.v1.VideoUpscalerThread.Failure")
+	proto.RegisterType((*VideoUpscalerThread_Solution)(nil), "janction.videoUpscaler.v1.VideoUpscalerThread.Solution")
+	proto.RegisterType((*VideoUpscalerThread_Validation)(nil), "janction.videoUpscaler.v1.VideoUpscalerThread.Validation")
+	proto.RegisterType((*VideoUpscalerThread_Frame)(nil), "janction.videoUpscaler.v1.VideoUpscalerThread.Frame")
+	proto.RegisterType((*TaskOutputManifest)(nil), "janction.videoUpscaler.v1.TaskOutputManifest")
+	proto.RegisterType((*TaskOutputManifest_Entry)(nil), "janction.videoUpscaler.v1.TaskOutputManifest.Entry")
+	proto.RegisterType((*TaskOutputManifest_InvalidEntry)(nil), "janction.videoUpscaler.v1.TaskOutputManifest.InvalidEntry")
+	proto.RegisterType((*ModuleAccounting)(nil), "janction.videoUpscaler.v1.ModuleAccounting")
+	proto.RegisterType((*ModuleAccounting_TaskEscrow)(nil), "janction.videoUpscaler.v1.ModuleAccounting.TaskEscrow")
+	proto.RegisterType((*ModuleAccounting_WorkerStake)(nil), "janction.videoUpscaler.v1.ModuleAccounting.WorkerStake")
+	proto.RegisterType((*VideoUpscalerTaskInfo)(nil), "janction.videoUpscaler.v1.VideoUpscalerTaskInfo")
+	proto.RegisterType((*IndexedVideoUpscalerTask)(nil), "janction.videoUpscaler.v1.IndexedVideoUpscalerTask")
+	proto.RegisterType((*VideoUpscalerLogs)(nil), "janction.videoUpscaler.v1.VideoUpscalerLogs")
+	proto.RegisterType((*VideoUpscalerLogs_VideoUpscalerLog)(nil), "janction.videoUpscaler.v1.VideoUpscalerLogs.VideoUpscalerLog")
+}
+
+func init() {
+	proto.RegisterFile("janction/videoUpscaler/v1/types.proto", fileDescriptor_93c659a7257600d0)
+}
+
+var fileDescriptor_93c659a7257600d0 = []byte{
+	// 3279 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5a, 0xcd, 0x73, 0x1b, 0xc7,
+	0x95, 0x17, 0x08, 0xe2, 0xeb, 0x01, 0xa4, 0xa0, 0xd6, 0x87, 0x47, 0xb0, 0x45, 0x51, 0x58, 0xcb,
+	0x4b, 0x69, 0x6d, 0x50, 0xa2, 0x5d, 0xf6, 0x7a, 0xb5, 0x2e, 0x2d, 0x08, 0x02, 0x14, 0xbc, 0x04,
+	0x41, 0x37, 0x40, 0xa9, 0xbc, 0x7b, 0x98, 0x6d, 0x60, 0x1a, 0x60, 0x9b, 0x98, 0x19, 0x78, 0x7a,
+	0xc0, 0x8f, 0xf3, 0xd6, 0x5e, 0xf6, 0x90, 0x4a, 0xf9, 0x9c, 0xca, 0x1f, 0x90, 0x53, 0xaa, 0x92,
+	0x1c, 0x72, 0xc8, 0xdd, 0x97, 0x54, 0xb9, 0x52, 0x39, 0x24, 0x17, 0x27, 0xb1, 0xef, 0xf9, 0x0f,
+	0x12, 0xa7, 0xfa, 0x63, 0x06, 0x03, 0x12, 0x04, 0x28, 0xdb, 0xc9, 0x89, 0x78, 0xaf, 0xdf, 0x7b,
+	0xdd, 0xfd, 0xfa, 0xf5, 0xef, 0xbd, 0x7e, 0x43, 0xb8, 0xff, 0x09, 0x71, 0xba, 0x3e, 0x73, 0x9d,
+	0xf5, 0x23, 0x66, 0x51, 0x77, 0x7f, 0xc8, 0xbb, 0x64, 0x40, 0xbd, 0xf5, 0xa3, 0xc7, 0xeb, 0xfe,
+	0xe9, 0x90, 0xf2, 0xd2, 0xd0, 0x73, 0x7d, 0x17, 0xdd, 0x0e, 0xc4, 0x4a, 0x13, 0x62, 0xa5, 0xa3,
+	0xc7, 0x85, 0x95, 0xae, 0xcb, 0x6d, 0x97, 0xaf, 0x77, 0x08, 0xa7, 0xeb, 0x47, 0x8f, 0x3b, 0xd4,
+	0x27, 0x8f, 0xd7, 0xbb, 0x2e, 0x73, 0x94, 0x6a, 0xe1, 0xb6, 0x1a, 0x37, 0x25, 0xb5, 0xae, 0x08,
+	0x3d, 0x74, 0xa3, 0xef, 0xf6, 0x5d, 0xc5, 0x17, 0xbf, 0x14, 0xb7, 0xf8, 0x97, 0x38, 0x24, 0xf7,
+	0x88, 0x47, 0x6c, 0x8e, 0xb6, 0x01, 0xd9, 0xcc, 0x31, 0x8f, 0x5d, 0xef, 0x90, 0x7a, 0x26, 0xf7,
+	0xc9, 0x21, 0x73, 0xfa, 0x46, 0x6c, 0x35, 0xb6, 0x96, 0xdd, 0xb8, 0x5d, 0xd2, 0xb6, 0xc4, 0xc4,
+	0x25, 0x3d, 0x71, 0xa9, 0xe2, 0x32, 0x07, 0xe7, 0x6d, 0xe6, 0xbc, 0x90, 0x3a, 0x2d, 0xa5, 0x82,
+	0xde, 0x86, 0x5b, 0x36, 0x39, 0xd1, 0x86, 0xb8, 0x39, 0xa4, 0x9e, 0xe9, 0x1f, 0x78, 0x94, 0x58,
+	0xc6, 0xc2, 0x6a, 0x6c, 0x2d, 0x8e, 0xaf, 0xdb, 0xe4, 0x44, 0x69, 0xf0, 0x3d, 0xea, 0xb5, 0xe5,
+	0x10, 0xba, 0x0f, 0xcb, 0x62, 0xf6, 0x23, 0x32, 0x60, 0x16, 0xf1, 0x5d, 0x8f, 0x1b, 0x71, 0x29,
+	0xbc, 0x64, 0x33, 0xe7, 0x79, 0xc8, 0x44, 0x4f, 0xe1, 0x35, 0x8b, 0xf6, 0xc8, 0x68, 0xe0, 0x9b,
+	0x3d, 0x8f, 0xd8, 0xd4, 0xf4, 0xa8, 0x63, 0x89, 0xe5, 0xd2, 0xae, 0xeb, 0x58, 0xdc, 0x58, 0x94,
+	0x4a, 0xb7, 0xb5, 0x4c, 0x4d, 0x88, 0x60, 0x29, 0xd1, 0x52, 0x02, 0xe8, 0x03, 0x78, 0x55, 0x2c,
+	0xce, 0x66, 0x9c, 0x53, 0xcb, 0x3c, 0xa0, 0xc4, 0xf3, 0x3b, 0x94, 0xf8, 0x66, 0x67, 0xe0, 0x76,
+	0x0f, 0xb9, 0x91, 0x90, 0xfa, 0x86, 0x4d, 0x4e, 0x1a, 0x52, 0xe2, 0x59, 0x20, 0xb0, 0x29, 0xc7,
+	0xd1, 0x0b, 0xb8, 0x3a, 0xd2, 0xe7, 0x61, 0x32, 0x9b, 0xf4, 0x29, 0x37, 0x92, 0xab, 0xf1, 0xb5,
+	0xec, 0xc6, 0x5a, 0xe9, 0xc2, 0x53, 0x2b, 0x05, 0xbf, 0xeb, 0x42, 0x61, 0x73, 0xf1, 0xf3, 0x2f,
+	0xef, 0x5e, 0xc1, 0xcb, 0xa3, 0x28, 0x93, 0xa3, 0x7f, 0x05, 0x31, 0xa9, 0xd9, 0x63, 0x4e, 0x9f,
+	0x7a, 0x43, 0x8f, 0x39, 0xbe, 0x69, 0x31, 0xee, 0x13, 0xa7, 0x4b, 0x8d, 0xd4, 0x6a, 0x6c, 0x2d,
+	0x81, 0x85, 0x53, 0x6b, 0xe3, 0xe1, 0x2d, 0x3d, 0x8a, 0x9e, 0x40, 0x41, 0x78, 0xce, 0xa6, 0x16,
+	0x23, 0x62, 0x5f, 0x36, 0xf1, 0xbb, 0x07, 0xa6, 0x47, 0x87, 0xae, 0xe7, 0x73, 0x23, 0x2d, 0x37,
+	0xf4, 0x8a, 0xcd, 0x9c, 0x86, 0x10, 0x68, 0xe8, 0x71, 0xac, 0x86, 0x8b, 0x4f, 0x61, 0x69, 0x62,
+	0x75, 0xc8, 0x80, 0x54, 0x87, 0x74, 0x0f, 0xa9, 0x63, 0xc9, 0xa3, 0xcf, 0xe0, 0x80, 0x44, 0x37,
+	0x20, 0x21, 0x77, 0x2c, 0x4f, 0x31, 0x83, 0x15, 0x51, 0xfc, 0x71, 0x02, 0x72, 0xdb, 0xd4, 0xa1,
+	0x9c, 0xf1, 0x96, 0x4f, 0x7c, 0x8a, 0x9e, 0x42, 0x72, 0x28, 0x03, 0x4a, 0x87, 0xce, 0xbd, 0x19,
+	0x8e, 0x51, 0x91, 0xa7, 0x3d, 0xa2, 0xd5, 0xd0, 0x00, 0x6e, 0x4e, 0x08, 0xb6, 0x09, 0x3f, 0xac,
+	0x3b, 0x3d, 0x57, 0x06, 0x44, 0x76, 0xe3, 0xd1, 0x0c, 0x7b, 0xcf, 0xa7, 0xe9, 0x69, 0xf3, 0xd3,
+	0x8d, 0x22, 0x77, 0xca, 0x6c, 0x3b, 0x8c, 0xfb, 0xc6, 0xa2, 0x3c, 0xd6, 0xb7, 0x67, 0xcc, 0x56,
+	0x77, 0x2c, 0x7a, 0x42, 0xad, 0x73, 0x93, 0x5e, 0x38, 0xa1, 0xb0, 0x8b, 0xca, 0x90, 0xd2, 0x37,
+	0xc3, 0x48, 0xac, 0xc6, 0xe7, 0x38, 0x48, 0x5d, 0x13, 0x6d, 0x30, 0xd0, 0x43, 0x26, 0xe4, 0x7c,
+	0xc2, 0x0f, 0x4d, 0xca, 0xbb, 0x9e, 0x7b, 0x1c, 0x44, 0xe0, 0xbb, 0x33, 0xec, 0x34, 0x5c, 0x6b,
+	0x34, 0xa0, 0xe5, 0x6e, 0xd7, 0x1d, 0x39, 0x3e, 0x73, 0xfa, 0x25, 0xb1, 0x9c, 0xaa, 0x54, 0xd7,
+	0xc6, 0xb3, 0x7e, 0xc8, 0xe1, 0xa8, 0x03, 0x4b, 0x11, 0x18, 0xa0, 0xdc, 0x48, 0xc9, 0x19, 0xde,
+	0x7b, 0x99, 0x19, 0xc6, 0x98, 0x10, 0x84, 0x7c, 0xee, 0x78, 0xcc, 0xe2, 0xa8, 0x07, 0xe9, 0x21,
+	0x61, 0x96, 0xe9, 0x8e, 0x7c, 0x23, 0xbd, 0x1a, 0x9f, 0x09, 0x32, 0x9b, 0x8f, 0x84, 0x81, 0x9f,
+	0xfc, 0xe1, 0xee, 0x5a, 0x9f, 0xf9, 0x07, 0xa3, 0x4e, 0xa9, 0xeb, 0xda, 0x1a, 0xdd, 0xf4, 0x9f,
+	0xb7, 0xb8, 0x75, 0xa8, 0x41, 0x54, 0x28, 0x70, 0x9c, 0x12, 0xc6, 0x9b, 0x23, 0xbf, 0xf8, 0xa7,
+	0x24, 0x24, 0xd5, 0x5a, 0xd0, 0x06, 0xa4, 0x88, 0x65, 0x79, 0x94, 0xab, 0xd8, 0xcc, 0x6c, 0x1a,
+	0xbf, 0xf9, 0xf9, 0x5b, 0x37, 0xf4, 0xa4, 0x65, 0x35, 0xd2, 0xf2, 0x3d, 0xe6, 0xf4, 0x71, 0x20,
+	0x88, 0x76, 0x00, 0x3c, 0x3a, 0x1c, 0xf9, 0x44, 0x6c, 0x5b, 0x87, 0xe0, 0x9b, 0x73, 0x4f, 0xac,
+	0x84, 0x43, 0x1d, 0x1c, 0xd1, 0x17, 0xb7, 0x8b, 0x3a, 0xa4, 0x33, 0xa0, 0x96, 0x44, 0xaa, 0x34,
+	0x0e, 0x48, 0xf4, 0x2a, 0x64, 0x86, 0xa3, 0xce, 0x80, 0x75, 0x4d, 0x36, 0x94, 0x17, 0x3e, 0x83,
+	0xd3, 0x8a, 0x51, 0x1f, 0xa2, 0x57, 0x20, 0xc5, 0x86, 0x3d, 0x6e, 0x32, 0x4b, 0xde, 0xe7, 0x0c,
+	0x4e, 0x0a, 0xb2, 0x6e, 0xa1, 0x5d, 0xc8, 0x12, 0xce, 0x59, 0xdf, 0xb1, 0xa9, 0xe3, 0x73, 0x23,
+	0xb3, 0x1a, 0xbf, 0xdc, 0xf2, 0xca, 0xa1, 0x12, 0x8e, 0x1a, 0x40, 0x77, 0x21, 0x2b, 0x50, 0x48,
+	0xc1, 0x35, 0x37, 0x40, 0x02, 0x0f, 0xd8, 0xe4, 0x44, 0xa1, 0x34, 0x47, 0x1f, 0x41, 0xae, 0x4b,
+	0x86, 0xa4, 0xc3, 0x06, 0xcc, 0x67, 0x94, 0x1b, 0x59, 0xe9, 0x90, 0xb7, 0xe6, 0xce, 0x58, 0x89,
+	0x28, 0xe1, 0x09, 0x13, 0x02, 0xf9, 0x07, 0x84, 0xfb, 0x63, 0x2c, 0x36, 0x72, 0x0a, 0xf9, 0x05,
+	0x37, 0xc4, 0x5f, 0x21, 0x46, 0x1c, 0xc7, 0x1d, 0x39, 0x5d, 0x6a, 0x8a, 0xc3, 0xe1, 0xc6, 0xd2,
+	0x6a, 0x7c, 0x2d, 0x83, 0x97, 0x02, 0xae, 0x38, 0x40, 0x5e, 0xf8, 0x26, 0x06, 0x30, 0x76, 0x3e,
+	0x7a, 0x0c, 0x49, 0x19, 0xc2, 0xd6, 0xfc, 0x44, 0xa6, 0x05, 0xd1, 0x2d, 0x48, 0x0e, 0x5d, 0x26,
+	0xdc, 0xa9, 0xd2, 0x95, 0xa6, 0xd0, 0x2a, 0x64, 0x75, 0x76, 0x62, 0xae, 0xa3, 0xd2, 0x53, 0x02,
+	0x47, 0x59, 0xe8, 0x35, 0xc8, 0x70, 0x77, 0x30, 0x52, 0xe3, 0x8b, 0x72, 0x7c, 0xcc, 0x40, 0x4f,
+	0x20, 0x7d, 0xcc, 0x1c, 0x87, 0x39, 0x7d, 0x95, 0x66, 0x66, 0x06, 0xbc, 0xba, 0x31, 0xa1, 0x02,
+	0x7a, 0x00, 0x79, 0x9d, 0xe9, 0xac, 0x91, 0xa7, 0x57, 0x20, 0xae, 0x7d, 0x1c, 0x5f, 0x55, 0xfc,
+	0xad, 0x80, 0x5d, 0xa0, 0x00, 0xe3, 0xe3, 0x15, 0xa1, 0x23, 0xb1, 0x82, 0x05, 0x78, 0x9e, 0x14,
+	0x64, 0xdd, 0x42, 0xf7, 0x20, 0xa7, 0x8e, 0xd9, 0x64, 0x02, 0xc7, 0xe4, 0x66, 0x13, 0x38, 0xab,
+	0x78, 0x12, 0xda, 0x44, 0x4c, 0x06, 0x22, 0x96, 0xdc, 0x6f, 0x06, 0xa7, 0xf5, 0xb8, 0xf5, 0xe1,
+	0x62, 0x3a, 0x91, 0x4f, 0x7e, 0xb8, 0x98, 0x4e, 0xe6, 0x53, 0xc5, 0xcf, 0x16, 0x00, 0x9d, 0x3f,
+	0x67, 0xa1, 0xdf, 0x1d, 0x8e, 0xcc, 0xae, 0xeb, 0x51, 0x75, 0xe3, 0x12, 0x38, 0xdd, 0x1d, 0x8e,
+	0x2a, 0xae, 0xa7, 0x06, 0x6d, 0x6a, 0xbb, 0xde, 0xa9, 0x69, 0x77, 0xb4, 0xa7, 0xd3, 0x8a, 0xd1,
+	0xe8, 0xa0, 0x7f, 0x82, 0x25, 0x19, 0x41, 0x66, 0x8f, 0x74, 0x75, 0x31, 0x10, 0x5f, 0x4b, 0xe0,
+	0x9c, 0x64, 0xd6, 0x14, 0x0f, 0x15, 0x20, 0xad, 0x73, 0x13, 0x97, 0x68, 0x9d, 0xc1, 0x21, 0x2d,
+	0x0e, 0xd1, 0x76, 0x2d, 0x3a, 0x50, 0x20, 0x9b, 0xc1, 0x9a, 0x42, 0x6f, 0xc0, 0x55, 0x11, 0xe0,
+	0xcc, 0x19, 0x8e, 0x7c, 0xf3, 0x98, 0x59, 0xfe, 0x81, 0x91, 0x94, 0x0b, 0x5b, 0xb2, 0xc9, 0x49,
+	0x5d, 0x70, 0x5f, 0x08, 0x26, 0x5a, 0x83, 0xfc, 0x58, 0xee, 0x80, 0xb2, 0xfe, 0x81, 0xaf, 0xd3,
+	0xf0, 0x72, 0x20, 0xf8, 0x4c, 0x72, 0x85, 0x83, 0x2d, 0xc6, 0x0f, 0xc5, 0x2e, 0x54, 0xae, 0x4d,
+	0x0a, 0xb2, 0xd1, 0x29, 0xfe, 0x39, 0x06, 0x79, 0x01, 0xb3, 0x98, 0x7e, 0x3a, 0x62, 0x1e, 0x55,
+	0x17, 0xac, 0x08, 0xa2, 0xa0, 0x31, 0xcf, 0xba, 0x25, 0x6b, 0x33, 0xa7, 0x12, 0x78, 0x46, 0xcb,
+	0x9c, 0xf5, 0x4e, 0x56, 0xe6, 0x70, 0xed, 0xa0, 0x48, 0x9a, 0x8e, 0x9f, 0x4b, 0xd3, 0x72, 0xaf,
+	0x32, 0x00, 0x33, 0x58, 0x11, 0xe2, 0x62, 0x47, 0xf7, 0x9c, 0x50, 0x17, 0x9b, 0x8d, 0x37, 0x7c,
+	0x0f, 0x72, 0x13, 0x9b, 0x55, 0x5e, 0xc9, 0xb2, 0xc8, 0x4e, 0x57, 0x40, 0x2c, 0xc1, 0x0c, 0x76,
+	0x9b, 0x92, 0xab, 0xca, 0xd8, 0xcc, 0xd9, 0x52, 0x1b, 0xfe, 0x26, 0x05, 0xd7, 0xce, 0x25, 0x43,
+	0x71, 0x12, 0x2a, 0xe2, 0xce, 0xc4, 0xdf, 0xbb, 0x90, 0xf1, 0xe8, 0xa7, 0x23, 0xca, 0x7d, 0xea,
+	0x19, 0x0b, 0x73, 0xe0, 0x78, 0x2c, 0x8a, 0xf2, 0x10, 0xef, 0x86, 0xe1, 0x28, 0x7e, 0x8a, 0xbd,
+	0x71, 0x9f, 0x78, 0xba, 0x22, 0xd4, 0x17, 0x0f, 0x24, 0x4b, 0x16, 0x80, 0x22, 0xd4, 0xa8, 0x63,
+	0xe9, 0x61, 0xb5, 0xf5, 0x34, 0x75, 0x2c, 0x35, 0x58, 0x0c, 0xee, 0x41, 0xd9, 0x16, 0xa9, 0x4b,
+	0x6f, 0x7c, 0x82, 0x27, 0x7c, 0x2a, 0x77, 0xa4, 0x43, 0x40, 0x11, 0xe2, 0xba, 0x77, 0x5d, 0x7b,
+	0x38, 0xa0, 0x3e, 0x55, 0xb8, 0x9c, 0xc6, 0x63, 0x86, 0x40, 0x1e, 0x8f, 0x1e, 0x13, 0xcf, 0x32,
+	0x32, 0x73, 0x91, 0x47, 0x09, 0xa2, 0x67, 0x90, 0x1a, 0x23, 0xaf, 0x40, 0xf2, 0xd2, 0xa5, 0x6b,
+	0x1d, 0xa9, 0x86, 0x03, 0x75, 0xb4, 0x01, 0x37, 0x7d, 0xe2, 0xf5, 0xa9, 0xaf, 0xa1, 0x3c, 0xac,
+	0x8f, 0xb3, 0xaa, 0x02, 0x57, 0x83, 0x4a, 0x2b, 0xa8, 0x8c, 0xef, 0x00, 0x38, 0xf4, 0x24, 0xf0,
+	0x62, 0x4e, 0xc1, 0x97, 0xe0, 0x28, 0x3f, 0x35, 0x21, 0xe7, 0x45, 0x22, 0xd9, 0x58, 0x92, 0xbb,
+	0xfa, 0x97, 0x19, 0x2b, 0x3c, 0x1b, 0xfc, 0x78, 0xc2, 0x00, 0xfa, 0x00, 0x92, 0xa4, 0xdb, 0x15,
+	0xc9, 0x78, 0x59, 0x9a, 0xba, 0x3f, 0xc7, 0x54, 0x59, 0x0a, 0x63, 0xad, 0x24, 0x20, 0x82, 0x9e,
+	0x0c, 0x99, 0x77, 0x1a, 0x44, 0xec, 0x55, 0xb9, 0xb5, 0x9c, 0x62, 0xea, 0x90, 0xfd, 0x77, 0xc8,
+	0x0d, 0x3d, 0xe6, 0x7a, 0xcc, 0x3f, 0x35, 0x7b, 0x94, 0x1a, 0xf9, 0x79, 0x47, 0x91, 0x0d, 0xc4,
+	0x6b, 0x54, 0xd6, 0xc2, 0xd2, 0x1a, 0xb5, 0x8c, 0x6b, 0x3a, 0x5b, 0x2b, 0x12, 0x55, 0x20, 0xe5,
+	0x0e, 0x15, 0x0a, 0x23, 0x69, 0xf2, 0xc1, 0xfc, 0xf2, 0xbf, 0xa9, 0x14, 0x70, 0xa0, 0x89, 0x6a,
+	0x90, 0xed, 0x31, 0x87, 0x0c, 0x4c, 0xa9, 0x61, 0x5c, 0x9f, 0xeb, 0x85, 0x9a, 0x90, 0x96, 0xe7,
+	0x8e, 0xa1, 0x17, 0xfe, 0x46, 0x15, 0x00, 0x55, 0xfc, 0x33, 0x51, 0x25, 0xdf, 0x90, 0x66, 0x5e,
+	0x9f, 0x55, 0xaa, 0x09, 0x61, 0x51, 0xfc, 0xe2, 0x8c, 0x1d, 0xfc, 0x14, 0x46, 0xd4, 0xfd, 0x17,
+	0x45, 0x94, 0x71, 0x73, 0x35, 0xb6, 0xb6, 0x3c, 0xd3, 0x88, 0x84, 0xc0, 0xf6, 0xe9, 0x90, 0xe2,
+	0x0c, 0x0b, 0x7e, 0x16, 0x7f, 0x14, 0x87, 0x4c, 0x68, 0x5d, 0xdc, 0x4b, 0xf5, 0x46, 0x93, 0x35,
+	0xa1, 0xbc, 0xfe, 0x71, 0x0c, 0x92, 0x55, 0x91, 0xd7, 0xea, 0x0e, 0x28, 0xca, 0xf4, 0x88, 0x1f,
+	0x3c, 0x2b, 0x32, 0x92, 0x83, 0xc5, 0x4b, 0xe2, 0x06, 0x24, 0x14, 0x5a, 0xa9, 0x54, 0xab, 0x08,
+	0x81, 0x27, 0xfa, 0xc0, 0xd5, 0x45, 0xd7, 0x94, 0x90, 0xee, 0xba, 0x16, 0xed, 0xca, 0x0b, 0x9e,
+	0xc1, 0x8a, 0x10, 0x6b, 0x08, 0x12, 0xa6, 0x69, 0x73, 0x79, 0xb9, 0xe3, 0x18, 0x02, 0x56, 0x83,
+	0xa3, 0x7d, 0x58, 0x22, 0x23, 0x8b, 0xb9, 0x26, 0xf7, 0x3d, 0x4a, 0xec, 0xa0, 0xd4, 0x7d, 0x74,
+	0x19, 0xff, 0x95, 0xca, 0x42, 0xb3, 0x25, 0x15, 0x71, 0x8e, 0x8c, 0x09, 0x5e, 0xf8, 0x2c, 0x06,
+	0xd9, 0xc8, 0xa8, 0x58, 0x9d, 0x4a, 0xb3, 0x0a, 0xef, 0x15, 0x31, 0x5e, 0xf3, 0x42, 0x74, 0xcd,
+	0x05, 0x48, 0x77, 0x0f, 0x88, 0xe3, 0x88, 0xec, 0x15, 0xd7, 0x59, 0x53, 0xd3, 0x12, 0xeb, 0x88,
+	0x80, 0x18, 0xe5, 0xb3, 0x00, 0xeb, 0x24, 0x4b, 0x3a, 0xad, 0x00, 0xe9, 0x01, 0x71, 0xfa, 0x23,
+	0xf1, 0x50, 0x53, 0x9e, 0x08, 0xe9, 0xe2, 0x5f, 0x63, 0x00, 0xe3, 0x18, 0x42, 0x4f, 0x20, 0x47,
+	0x38, 0xa7, 0xb6, 0xa8, 0x3f, 0xcd, 0xce, 0xe9, 0xdc, 0x9a, 0x38, 0x1b, 0x4a, 0x6f, 0x9e, 0x06,
+	0x30, 0xbc, 0x30, 0x86, 0x61, 0x04, 0x8b, 0x07, 0x84, 0x1f, 0x68, 0x64, 0x96, 0xbf, 0xcf, 0x1c,
+	0x56, 0x3c, 0x3c, 0xac, 0xf7, 0x21, 0x7b, 0x44, 0x3d, 0xd6, 0x63, 0x6a, 0xe6, 0xc4, 0x9c, 0x99,
+	0x21, 0x10, 0xde, 0x3c, 0x15, 0x1b, 0x0c, 0x28, 0x79, 0x9c, 0x69, 0x1c, 0xd2, 0x68, 0x45, 0x14,
+	0xeb, 0x9f, 0xd0, 0xae, 0xba, 0x99, 0x0a, 0xac, 0x23, 0x9c, 0xe2, 0xff, 0xc7, 0x61, 0x79, 0xf2,
+	0x36, 0x8e, 0xa1, 0x3d, 0x16, 0x85, 0x76, 0x03, 0x52, 0x16, 0x75, 0x5c, 0xc6, 0x55, 0x58, 0xa6,
+	0x71, 0x40, 0x8a, 0x03, 0x90, 0x3f, 0xcc, 0x01, 0x3d, 0xa2, 0x03, 0x7d, 0x3e, 0x20, 0x59, 0x3b,
+	0x82, 0x73, 0x41, 0xfe, 0x7d, 0x1d, 0x96, 0x2c, 0xea, 0x53, 0xcf, 0x66, 0x0e, 0xe3, 0x3e, 0x53,
+	0x51, 0x9a, 0xc6, 0x93, 0xcc, 0x68, 0x56, 0x4f, 0x4e, 0x66, 0xf5, 0x1d, 0x58, 0x72, 0x47, 0xbe,
+	0xb8, 0x9f, 0x3d, 0xd7, 0xb3, 0x89, 0x2a, 0x46, 0x96, 0x37, 0xfe, 0x79, 0x46, 0x98, 0x36, 0xa5,
+	0x7c, 0x4d, 0x8a, 0xe3, 0x9c, 0x1b, 0xa1, 0x50, 0x1d, 0x60, 0x5c, 0xb7, 0xca, 0xd4, 0xb5, 0x3c,
+	0x13, 0xc1, 0x9e, 0x87, 0xc2, 0x0d, 0xd7, 0xa2, 0x38, 0xa2, 0x3c, 0xb3, 0x6f, 0x91, 0x99, 0xd5,
+	0xb7, 0x28, 0xfe, 0x2c, 0x06, 0x30, 0xc6, 0x75, 0x54, 0x86, 0xab, 0x64, 0x30, 0x70, 0x8f, 0xa9,
+	0x15, 0x74, 0x8e, 0x8c, 0xd8, 0x6a, 0x7c, 0x66, 0x58, 0x2c, 0x6b, 0x05, 0xdd, 0x4c, 0x42, 0x4f,
+	0x61, 0xd9, 0xa2, 0x0e, 0x8b, 0x58, 0x58, 0x98, 0x63, 0x61, 0x49, 0xc9, 0x07, 0x06, 0xee, 0x81,
+	0x7e, 0xa3, 0x9a, 0x7d, 0xcf, 0x1d, 0x0d, 0x75, 0x28, 0x67, 0x15, 0x6f, 0x5b, 0xb0, 0x8a, 0xff,
+	0x17, 0x83, 0xec, 0x8b, 0x31, 0x2d, 0xa2, 0xde, 0x21, 0xb6, 0x0a, 0x9f, 0x0c, 0x96, 0xbf, 0x51,
+	0x09, 0x12, 0xee, 0xb1, 0x73, 0x89, 0xb2, 0x46, 0x89, 0x89, 0x77, 0xa9, 0x4d, 0xed, 0x0e, 0xd5,
+	0x75, 0xee, 0xcc, 0x77, 0xa9, 0x16, 0x2c, 0xfe, 0x32, 0x0b, 0xd7, 0xa7, 0x94, 0x00, 0x93, 0x35,
+	0x7b, 0x6c, 0xb2, 0x66, 0x8f, 0x3e, 0x06, 0x16, 0x26, 0x8a, 0xb1, 0x33, 0x25, 0x94, 0x6a, 0xbd,
+	0x5d, 0x58, 0x42, 0xa9, 0xbb, 0x3c, 0x2e, 0xa1, 0xc2, 0x3b, 0xa4, 0xba, 0x67, 0xd3, 0xca, 0xa3,
+	0xe4, 0xd9, 0xf2, 0xc8, 0x18, 0xb7, 0x41, 0x52, 0xb2, 0x42, 0x0f, 0x48, 0xd4, 0x82, 0x74, 0xf0,
+	0x68, 0x92, 0xa1, 0x39, 0xbb, 0xef, 0x30, 0xc5, 0x07, 0xa5, 0x96, 0x56, 0xc7, 0xa1, 0x21, 0xf4,
+	0xdf, 0x93, 0x8f, 0x37, 0xf5, 0x50, 0x7e, 0xff, 0x25, 0xed, 0x8e, 0xaf, 0xc1, 0xe4, 0xbb, 0xef,
+	0x1d, 0xb8, 0x45, 0x8e, 0xa8, 0x47, 0xfa, 0xe7, 0xda, 0x91, 0x20, 0x1d, 0x72, 0x43, 0x8f, 0x4e,
+	0x76, 0x22, 0x23, 0x35, 0x44, 0xf6, 0x5b, 0xd7, 0x10, 0x18, 0xd2, 0x3d, 0xc2, 0x06, 0x23, 0xf1,
+	0x94, 0xc8, 0xcd, 0x6d, 0x03, 0x4d, 0xdb, 0x54, 0x4d, 0xa9, 0xe3, 0xd0, 0x4e, 0xe1, 0x17, 0x31,
+	0x48, 0x69, 0x2e, 0x7a, 0x04, 0x49, 0x75, 0x2e, 0x73, 0xb3, 0x83, 0x96, 0x43, 0x35, 0x51, 0xf7,
+	0x12, 0xee, 0x3a, 0x32, 0xc4, 0x96, 0x67, 0xd6, 0xb0, 0x6a, 0x09, 0xc1, 0x0a, 0xa4, 0x16, 0xd6,
+	0xda, 0x22, 0x40, 0x6c, 0xca, 0x39, 0xe9, 0xab, 0x70, 0xcc, 0xe0, 0x80, 0xbc, 0x28, 0xa9, 0x14,
+	0xfe, 0x77, 0x01, 0xd2, 0xc1, 0xd1, 0x8b, 0x0c, 0x33, 0xf4, 0xdc, 0xa1, 0xcb, 0x2f, 0x97, 0xdb,
+	0x20, 0x10, 0xde, 0x3c, 0x45, 0x3b, 0x90, 0x94, 0x71, 0xae, 0xe0, 0x23, 0xbb, 0xf1, 0xce, 0xcb,
+	0x7a, 0x54, 0x28, 0x63, 0x6d, 0x43, 0x14, 0x39, 0xba, 0xb1, 0x73, 0x48, 0x4f, 0xf5, 0x56, 0x74,
+	0xab, 0xe7, 0x3f, 0xa9, 0xcc, 0xa3, 0x16, 0xf3, 0x74, 0xb2, 0x10, 0x3f, 0x45, 0x82, 0x13, 0x25,
+	0xee, 0x50, 0x5c, 0x1b, 0x95, 0x25, 0x42, 0x5a, 0xbe, 0xd2, 0x44, 0xdb, 0xd5, 0xb4, 0x58, 0x9f,
+	0x72, 0x5f, 0x67, 0x89, 0xac, 0xe4, 0x6d, 0x49, 0x56, 0xe1, 0x8b, 0x18, 0xc0, 0x38, 0x50, 0xc5,
+	0x33, 0x2b, 0xec, 0xa9, 0xcf, 0xf5, 0xc2, 0x58, 0xf4, 0x1f, 0xeb, 0x84, 0x3b, 0x00, 0x8c, 0x9b,
+	0x1e, 0x3d, 0xa2, 0x1e, 0xa7, 0xba, 0x33, 0x96, 0x61, 0x1c, 0x2b, 0x46, 0xe1, 0xd7, 0x31, 0x48,
+	0x28, 0xa4, 0x29, 0x40, 0xba, 0xc7, 0x06, 0x34, 0x82, 0xb8, 0x21, 0x2d, 0xbb, 0x2f, 0xac, 0xef,
+	0x10, 0x7f, 0xe4, 0x85, 0xc5, 0x64, 0xc8, 0x98, 0xf2, 0x6c, 0x0c, 0xea, 0x95, 0xc5, 0x48, 0xbd,
+	0xb2, 0xa2, 0x13, 0xa3, 0xac, 0x4f, 0x35, 0x9c, 0x45, 0x38, 0xe2, 0xb1, 0xc8, 0x9c, 0x88, 0x84,
+	0xaa, 0x27, 0x27, 0x78, 0xa2, 0x4f, 0x14, 0xc9, 0x86, 0xba, 0x97, 0x17, 0x65, 0x15, 0x7f, 0xb5,
+	0x08, 0x48, 0x64, 0x3e, 0x95, 0xa1, 0x1b, 0xc4, 0x61, 0x3d, 0xca, 0x67, 0xb4, 0x6a, 0xce, 0xd7,
+	0x5a, 0x13, 0xd8, 0x1a, 0x3f, 0x8b, 0xad, 0x0d, 0xd1, 0x65, 0xf4, 0x3d, 0x46, 0xf9, 0x25, 0xba,
+	0xd8, 0xe7, 0x17, 0x52, 0xaa, 0x3a, 0xbe, 0x77, 0x8a, 0x03, 0x1b, 0xea, 0xd3, 0x0c, 0xe7, 0xcc,
+	0xe9, 0x9b, 0x3a, 0x24, 0x12, 0xb2, 0xf3, 0xb4, 0xa4, 0xb9, 0x35, 0x75, 0xc6, 0x0f, 0x20, 0x6f,
+	0x8d, 0x86, 0x03, 0xd6, 0x25, 0x3e, 0x0d, 0x04, 0x75, 0x8b, 0x2a, 0xe4, 0x6b, 0xd1, 0x2e, 0x5c,
+	0xd5, 0x2e, 0x33, 0x83, 0x85, 0xaa, 0xb2, 0xfb, 0xdf, 0x5e, 0x6e, 0xa1, 0x75, 0x65, 0x44, 0xad,
+	0x77, 0x99, 0x8d, 0x29, 0x46, 0x79, 0xe1, 0x07, 0x31, 0x48, 0xc8, 0x11, 0x91, 0x9f, 0x54, 0xe2,
+	0x52, 0x4f, 0x90, 0x44, 0xef, 0x5c, 0x2c, 0x2d, 0x9c, 0x89, 0xa5, 0xcb, 0x45, 0xcb, 0x44, 0xae,
+	0x4d, 0x9c, 0xc9, 0xb5, 0xfa, 0x62, 0x27, 0xc3, 0x8b, 0x5d, 0xd8, 0x86, 0x5c, 0x74, 0xc1, 0xb3,
+	0x53, 0xf5, 0x8c, 0xd5, 0x15, 0xbf, 0x4c, 0x42, 0xfe, 0x6c, 0xbf, 0x1d, 0xed, 0x41, 0x2a, 0xf8,
+	0x1e, 0x10, 0xfb, 0x2e, 0xdf, 0x03, 0x70, 0x60, 0x06, 0x35, 0x75, 0xef, 0x34, 0x80, 0x80, 0x6f,
+	0xdb, 0xfe, 0xd7, 0x9d, 0x55, 0x8e, 0x3c, 0x58, 0xf6, 0x5d, 0x9f, 0x0c, 0xf4, 0x87, 0x0b, 0x19,
+	0xba, 0xdf, 0x7b, 0xe3, 0x7f, 0x49, 0x4e, 0x51, 0xd5, 0x33, 0x20, 0x07, 0x72, 0x6a, 0x4e, 0xdd,
+	0x06, 0x5e, 0xfc, 0xfe, 0x67, 0xcc, 0xca, 0x09, 0xe4, 0x56, 0x2d, 0xf4, 0x69, 0xb0, 0xc7, 0xf0,
+	0xe3, 0x46, 0xe2, 0xfb, 0x9f, 0x51, 0x6d, 0x69, 0x4f, 0x7d, 0xe1, 0x10, 0x6e, 0xb5, 0xa5, 0xfb,
+	0xcd, 0x0e, 0x19, 0xc8, 0xc2, 0x3b, 0xf9, 0x77, 0x70, 0xab, 0x9a, 0x62, 0x53, 0xcd, 0x50, 0xe8,
+	0xa8, 0xda, 0x5d, 0xb9, 0xf9, 0x62, 0xe4, 0x7a, 0x02, 0xe9, 0xf0, 0xac, 0x17, 0x2e, 0xd9, 0xf3,
+	0x0e, 0x14, 0x0a, 0x27, 0x41, 0xa5, 0x2d, 0x5d, 0xfb, 0x2d, 0x4a, 0x91, 0xf7, 0xc2, 0xe6, 0xff,
+	0x25, 0xe7, 0xd6, 0xe2, 0xc5, 0x75, 0xb8, 0x39, 0xf5, 0x53, 0xa2, 0x28, 0x3d, 0x44, 0x47, 0x4c,
+	0x37, 0x33, 0xe3, 0x58, 0x53, 0xc5, 0xcf, 0x62, 0x60, 0x5c, 0xf4, 0x39, 0x70, 0xf2, 0xed, 0x9f,
+	0x09, 0xde, 0xfe, 0xff, 0x03, 0xd7, 0xce, 0x7d, 0x20, 0xd4, 0xeb, 0x7c, 0xf3, 0x65, 0x3e, 0x71,
+	0xea, 0xa5, 0x9f, 0x37, 0x56, 0xfc, 0xfd, 0xc2, 0x99, 0x7e, 0xec, 0x8e, 0xdb, 0x97, 0x5d, 0xf3,
+	0x00, 0x64, 0xce, 0x81, 0xce, 0x47, 0xb0, 0x38, 0x70, 0xfb, 0xc1, 0x7d, 0xff, 0xe0, 0xb2, 0xcb,
+	0x10, 0x76, 0xcf, 0x71, 0xb0, 0x34, 0x55, 0xf8, 0x6d, 0x0c, 0xf2, 0x67, 0x87, 0x04, 0x36, 0x0e,
+	0xdc, 0x7e, 0x90, 0xd0, 0x06, 0x6e, 0x5f, 0x24, 0x34, 0x9f, 0xd9, 0x94, 0xfb, 0xc4, 0x1e, 0xea,
+	0xe7, 0xc7, 0x98, 0x81, 0x3a, 0x90, 0xe6, 0xa2, 0x16, 0x60, 0xfe, 0xa9, 0x04, 0xe0, 0xe5, 0x8d,
+	0xda, 0x77, 0x5a, 0x5b, 0xa9, 0x55, 0x7d, 0x5e, 0xc5, 0xf5, 0xf6, 0xc7, 0x38, 0xb4, 0x5b, 0x7c,
+	0x13, 0xd2, 0x01, 0x17, 0xa5, 0x61, 0xb1, 0xbe, 0x5b, 0x6b, 0xe6, 0xaf, 0xa0, 0x2c, 0xa4, 0x5a,
+	0xfb, 0x95, 0x4a, 0xb5, 0xd5, 0xca, 0xc7, 0x50, 0x06, 0x12, 0x55, 0x8c, 0x9b, 0x38, 0xbf, 0xf0,
+	0xf0, 0x3f, 0x20, 0x13, 0x76, 0xc0, 0xd0, 0x0d, 0xc8, 0xd7, 0x77, 0xf7, 0xf6, 0xdb, 0x66, 0xfb,
+	0xe3, 0xbd, 0xaa, 0xf9, 0xbc, 0xbe, 0x55, 0x15, 0xaa, 0x77, 0xe0, 0x76, 0x84, 0x5b, 0x6f, 0x94,
+	0xb7, 0xab, 0x66, 0xab, 0xfa, 0xd1, 0x7e, 0x75, 0xb7, 0x52, 0xcd, 0xc7, 0x1e, 0xfe, 0x74, 0x01,
+	0xae, 0x4f, 0xa9, 0x7f, 0xd1, 0x7d, 0xb8, 0xd7, 0x7e, 0x86, 0xab, 0xe5, 0x2d, 0xb3, 0x56, 0xae,
+	0xef, 0xec, 0xe3, 0xaa, 0x89, 0xab, 0xe5, 0x56, 0x73, 0xd7, 0xdc, 0xdf, 0x6d, 0xed, 0x55, 0x2b,
+	0xf5, 0x5a, 0xbd, 0xba, 0x95, 0xbf, 0x82, 0xde, 0x80, 0xe2, 0x74, 0x31, 0x35, 0xe7, 0x56, 0xb5,
+	0xd2, 0xdc, 0xaa, 0xe6, 0x63, 0xe8, 0x01, 0xdc, 0x9f, 0x2e, 0x57, 0x69, 0xee, 0xb6, 0xcb, 0xf5,
+	0xdd, 0x2a, 0x36, 0x5b, 0xed, 0x32, 0x6e, 0xe7, 0x17, 0xd0, 0x3d, 0xb8, 0x33, 0x5d, 0xb4, 0x5d,
+	0x6f, 0x54, 0x9b, 0xfb, 0xed, 0x7c, 0x1c, 0xad, 0xc1, 0xeb, 0xd3, 0x45, 0x1a, 0xf5, 0x56, 0xab,
+	0xbe, 0xbb, 0x6d, 0x36, 0xf7, 0xdb, 0x7b, 0xfb, 0xed, 0xfc, 0xe2, 0xc5, 0x92, 0xb8, 0xda, 0x6a,
+	0xee, 0xe3, 0x4a, 0xd5, 0xdc, 0xa9, 0x37, 0xea, 0xed, 0x7c, 0x62, 0x86, 0xcd, 0xea, 0x56, 0xbd,
+	0x2c, 0x2c, 0x37, 0xca, 0xed, 0xca, 0xb3, 0x7c, 0xf2, 0x61, 0x03, 0x96, 0x27, 0x3b, 0x11, 0xa8,
+	0x00, 0xb7, 0x9e, 0x97, 0x77, 0xea, 0x5b, 0xe5, 0x76, 0x5d, 0x28, 0x34, 0xb7, 0xaa, 0x66, 0xab,
+	0x8d, 0xeb, 0x95, 0x76, 0xfe, 0x0a, 0xba, 0x0b, 0xaf, 0x9e, 0x1d, 0xab, 0xd5, 0x77, 0xb7, 0xab,
+	0x78, 0x0f, 0xd7, 0x77, 0xdb, 0xf9, 0xd8, 0x43, 0x02, 0xb9, 0x68, 0x8f, 0x04, 0xdd, 0x84, 0x6b,
+	0x6a, 0xf9, 0x66, 0xad, 0x89, 0x1b, 0xe5, 0xb6, 0xb9, 0xb7, 0xbb, 0xad, 0xec, 0x4c, 0xb2, 0x5f,
+	0x54, 0x37, 0xf7, 0xcc, 0x9d, 0x66, 0xab, 0xb5, 0xa3, 0xc2, 0xe2, 0x36, 0xdc, 0x9c, 0x14, 0x68,
+	0xd7, 0x6b, 0x35, 0xf3, 0xf1, 0xbb, 0xf9, 0x85, 0xcd, 0x27, 0x9f, 0x7f, 0xb5, 0x12, 0xfb, 0xe2,
+	0xab, 0x95, 0xd8, 0x1f, 0xbf, 0x5a, 0x89, 0xfd, 0xf0, 0xeb, 0x95, 0x2b, 0x5f, 0x7c, 0xbd, 0x72,
+	0xe5, 0x77, 0x5f, 0xaf, 0x5c, 0xf9, 0xaf, 0x7b, 0x11, 0xe4, 0x9d, 0xfe, 0x6f, 0x41, 0x9d, 0xa4,
+	0xfc, 0x17, 0x9d, 0xb7, 0xff, 0x36, 0x00, 0xb6, 0xed, 0xc0, 0xdc, 0x37, 0x24, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinMediaMismatchReports != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MinMediaMismatchReports))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaxFingerprintDistance != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxFingerprintDistance))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.UpscalerImages) > 0 {
+		for iNdEx := len(m.UpscalerImages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UpscalerImages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.MaxMissedHeartbeatBlocks != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxMissedHeartbeatBlocks))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.DefaultFrameRenderSeconds != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.DefaultFrameRenderSeconds))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MinValidators != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MinValidators))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxWorkersPerThread != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxWorkersPerThread))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MinWorkerStaking != nil {
+		{
+			size, err := m.MinWorkerStaking.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpscalerImage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpscalerImage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpscalerImage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Image) > 0 {
+		i -= len(m.Image)
+		copy(dAtA[i:], m.Image)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Image)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Backend) > 0 {
+		i -= len(m.Backend)
+		copy(dAtA[i:], m.Backend)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Backend)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PaidOut) > 0 {
+		for iNdEx := len(m.PaidOut) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PaidOut[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.WorkerStakes) > 0 {
+		for iNdEx := len(m.WorkerStakes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WorkerStakes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.TaskEscrows) > 0 {
+		for iNdEx := len(m.TaskEscrows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TaskEscrows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Workers) > 0 {
+		for iNdEx := len(m.Workers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Workers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.VideoUpscalerTaskList) > 0 {
+		for iNdEx := len(m.VideoUpscalerTaskList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VideoUpscalerTaskList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.VideoUpscalerTaskInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Worker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Worker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Worker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AnnounceAddrs) > 0 {
+		for iNdEx := len(m.AnnounceAddrs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AnnounceAddrs[iNdEx])
+			copy(dAtA[i:], m.AnnounceAddrs[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.AnnounceAddrs[iNdEx])))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.LastHeartbeat != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LastHeartbeat))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Capabilities != nil {
+		{
+			size, err := m.Capabilities.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.MaxThreads != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxThreads))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Assignments) > 0 {
+		for iNdEx := len(m.Assignments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Assignments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.IpfsId) > 0 {
+		i -= len(m.IpfsId)
+		copy(dAtA[i:], m.IpfsId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.IpfsId)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.PublicIp) > 0 {
+		i -= len(m.PublicIp)
+		copy(dAtA[i:], m.PublicIp)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.PublicIp)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Reputation != nil {
+		{
+			size, err := m.Reputation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Worker_Reputation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Worker_Reputation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Worker_Reputation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RenderDurations) > 0 {
+		dAtA7 := make([]byte, len(m.RenderDurations)*10)
+		var j6 int
+		for _, num1 := range m.RenderDurations {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA7[j6] = uint8(num)
+			j6++
+		}
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintTypes(dAtA, i, uint64(j6))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size, err := m.Winnings.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Solutions != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Solutions))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Validations != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Validations))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Points != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Points))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Staked != nil {
+		{
+			size, err := m.Staked.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Worker_Assignment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Worker_Assignment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Worker_Assignment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ThreadId) > 0 {
+		i -= len(m.ThreadId)
+		copy(dAtA[i:], m.ThreadId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ThreadId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ThreadIndex != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ThreadIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkerCapabilities) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkerCapabilities) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkerCapabilities) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DiskMb != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.DiskMb))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaxInputHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxInputHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxInputWidth != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxInputWidth))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Models) > 0 {
+		for iNdEx := len(m.Models) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Models[iNdEx])
+			copy(dAtA[i:], m.Models[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Models[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Backends) > 0 {
+		for iNdEx := len(m.Backends) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Backends[iNdEx])
+			copy(dAtA[i:], m.Backends[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Backends[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ScaleFactors) > 0 {
+		dAtA11 := make([]byte, len(m.ScaleFactors)*10)
+		var j10 int
+		for _, num1 := range m.ScaleFactors {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j10++
+			}
+			dAtA11[j10] = uint8(num)
+			j10++
+		}
+		i -= j10
+		copy(dAtA[i:], dAtA11[:j10])
+		i = encodeVarintTypes(dAtA, i, uint64(j10))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MemoryMb != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MemoryMb))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CpuCores != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CpuCores))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskRequirements) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskRequirements) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskRequirements) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinDiskMb != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MinDiskMb))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.InputHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.InputHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.InputWidth != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.InputWidth))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Model) > 0 {
+		i -= len(m.Model)
+		copy(dAtA[i:], m.Model)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Model)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Backend) > 0 {
+		i -= len(m.Backend)
+		copy(dAtA[i:], m.Backend)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Backend)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MinMemoryMb != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MinMemoryMb))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MinCpuCores != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MinCpuCores))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VideoUpscalerTask) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VideoUpscalerTask) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VideoUpscalerTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.InputType != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.InputType))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.MediaInfo != nil {
+		{
+			size, err := m.MediaInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.FinalVideo != nil {
+		{
+			size, err := m.FinalVideo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.Options != nil {
+		{
+			size, err := m.Options.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.Expired {
+		i--
+		if m.Expired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.PriorityFee != nil {
+		{
+			size, err := m.PriorityFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.Access != nil {
+		{
+			size, err := m.Access.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.Requirements != nil {
+		{
+			size, err := m.Requirements.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.NextFrame != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.NextFrame))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.TargetThreadSeconds != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TargetThreadSeconds))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.Threads) > 0 {
+		for iNdEx := len(m.Threads) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Threads[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.Reward != nil {
+		{
+			size, err := m.Reward.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Completed {
+		i--
+		if m.Completed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Scale != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Scale))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ThreadAmount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ThreadAmount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.EndFrame != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EndFrame))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StartFrame != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.StartFrame))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Cid) > 0 {
+		i -= len(m.Cid)
+		copy(dAtA[i:], m.Cid)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Cid)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Requester) > 0 {
+		i -= len(m.Requester)
+		copy(dAtA[i:], m.Requester)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Requester)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MediaInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MediaInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MediaInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AudioStreams) > 0 {
+		for iNdEx := len(m.AudioStreams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AudioStreams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.DurationMs != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.DurationMs))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Codec) > 0 {
+		i -= len(m.Codec)
+		copy(dAtA[i:], m.Codec)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Codec)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Width != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Width))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.FrameRate) > 0 {
+		i -= len(m.FrameRate)
+		copy(dAtA[i:], m.FrameRate)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.FrameRate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.FrameCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.FrameCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MediaInfo_AudioStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MediaInfo_AudioStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MediaInfo_AudioStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Language) > 0 {
+		i -= len(m.Language)
+		copy(dAtA[i:], m.Language)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Language)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.SampleRate != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SampleRate))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Channels != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Channels))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Codec) > 0 {
+		i -= len(m.Codec)
+		copy(dAtA[i:], m.Codec)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Codec)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FinalVideo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalVideo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalVideo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Rejections != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Rejections))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Verified {
+		i--
+		if m.Verified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.VerifiedBy) > 0 {
+		i -= len(m.VerifiedBy)
+		copy(dAtA[i:], m.VerifiedBy)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.VerifiedBy)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Cid) > 0 {
+		i -= len(m.Cid)
+		copy(dAtA[i:], m.Cid)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Cid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AssembledBy) > 0 {
+		i -= len(m.AssembledBy)
+		copy(dAtA[i:], m.AssembledBy)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.AssembledBy)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpscaleOptions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpscaleOptions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpscaleOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxFingerprintDistance != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxFingerprintDistance))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Validation != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Validation))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.OutputFormat != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.OutputFormat))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Backend) > 0 {
+		i -= len(m.Backend)
+		copy(dAtA[i:], m.Backend)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Backend)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Deterministic {
+		i--
+		if m.Deterministic {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Model) > 0 {
+		i -= len(m.Model)
+		copy(dAtA[i:], m.Model)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Model)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.NoiseLevel != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.NoiseLevel))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Denoise {
+		i--
+		if m.Denoise {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Scale != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Scale))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskAccess) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskAccess) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskAccess) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WorkerGroup) > 0 {
+		i -= len(m.WorkerGroup)
+		copy(dAtA[i:], m.WorkerGroup)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.WorkerGroup)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DeniedWorkers) > 0 {
+		for iNdEx := len(m.DeniedWorkers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeniedWorkers[iNdEx])
+			copy(dAtA[i:], m.DeniedWorkers[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.DeniedWorkers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.AllowedWorkers) > 0 {
+		for iNdEx := len(m.AllowedWorkers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedWorkers[iNdEx])
+			copy(dAtA[i:], m.AllowedWorkers[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.AllowedWorkers[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkerGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkerGroup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkerGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Members[iNdEx])
+			copy(dAtA[i:], m.Members[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Members[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VideoUpscalerThread) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VideoUpscalerThread) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VideoUpscalerThread) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Failures) > 0 {
+		for iNdEx := len(m.Failures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Failures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.Options != nil {
+		{
+			size, err := m.Options.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.AverageRenderSeconds != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.AverageRenderSeconds))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Validations) > 0 {
+		for iNdEx := len(m.Validations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.Solution != nil {
+		{
+			size, err := m.Solution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Workers) > 0 {
+		for iNdEx := len(m.Workers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Workers[iNdEx])
+			copy(dAtA[i:], m.Workers[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Workers[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.Completed {
+		i--
+		if m.Completed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Scale != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Scale))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.EndFrame != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EndFrame))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartFrame != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.StartFrame))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ThreadId) > 0 {
+		i -= len(m.ThreadId)
+		copy(dAtA[i:], m.ThreadId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ThreadId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VideoUpscalerThread_Failure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VideoUpscalerThread_Failure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VideoUpscalerThread_Failure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Reason != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Worker) > 0 {
+		i -= len(m.Worker)
+		copy(dAtA[i:], m.Worker)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Worker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VideoUpscalerThread_Solution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VideoUpscalerThread_Solution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VideoUpscalerThread_Solution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ImageDigest) > 0 {
+		i -= len(m.ImageDigest)
+		copy(dAtA[i:], m.ImageDigest)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ImageDigest)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Accepted {
+		i--
+		if m.Accepted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Dir) > 0 {
+		i -= len(m.Dir)
+		copy(dAtA[i:], m.Dir)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Dir)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Frames) > 0 {
+		for iNdEx := len(m.Frames) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Frames[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ProposedBy) > 0 {
+		i -= len(m.ProposedBy)
+		copy(dAtA[i:], m.ProposedBy)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ProposedBy)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VideoUpscalerThread_Validation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VideoUpscalerThread_Validation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VideoUpscalerThread_Validation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsReverse {
+		i--
+		if m.IsReverse {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Frames) > 0 {
+		for iNdEx := len(m.Frames) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Frames[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VideoUpscalerThread_Frame) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VideoUpscalerThread_Frame) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VideoUpscalerThread_Frame) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fingerprint) > 0 {
+		i -= len(m.Fingerprint)
+		copy(dAtA[i:], m.Fingerprint)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Fingerprint)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.InvalidCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.InvalidCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ValidCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ValidCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Cid) > 0 {
+		i -= len(m.Cid)
+		copy(dAtA[i:], m.Cid)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Cid)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Filename) > 0 {
+		i -= len(m.Filename)
+		copy(dAtA[i:], m.Filename)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Filename)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskOutputManifest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskOutputManifest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskOutputManifest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.InvalidEntries) > 0 {
+		for iNdEx := len(m.InvalidEntries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InvalidEntries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.DuplicateFrames) > 0 {
+		dAtA22 := make([]byte, len(m.DuplicateFrames)*10)
+		var j21 int
+		for _, num1 := range m.DuplicateFrames {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA22[j21] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j21++
+			}
+			dAtA22[j21] = uint8(num)
+			j21++
+		}
+		i -= j21
+		copy(dAtA[i:], dAtA22[:j21])
+		i = encodeVarintTypes(dAtA, i, uint64(j21))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.MissingFrames) > 0 {
+		dAtA24 := make([]byte, len(m.MissingFrames)*10)
+		var j23 int
+		for _, num1 := range m.MissingFrames {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA24[j23] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j23++
+			}
+			dAtA24[j23] = uint8(num)
+			j23++
+		}
+		i -= j23
+		copy(dAtA[i:], dAtA24[:j23])
+		i = encodeVarintTypes(dAtA, i, uint64(j23))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Completed {
+		i--
+		if m.Completed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Cid) > 0 {
+		i -= len(m.Cid)
+		copy(dAtA[i:], m.Cid)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Cid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskOutputManifest_Entry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskOutputManifest_Entry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskOutputManifest_Entry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Dir) > 0 {
+		i -= len(m.Dir)
+		copy(dAtA[i:], m.Dir)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Dir)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ThreadId) > 0 {
+		i -= len(m.ThreadId)
+		copy(dAtA[i:], m.ThreadId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ThreadId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Cid) > 0 {
+		i -= len(m.Cid)
+		copy(dAtA[i:], m.Cid)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Cid)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Filename) > 0 {
+		i -= len(m.Filename)
+		copy(dAtA[i:], m.Filename)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Filename)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Frame != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Frame))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskOutputManifest_InvalidEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskOutputManifest_InvalidEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskOutputManifest_InvalidEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Filename) > 0 {
+		i -= len(m.Filename)
+		copy(dAtA[i:], m.Filename)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Filename)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ThreadId) > 0 {
+		i -= len(m.ThreadId)
+		copy(dAtA[i:], m.ThreadId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ThreadId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ModuleAccounting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModuleAccounting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModuleAccounting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ModuleBalance) > 0 {
+		for iNdEx := len(m.ModuleBalance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ModuleBalance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.TotalPaidOut) > 0 {
+		for iNdEx := len(m.TotalPaidOut) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalPaidOut[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.TotalStaked) > 0 {
+		for iNdEx := len(m.TotalStaked) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalStaked[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.TotalEscrowed) > 0 {
+		for iNdEx := len(m.TotalEscrowed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalEscrowed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Stakes) > 0 {
+		for iNdEx := len(m.Stakes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stakes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Escrows) > 0 {
+		for iNdEx := len(m.Escrows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Escrows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ModuleAccounting_TaskEscrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModuleAccounting_TaskEscrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModuleAccounting_TaskEscrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Escrowed.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ModuleAccounting_WorkerStake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModuleAccounting_WorkerStake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModuleAccounting_WorkerStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Staked.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Worker) > 0 {
+		i -= len(m.Worker)
+		copy(dAtA[i:], m.Worker)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Worker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VideoUpscalerTaskInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VideoUpscalerTaskInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VideoUpscalerTaskInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.NextId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IndexedVideoUpscalerTask) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IndexedVideoUpscalerTask) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndexedVideoUpscalerTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.VideoUpscalerTask.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VideoUpscalerLogs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VideoUpscalerLogs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VideoUpscalerLogs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Logs) > 0 {
+		for iNdEx := len(m.Logs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Logs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ThreadId) > 0 {
+		i -= len(m.ThreadId)
+		copy(dAtA[i:], m.ThreadId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ThreadId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VideoUpscalerLogs_VideoUpscalerLog) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VideoUpscalerLogs_VideoUpscalerLog) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VideoUpscalerLogs_VideoUpscalerLog) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Severity != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Severity))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Log) > 0 {
+		i -= len(m.Log)
+		copy(dAtA[i:], m.Log)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Log)))
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinWorkerStaking != nil {
+		l = m.MinWorkerStaking.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.MaxWorkersPerThread != 0 {
+		n += 1 + sovTypes(uint64(m.MaxWorkersPerThread))
+	}
+	if m.MinValidators != 0 {
+		n += 1 + sovTypes(uint64(m.MinValidators))
+	}
+	if m.DefaultFrameRenderSeconds != 0 {
+		n += 1 + sovTypes(uint64(m.DefaultFrameRenderSeconds))
+	}
+	if m.MaxMissedHeartbeatBlocks != 0 {
+		n += 1 + sovTypes(uint64(m.MaxMissedHeartbeatBlocks))
+	}
+	if len(m.UpscalerImages) > 0 {
+		for _, e := range m.UpscalerImages {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.MaxFingerprintDistance != 0 {
+		n += 1 + sovTypes(uint64(m.MaxFingerprintDistance))
+	}
+	if m.MinMediaMismatchReports != 0 {
+		n += 1 + sovTypes(uint64(m.MinMediaMismatchReports))
+	}
+	return n
+}
+
+func (m *UpscalerImage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Backend)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Image)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.VideoUpscalerTaskInfo.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.VideoUpscalerTaskList) > 0 {
+		for _, e := range m.VideoUpscalerTaskList {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Workers) > 0 {
+		for _, e := range m.Workers {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.TaskEscrows) > 0 {
+		for _, e := range m.TaskEscrows {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.WorkerStakes) > 0 {
+		for _, e := range m.WorkerStakes {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.PaidOut) > 0 {
+		for _, e := range m.PaidOut {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Worker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Reputation != nil {
+		l = m.Reputation.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	l = len(m.PublicIp)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.IpfsId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Assignments) > 0 {
+		for _, e := range m.Assignments {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.MaxThreads != 0 {
+		n += 1 + sovTypes(uint64(m.MaxThreads))
+	}
+	if m.Capabilities != nil {
+		l = m.Capabilities.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.LastHeartbeat != 0 {
+		n += 1 + sovTypes(uint64(m.LastHeartbeat))
+	}
+	if len(m.AnnounceAddrs) > 0 {
+		for _, s := range m.AnnounceAddrs {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Worker_Reputation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Staked != nil {
+		l = m.Staked.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Points != 0 {
+		n += 1 + sovTypes(uint64(m.Points))
+	}
+	if m.Validations != 0 {
+		n += 1 + sovTypes(uint64(m.Validations))
+	}
+	if m.Solutions != 0 {
+		n += 1 + sovTypes(uint64(m.Solutions))
+	}
+	l = m.Winnings.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.RenderDurations) > 0 {
+		l = 0
+		for _, e := range m.RenderDurations {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *Worker_Assignment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ThreadIndex != 0 {
+		n += 1 + sovTypes(uint64(m.ThreadIndex))
+	}
+	l = len(m.ThreadId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *WorkerCapabilities) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CpuCores != 0 {
+		n += 1 + sovTypes(uint64(m.CpuCores))
+	}
+	if m.MemoryMb != 0 {
+		n += 1 + sovTypes(uint64(m.MemoryMb))
+	}
+	if len(m.ScaleFactors) > 0 {
+		l = 0
+		for _, e := range m.ScaleFactors {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	if len(m.Backends) > 0 {
+		for _, s := range m.Backends {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Models) > 0 {
+		for _, s := range m.Models {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.MaxInputWidth != 0 {
+		n += 1 + sovTypes(uint64(m.MaxInputWidth))
+	}
+	if m.MaxInputHeight != 0 {
+		n += 1 + sovTypes(uint64(m.MaxInputHeight))
+	}
+	if m.DiskMb != 0 {
+		n += 1 + sovTypes(uint64(m.DiskMb))
+	}
+	return n
+}
+
+func (m *TaskRequirements) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinCpuCores != 0 {
+		n += 1 + sovTypes(uint64(m.MinCpuCores))
+	}
+	if m.MinMemoryMb != 0 {
+		n += 1 + sovTypes(uint64(m.MinMemoryMb))
+	}
+	l = len(m.Backend)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Model)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.InputWidth != 0 {
+		n += 1 + sovTypes(uint64(m.InputWidth))
+	}
+	if m.InputHeight != 0 {
+		n += 1 + sovTypes(uint64(m.InputHeight))
+	}
+	if m.MinDiskMb != 0 {
+		n += 1 + sovTypes(uint64(m.MinDiskMb))
+	}
+	return n
+}
+
+func (m *VideoUpscalerTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Requester)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Cid)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.StartFrame != 0 {
+		n += 1 + sovTypes(uint64(m.StartFrame))
+	}
+	if m.EndFrame != 0 {
+		n += 1 + sovTypes(uint64(m.EndFrame))
+	}
+	if m.ThreadAmount != 0 {
+		n += 1 + sovTypes(uint64(m.ThreadAmount))
+	}
+	if m.Scale != 0 {
+		n += 1 + sovTypes(uint64(m.Scale))
+	}
+	if m.Completed {
+		n += 2
+	}
+	if m.Reward != nil {
+		l = m.Reward.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Threads) > 0 {
+		for _, e := range m.Threads {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.TargetThreadSeconds != 0 {
+		n += 1 + sovTypes(uint64(m.TargetThreadSeconds))
+	}
+	if m.NextFrame != 0 {
+		n += 1 + sovTypes(uint64(m.NextFrame))
+	}
+	if m.Requirements != nil {
+		l = m.Requirements.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Access != nil {
+		l = m.Access.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovTypes(uint64(m.ExpiryHeight))
+	}
+	if m.PriorityFee != nil {
+		l = m.PriorityFee.Size()
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	if m.Expired {
+		n += 3
+	}
+	if m.Options != nil {
+		l = m.Options.Size()
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	if m.FinalVideo != nil {
+		l = m.FinalVideo.Size()
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	if m.MediaInfo != nil {
+		l = m.MediaInfo.Size()
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	if m.InputType != 0 {
+		n += 2 + sovTypes(uint64(m.InputType))
+	}
+	return n
+}
+
+func (m *MediaInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FrameCount != 0 {
+		n += 1 + sovTypes(uint64(m.FrameCount))
+	}
+	l = len(m.FrameRate)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Width != 0 {
+		n += 1 + sovTypes(uint64(m.Width))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	l = len(m.Codec)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.DurationMs != 0 {
+		n += 1 + sovTypes(uint64(m.DurationMs))
+	}
+	if len(m.AudioStreams) > 0 {
+		for _, e := range m.AudioStreams {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MediaInfo_AudioStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovTypes(uint64(m.Index))
+	}
+	l = len(m.Codec)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Channels != 0 {
+		n += 1 + sovTypes(uint64(m.Channels))
+	}
+	if m.SampleRate != 0 {
+		n += 1 + sovTypes(uint64(m.SampleRate))
+	}
+	l = len(m.Language)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *FinalVideo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AssembledBy)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Cid)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	l = len(m.VerifiedBy)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Verified {
+		n += 2
+	}
+	if m.Rejections != 0 {
+		n += 1 + sovTypes(uint64(m.Rejections))
+	}
+	return n
+}
+
+func (m *UpscaleOptions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Scale != 0 {
+		n += 1 + sovTypes(uint64(m.Scale))
+	}
+	if m.Denoise {
+		n += 2
+	}
+	if m.NoiseLevel != 0 {
+		n += 1 + sovTypes(uint64(m.NoiseLevel))
+	}
+	l = len(m.Model)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Deterministic {
+		n += 2
+	}
+	l = len(m.Backend)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.OutputFormat != 0 {
+		n += 1 + sovTypes(uint64(m.OutputFormat))
+	}
+	if m.Validation != 0 {
+		n += 1 + sovTypes(uint64(m.Validation))
+	}
+	if m.MaxFingerprintDistance != 0 {
+		n += 1 + sovTypes(uint64(m.MaxFingerprintDistance))
+	}
+	return n
+}
+
+func (m *TaskAccess) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowedWorkers) > 0 {
+		for _, s := range m.AllowedWorkers {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.DeniedWorkers) > 0 {
+		for _, s := range m.DeniedWorkers {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = len(m.WorkerGroup)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *WorkerGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Members) > 0 {
+		for _, s := range m.Members {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *VideoUpscalerThread) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ThreadId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.StartFrame != 0 {
+		n += 1 + sovTypes(uint64(m.StartFrame))
+	}
+	if m.EndFrame != 0 {
+		n += 1 + sovTypes(uint64(m.EndFrame))
+	}
+	if m.Scale != 0 {
+		n += 1 + sovTypes(uint64(m.Scale))
+	}
+	if m.Completed {
+		n += 2
+	}
+	if len(m.Workers) > 0 {
+		for _, s := range m.Workers {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.Solution != nil {
+		l = m.Solution.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Validations) > 0 {
+		for _, e := range m.Validations {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.AverageRenderSeconds != 0 {
+		n += 1 + sovTypes(uint64(m.AverageRenderSeconds))
+	}
+	if m.Options != nil {
+		l = m.Options.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Failures) > 0 {
+		for _, e := range m.Failures {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *VideoUpscalerThread_Failure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Worker)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Reason != 0 {
+		n += 1 + sovTypes(uint64(m.Reason))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *VideoUpscalerThread_Solution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProposedBy)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Frames) > 0 {
+		for _, e := range m.Frames {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Dir)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Accepted {
+		n += 2
+	}
+	l = len(m.ImageDigest)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *VideoUpscalerThread_Validation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Frames) > 0 {
+		for _, e := range m.Frames {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.IsReverse {
+		n += 2
+	}
+	return n
+}
+
+func (m *VideoUpscalerThread_Frame) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Filename)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Cid)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ValidCount != 0 {
+		n += 1 + sovTypes(uint64(m.ValidCount))
+	}
+	if m.InvalidCount != 0 {
+		n += 1 + sovTypes(uint64(m.InvalidCount))
+	}
+	l = len(m.Fingerprint)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *TaskOutputManifest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Cid)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Completed {
+		n += 2
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.MissingFrames) > 0 {
+		l = 0
+		for _, e := range m.MissingFrames {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	if len(m.DuplicateFrames) > 0 {
+		l = 0
+		for _, e := range m.DuplicateFrames {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	if len(m.InvalidEntries) > 0 {
+		for _, e := range m.InvalidEntries {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TaskOutputManifest_Entry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Frame != 0 {
+		n += 1 + sovTypes(uint64(m.Frame))
+	}
+	l = len(m.Filename)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Cid)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ThreadId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Dir)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *TaskOutputManifest_InvalidEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ThreadId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Filename)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ModuleAccounting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Escrows) > 0 {
+		for _, e := range m.Escrows {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Stakes) > 0 {
+		for _, e := range m.Stakes {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.TotalEscrowed) > 0 {
+		for _, e := range m.TotalEscrowed {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.TotalStaked) > 0 {
+		for _, e := range m.TotalStaked {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.TotalPaidOut) > 0 {
+		for _, e := range m.TotalPaidOut {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.ModuleBalance) > 0 {
+		for _, e := range m.ModuleBalance {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ModuleAccounting_TaskEscrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Escrowed.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *ModuleAccounting_WorkerStake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Worker)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Staked.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *VideoUpscalerTaskInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NextId != 0 {
+		n += 1 + sovTypes(uint64(m.NextId))
+	}
+	return n
+}
+
+func (m *IndexedVideoUpscalerTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.VideoUpscalerTask.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *VideoUpscalerLogs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ThreadId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Logs) > 0 {
+		for _, e := range m.Logs {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *VideoUpscalerLogs_VideoUpscalerLog) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Log)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovTypes(uint64(m.Timestamp))
+	}
+	if m.Severity != 0 {
+		n += 1 + sovTypes(uint64(m.Severity))
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
 	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinWorkerStaking", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MinWorkerStaking == nil {
+				m.MinWorkerStaking = &types.Coin{}
+			}
+			if err := m.MinWorkerStaking.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxWorkersPerThread", wireType)
+			}
+			m.MaxWorkersPerThread = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxWorkersPerThread |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinValidators", wireType)
+			}
+			m.MinValidators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinValidators |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultFrameRenderSeconds", wireType)
+			}
+			m.DefaultFrameRenderSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DefaultFrameRenderSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMissedHeartbeatBlocks", wireType)
+			}
+			m.MaxMissedHeartbeatBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMissedHeartbeatBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpscalerImages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpscalerImages = append(m.UpscalerImages, UpscalerImage{})
+			if err := m.UpscalerImages[len(m.UpscalerImages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFingerprintDistance", wireType)
+			}
+			m.MaxFingerprintDistance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFingerprintDistance |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinMediaMismatchReports", wireType)
+			}
+			m.MinMediaMismatchReports = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinMediaMismatchReports |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpscalerImage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpscalerImage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpscalerImage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Backend", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Backend = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Image = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VideoUpscalerTaskInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VideoUpscalerTaskInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VideoUpscalerTaskList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VideoUpscalerTaskList = append(m.VideoUpscalerTaskList, IndexedVideoUpscalerTask{})
+			if err := m.VideoUpscalerTaskList[len(m.VideoUpscalerTaskList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Workers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Workers = append(m.Workers, Worker{})
+			if err := m.Workers[len(m.Workers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskEscrows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskEscrows = append(m.TaskEscrows, ModuleAccounting_TaskEscrow{})
+			if err := m.TaskEscrows[len(m.TaskEscrows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerStakes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkerStakes = append(m.WorkerStakes, ModuleAccounting_WorkerStake{})
+			if err := m.WorkerStakes[len(m.WorkerStakes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaidOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaidOut = append(m.PaidOut, types.Coin{})
+			if err := m.PaidOut[len(m.PaidOut)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Worker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Worker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Worker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Reputation == nil {
+				m.Reputation = &Worker_Reputation{}
+			}
+			if err := m.Reputation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicIp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicIp = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IpfsId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IpfsId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assignments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Assignments = append(m.Assignments, &Worker_Assignment{})
+			if err := m.Assignments[len(m.Assignments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxThreads", wireType)
+			}
+			m.MaxThreads = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxThreads |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capabilities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Capabilities == nil {
+				m.Capabilities = &WorkerCapabilities{}
+			}
+			if err := m.Capabilities.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHeartbeat", wireType)
+			}
+			m.LastHeartbeat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastHeartbeat |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnounceAddrs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AnnounceAddrs = append(m.AnnounceAddrs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Worker_Reputation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Reputation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Reputation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Staked", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Staked == nil {
+				m.Staked = &types.Coin{}
+			}
+			if err := m.Staked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Points", wireType)
+			}
+			m.Points = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Points |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validations", wireType)
+			}
+			m.Validations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Validations |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Solutions", wireType)
+			}
+			m.Solutions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Solutions |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Winnings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Winnings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RenderDurations = append(m.RenderDurations, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RenderDurations) == 0 {
+					m.RenderDurations = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RenderDurations = append(m.RenderDurations, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RenderDurations", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Worker_Assignment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Assignment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Assignment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThreadIndex", wireType)
+			}
+			m.ThreadIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ThreadIndex |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThreadId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ThreadId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkerCapabilities) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkerCapabilities: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkerCapabilities: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CpuCores", wireType)
+			}
+			m.CpuCores = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CpuCores |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryMb", wireType)
+			}
+			m.MemoryMb = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemoryMb |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ScaleFactors = append(m.ScaleFactors, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ScaleFactors) == 0 {
+					m.ScaleFactors = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ScaleFactors = append(m.ScaleFactors, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScaleFactors", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Backends", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Backends = append(m.Backends, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Models", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Models = append(m.Models, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInputWidth", wireType)
+			}
+			m.MaxInputWidth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxInputWidth |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInputHeight", wireType)
+			}
+			m.MaxInputHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxInputHeight |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiskMb", wireType)
+			}
+			m.DiskMb = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DiskMb |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskRequirements) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskRequirements: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskRequirements: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCpuCores", wireType)
+			}
+			m.MinCpuCores = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinCpuCores |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinMemoryMb", wireType)
+			}
+			m.MinMemoryMb = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinMemoryMb |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Backend", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Backend = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Model", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Model = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InputWidth", wireType)
+			}
+			m.InputWidth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InputWidth |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InputHeight", wireType)
+			}
+			m.InputHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InputHeight |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDiskMb", wireType)
+			}
+			m.MinDiskMb = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinDiskMb |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VideoUpscalerTask) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VideoUpscalerTask: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VideoUpscalerTask: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requester = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartFrame", wireType)
+			}
+			m.StartFrame = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartFrame |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndFrame", wireType)
+			}
+			m.EndFrame = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndFrame |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThreadAmount", wireType)
+			}
+			m.ThreadAmount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ThreadAmount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scale", wireType)
+			}
+			m.Scale = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Scale |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Completed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Completed = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Reward == nil {
+				m.Reward = &types.Coin{}
+			}
+			if err := m.Reward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threads", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Threads = append(m.Threads, &VideoUpscalerThread{})
+			if err := m.Threads[len(m.Threads)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetThreadSeconds", wireType)
+			}
+			m.TargetThreadSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetThreadSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextFrame", wireType)
+			}
+			m.NextFrame = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextFrame |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requirements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Requirements == nil {
+				m.Requirements = &TaskRequirements{}
+			}
+			if err := m.Requirements.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Access", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Access == nil {
+				m.Access = &TaskAccess{}
+			}
+			if err := m.Access.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PriorityFee == nil {
+				m.PriorityFee = &types.Coin{}
+			}
+			if err := m.PriorityFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Expired = bool(v != 0)
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Options == nil {
+				m.Options = &UpscaleOptions{}
+			}
+			if err := m.Options.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalVideo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FinalVideo == nil {
+				m.FinalVideo = &FinalVideo{}
+			}
+			if err := m.FinalVideo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MediaInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MediaInfo == nil {
+				m.MediaInfo = &MediaInfo{}
+			}
+			if err := m.MediaInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InputType", wireType)
+			}
+			m.InputType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InputType |= InputType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MediaInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MediaInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MediaInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrameCount", wireType)
+			}
+			m.FrameCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FrameCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrameRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrameRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Width", wireType)
+			}
+			m.Width = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Width |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Codec", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Codec = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationMs", wireType)
+			}
+			m.DurationMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DurationMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AudioStreams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AudioStreams = append(m.AudioStreams, &MediaInfo_AudioStream{})
+			if err := m.AudioStreams[len(m.AudioStreams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MediaInfo_AudioStream) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AudioStream: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AudioStream: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Codec", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Codec = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channels", wireType)
+			}
+			m.Channels = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Channels |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SampleRate", wireType)
+			}
+			m.SampleRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SampleRate |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Language", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Language = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FinalVideo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FinalVideo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FinalVideo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssembledBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssembledBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifiedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerifiedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verified", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Verified = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rejections", wireType)
+			}
+			m.Rejections = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rejections |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpscaleOptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpscaleOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpscaleOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scale", wireType)
+			}
+			m.Scale = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Scale |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoise", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Denoise = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoiseLevel", wireType)
+			}
+			m.NoiseLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NoiseLevel |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Model", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Model = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deterministic", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deterministic = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Backend", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Backend = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputFormat", wireType)
+			}
+			m.OutputFormat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutputFormat |= OutputFormat(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validation", wireType)
+			}
+			m.Validation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Validation |= ValidationMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFingerprintDistance", wireType)
+			}
+			m.MaxFingerprintDistance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFingerprintDistance |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskAccess) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskAccess: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskAccess: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedWorkers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedWorkers = append(m.AllowedWorkers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeniedWorkers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeniedWorkers = append(m.DeniedWorkers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerGroup", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkerGroup = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkerGroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkerGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkerGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VideoUpscalerThread) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2343,28 +9725,201 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowTypes
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VideoUpscalerThread: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VideoUpscalerThread: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThreadId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.ThreadId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartFrame", wireType)
+			}
+			m.StartFrame = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartFrame |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndFrame", wireType)
+			}
+			m.EndFrame = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndFrame |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scale", wireType)
+			}
+			m.Scale = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Scale |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Completed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Completed = bool(v != 0)
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinWorkerStaking", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Workers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Workers = append(m.Workers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Solution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2391,18 +9946,18 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MinWorkerStaking == nil {
-				m.MinWorkerStaking = &types.Coin{}
+			if m.Solution == nil {
+				m.Solution = &VideoUpscalerThread_Solution{}
 			}
-			if err := m.MinWorkerStaking.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Solution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxWorkersPerThread", wireType)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validations", wireType)
 			}
-			m.MaxWorkersPerThread = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2412,16 +9967,86 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxWorkersPerThread |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validations = append(m.Validations, &VideoUpscalerThread_Validation{})
+			if err := m.Validations[len(m.Validations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinValidators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageRenderSeconds", wireType)
+			}
+			m.AverageRenderSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AverageRenderSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Options == nil {
+				m.Options = &UpscaleOptions{}
+			}
+			if err := m.Options.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failures", wireType)
 			}
-			m.MinValidators = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2431,11 +10056,26 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinValidators |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Failures = append(m.Failures, &VideoUpscalerThread_Failure{})
+			if err := m.Failures[len(m.Failures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2457,7 +10097,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *VideoUpscalerThread_Failure) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2480,17 +10120,17 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: Failure: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Failure: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2500,30 +10140,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Worker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VideoUpscalerTaskInfo", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			m.Reason = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2533,30 +10172,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Reason |= ThreadFailureReason(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.VideoUpscalerTaskInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VideoUpscalerTaskList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2566,31 +10191,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VideoUpscalerTaskList = append(m.VideoUpscalerTaskList, IndexedVideoUpscalerTask{})
-			if err := m.VideoUpscalerTaskList[len(m.VideoUpscalerTaskList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Workers", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var msglen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2600,26 +10223,11 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Workers = append(m.Workers, Worker{})
-			if err := m.Workers[len(m.Workers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2641,7 +10249,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Worker) Unmarshal(dAtA []byte) error {
+func (m *VideoUpscalerThread_Solution) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2664,15 +10272,15 @@ func (m *Worker) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Worker: wiretype end group for non-group")
+			return fmt.Errorf("proto: Solution: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Worker: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Solution: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposedBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2700,11 +10308,11 @@ func (m *Worker) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.ProposedBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reputation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Frames", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2731,16 +10339,78 @@ func (m *Worker) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Reputation == nil {
-				m.Reputation = &Worker_Reputation{}
-			}
-			if err := m.Reputation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Frames = append(m.Frames, &VideoUpscalerThread_Frame{})
+			if err := m.Frames[len(m.Frames)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dir", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dir = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Accepted", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -2757,10 +10427,10 @@ func (m *Worker) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Enabled = bool(v != 0)
-		case 5:
+			m.Accepted = bool(v != 0)
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTaskId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageDigest", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2788,13 +10458,63 @@ func (m *Worker) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrentTaskId = string(dAtA[iNdEx:postIndex])
+			m.ImageDigest = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentThreadIndex", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VideoUpscalerThread_Validation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Validation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Validation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
-			m.CurrentThreadIndex = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2804,16 +10524,29 @@ func (m *Worker) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CurrentThreadIndex |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicIp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Frames", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2823,27 +10556,29 @@ func (m *Worker) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PublicIp = string(dAtA[iNdEx:postIndex])
+			m.Frames = append(m.Frames, &VideoUpscalerThread_Frame{})
+			if err := m.Frames[len(m.Frames)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IpfsId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2871,8 +10606,28 @@ func (m *Worker) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IpfsId = string(dAtA[iNdEx:postIndex])
+			m.PublicKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsReverse", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsReverse = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2894,7 +10649,7 @@ func (m *Worker) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Worker_Reputation) Unmarshal(dAtA []byte) error {
+func (m *VideoUpscalerThread_Frame) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2917,17 +10672,17 @@ func (m *Worker_Reputation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Reputation: wiretype end group for non-group")
+			return fmt.Errorf("proto: Frame: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Reputation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Frame: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Staked", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filename", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2937,33 +10692,29 @@ func (m *Worker_Reputation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Staked == nil {
-				m.Staked = &types.Coin{}
-			}
-			if err := m.Staked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Filename = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Points", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
 			}
-			m.Points = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2973,16 +10724,29 @@ func (m *Worker_Reputation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Points |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validations", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cid", wireType)
 			}
-			m.Validations = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2992,16 +10756,29 @@ func (m *Worker_Reputation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Validations |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Solutions", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
-			m.Solutions = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3011,16 +10788,67 @@ func (m *Worker_Reputation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Solutions |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidCount", wireType)
+			}
+			m.ValidCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidCount", wireType)
+			}
+			m.InvalidCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InvalidCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Winnings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fingerprint", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3030,101 +10858,24 @@ func (m *Worker_Reputation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Winnings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Fingerprint = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTypes
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.RenderDurations = append(m.RenderDurations, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTypes
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTypes
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTypes
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.RenderDurations) == 0 {
-					m.RenderDurations = make([]int64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTypes
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.RenderDurations = append(m.RenderDurations, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field RenderDurations", wireType)
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -3146,7 +10897,7 @@ func (m *Worker_Reputation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VideoUpscalerTask) Unmarshal(dAtA []byte) error {
+func (m *TaskOutputManifest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3169,10 +10920,10 @@ func (m *VideoUpscalerTask) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VideoUpscalerTask: wiretype end group for non-group")
+			return fmt.Errorf("proto: TaskOutputManifest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VideoUpscalerTask: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TaskOutputManifest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3208,38 +10959,6 @@ func (m *VideoUpscalerTask) Unmarshal(dAtA []byte) error {
 			m.TaskId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Requester = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Cid", wireType)
 			}
@@ -3271,83 +10990,7 @@ func (m *VideoUpscalerTask) Unmarshal(dAtA []byte) error {
 			}
 			m.Cid = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartFrame", wireType)
-			}
-			m.StartFrame = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StartFrame |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndFrame", wireType)
-			}
-			m.EndFrame = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EndFrame |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ThreadAmount", wireType)
-			}
-			m.ThreadAmount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ThreadAmount |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scale", wireType)
-			}
-			m.Scale = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Scale |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
+		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Completed", wireType)
 			}
@@ -3367,9 +11010,9 @@ func (m *VideoUpscalerTask) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Completed = bool(v != 0)
-		case 9:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3396,16 +11039,166 @@ func (m *VideoUpscalerTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Reward == nil {
-				m.Reward = &types.Coin{}
-			}
-			if err := m.Reward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Entries = append(m.Entries, &TaskOutputManifest_Entry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 10:
+			iNdEx = postIndex
+		case 5:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MissingFrames = append(m.MissingFrames, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MissingFrames) == 0 {
+					m.MissingFrames = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MissingFrames = append(m.MissingFrames, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingFrames", wireType)
+			}
+		case 6:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.DuplicateFrames = append(m.DuplicateFrames, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.DuplicateFrames) == 0 {
+					m.DuplicateFrames = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.DuplicateFrames = append(m.DuplicateFrames, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field DuplicateFrames", wireType)
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Threads", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidEntries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3432,8 +11225,8 @@ func (m *VideoUpscalerTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Threads = append(m.Threads, &VideoUpscalerThread{})
-			if err := m.Threads[len(m.Threads)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.InvalidEntries = append(m.InvalidEntries, &TaskOutputManifest_InvalidEntry{})
+			if err := m.InvalidEntries[len(m.InvalidEntries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3458,7 +11251,7 @@ func (m *VideoUpscalerTask) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VideoUpscalerThread) Unmarshal(dAtA []byte) error {
+func (m *TaskOutputManifest_Entry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3481,17 +11274,17 @@ func (m *VideoUpscalerThread) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VideoUpscalerThread: wiretype end group for non-group")
+			return fmt.Errorf("proto: Entry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VideoUpscalerThread: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Entry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ThreadId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frame", wireType)
 			}
-			var stringLen uint64
+			m.Frame = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3501,27 +11294,14 @@ func (m *VideoUpscalerThread) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Frame |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ThreadId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filename", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3549,13 +11329,13 @@ func (m *VideoUpscalerThread) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaskId = string(dAtA[iNdEx:postIndex])
+			m.Filename = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartFrame", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cid", wireType)
 			}
-			m.StartFrame = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3565,35 +11345,29 @@ func (m *VideoUpscalerThread) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartFrame |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndFrame", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
 			}
-			m.EndFrame = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EndFrame |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scale", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Scale = 0
+			m.Cid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3603,34 +11377,27 @@ func (m *VideoUpscalerThread) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Scale |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Completed", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
 			}
-			m.Completed = bool(v != 0)
-		case 7:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Workers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ThreadId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3658,13 +11425,13 @@ func (m *VideoUpscalerThread) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Workers = append(m.Workers, string(dAtA[iNdEx:postIndex]))
+			m.ThreadId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Solution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dir", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3674,33 +11441,79 @@ func (m *VideoUpscalerThread) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Solution == nil {
-				m.Solution = &VideoUpscalerThread_Solution{}
-			}
-			if err := m.Solution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Dir = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 9:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskOutputManifest_InvalidEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InvalidEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InvalidEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ThreadId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3710,31 +11523,29 @@ func (m *VideoUpscalerThread) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validations = append(m.Validations, &VideoUpscalerThread_Validation{})
-			if err := m.Validations[len(m.Validations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ThreadId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AverageRenderSeconds", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filename", wireType)
 			}
-			m.AverageRenderSeconds = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3744,11 +11555,24 @@ func (m *VideoUpscalerThread) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AverageRenderSeconds |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Filename = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -3770,7 +11594,7 @@ func (m *VideoUpscalerThread) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VideoUpscalerThread_Solution) Unmarshal(dAtA []byte) error {
+func (m *ModuleAccounting) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3793,17 +11617,51 @@ func (m *VideoUpscalerThread_Solution) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Solution: wiretype end group for non-group")
+			return fmt.Errorf("proto: ModuleAccounting: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Solution: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ModuleAccounting: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Escrows = append(m.Escrows, &ModuleAccounting_TaskEscrow{})
+			if err := m.Escrows[len(m.Escrows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stakes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3813,27 +11671,29 @@ func (m *VideoUpscalerThread_Solution) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProposedBy = string(dAtA[iNdEx:postIndex])
+			m.Stakes = append(m.Stakes, &ModuleAccounting_WorkerStake{})
+			if err := m.Stakes[len(m.Stakes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Frames", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalEscrowed", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3860,16 +11720,16 @@ func (m *VideoUpscalerThread_Solution) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Frames = append(m.Frames, &VideoUpscalerThread_Frame{})
-			if err := m.Frames[len(m.Frames)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TotalEscrowed = append(m.TotalEscrowed, types.Coin{})
+			if err := m.TotalEscrowed[len(m.TotalEscrowed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalStaked", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3879,29 +11739,31 @@ func (m *VideoUpscalerThread_Solution) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PublicKey = string(dAtA[iNdEx:postIndex])
+			m.TotalStaked = append(m.TotalStaked, types.Coin{})
+			if err := m.TotalStaked[len(m.TotalStaked)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dir", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPaidOut", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3911,29 +11773,31 @@ func (m *VideoUpscalerThread_Solution) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Dir = string(dAtA[iNdEx:postIndex])
+			m.TotalPaidOut = append(m.TotalPaidOut, types.Coin{})
+			if err := m.TotalPaidOut[len(m.TotalPaidOut)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Accepted", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleBalance", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3943,12 +11807,26 @@ func (m *VideoUpscalerThread_Solution) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Accepted = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleBalance = append(m.ModuleBalance, types.Coin{})
+			if err := m.ModuleBalance[len(m.ModuleBalance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -3970,7 +11848,7 @@ func (m *VideoUpscalerThread_Solution) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VideoUpscalerThread_Validation) Unmarshal(dAtA []byte) error {
+func (m *ModuleAccounting_TaskEscrow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3993,15 +11871,15 @@ func (m *VideoUpscalerThread_Validation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Validation: wiretype end group for non-group")
+			return fmt.Errorf("proto: TaskEscrow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Validation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TaskEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4029,11 +11907,11 @@ func (m *VideoUpscalerThread_Validation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validator = string(dAtA[iNdEx:postIndex])
+			m.TaskId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Frames", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrowed", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4060,63 +11938,10 @@ func (m *VideoUpscalerThread_Validation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Frames = append(m.Frames, &VideoUpscalerThread_Frame{})
-			if err := m.Frames[len(m.Frames)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Escrowed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PublicKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsReverse", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsReverse = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -4138,7 +11963,7 @@ func (m *VideoUpscalerThread_Validation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VideoUpscalerThread_Frame) Unmarshal(dAtA []byte) error {
+func (m *ModuleAccounting_WorkerStake) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4161,15 +11986,15 @@ func (m *VideoUpscalerThread_Frame) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Frame: wiretype end group for non-group")
+			return fmt.Errorf("proto: WorkerStake: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Frame: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WorkerStake: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filename", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4197,45 +12022,13 @@ func (m *VideoUpscalerThread_Frame) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Filename = string(dAtA[iNdEx:postIndex])
+			m.Worker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Signature = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Staked", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4245,94 +12038,25 @@ func (m *VideoUpscalerThread_Frame) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cid = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Staked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidCount", wireType)
-			}
-			m.ValidCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ValidCount |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InvalidCount", wireType)
-			}
-			m.InvalidCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.InvalidCount |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
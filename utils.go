@@ -2,15 +2,26 @@ package videoUpscaler
 
 import (
 	"crypto/sha256"
+	"encoding/binary"
 	"encoding/hex"
 	fmt "fmt"
 	"image"
+	"image/color"
+	"io"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"runtime"
 	"strings"
+	"sync"
 
 	"github.com/janction/videoUpscaler/videoUpscalerLogger"
+
+	// decoders of the output formats the frames can be upscaled to
+	_ "image/png"
+
+	_ "golang.org/x/image/tiff"
+	_ "golang.org/x/image/webp"
 )
 
 // Transforms a slice with format [key]=[value] to a map
@@ -74,12 +85,16 @@ func FromCliToFrames(entries []string) map[string]VideoUpscalerThread_Frame {
 		}
 
 		filename := parts[0]
+		// the fingerprint is only revealed by threads validated by fingerprint
 		cidAndHash := strings.Split(parts[1], ":")
-		if len(cidAndHash) != 2 {
+		if len(cidAndHash) != 2 && len(cidAndHash) != 3 {
 			fmt.Println("Invalid CID:Hash format:", parts[1])
 			continue
 		}
 		frame := VideoUpscalerThread_Frame{Filename: filename, Cid: cidAndHash[0], Hash: cidAndHash[1]}
+		if len(cidAndHash) == 3 {
+			frame.Fingerprint = cidAndHash[2]
+		}
 		result[filename] = frame
 	}
 
@@ -91,6 +106,9 @@ func FromFramesToCli(frames map[string]VideoUpscalerThread_Frame) []string {
 
 	for filename, frame := range frames {
 		entry := fmt.Sprintf("%s=%s:%s", filename, frame.Cid, frame.Hash)
+		if frame.Fingerprint != "" {
+			entry += ":" + frame.Fingerprint
+		}
 		result = append(result, entry)
 	}
 
@@ -99,77 +117,167 @@ func FromFramesToCli(frames map[string]VideoUpscalerThread_Frame) []string {
 
 // CalculateFileHash calculates the SHA-256 hash of a given file.
 func CalculateFileHash(filePath string) (string, error) {
-	hash, err := calculateImagePixelHash(filePath)
+	hash, _, _, err := calculateImageInfo(filePath)
 	if err != nil {
 		return "", err
 	}
 	return hash, nil
 }
 
-// CalculateImagePixelHash computes the SHA-256 hash of an image based only on pixel values.
-func calculateImagePixelHash(filePath string) (string, error) {
+// calculateImageInfo computes the SHA-256 hash of an image based only on pixel values, and returns it with the dimensions of the image.
+// Pixels are hashed as non-premultiplied RGBA with 16 bits per channel, whatever the format and color model of the file,
+// so the same pixels stored as PNG, lossless WebP or 16 bit TIFF get the same hash
+func calculateImageInfo(filePath string) (string, int32, int32, error) {
 	file, err := os.Open(filePath)
 	if err != nil {
-		return "", err
+		return "", 0, 0, err
 	}
 	defer file.Close()
 
 	// Decode the image
-	img, format, err := image.Decode(file)
+	img, _, err := image.Decode(file)
 	if err != nil {
-		return "", fmt.Errorf("failed to decode image: %w", err)
+		return "", 0, 0, fmt.Errorf("failed to decode image: %w", err)
 	}
 
-	fmt.Println("Image format:", format) // Debugging purpose
-
-	// Convert image pixels to a byte slice
+	hasher := sha256.New()
+	writePixels(hasher, img)
 	bounds := img.Bounds()
-	var pixelData []byte
+	return hex.EncodeToString(hasher.Sum(nil)), int32(bounds.Dx()), int32(bounds.Dy()), nil
+}
 
-	// Extract RGB(A) pixel data
+// writePixels writes the pixels of the image row by row as big endian non-premultiplied RGBA with 16 bits per channel,
+// the same values color.NRGBA64Model gives for each pixel. The color models decoders return for the output formats
+// are converted straight from their pixel buffers, any other model goes through color.NRGBA64Model
+func writePixels(w io.Writer, img image.Image) {
+	bounds := img.Bounds()
+	row := make([]byte, bounds.Dx()*8)
 	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
-		for x := bounds.Min.X; x < bounds.Max.X; x++ {
-			r, g, b, a := img.At(x, y).RGBA()
-			pixelData = append(pixelData,
-				byte(r>>8), byte(g>>8), byte(b>>8), byte(a>>8)) // Convert 16-bit values to 8-bit
+		switch src := img.(type) {
+		case *image.NRGBA64:
+			// already in the hashed representation
+			i := src.PixOffset(bounds.Min.X, y)
+			copy(row, src.Pix[i:i+len(row)])
+		case *image.RGBA64:
+			pix := src.Pix[src.PixOffset(bounds.Min.X, y):]
+			for x := 0; x < len(row); x += 8 {
+				putNRGBA64(row[x:],
+					uint32(binary.BigEndian.Uint16(pix[x:])),
+					uint32(binary.BigEndian.Uint16(pix[x+2:])),
+					uint32(binary.BigEndian.Uint16(pix[x+4:])),
+					uint32(binary.BigEndian.Uint16(pix[x+6:])))
+			}
+		case *image.RGBA:
+			pix := src.Pix[src.PixOffset(bounds.Min.X, y):]
+			for x := 0; x < len(row)/2; x += 4 {
+				putNRGBA64(row[x*2:], uint32(pix[x])*0x101, uint32(pix[x+1])*0x101, uint32(pix[x+2])*0x101, uint32(pix[x+3])*0x101)
+			}
+		case *image.NRGBA:
+			pix := src.Pix[src.PixOffset(bounds.Min.X, y):]
+			for x := 0; x < len(row)/2; x += 4 {
+				// premultiplied like color.NRGBA.RGBA does, so translucent pixels round like the generic conversion
+				a := uint32(pix[x+3]) * 0x101
+				putNRGBA64(row[x*2:], uint32(pix[x])*0x101*a/0xffff, uint32(pix[x+1])*0x101*a/0xffff, uint32(pix[x+2])*0x101*a/0xffff, a)
+			}
+		default:
+			for x := bounds.Min.X; x < bounds.Max.X; x++ {
+				c := color.NRGBA64Model.Convert(img.At(x, y)).(color.NRGBA64)
+				i := (x - bounds.Min.X) * 8
+				binary.BigEndian.PutUint16(row[i:], c.R)
+				binary.BigEndian.PutUint16(row[i+2:], c.G)
+				binary.BigEndian.PutUint16(row[i+4:], c.B)
+				binary.BigEndian.PutUint16(row[i+6:], c.A)
+			}
 		}
+		w.Write(row)
 	}
+}
 
-	// Compute SHA-256 hash
-	hasher := sha256.New()
-	hasher.Write(pixelData)
-	return hex.EncodeToString(hasher.Sum(nil)), nil
+// putNRGBA64 writes a premultiplied 16 bit color as big endian non-premultiplied RGBA into the first 8 bytes of dst,
+// with the same rounding as color.NRGBA64Model
+func putNRGBA64(dst []byte, r, g, b, a uint32) {
+	switch a {
+	case 0xffff:
+	case 0:
+		r, g, b = 0, 0, 0
+	default:
+		r = r * 0xffff / a
+		g = g * 0xffff / a
+		b = b * 0xffff / a
+	}
+	binary.BigEndian.PutUint16(dst, uint16(r))
+	binary.BigEndian.PutUint16(dst[2:], uint16(g))
+	binary.BigEndian.PutUint16(dst[4:], uint16(b))
+	binary.BigEndian.PutUint16(dst[6:], uint16(a))
 }
 
+// maxHashWorkers bounds the frames decoded at the same time by GenerateDirectoryFileHashes, as each
+// decoded 4K frame takes between 32 and 64 MB
+const maxHashWorkers = 8
+
 // GenerateDirectoryFileHashes walks through a directory and computes SHA-256 hashes for all files.
+// Files are hashed in parallel by up to maxHashWorkers workers
 func GenerateDirectoryFileHashes(dirPath string) (map[string]string, error) {
-	hashes := make(map[string]string)
-
+	var paths []string
 	err := filepath.Walk(dirPath, func(path string, info os.FileInfo, err error) error {
 		if err != nil {
 			return err
 		}
 		// Skip directories
-		if info.IsDir() {
-			return nil
+		if !info.IsDir() {
+			paths = append(paths, path)
 		}
-
-		// Compute file hash
-		hash, err := CalculateFileHash(path)
-		if err != nil {
-			return err
-		}
-
-		// Store hash with filename (relative path)
-		relPath, _ := filepath.Rel(dirPath, path)
-		hashes[relPath] = hash
-
 		return nil
 	})
-
 	if err != nil {
 		return nil, err
 	}
 
+	type fileHash struct {
+		path string
+		hash string
+		err  error
+	}
+	jobs := make(chan string)
+	results := make(chan fileHash)
+	var wg sync.WaitGroup
+	for i := 0; i < min(runtime.NumCPU(), maxHashWorkers, len(paths)); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for path := range jobs {
+				hash, err := CalculateFileHash(path)
+				results <- fileHash{path: path, hash: hash, err: err}
+			}
+		}()
+	}
+	go func() {
+		defer close(jobs)
+		for _, path := range paths {
+			jobs <- path
+		}
+	}()
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	hashes := make(map[string]string, len(paths))
+	var firstErr error
+	for result := range results {
+		if result.err != nil {
+			if firstErr == nil {
+				firstErr = result.err
+			}
+			continue
+		}
+		// Store hash with filename (relative path)
+		relPath, _ := filepath.Rel(dirPath, result.path)
+		hashes[relPath] = result.hash
+	}
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
 	return hashes, nil
 }
@@ -1,8 +1,11 @@
 package videoUpscaler
 
 import (
+	"crypto/sha256"
 	fmt "fmt"
 	"image"
+	"image/color"
+	"image/draw"
 	"image/png"
 	"os"
 	"os/exec"
@@ -12,7 +15,9 @@ import (
 	"testing"
 
 	"bou.ke/monkey"
+	"github.com/janction/videoUpscaler/vm"
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/image/tiff"
 )
 
 // --- Test for SliceToMap ---
@@ -298,6 +303,85 @@ func TestCalculateFileHash(t *testing.T) {
 	}
 }
 
+// --- Test for the pixel hash of each output format ---
+func TestCalculateFileHashFormats(t *testing.T) {
+	dir := t.TempDir()
+	frame := vm.FakeFrame("QmInput", 3, 8, 6)
+
+	pngPath := filepath.Join(dir, vm.FormatPNG.FrameFilename(3))
+	pngFile, err := os.Create(pngPath)
+	assert.NoError(t, err)
+	assert.NoError(t, png.Encode(pngFile, frame))
+	pngFile.Close()
+
+	// the same pixels expanded to 16 bits per channel, like ffmpeg writes them
+	wide := image.NewRGBA64(frame.Bounds())
+	draw.Draw(wide, wide.Bounds(), frame, frame.Bounds().Min, draw.Src)
+	tiffPath := filepath.Join(dir, vm.FormatTIFF16.FrameFilename(3))
+	tiffFile, err := os.Create(tiffPath)
+	assert.NoError(t, err)
+	assert.NoError(t, tiff.Encode(tiffFile, wide, &tiff.Options{Compression: tiff.Deflate}))
+	tiffFile.Close()
+
+	pngHash, err := CalculateFileHash(pngPath)
+	assert.NoError(t, err)
+	tiffHash, err := CalculateFileHash(tiffPath)
+	assert.NoError(t, err)
+	assert.Equal(t, pngHash, tiffHash)
+
+	// a different pixel is a different hash
+	frame.Set(0, 0, color.RGBA{R: frame.RGBAAt(0, 0).R + 1, A: 255})
+	pngFile, err = os.Create(pngPath)
+	assert.NoError(t, err)
+	assert.NoError(t, png.Encode(pngFile, frame))
+	pngFile.Close()
+	changed, err := CalculateFileHash(pngPath)
+	assert.NoError(t, err)
+	assert.NotEqual(t, pngHash, changed)
+}
+
+// genericImage hides the concrete type of an image, so writePixels converts it pixel by pixel
+type genericImage struct {
+	image.Image
+}
+
+// --- Test for writePixels ---
+func TestWritePixels(t *testing.T) {
+	frame := vm.FakeFrame("QmInput", 1, 16, 9)
+	// translucent pixels exercise the premultiplied rounding
+	for x := 0; x < 16; x++ {
+		frame.Pix[frame.PixOffset(x, 2)+3] = uint8(x * 16)
+	}
+	nrgba := image.NewNRGBA(frame.Bounds())
+	rgba64 := image.NewRGBA64(frame.Bounds())
+	nrgba64 := image.NewNRGBA64(frame.Bounds())
+	for y := 0; y < 9; y++ {
+		for x := 0; x < 16; x++ {
+			c := frame.RGBAAt(x, y)
+			nrgba.SetNRGBA(x, y, color.NRGBA{R: c.R, G: c.G, B: c.B, A: c.A})
+			rgba64.Set(x, y, color.RGBA64{R: uint16(c.R) * 0x101, G: uint16(c.G) * 0x101, B: uint16(c.B) * 0x101, A: uint16(c.A) * 0x101})
+			nrgba64.Set(x, y, color.NRGBA64{R: uint16(c.R) * 0x101, G: 0x1234, B: uint16(c.B) * 0x101, A: uint16(c.A) * 0x101})
+		}
+	}
+
+	images := map[string]image.Image{
+		"RGBA":     frame,
+		"NRGBA":    nrgba,
+		"RGBA64":   rgba64,
+		"NRGBA64":  nrgba64,
+		"SubImage": frame.SubImage(image.Rect(3, 1, 11, 7)),
+	}
+	for name, img := range images {
+		t.Run(name, func(t *testing.T) {
+			fast := sha256.New()
+			writePixels(fast, img)
+			generic := sha256.New()
+			writePixels(generic, genericImage{img})
+			assert.Equal(t, generic.Sum(nil), fast.Sum(nil))
+		})
+	}
+}
+
 // --- Test for GenerateDirectoryFileHashes ---
 func TestGenerateDirectoryFileHashes(t *testing.T) {
 	type testCase struct {
@@ -386,3 +470,49 @@ func TestGenerateDirectoryFileHashes(t *testing.T) {
 		})
 	}
 }
+
+func BenchmarkWritePixels(b *testing.B) {
+	frame := vm.FakeFrame("QmInput", 1, 3840, 2160)
+	b.Run("RGBA", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			writePixels(sha256.New(), frame)
+		}
+	})
+	b.Run("Generic", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			writePixels(sha256.New(), genericImage{frame})
+		}
+	})
+}
+
+func BenchmarkGenerateDirectoryFileHashes(b *testing.B) {
+	dir := b.TempDir()
+	for frame := int64(0); frame < 16; frame++ {
+		file, err := os.Create(filepath.Join(dir, vm.FormatPNG.FrameFilename(frame)))
+		if err != nil {
+			b.Fatal(err)
+		}
+		if err := png.Encode(file, vm.FakeFrame("QmInput", frame, 1920, 1080)); err != nil {
+			b.Fatal(err)
+		}
+		file.Close()
+	}
+
+	b.ResetTimer()
+	b.Run("Parallel", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := GenerateDirectoryFileHashes(dir); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("Sequential", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for frame := int64(0); frame < 16; frame++ {
+				if _, err := CalculateFileHash(filepath.Join(dir, vm.FormatPNG.FrameFilename(frame))); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+}
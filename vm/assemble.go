@@ -0,0 +1,126 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/janction/videoUpscaler/videoUpscalerLogger"
+)
+
+// SequenceFrameRate is the frame rate of the final video of image sequence inputs, which have no frame rate
+const SequenceFrameRate = "24/1"
+
+// AssembleJob describes the final video of a task, encoded from all of its upscaled frames
+type AssembleJob struct {
+	// original video of the task. Its audio and subtitle streams are copied into the final video.
+	// Empty for image sequences, which are encoded at SequenceFrameRate without audio
+	Input string
+	// dir with every upscaled frame of the task, named with the FrameFilename of Format
+	FramesPath string
+	Format     OutputFormat
+	StartFrame int64
+	EndFrame   int64
+	Output     string
+}
+
+// AssembleVideo encodes the upscaled frames at the frame rate of the input, and muxes the audio and
+// subtitles of the input for the same range of frames. The output is a matroska container
+func AssembleVideo(ctx context.Context, job AssembleJob) error {
+	rate := SequenceFrameRate
+	if job.Input != "" {
+		var err error
+		if rate, err = ProbeFrameRate(ctx, job.Input); err != nil {
+			return err
+		}
+	}
+	args, err := assembleArgs(job, rate)
+	if err != nil {
+		return err
+	}
+
+	cmd := exec.CommandContext(ctx, "ffmpeg", args...)
+	videoUpscalerLogger.Logger.Info("Starting ffmpeg: %s", cmd.String())
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("failed to assemble video: %w. %s", err, string(output))
+	}
+	if _, err := os.Stat(job.Output); err != nil {
+		return fmt.Errorf("assembled video not found at %s: %w", job.Output, err)
+	}
+	return nil
+}
+
+// assembleArgs returns the ffmpeg arguments to encode the job at the frame rate. The input is seeked to the
+// first frame, so audio and subtitles stay in sync with the frames. Metadata is dropped and bitexact flags are
+// set, so the container doesn't depend on the time or the ffmpeg version that encoded it
+func assembleArgs(job AssembleJob, rate string) ([]string, error) {
+	fps, err := ParseFrameRate(rate)
+	if err != nil {
+		return nil, err
+	}
+	frames := job.EndFrame - job.StartFrame + 1
+	start := strconv.FormatFloat(float64(job.StartFrame)/fps, 'f', 6, 64)
+	duration := strconv.FormatFloat(float64(frames)/fps, 'f', 6, 64)
+
+	args := []string{
+		"-hide_banner", "-loglevel", "error", "-y",
+		"-framerate", rate, "-start_number", strconv.FormatInt(job.StartFrame, 10),
+		"-i", filepath.Join(job.FramesPath, job.Format.FramePattern()),
+	}
+	if job.Input != "" {
+		args = append(args, "-ss", start, "-t", duration, "-i", job.Input,
+			"-map", "0:v:0", "-map", "1:a?", "-map", "1:s?")
+	}
+	return append(args,
+		"-frames:v", strconv.FormatInt(frames, 10),
+		"-c:v", "libx264", "-preset", "medium", "-crf", "18", "-pix_fmt", "yuv420p",
+		"-c:a", "copy", "-c:s", "copy",
+		"-map_metadata", "-1", "-fflags", "+bitexact", "-flags:v", "+bitexact",
+		job.Output,
+	), nil
+}
+
+// ProbeFrameRate returns the frame rate of the first video stream of the file, like 30000/1001
+func ProbeFrameRate(ctx context.Context, path string) (string, error) {
+	output, err := exec.CommandContext(ctx, "ffprobe", "-v", "error", "-select_streams", "v:0", "-show_entries", "stream=r_frame_rate", "-of", "default=noprint_wrappers=1:nokey=1", path).Output()
+	if err != nil {
+		return "", fmt.Errorf("failed to probe frame rate of %s: %w", path, err)
+	}
+	rate := strings.TrimSpace(string(output))
+	if _, err := ParseFrameRate(rate); err != nil {
+		return "", err
+	}
+	return rate, nil
+}
+
+// CountVideoFrames returns the amount of frames of the first video stream of the file
+func CountVideoFrames(ctx context.Context, path string) (int64, error) {
+	output, err := exec.CommandContext(ctx, "ffprobe", "-v", "error", "-select_streams", "v:0", "-count_packets", "-show_entries", "stream=nb_read_packets", "-of", "csv=p=0", path).Output()
+	if err != nil {
+		return 0, fmt.Errorf("failed to count frames of %s: %w", path, err)
+	}
+	return strconv.ParseInt(strings.TrimSpace(string(output)), 10, 64)
+}
+
+// ParseFrameRate converts a frame rate like 30000/1001 or 25 into frames per second
+func ParseFrameRate(rate string) (float64, error) {
+	num, den, found := strings.Cut(rate, "/")
+	fps, err := strconv.ParseFloat(num, 64)
+	if err == nil && found {
+		var d float64
+		d, err = strconv.ParseFloat(den, 64)
+		if err == nil && d != 0 {
+			fps = fps / d
+		} else {
+			fps = 0
+		}
+	}
+	if err != nil || fps <= 0 {
+		return 0, fmt.Errorf("invalid frame rate %s", rate)
+	}
+	return fps, nil
+}
@@ -0,0 +1,47 @@
+package vm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// --- Test for AssembleVideo ---
+func TestParseFrameRate(t *testing.T) {
+	fps, err := ParseFrameRate("30000/1001")
+	require.NoError(t, err)
+	require.InDelta(t, 29.97, fps, 0.001)
+
+	fps, err = ParseFrameRate("25")
+	require.NoError(t, err)
+	require.Equal(t, 25.0, fps)
+
+	for _, rate := range []string{"", "0/0", "30/0", "abc", "-24"} {
+		_, err := ParseFrameRate(rate)
+		require.Error(t, err, rate)
+	}
+}
+
+func TestAssembleArgs(t *testing.T) {
+	// 1. Setup
+	job := AssembleJob{Input: "/work/cid", FramesPath: "/work/frames", StartFrame: 50, EndFrame: 99, Output: "/work/final.mkv"}
+
+	// 2. frames are read from the first frame of the task, and the input is seeked to the same second
+	args, err := assembleArgs(job, "25/1")
+	require.NoError(t, err)
+	require.Equal(t, []string{"-framerate", "25/1", "-start_number", "50", "-i", "/work/frames/frame_%06d.png", "-ss", "2.000000", "-t", "2.000000", "-i", "/work/cid"}, args[4:16])
+	require.Contains(t, args, "1:a?")
+	require.Contains(t, args, "1:s?")
+	require.Equal(t, "/work/final.mkv", args[len(args)-1])
+
+	// 3. image sequences have no input to copy audio from
+	job.Input = ""
+	args, err = assembleArgs(job, SequenceFrameRate)
+	require.NoError(t, err)
+	require.NotContains(t, args, "1:a?")
+	require.Equal(t, []string{"-framerate", "24/1", "-start_number", "50", "-i", "/work/frames/frame_%06d.png", "-frames:v", "50"}, args[4:12])
+
+	// 4. invalid frame rates are rejected
+	_, err = assembleArgs(job, "0/0")
+	require.Error(t, err)
+}
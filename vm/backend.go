@@ -0,0 +1,200 @@
+package vm
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"text/template"
+)
+
+// upscaling backends
+const (
+	BackendWaifu2x       = "waifu2x-cpu"
+	BackendRealESRGAN    = "realesrgan-ncnn-cpu"
+	BackendFFmpegLanczos = "ffmpeg-lanczos"
+	BackendFFmpegBicubic = "ffmpeg-bicubic"
+)
+
+// DefaultBackend is the backend of tasks that don't select one
+const DefaultBackend = BackendWaifu2x
+
+// framePattern is the name of the PNG frames extracted and upscaled before they are written in the output format
+const framePattern = "frame_%06d.png"
+
+// Backend is an upscaling program run by the upscaler containers. The image of each backend is pinned
+// by digest in the module params, so every worker renders a backend with exactly the same program
+type Backend struct {
+	Name string
+	// container command. Each argument is a text/template over BackendArgs. Arguments that expand to
+	// an empty string are dropped
+	Args []string
+	// true if Args are ffmpeg arguments, for backends that only resize with an ffmpeg scaler
+	FFmpeg bool
+	// true if the backend writes frames with a leading dot, because it doesn't write them to a temporary file
+	// first. Frames get their final name with NormalizeOutput once the backend exits
+	HiddenOutput bool
+	// upscale ratios the backend supports. Empty supports any
+	Scales []int32
+	// true if the backend can denoise
+	Denoise bool
+	// models the backend ships with. Empty means the backend has no models
+	Models       []string
+	DefaultModel string
+}
+
+// BackendArgs are the values the argument templates of a backend are expanded with
+type BackendArgs struct {
+	UpscaleOptions
+	// video file, or image2 pattern of the frames if Sequence is true
+	Input    string
+	Sequence bool
+	Output   string
+	Start    int64
+	End      int64
+	Frames   int64
+	Format   OutputFormat
+	// extension of the output format
+	Ext string
+}
+
+var backends = map[string]Backend{
+	BackendWaifu2x: {
+		Name: BackendWaifu2x,
+		Args: []string{"-i", "{{.Input}}", "-o", "{{.Output}}", "--start-frame", "{{.Start}}", "--end-frame", "{{.End}}",
+			"-s", "{{.Scale}}", "-n", "{{if .Denoise}}{{.NoiseLevel}}{{else}}-1{{end}}", "--model", "{{.Model}}",
+			"{{if not .Deterministic}}--fast{{end}}", `{{if ne .Format "png"}}--format{{end}}`, `{{if ne .Format "png"}}{{.Format}}{{end}}`},
+		Denoise:      true,
+		Models:       []string{"models_rgb"},
+		DefaultModel: "models_rgb",
+	},
+	BackendRealESRGAN: {
+		Name: BackendRealESRGAN,
+		Args: []string{"-i", "{{.Input}}", "-o", "{{.Output}}", "--start-frame", "{{.Start}}", "--end-frame", "{{.End}}",
+			"-s", "{{.Scale}}", "--model", "{{.Model}}", "{{if not .Deterministic}}--fast{{end}}",
+			`{{if ne .Format "png"}}--format{{end}}`, `{{if ne .Format "png"}}{{.Format}}{{end}}`},
+		Scales:       []int32{2, 3, 4},
+		Models:       []string{"realesr-animevideov3", "realesrgan-x4plus", "realesrgan-x4plus-anime"},
+		DefaultModel: "realesr-animevideov3",
+	},
+	BackendFFmpegLanczos: ffmpegBackend(BackendFFmpegLanczos, "lanczos"),
+	BackendFFmpegBicubic: ffmpegBackend(BackendFFmpegBicubic, "bicubic"),
+}
+
+// ffmpegBackend resizes frames with a plain ffmpeg scaler, for cheap jobs. Images run ffmpeg as entrypoint
+func ffmpegBackend(name string, flags string) Backend {
+	// sequences are read from the first frame of the range, videos are decoded up to the range
+	args := []string{"-hide_banner", "-loglevel", "error", "-y",
+		"{{if .Sequence}}-start_number{{end}}", "{{if .Sequence}}{{.Start}}{{end}}", "-i", "{{.Input}}",
+		"-vf", `{{if not .Sequence}}select=between(n\,{{.Start}}\,{{.End}}),{{end}}scale=iw*{{.Scale}}:ih*{{.Scale}}:flags=` + flags,
+		"{{if .Sequence}}-frames:v{{end}}", "{{if .Sequence}}{{.Frames}}{{end}}",
+		"-vsync", "vfr", "-start_number", "{{.Start}}"}
+	args = append(args, formatArgs(FormatWebP)...)
+	args = append(args, formatArgs(FormatTIFF16)...)
+
+	return Backend{
+		Name:   name,
+		FFmpeg: true,
+		Args:   append(args, "{{.Output}}/.frame_%06d.{{.Ext}}"),
+		// ffmpeg doesn't write to a temporary file, so frames get their final name once it exits
+		HiddenOutput: true,
+	}
+}
+
+// formatArgs returns argument templates with the ffmpeg codec arguments of the format, which only expand for tasks in that format
+func formatArgs(format OutputFormat) []string {
+	var templates []string
+	for _, arg := range format.codecArgs() {
+		templates = append(templates, fmt.Sprintf(`{{if eq .Format "%s"}}%s{{end}}`, format, arg))
+	}
+	return templates
+}
+
+// GetBackend returns the backend with the provided name. An empty name is the default backend
+func GetBackend(name string) (Backend, bool) {
+	if name == "" {
+		name = DefaultBackend
+	}
+	backend, found := backends[name]
+	return backend, found
+}
+
+// BackendNames returns the names of all the backends, sorted
+func BackendNames() []string {
+	var names []string
+	for name := range backends {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Validate makes sure the backend supports the options
+func (b Backend) Validate(options UpscaleOptions) error {
+	if len(b.Scales) > 0 && !slices.Contains(b.Scales, options.getScale()) {
+		return fmt.Errorf("backend %s doesn't support scale %v", b.Name, options.getScale())
+	}
+	if options.Denoise && !b.Denoise {
+		return fmt.Errorf("backend %s doesn't support denoise", b.Name)
+	}
+	if options.Model != "" && !slices.Contains(b.Models, options.Model) {
+		return fmt.Errorf("backend %s doesn't support model %s", b.Name, options.Model)
+	}
+	return options.Format.Validate()
+}
+
+// ExpandArgs returns the command of the backend to upscale the range of frames of the job input, found at
+// inputDir, into the output dir
+func (b Backend) ExpandArgs(job RenderJob, inputDir, output string, frameRange FrameRange) ([]string, error) {
+	options := job.Options
+	options.Scale = options.getScale()
+	if options.Model == "" {
+		options.Model = b.DefaultModel
+	}
+	format := options.GetFormat()
+	values := BackendArgs{UpscaleOptions: options, Input: job.input(inputDir), Sequence: job.Sequence != nil, Output: output,
+		Start: frameRange.Start, End: frameRange.End, Frames: frameRange.End - frameRange.Start + 1, Format: format, Ext: format.Ext()}
+
+	var args []string
+	for _, arg := range b.Args {
+		tmpl, err := template.New(b.Name).Parse(arg)
+		if err != nil {
+			return nil, fmt.Errorf("invalid argument template of backend %s: %w", b.Name, err)
+		}
+		var expanded bytes.Buffer
+		if err := tmpl.Execute(&expanded, values); err != nil {
+			return nil, err
+		}
+		if expanded.Len() > 0 {
+			args = append(args, expanded.String())
+		}
+	}
+	return args, nil
+}
+
+// outputFilename returns the name the backend writes the frame with
+func (b Backend) outputFilename(format OutputFormat, frameNumber int64) string {
+	if b.HiddenOutput {
+		return "." + format.FrameFilename(frameNumber)
+	}
+	return format.FrameFilename(frameNumber)
+}
+
+// NormalizeOutput renames the frames of the range written by the backend to the FrameFilename of the format.
+// Frames missing from the range are ignored
+func (b Backend) NormalizeOutput(outputPath string, format OutputFormat, frameRange FrameRange) error {
+	if !b.HiddenOutput {
+		return nil
+	}
+	for frame := frameRange.Start; frame <= frameRange.End; frame++ {
+		written := filepath.Join(outputPath, b.outputFilename(format, frame))
+		err := os.Rename(written, filepath.Join(outputPath, format.FrameFilename(frame)))
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package vm
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// --- Test for Backend ---
+func TestBackendExpandArgs(t *testing.T) {
+	frameRange := FrameRange{Start: 10, End: 19}
+
+	// 1. defaults match the previous hard coded arguments of the waifu2x image
+	backend, found := GetBackend("")
+	require.True(t, found)
+	args, err := backend.ExpandArgs(RenderJob{Cid: "cid"}, "/input", "/output", frameRange)
+	require.NoError(t, err)
+	require.Equal(t, []string{"-i", "/input/cid", "-o", "/output", "--start-frame", "10", "--end-frame", "19", "-s", "2", "-n", "-1", "--model", "models_rgb", "--fast"}, args)
+
+	// 2. all the options are passed to the container
+	options := UpscaleOptions{Scale: 4, Denoise: true, NoiseLevel: 2, Model: "models_rgb", Deterministic: true}
+	args, err = backend.ExpandArgs(RenderJob{Cid: "cid", Options: options}, "/input", "/output", frameRange)
+	require.NoError(t, err)
+	require.Equal(t, []string{"-i", "/input/cid", "-o", "/output", "--start-frame", "10", "--end-frame", "19", "-s", "4", "-n", "2", "--model", "models_rgb"}, args)
+
+	// 3. ffmpeg backends resize the range with the scaler of the backend
+	backend, found = GetBackend(BackendFFmpegLanczos)
+	require.True(t, found)
+	args, err = backend.ExpandArgs(RenderJob{Cid: "cid", Options: UpscaleOptions{Scale: 3}}, "/input", "/output", frameRange)
+	require.NoError(t, err)
+	require.Contains(t, args, `select=between(n\,10\,19),scale=iw*3:ih*3:flags=lanczos`)
+	require.NotContains(t, args, "-frames:v")
+	require.Equal(t, "/output/.frame_%06d.png", args[len(args)-1])
+
+	// 4. image sequences are read from the first frame of the range, without decoding the frames before it
+	sequence := &ImageSequence{Files: []string{"shot.1001.exr"}, Ext: ".exr"}
+	args, err = backend.ExpandArgs(RenderJob{Cid: "cid", Options: UpscaleOptions{Scale: 3}, Sequence: sequence}, "/input", "/output", frameRange)
+	require.NoError(t, err)
+	require.Equal(t, []string{"-start_number", "10", "-i", "/input/cid/frame_%06d.exr", "-vf", "scale=iw*3:ih*3:flags=lanczos", "-frames:v", "10"}, args[4:12])
+
+	// 5. other output formats are encoded by the backend
+	args, err = backend.ExpandArgs(RenderJob{Cid: "cid", Options: UpscaleOptions{Format: FormatTIFF16}}, "/input", "/output", frameRange)
+	require.NoError(t, err)
+	require.Equal(t, []string{"-c:v", "tiff", "-pix_fmt", "rgb48le", "-compression_algo", "deflate", "/output/.frame_%06d.tiff"}, args[len(args)-7:])
+	backend, _ = GetBackend(BackendWaifu2x)
+	args, err = backend.ExpandArgs(RenderJob{Cid: "cid", Options: UpscaleOptions{Format: FormatWebP}}, "/input", "/output", frameRange)
+	require.NoError(t, err)
+	require.Equal(t, []string{"--format", "webp"}, args[len(args)-2:])
+}
+
+func TestBackendValidate(t *testing.T) {
+	// 1. Setup
+	realESRGAN, _ := GetBackend(BackendRealESRGAN)
+	bicubic, _ := GetBackend(BackendFFmpegBicubic)
+
+	// 2. Assertions
+	require.NoError(t, realESRGAN.Validate(UpscaleOptions{Scale: 4, Model: "realesrgan-x4plus"}))
+	require.Error(t, realESRGAN.Validate(UpscaleOptions{Scale: 8}))
+	require.Error(t, realESRGAN.Validate(UpscaleOptions{Scale: 2, Model: "models_rgb"}))
+	require.NoError(t, bicubic.Validate(UpscaleOptions{Scale: 8}))
+	require.Error(t, bicubic.Validate(UpscaleOptions{Denoise: true}))
+
+	_, err := UpscaleOptions{Backend: "waifu3x"}.GetBackend()
+	require.Error(t, err)
+}
+
+func TestBackendNormalizeOutput(t *testing.T) {
+	// 1. Setup
+	dir := t.TempDir()
+	backend, _ := GetBackend(BackendFFmpegBicubic)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".frame_000003.png"), []byte("png"), 0o644))
+
+	// 2. Frames written by ffmpeg get their final name, missing frames are ignored
+	require.NoError(t, backend.NormalizeOutput(dir, FormatPNG, FrameRange{Start: 3, End: 4}))
+	require.FileExists(t, filepath.Join(dir, FormatFrameFilename(3)))
+	require.NoFileExists(t, filepath.Join(dir, ".frame_000003.png"))
+}
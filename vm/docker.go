@@ -1,12 +1,10 @@
 package vm
 
 import (
-	"bytes"
 	"context"
 	"errors"
 	"fmt"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"runtime"
 	"strconv"
@@ -17,149 +15,305 @@ import (
 	"github.com/janction/videoUpscaler/videoUpscalerLogger"
 )
 
-func IsContainerRunning(ctx context.Context, threadId string) bool {
-	name := fmt.Sprintf("upscaler-cpu%s", threadId)
+// labels added to the upscaler containers, so they can be found without matching names
+const (
+	LabelTask   = "janction.task"
+	LabelThread = "janction.thread"
+	LabelFrames = "janction.frames"
+)
+
+// DockerLimits are the resources an upscaler container can use. Zero values mean no limit
+type DockerLimits struct {
+	CPUs     float64
+	MemoryMB int64
+	// max time to upscale a single frame. The container of a range gets this time for each frame
+	FrameTimeout time.Duration
+}
+
+// ExitReason classifies why an upscaler container didn't complete
+type ExitReason string
+
+const (
+	// ExitFailed means the upscaler returned an error
+	ExitFailed ExitReason = "failed"
+	// ExitOOMKilled means the container went over its memory limit
+	ExitOOMKilled ExitReason = "oom_killed"
+	// ExitTimeout means the container went over its frame timeout and was stopped
+	ExitTimeout ExitReason = "timeout"
+	// ExitKilled means the container was killed by a signal, for example when cancelled
+	ExitKilled ExitReason = "killed"
+)
+
+// ContainerExitError is returned when an upscaler container doesn't exit successfully
+type ContainerExitError struct {
+	Code   int64
+	Reason ExitReason
+}
+
+func (e *ContainerExitError) Error() string {
+	return fmt.Sprintf("upscaler container exited with code %v (%s)", e.Code, e.Reason)
+}
+
+// exitReason classifies the exit code of a container
+func exitReason(code int64, state ContainerState) ExitReason {
+	if state.OOMKilled {
+		return ExitOOMKilled
+	}
+	// codes over 128 are processes terminated by a signal
+	if code > 128 {
+		return ExitKilled
+	}
+	return ExitFailed
+}
+
+// DockerRenderer upscales frames with the image of the job, pinned by digest, one container per range
+// of frames, using the Docker Engine API
+type DockerRenderer struct {
+	Client  *DockerClient
+	Limits  DockerLimits
+	Sandbox Sandbox
+}
 
-	// Command to check for running containers
-	cmd := exec.CommandContext(ctx, "docker", "ps", "--filter", fmt.Sprintf("name=%s", name), "--format", "{{.Names}}")
+// NewDockerRenderer returns a renderer using the docker daemon listening at socketPath
+func NewDockerRenderer(socketPath string, limits DockerLimits, sandbox Sandbox) *DockerRenderer {
+	return &DockerRenderer{Client: NewDockerClient(socketPath), Limits: limits, Sandbox: sandbox}
+}
+
+// ContainerName returns the name of the upscaler container of the thread
+func ContainerName(threadId string) string {
+	return "upscaler-cpu" + threadId
+}
+
+// RenderRange upscales the range of frames in a single container. The image of the job must be pinned
+// by digest and match the local image, so every worker renders with exactly the same image
+func (r *DockerRenderer) RenderRange(ctx context.Context, job RenderJob, frameRange FrameRange, db *db.DB) error {
+	if err := r.Client.EnsureImage(ctx, job.Image); err != nil {
+		db.AddLogEntry(job.ThreadId, fmt.Sprintf("Refusing to upscale with image %s. %s", job.Image, err.Error()), time.Now().Unix(), 2)
+		return startError{fmt.Errorf("invalid upscaler image: %w", err)}
+	}
+	return r.renderVideoRange(ctx, job, frameRange, db)
+}
 
-	output, err := cmd.Output()
+// IsRunning returns true if the container of the thread is running
+func (r *DockerRenderer) IsRunning(ctx context.Context, id string) bool {
+	state, err := r.Client.InspectContainer(ctx, ContainerName(id))
 	if err != nil {
-		videoUpscalerLogger.Logger.Error("Error executing Docker command: %v\n", err)
+		if !errors.Is(err, ErrNotFound) {
+			videoUpscalerLogger.Logger.Error("Error inspecting container %s: %s", ContainerName(id), err.Error())
+		}
 		return false
 	}
+	return state.Running
+}
+
+// IsExited returns true if the container of the thread exists but is no longer running
+func (r *DockerRenderer) IsExited(id string) (bool, error) {
+	state, err := r.Client.InspectContainer(context.Background(), ContainerName(id))
+	if errors.Is(err, ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return state.Status == "exited", nil
+}
+
+// Cancel stops the container of the thread
+func (r *DockerRenderer) Cancel(ctx context.Context, id string) error {
+	err := r.Client.StopContainer(ctx, ContainerName(id), 0)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		videoUpscalerLogger.Logger.Error(err.Error())
+		return err
+	}
+	return nil
+}
+
+// Cleanup removes every container of the thread, found by label
+func (r *DockerRenderer) Cleanup(ctx context.Context, id string) error {
+	containers, err := r.Client.ListContainers(ctx, map[string]string{LabelThread: id})
+	if err != nil {
+		videoUpscalerLogger.Logger.Error(err.Error())
+		return err
+	}
+	for _, container := range containers {
+		if err := r.Client.RemoveContainer(ctx, container.Id); err != nil && !errors.Is(err, ErrNotFound) {
+			videoUpscalerLogger.Logger.Error(err.Error())
+			return err
+		}
+	}
+	return nil
+}
 
-	// Trim output and compare with container name
-	containerName := strings.TrimSpace(string(output))
-	return containerName == name
+// FrameRange is an inclusive range of consecutive frames
+type FrameRange struct {
+	Start int64
+	End   int64
 }
 
-func RenderVideo(ctx context.Context, cid string, start int64, end int64, id string, path string, reverse bool, db *db.DB) {
-	if reverse {
-		for i := end; i >= start; i-- {
-			videoUpscalerLogger.Logger.Info("Upscaler` frame %v in reverse", i)
-			renderVideoFrame(ctx, cid, i, id, path, db)
+// SplitFrameRanges groups consecutive frames, in the provided order, in ranges of up to batchSize frames
+func SplitFrameRanges(frames []int64, batchSize int) []FrameRange {
+	if batchSize < 1 {
+		batchSize = 1
+	}
+
+	var ranges []FrameRange
+	var current []int64
+	flush := func() {
+		if len(current) == 0 {
+			return
 		}
-	} else {
-		for i := start; i <= end; i++ {
-			videoUpscalerLogger.Logger.Info("Upscaler frame %v", i)
-			renderVideoFrame(ctx, cid, i, id, path, db)
+		first, last := current[0], current[len(current)-1]
+		ranges = append(ranges, FrameRange{Start: min(first, last), End: max(first, last)})
+		current = nil
+	}
+
+	for _, frame := range frames {
+		if len(current) > 0 {
+			previous := current[len(current)-1]
+			step := frame - previous
+			consecutive := step == 1 || step == -1
+			// a range keeps the direction of its first two frames
+			if len(current) > 1 && step != current[1]-current[0] {
+				consecutive = false
+			}
+			if !consecutive || len(current) == batchSize {
+				flush()
+			}
 		}
+		current = append(current, frame)
 	}
+	flush()
+
+	return ranges
 }
 
-func renderVideoFrame(ctx context.Context, cid string, frameNumber int64, id string, path string, db *db.DB) error {
-	n := "upscaler-cpu" + id
+func (r *DockerRenderer) renderVideoRange(ctx context.Context, job RenderJob, frameRange FrameRange, db *db.DB) error {
+	n := ContainerName(job.ThreadId)
 
 	started := time.Now().Unix()
-	db.AddLogEntry(id, fmt.Sprintf("Started upscaler frame %v...", frameNumber), started, 0)
+	db.AddLogEntry(job.ThreadId, fmt.Sprintf("Started upscaler frames %v to %v...", frameRange.Start, frameRange.End), started, 0)
 
-	// Check if the container exists using `docker ps -a`
-	checkCmd := exec.CommandContext(ctx, "docker", "ps", "-a", "--filter", fmt.Sprintf("name=%s", n), "--format", "{{.Names}}")
-	output, err := checkCmd.Output()
+	backend, err := job.Options.GetBackend()
 	if err != nil {
-		db.AddLogEntry(id, "Error trying to verify if container already exists.", started, 2)
-		fail := fmt.Errorf("failed to check container existence: %w", err)
-		videoUpscalerLogger.Logger.Error(fail.Error())
-		return fail
-	}
-
-	// If the container already exists, exit the function
-	if string(output) != "" {
-		videoUpscalerLogger.Logger.Debug("Container already exists.")
-		return nil
-	}
-
-	// Construct the bind path and command
-	bindPath := fmt.Sprintf("%s:/work", path)
-
-	var blenderArgs []string
-
-	blenderArgs = append(blenderArgs, "-i")
-	blenderArgs = append(blenderArgs, fmt.Sprintf("/work/%s", cid))
-	blenderArgs = append(blenderArgs, "-o")
-	blenderArgs = append(blenderArgs, "/work/output")
-	blenderArgs = append(blenderArgs, "--frame")
-	blenderArgs = append(blenderArgs, strconv.FormatInt(frameNumber, 10))
-	blenderArgs = append(blenderArgs, "-s")
-	blenderArgs = append(blenderArgs, "2")
-	blenderArgs = append(blenderArgs, "-n")
-	blenderArgs = append(blenderArgs, "-1")
-	blenderArgs = append(blenderArgs, "--fast")
-
-	var dockerArgs []string
-	dockerArgs = append(dockerArgs, "run")
-
-	dockerArgs = append(dockerArgs, "--rm")
-	dockerArgs = append(dockerArgs, "--name")
-	dockerArgs = append(dockerArgs, n)
-	dockerArgs = append(dockerArgs, "-v")
-	dockerArgs = append(dockerArgs, bindPath)
-	dockerArgs = append(dockerArgs, "-d")
-
-	// TODO if on Mac, we use another image that is non deterministic
-	dockerArgs = append(dockerArgs, "rodrigoa77/upscaler-cpu")
-
-	dockerArgs = append(dockerArgs, blenderArgs...)
-
-	// Create and start the container
-	runCmd := exec.CommandContext(ctx, "docker", dockerArgs...)
-	videoUpscalerLogger.Logger.Info("Starting docker: %s", runCmd.String())
-	err = runCmd.Run()
+		return startError{err}
+	}
+	upscalerArgs, err := backend.ExpandArgs(job, containerInputDir, containerOutputDir, frameRange)
 	if err != nil {
-		db.AddLogEntry(id, fmt.Sprintf("Error in creating the container. %s", err.Error()), started, 1)
-		videoUpscalerLogger.Logger.Error("failed to create and start container: %s", err.Error())
-		return fmt.Errorf("failed to create and start container: %w", err)
+		return startError{err}
+	}
+
+	config := ContainerConfig{
+		Image: job.Image,
+		Cmd:   upscalerArgs,
+		Labels: map[string]string{
+			LabelTask:   job.TaskId,
+			LabelThread: job.ThreadId,
+			LabelFrames: fmt.Sprintf("%v-%v", frameRange.Start, frameRange.End),
+		},
+		HostConfig: HostConfig{
+			NanoCpus: int64(r.Limits.CPUs * 1e9),
+			Memory:   r.Limits.MemoryMB * 1024 * 1024,
+		},
+	}
+	if err := r.Sandbox.apply(&config, job); err != nil {
+		db.AddLogEntry(job.ThreadId, fmt.Sprintf("Error preparing the container sandbox. %s", err.Error()), started, 2)
+		return startError{fmt.Errorf("failed to prepare sandbox: %w", err)}
 	}
 
-	// Wait for the container to finish
-	waitCmd := exec.CommandContext(ctx, "docker", "wait", n)
-	err = waitCmd.Run()
+	// the container is not auto removed, so logs are still available after it exits
+	if err := r.Client.RemoveContainer(ctx, n); err != nil && !errors.Is(err, ErrNotFound) {
+		videoUpscalerLogger.Logger.Error("failed to remove previous container: %s", err.Error())
+	}
+	videoUpscalerLogger.Logger.Info("Starting container %s: %s", n, strings.Join(upscalerArgs, " "))
+	id, err := r.Client.CreateContainer(ctx, n, config)
+	if err == nil {
+		err = r.Client.StartContainer(ctx, id)
+	}
+	if err != nil {
+		db.AddLogEntry(job.ThreadId, fmt.Sprintf("Error in creating the container. %s", err.Error()), started, 2)
+		videoUpscalerLogger.Logger.Error("failed to create and start container: %s", err.Error())
+		return startError{fmt.Errorf("failed to create and start container: %w", err)}
+	}
+	// removal must happen even if ctx was cancelled
+	defer r.Client.RemoveContainer(context.WithoutCancel(ctx), id)
+
+	waitCtx := ctx
+	if r.Limits.FrameTimeout > 0 {
+		var cancel context.CancelFunc
+		waitCtx, cancel = context.WithTimeout(ctx, r.Limits.FrameTimeout*time.Duration(frameRange.End-frameRange.Start+1))
+		defer cancel()
+	}
+	code, err := r.Client.WaitContainer(waitCtx, id)
 	if err != nil {
+		if errors.Is(waitCtx.Err(), context.DeadlineExceeded) && ctx.Err() == nil {
+			r.Client.StopContainer(ctx, id, 0)
+			db.AddLogEntry(job.ThreadId, fmt.Sprintf("Upscaler of frames %v to %v timed out.", frameRange.Start, frameRange.End), time.Now().Unix(), 2)
+			return &ContainerExitError{Code: -1, Reason: ExitTimeout}
+		}
 		videoUpscalerLogger.Logger.Error("failed to wait for container: %s", err.Error())
 		return fmt.Errorf("failed to wait for container: %w", err)
 	}
 
-	// Retrieve and print logs
-	logsCmd := exec.CommandContext(ctx, "docker", "logs", n)
-	logsOutput, err := logsCmd.Output()
+	logsOutput, err := r.Client.ContainerLogs(ctx, id)
 	if err != nil {
 		videoUpscalerLogger.Logger.Error("failed to retrieve container logs: %s", err.Error())
 		return fmt.Errorf("failed to retrieve container logs: %w", err)
 	}
 	videoUpscalerLogger.Logger.Info("Container logs:")
-	videoUpscalerLogger.Logger.Info(string(logsOutput))
+	videoUpscalerLogger.Logger.Info(logsOutput)
+
+	if code == 0 {
+		if err := backend.NormalizeOutput(filepath.Join(job.Path, "output"), job.Options.GetFormat(), frameRange); err != nil {
+			return fmt.Errorf("failed to rename the upscaled frames: %w", err)
+		}
+	}
+
+	// the container reports the duration of each frame, which we record as if rendered one by one.
+	// Frames completed before a failure are recorded too
+	durations := ParseFrameDurations(logsOutput)
+	if code == 0 && len(durations) == 0 {
+		// backends that don't report frames, like ffmpeg, take the same time for each frame of the range
+		frames := frameRange.End - frameRange.Start + 1
+		for frame := frameRange.Start; frame <= frameRange.End; frame++ {
+			durations[frame] = int((time.Now().Unix() - started) / frames)
+		}
+	}
+	for frame, duration := range durations {
+		db.AddLogEntry(job.ThreadId, fmt.Sprintf("Successfully rendered frame %v in %v seconds.", frame, duration), time.Now().Unix(), 1)
+		db.AddRenderDuration(job.ThreadId, int(frame), duration)
+	}
 
-	RemoveContainer(ctx, n)
+	if code != 0 {
+		state, _ := r.Client.InspectContainer(ctx, id)
+		exitErr := &ContainerExitError{Code: code, Reason: exitReason(code, state)}
+		db.AddLogEntry(job.ThreadId, fmt.Sprintf("Error while upscaler frames %v to %v. %s", frameRange.Start, frameRange.End, exitErr.Error()), time.Now().Unix(), 2)
+		return exitErr
+	}
 
-	// Verify the frame exists and log
-	frameFile := FormatFrameFilename(int(frameNumber))
-	framePath := filepath.Join(path, "output", frameFile)
 	finish := time.Now().Unix()
-	difference := time.Unix(finish, 0).Sub(time.Unix(started, 0))
-	if _, err := os.Stat(framePath); errors.Is(err, os.ErrNotExist) {
-		db.AddLogEntry(id, fmt.Sprintf("Error while upscaler frame %v. %s file is not there", frameNumber, framePath), started, 2)
-		renderVideoFrame(ctx, cid, frameNumber, id, path, db)
-	} else {
-		// we capture the duration of the upscaler
-		duration := int(difference.Seconds())
-		// we add the log
-		db.AddLogEntry(id, fmt.Sprintf("Successfully rendered frame %v in %v seconds.", frameNumber, duration), finish, 1)
-		// and record the duration for the frame
-		videoUpscalerLogger.Logger.Info("Recorded duration for frame %v: %v seconds", int(frameNumber), duration)
-		db.AddRenderDuration(id, int(frameNumber), duration)
-	}
+	db.AddLogEntry(job.ThreadId, fmt.Sprintf("Upscaled %v frames from %v to %v in %v seconds.", len(durations), frameRange.Start, frameRange.End, finish-started), finish, 1)
 	return nil
 }
 
-func RemoveContainer(ctx context.Context, name string) error {
-	// Remove the container after completion
-	rmCmd := exec.CommandContext(ctx, "docker", "rm", name)
-	err := rmCmd.Run()
-	if err != nil {
-		videoUpscalerLogger.Logger.Error(err.Error())
+// ParseFrameDurations reads the "FRAME_DONE <frame> <seconds>" lines written by the upscaler in batch mode
+func ParseFrameDurations(logs string) map[int64]int {
+	durations := make(map[int64]int)
+	for _, line := range strings.Split(logs, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 3 || fields[0] != "FRAME_DONE" {
+			continue
+		}
+		frame, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil {
+			continue
+		}
+		duration, err := strconv.Atoi(fields[2])
+		if err != nil {
+			continue
+		}
+		durations[frame] = duration
 	}
-	return err
+	return durations
 }
 
 // CountFilesInDirectory counts the number of files in a given directory
@@ -181,33 +335,13 @@ func CountFilesInDirectory(directoryPath string) int {
 	return fileCount
 }
 
-// FormatFrameFilename returns the correct filename for a given frame number.
+// FormatFrameFilename returns the filename of a frame in the default format. Use the FrameFilename of the
+// output format for frames of a task
 func FormatFrameFilename(frameNumber int) string {
-	return fmt.Sprintf("frame_%06d.png", frameNumber)
+	return DefaultFormat.FrameFilename(int64(frameNumber))
 }
 
 func isARM64() bool {
 	videoUpscalerLogger.Logger.Debug("isARM64: %s", runtime.GOARCH)
 	return runtime.GOARCH == "arm64"
 }
-
-func IsContainerExited(threadId string) (bool, error) {
-	containerName := "upscaler-cpu" + threadId
-	cmd := exec.Command(
-		"docker", "ps", "-a",
-		"--filter", "name="+containerName,
-		"--filter", "status=exited",
-		"--format", "{{.Names}}",
-	)
-
-	var out bytes.Buffer
-	cmd.Stdout = &out
-
-	if err := cmd.Run(); err != nil {
-		return false, err
-	}
-
-	// Trim and check if the container name appears in output
-	result := strings.TrimSpace(out.String())
-	return result == containerName, nil
-}
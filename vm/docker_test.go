@@ -4,457 +4,171 @@ import (
 	"context"
 	"fmt"
 	"os"
-	"os/exec"
-	"reflect"
+	"path/filepath"
 	"runtime"
 	"testing"
 
 	"bou.ke/monkey"
 	"github.com/janction/videoUpscaler/db"
-	"github.com/janction/videoUpscaler/mocks"
-	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
-// --- Test for IsContainerRunning ---
+// unreachableRenderer returns a renderer whose docker socket doesn't exist
+func unreachableRenderer(t *testing.T) *DockerRenderer {
+	return NewDockerRenderer(filepath.Join(t.TempDir(), "missing.sock"), DockerLimits{}, DefaultSandbox())
+}
+
+// --- Test for IsRunning ---
 func TestIsContainerRunningKo(t *testing.T) {
 	// 1. Setup
-	ctx := context.Background()
-
-	// 2. Monkey patching
-	patch1 := monkey.Patch(exec.CommandContext, func(ctx context.Context, name string, arg ...string) *exec.Cmd {
-		return nil
-	})
-	defer patch1.Unpatch()
-
-	patch2 := monkey.PatchInstanceMethod(reflect.TypeOf(&exec.Cmd{}), "Output", func(cmd *exec.Cmd) ([]byte, error) {
-		return nil, fmt.Errorf("Output error")
-	})
-	defer patch2.Unpatch()
+	renderer := unreachableRenderer(t)
 
-	// 3. Execute method under test
-	b := IsContainerRunning(ctx, "1234")
+	// 2. Execute method under test
+	b := renderer.IsRunning(context.Background(), "1234")
 
-	// 4. Verification
+	// 3. Verification
 	require.False(t, b)
 }
 
 func TestIsContainerRunningOk(t *testing.T) {
 	// 1. Setup
-	ctx := context.Background()
+	fake, socket := newFakeDocker(t)
+	fake.containers["a"] = &fakeContainer{id: "a", name: ContainerName("1234"), state: ContainerState{Status: "running", Running: true}}
+	renderer := NewDockerRenderer(socket, DockerLimits{}, DefaultSandbox())
 
-	// 2. Monkey patching
-	patch1 := monkey.Patch(exec.CommandContext, func(ctx context.Context, name string, arg ...string) *exec.Cmd {
-		return nil
-	})
-	defer patch1.Unpatch()
-
-	patch2 := monkey.PatchInstanceMethod(reflect.TypeOf(&exec.Cmd{}), "Output", func(cmd *exec.Cmd) ([]byte, error) {
-		return []byte("upscaler-cpu"), nil
-	})
-	defer patch2.Unpatch()
+	// 2. Execute method under test
+	b := renderer.IsRunning(context.Background(), "1234")
 
-	// 3. Execute method under test
-	b := IsContainerRunning(ctx, "1234")
-
-	// 4. Verification
+	// 3. Verification
 	require.True(t, b)
+	require.False(t, renderer.IsRunning(context.Background(), "5678"))
 }
 
-// --- Test for RenderVideo ---
-func TestRenderVideoNoReverse(t *testing.T) {
-	// 1. Setup
-	mockDB := new(mocks.DB)
-	ctx := context.Background()
-	cid := "bafybeigdyrztxx3b7d5qzq2ujay5g4qxxuj5f6x3h6lgv7d4ttrddn3cxa"
-	id := "thread123"
-	path := "/tmp/upscaler/thread123/frame_42"
-	start := int64(1)
-	end := int64(10)
-	reverse := false
-	function_calls := make([]int64, 0, 10) // Empty slice with a capacity of 10
-
-	// 2. Monkey patch the renderVideoFrame function to not actually call it, just save the call to a variable
-	patch1 := monkey.Patch(renderVideoFrame, func(ctx context.Context, cid string, frameNumber int64, id string, path string, db db.Database) error {
-		function_calls = append(function_calls, frameNumber)
-		return nil
-	})
-	defer patch1.Unpatch()
-
-	// 3. Execute method under test
-	RenderVideo(ctx, cid, start, end, id, path, reverse, mockDB)
-
-	// 5. Verification
-	expected := []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
-	require.Equal(t, function_calls, expected)
-}
-
-func TestRenderVideoReverse(t *testing.T) {
-	// 1. Setup
-	mockDB := new(mocks.DB)
-	ctx := context.Background()
-	cid := "bafybeigdyrztxx3b7d5qzq2ujay5g4qxxuj5f6x3h6lgv7d4ttrddn3cxa"
-	id := "thread123"
-	path := "/tmp/upscaler/thread123/frame_42"
-	start := int64(1)
-	end := int64(10)
-	reverse := true
-	function_calls := make([]int64, 0, 10) // Empty slice with a capacity of 10
-
-	// 2. Monkey patch the renderVideoFrame function to not actually call it, just count the number of times it is called
-	patch1 := monkey.Patch(renderVideoFrame, func(ctx context.Context, cid string, frameNumber int64, id string, path string, db db.Database) error {
-		function_calls = append(function_calls, frameNumber)
-		return nil
-	})
-	defer patch1.Unpatch()
-
-	// 3. Execute method under test
-	RenderVideo(ctx, cid, start, end, id, path, reverse, mockDB)
-
-	// 5. Verification
-	expected := []int64{10, 9, 8, 7, 6, 5, 4, 3, 2, 1}
-	require.Equal(t, function_calls, expected)
+// --- Test for RenderRange ---
+func newRenderRangeTest(t *testing.T) (*fakeDocker, *DockerRenderer, RenderJob, *db.DB) {
+	fake, socket := newFakeDocker(t)
+	database, err := db.Init(t.TempDir())
+	require.NoError(t, err)
+	renderer := NewDockerRenderer(socket, DockerLimits{}, DefaultSandbox())
+	job := RenderJob{TaskId: "1", ThreadId: "thread123", Cid: "cid", Path: t.TempDir(), Image: testImage}
+	return fake, renderer, job, database
 }
 
-// --- Test for renderVideoFrame ---
 func TestRenderVideoFrame_ContainerVerificationError(t *testing.T) {
 	// 1. Setup
-	mockDB := new(mocks.DB)
-	ctx := context.Background()
-	cid := "bafybeigdyrztxx3b7d5qzq2ujay5g4qxxuj5f6x3h6lgv7d4ttrddn3cxa"
-	frameNumber := int64(42)
-	id := "thread123"
-	path := "/tmp/upscaler/thread123/frame_42"
-
-	// 2. Mock DB methods
-	mockDB.On("AddLogEntry", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
-
-	// 3. Monkey patching
-	patch1 := monkey.Patch(exec.CommandContext, func(ctx context.Context, name string, arg ...string) *exec.Cmd {
-		return &exec.Cmd{}
-	})
-	defer patch1.Unpatch()
+	_, _, job, database := newRenderRangeTest(t)
+	renderer := unreachableRenderer(t)
 
-	patch2 := monkey.PatchInstanceMethod(reflect.TypeOf(&exec.Cmd{}), "Output", func(cmd *exec.Cmd) ([]byte, error) {
-		return nil, fmt.Errorf("Error verifying if container already exists")
-	})
-	defer patch2.Unpatch()
-
-	// 4. Execute method under test
-	err := renderVideoFrame(ctx, cid, frameNumber, id, path, mockDB)
+	// 2. Execute method under test
+	err := renderer.RenderRange(context.Background(), job, FrameRange{Start: 42, End: 42}, database)
 
-	// 5. Verification
+	// 3. Verification: the image can't be verified, so the container doesn't start
 	require.Error(t, err)
-	require.Contains(t, err.Error(), "failed to check container existence: Error verifying if container already exists")
-
-	// 6. Verify mock expectations
-	mockDB.AssertExpectations(t)
+	require.Equal(t, FailureContainerStart, ClassifyFailure(err))
 }
 
 func TestRenderVideoFrame_ContainerAlreadyExist(t *testing.T) {
-	// 1. Setup
-	mockDB := new(mocks.DB)
-	ctx := context.Background()
-	cid := "bafybeigdyrztxx3b7d5qzq2ujay5g4qxxuj5f6x3h6lgv7d4ttrddn3cxa"
-	frameNumber := int64(42)
-	id := "thread123"
-	path := "/tmp/upscaler/thread123/frame_42"
-
-	// 2. Mock DB methods
-	mockDB.On("AddLogEntry", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
-
-	// 3. Monkey patching
-	patch1 := monkey.Patch(exec.CommandContext, func(ctx context.Context, name string, arg ...string) *exec.Cmd {
-		return &exec.Cmd{}
-	})
-	defer patch1.Unpatch()
+	// 1. Setup: a container of a previous run is still there
+	fake, renderer, job, database := newRenderRangeTest(t)
+	fake.containers["old"] = &fakeContainer{id: "old", name: ContainerName(job.ThreadId), state: ContainerState{Status: "exited"}}
+	fake.run = func(c *fakeContainer) (int64, string) {
+		return 0, writeFrames(t, c)
+	}
 
-	patch2 := monkey.PatchInstanceMethod(reflect.TypeOf(&exec.Cmd{}), "Output", func(cmd *exec.Cmd) ([]byte, error) {
-		return []byte("upscaler-cpu"), nil
-	})
-	defer patch2.Unpatch()
+	// 2. Execute method under test
+	err := renderer.RenderRange(context.Background(), job, FrameRange{Start: 42, End: 42}, database)
 
-	// 4. Execute method under test
-	err := renderVideoFrame(ctx, cid, frameNumber, id, path, mockDB)
-
-	// 5. Verification
+	// 3. Verification: the previous container is replaced
 	require.NoError(t, err)
-
-	// 6. Verify mock expectations
-	mockDB.AssertExpectations(t)
+	require.Empty(t, fake.containers)
 }
 
 func TestRenderVideoFrame_CreatingContainerKo(t *testing.T) {
 	// 1. Setup
-	mockDB := new(mocks.DB)
-	ctx := context.Background()
-	cid := "bafybeigdyrztxx3b7d5qzq2ujay5g4qxxuj5f6x3h6lgv7d4ttrddn3cxa"
-	frameNumber := int64(42)
-	id := "thread123"
-	path := "/tmp/upscaler/thread123/frame_42"
-
-	// 2. Mock DB methods
-	mockDB.On("AddLogEntry", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
-
-	// 3. Monkey patch CommandContext to return an *exec.Cmd with visible arguments
-	patch1 := monkey.Patch(exec.CommandContext, func(ctx context.Context, name string, arg ...string) *exec.Cmd {
-		return &exec.Cmd{
-			Path: name,
-			Args: append([]string{name}, arg...),
-		}
-	})
-	defer patch1.Unpatch()
+	fake, renderer, job, database := newRenderRangeTest(t)
+	fake.failing["create"] = true
 
-	// 4. Patch Output to simulate that container doesn't exist
-	patch2 := monkey.PatchInstanceMethod(reflect.TypeOf(&exec.Cmd{}), "Output", func(cmd *exec.Cmd) ([]byte, error) {
-		return []byte(""), nil
-	})
-	defer patch2.Unpatch()
+	// 2. Execute method under test
+	err := renderer.RenderRange(context.Background(), job, FrameRange{Start: 42, End: 42}, database)
 
-	// 5. Patch Run to simulate failure when creating the container
-	patch3 := monkey.PatchInstanceMethod(reflect.TypeOf(&exec.Cmd{}), "Run", func(cmd *exec.Cmd) error {
-		return fmt.Errorf("Error creating container")
-	})
-	defer patch3.Unpatch()
-
-	// 6. Execute the function under test
-	err := renderVideoFrame(ctx, cid, frameNumber, id, path, mockDB)
-
-	// 7. Assert the error
-	require.Error(t, err)
-	require.Contains(t, err.Error(), "failed to create and start container: Error creating container")
-
-	// 8. Verify mock expectations
-	mockDB.AssertExpectations(t)
+	// 3. Verification
+	require.ErrorContains(t, err, "failed to create and start container")
+	require.Equal(t, FailureContainerStart, ClassifyFailure(err))
 }
 
 func TestRenderVideoFrame_CreatingContainerOk_WaitingContainerKo(t *testing.T) {
 	// 1. Setup
-	mockDB := new(mocks.DB)
-	ctx := context.Background()
-	cid := "bafybeigdyrztxx3b7d5qzq2ujay5g4qxxuj5f6x3h6lgv7d4ttrddn3cxa"
-	frameNumber := int64(42)
-	id := "thread123"
-	path := "/tmp/upscaler/thread123/frame_42"
-
-	// 2. Mock DB methods
-	mockDB.On("AddLogEntry", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
-
-	// 3. Monkey patch CommandContext to return an *exec.Cmd with visible arguments
-	patch1 := monkey.Patch(exec.CommandContext, func(ctx context.Context, name string, arg ...string) *exec.Cmd {
-		return &exec.Cmd{
-			Path: name,
-			Args: append([]string{name}, arg...),
-		}
-	})
-	defer patch1.Unpatch()
+	fake, renderer, job, database := newRenderRangeTest(t)
+	fake.failing["wait"] = true
 
-	// 4. Patch Output to simulate that container doesn't exist
-	patch2 := monkey.PatchInstanceMethod(reflect.TypeOf(&exec.Cmd{}), "Output", func(cmd *exec.Cmd) ([]byte, error) {
-		return []byte(""), nil
-	})
-	defer patch2.Unpatch()
-
-	// 5. Patch Run to simulate success when creating the container and failure when waiting for it
-	patch3 := monkey.PatchInstanceMethod(reflect.TypeOf(&exec.Cmd{}), "Run", func(cmd *exec.Cmd) error {
-		if len(cmd.Args) > 1 {
-			switch cmd.Args[1] {
-			case "run":
-				return nil
-			case "wait":
-				return fmt.Errorf("failed here")
-			}
-		}
-		return fmt.Errorf("unexpected command")
-	})
-	defer patch3.Unpatch()
-
-	// 6. Execute the function under test
-	err := renderVideoFrame(ctx, cid, frameNumber, id, path, mockDB)
-
-	// 7. Assert the error
-	require.Error(t, err)
-	require.Contains(t, err.Error(), "failed to wait for container: failed here")
+	// 2. Execute method under test
+	err := renderer.RenderRange(context.Background(), job, FrameRange{Start: 42, End: 42}, database)
 
-	// 8. Verify mock expectations
-	mockDB.AssertExpectations(t)
+	// 3. Verification: the container is removed anyway
+	require.ErrorContains(t, err, "failed to wait for container")
+	require.Empty(t, fake.containers)
 }
 
 func TestRenderVideoFrame_CreatingContainerOk_WaitingContainerOk_RetrieveLogsKo(t *testing.T) {
 	// 1. Setup
-	mockDB := new(mocks.DB)
-	ctx := context.Background()
-	cid := "bafybeigdyrztxx3b7d5qzq2ujay5g4qxxuj5f6x3h6lgv7d4ttrddn3cxa"
-	frameNumber := int64(42)
-	id := "thread123"
-	path := "/tmp/upscaler/thread123/frame_42"
-
-	// 2. Mock DB methods
-	mockDB.On("AddLogEntry", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
-
-	// 3. Monkey patch CommandContext to return an *exec.Cmd with visible arguments
-	patch1 := monkey.Patch(exec.CommandContext, func(ctx context.Context, name string, arg ...string) *exec.Cmd {
-		return &exec.Cmd{
-			Path: name,
-			Args: append([]string{name}, arg...),
-		}
-	})
-	defer patch1.Unpatch()
-
-	// 4. Patch Output to simulate that container doesn't exist and retrieving logs fail
-	patch2 := monkey.PatchInstanceMethod(reflect.TypeOf(&exec.Cmd{}), "Output", func(cmd *exec.Cmd) ([]byte, error) {
-		switch cmd.Args[1] {
-		case "ps":
-			return []byte(""), nil
-		case "logs":
-			return nil, fmt.Errorf("failed here")
-		}
-		return nil, fmt.Errorf("unexpected command")
-	})
-	defer patch2.Unpatch()
-
-	// 5. Patch Run to simulate success when creating and waiting for the container and failure when retrieving
-	patch3 := monkey.PatchInstanceMethod(reflect.TypeOf(&exec.Cmd{}), "Run", func(cmd *exec.Cmd) error {
-		if len(cmd.Args) > 1 {
-			switch cmd.Args[1] {
-			case "run":
-				return nil
-			case "wait":
-				return nil
-			}
-		}
-		return fmt.Errorf("unexpected command")
-	})
-	defer patch3.Unpatch()
+	fake, renderer, job, database := newRenderRangeTest(t)
+	fake.run = func(c *fakeContainer) (int64, string) {
+		return 0, writeFrames(t, c)
+	}
+	fake.failing["logs"] = true
 
-	// 6. Execute the function under test
-	err := renderVideoFrame(ctx, cid, frameNumber, id, path, mockDB)
+	// 2. Execute method under test
+	err := renderer.RenderRange(context.Background(), job, FrameRange{Start: 42, End: 42}, database)
 
-	// 7. Assert the error
-	require.Error(t, err)
-	require.Contains(t, err.Error(), "failed to retrieve container logs: failed here")
-
-	// 8. Verify mock expectations
-	mockDB.AssertExpectations(t)
+	// 3. Verification
+	require.ErrorContains(t, err, "failed to retrieve container logs")
 }
 
 func TestRenderVideoFrame_CreatingContainerOk_WaitingContainerOk_RetrieveLogsOk_VerifyFileOk(t *testing.T) {
 	// 1. Setup
-	mockDB := new(mocks.DB)
-	ctx := context.Background()
-	cid := "bafybeigdyrztxx3b7d5qzq2ujay5g4qxxuj5f6x3h6lgv7d4ttrddn3cxa"
-	frameNumber := int64(42)
-	id := "thread123"
-	path := "/tmp/upscaler/thread123/frame_42"
-
-	// 2. Mock DB methods
-	mockDB.On("AddLogEntry", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
-
-	// 3. Monkey patch CommandContext to return an *exec.Cmd with visible arguments
-	patch1 := monkey.Patch(exec.CommandContext, func(ctx context.Context, name string, arg ...string) *exec.Cmd {
-		return &exec.Cmd{
-			Path: name,
-			Args: append([]string{name}, arg...),
-		}
-	})
-	defer patch1.Unpatch()
-
-	// 4. Patch Output to simulate that container doesn't exist and retrieving logs succeeds
-	patch2 := monkey.PatchInstanceMethod(reflect.TypeOf(&exec.Cmd{}), "Output", func(cmd *exec.Cmd) ([]byte, error) {
-		switch cmd.Args[1] {
-		case "ps":
-			return []byte(""), nil
-		case "logs":
-			return []byte(""), nil
-		}
-		return nil, fmt.Errorf("unexpected command")
-	})
-	defer patch2.Unpatch()
-
-	// 5. Patch Run to simulate success when creating and waiting for the container
-	patch3 := monkey.PatchInstanceMethod(reflect.TypeOf(&exec.Cmd{}), "Run", func(cmd *exec.Cmd) error {
-		if len(cmd.Args) > 1 {
-			switch cmd.Args[1] {
-			case "run":
-				return nil
-			case "wait":
-				return nil
-			}
-		}
-		return fmt.Errorf("unexpected command")
-	})
-	defer patch3.Unpatch()
+	fake, renderer, job, database := newRenderRangeTest(t)
+	fake.run = func(c *fakeContainer) (int64, string) {
+		return 0, writeFrames(t, c)
+	}
 
-	// 6. Patch Os.Stat to simulate success when verifying that file exists
-	patch4 := monkey.Patch(os.Stat, func(name string) (os.FileInfo, error) {
-		return nil, nil
-	})
-	defer patch4.Unpatch()
+	// 2. Execute method under test
+	err := renderer.RenderRange(context.Background(), job, FrameRange{Start: 41, End: 42}, database)
 
-	// 7. Execute the function under test
-	err := renderVideoFrame(ctx, cid, frameNumber, id, path, mockDB)
-
-	// 8. Assert no error
+	// 3. Verification: the frames are written and their durations recorded
 	require.NoError(t, err)
-
-	// 9. Verify mock expectations
-	mockDB.AssertExpectations(t)
+	require.Equal(t, 2, CountFilesInDirectory(filepath.Join(job.Path, "output")))
+	require.FileExists(t, filepath.Join(job.Path, "output", FormatFrameFilename(42)))
+	average, err := database.GetAverageRenderTime(job.ThreadId)
+	require.NoError(t, err)
+	require.Equal(t, 3, average)
 }
 
-// --- Test for RemoveContainer ---
+// --- Test for Cleanup ---
 func TestRemoveContainerKo(t *testing.T) {
 	// 1. Setup
-	ctx := context.Background()
-	name := "container123"
-
-	// 2. Monkey patch CommandContext to return an *exec.Cmd with visible arguments
-	patch1 := monkey.Patch(exec.CommandContext, func(ctx context.Context, name string, arg ...string) *exec.Cmd {
-		return &exec.Cmd{
-			Path: name,
-			Args: append([]string{name}, arg...),
-		}
-	})
-	defer patch1.Unpatch()
-
-	// 3. Patch Run to simulate that container was removed successfully
-	patch2 := monkey.PatchInstanceMethod(reflect.TypeOf(&exec.Cmd{}), "Run", func(cmd *exec.Cmd) error {
-		return fmt.Errorf("failed removing container")
-	})
-	defer patch2.Unpatch()
+	renderer := unreachableRenderer(t)
 
-	// 4. Execute the function under test
-	err := RemoveContainer(ctx, name)
+	// 2. Execute method under test
+	err := renderer.Cleanup(context.Background(), "1234")
 
-	// 5. Assert the error
+	// 3. Verification
 	require.Error(t, err)
-	require.Contains(t, err.Error(), "failed removing container")
 }
 
 func TestRemoveContainerOk(t *testing.T) {
 	// 1. Setup
-	ctx := context.Background()
-	name := "container123"
-
-	// 2. Monkey patch CommandContext to return an *exec.Cmd with visible arguments
-	patch1 := monkey.Patch(exec.CommandContext, func(ctx context.Context, name string, arg ...string) *exec.Cmd {
-		return &exec.Cmd{
-			Path: name,
-			Args: append([]string{name}, arg...),
-		}
-	})
-	defer patch1.Unpatch()
+	fake, socket := newFakeDocker(t)
+	fake.containers["a"] = &fakeContainer{id: "a", name: ContainerName("1234"), config: ContainerConfig{Labels: map[string]string{LabelThread: "1234"}}, state: ContainerState{Status: "exited"}}
+	renderer := NewDockerRenderer(socket, DockerLimits{}, DefaultSandbox())
 
-	// 3. Patch Run to simulate that container was removed successfully
-	patch2 := monkey.PatchInstanceMethod(reflect.TypeOf(&exec.Cmd{}), "Run", func(cmd *exec.Cmd) error {
-		return nil
-	})
-	defer patch2.Unpatch()
+	// 2. Execute method under test
+	err := renderer.Cleanup(context.Background(), "1234")
 
-	// 4. Execute the function under test
-	err := RemoveContainer(ctx, name)
-
-	// 5. Assert no error
+	// 3. Verification
 	require.NoError(t, err)
+	require.Empty(t, fake.containers)
+	// nothing to remove is not an error
+	require.NoError(t, renderer.Cleanup(context.Background(), "1234"))
 }
 
 // --- Test for CountFilesInDirectory ---
@@ -492,6 +206,42 @@ func TestCountFilesInDirectoryOk(t *testing.T) {
 	require.Equal(t, count, 0)
 }
 
+func TestCountFilesInDirectorySkipsDirectories(t *testing.T) {
+	// 1. Setup
+	path := t.TempDir()
+	for _, name := range []string{FormatFrameFilename(1), FormatFrameFilename(2)} {
+		require.NoError(t, os.WriteFile(filepath.Join(path, name), []byte("png"), 0o644))
+	}
+	require.NoError(t, os.Mkdir(filepath.Join(path, "tmp"), 0o755))
+
+	// 2. Execute the function under test
+	count := CountFilesInDirectory(path)
+
+	// 3. Assert only the files are counted
+	require.Equal(t, 2, count)
+	require.Equal(t, 0, CountFilesInDirectory(filepath.Join(path, "missing")))
+}
+
+// --- Test for ParseFrameDurations ---
+func TestParseFrameDurations(t *testing.T) {
+	// 1. Setup
+	logs := "starting\nFRAME_DONE 41 3\nFRAME_DONE 42 x\nFRAME_DONE 43 5\nFRAME_DONE 44\n"
+
+	// 2. Execute the function under test
+	durations := ParseFrameDurations(logs)
+
+	// 3. Assert malformed lines are skipped
+	require.Equal(t, map[int64]int{41: 3, 43: 5}, durations)
+}
+
+// --- Test for SplitFrameRanges ---
+func TestSplitFrameRanges(t *testing.T) {
+	require.Equal(t, []FrameRange{{Start: 1, End: 3}, {Start: 4, End: 5}, {Start: 8, End: 8}}, SplitFrameRanges([]int64{1, 2, 3, 4, 5, 8}, 3))
+	// reverse order keeps the direction, and ranges are always ascending
+	require.Equal(t, []FrameRange{{Start: 4, End: 5}, {Start: 2, End: 3}}, SplitFrameRanges([]int64{5, 4, 3, 2}, 2))
+	require.Equal(t, []FrameRange{{Start: 1, End: 1}, {Start: 2, End: 2}}, SplitFrameRanges([]int64{1, 2}, 0))
+}
+
 // --- Test for FormatFrameFilename ---
 func TestFormatFrameFilename(t *testing.T) {
 	// 1. Setup
@@ -513,68 +263,37 @@ func TestIsARM64(t *testing.T) {
 	require.Equal(t, is_arm, runtime.GOARCH == "arm64")
 }
 
-// --- Test for IsContainerExited ---
+// --- Test for IsExited ---
 func TestIsContainerExitedKo(t *testing.T) {
 	// 1. Setup
-	id := "thread123"
-
-	// 2. Monkey patch CommandContext to return an *exec.Cmd with visible arguments
-	patch1 := monkey.Patch(exec.Command, func(name string, arg ...string) *exec.Cmd {
-		return &exec.Cmd{
-			Path: name,
-			Args: append([]string{name}, arg...),
-		}
-	})
-	defer patch1.Unpatch()
-
-	// 3. Patch Run in order to simulate docker ps failure
-	patch2 := monkey.PatchInstanceMethod(reflect.TypeOf(&exec.Cmd{}), "Run", func(cmd *exec.Cmd) error {
-		switch cmd.Args[1] {
-		case "ps":
-			return fmt.Errorf("Error creating container")
-		}
-		return fmt.Errorf("unexpected command")
-	})
-	defer patch2.Unpatch()
+	renderer := unreachableRenderer(t)
 
-	// 4. Execute the function under test
-	result, err := IsContainerExited(id)
+	// 2. Execute method under test
+	exited, err := renderer.IsExited("1234")
 
-	// 5. Assert
-	require.Equal(t, result, false)
+	// 3. Verification
 	require.Error(t, err)
-	require.Contains(t, err.Error(), "Error creating container")
+	require.False(t, exited)
 }
 
 func TestIsContainerExitedOk(t *testing.T) {
 	// 1. Setup
-	threadId := "thread123"
-	containerName := "upscaler-cpu" + threadId
-
-	// 2. Monkey patch CommandContext to return an *exec.Cmd with visible arguments
-	patch1 := monkey.Patch(exec.Command, func(name string, arg ...string) *exec.Cmd {
-		return &exec.Cmd{
-			Path: name,
-			Args: append([]string{name}, arg...),
-		}
-	})
-	defer patch1.Unpatch()
-
-	// 3. Patch Run in order to simulate docker ps failure
-	patch2 := monkey.PatchInstanceMethod(reflect.TypeOf(&exec.Cmd{}), "Run", func(cmd *exec.Cmd) error {
-		switch cmd.Args[1] {
-		case "ps":
-			cmd.Stdout.Write([]byte(containerName))
-			return nil
-		}
-		return fmt.Errorf("unexpected command")
-	})
-	defer patch2.Unpatch()
+	fake, socket := newFakeDocker(t)
+	fake.containers["a"] = &fakeContainer{id: "a", name: ContainerName("1234"), state: ContainerState{Status: "exited"}}
+	fake.containers["b"] = &fakeContainer{id: "b", name: ContainerName("5678"), state: ContainerState{Status: "running", Running: true}}
+	renderer := NewDockerRenderer(socket, DockerLimits{}, DefaultSandbox())
 
-	// 4. Execute the function under test
-	result, err := IsContainerExited(threadId)
+	// 2. Execute method under test and verify
+	exited, err := renderer.IsExited("1234")
+	require.NoError(t, err)
+	require.True(t, exited)
+
+	exited, err = renderer.IsExited("5678")
+	require.NoError(t, err)
+	require.False(t, exited)
 
-	// 5. Assert
-	require.Equal(t, result, true)
+	// a container that doesn't exist didn't exit
+	exited, err = renderer.IsExited("9999")
 	require.NoError(t, err)
+	require.False(t, exited)
 }
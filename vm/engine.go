@@ -0,0 +1,228 @@
+package vm
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// DefaultDockerSocket is where the docker daemon listens by default
+const DefaultDockerSocket = "/var/run/docker.sock"
+
+// ErrNotFound is returned by the docker client when the container or image doesn't exist
+var ErrNotFound = errors.New("not found")
+
+// DockerClient talks to the Docker Engine HTTP API over its unix socket
+type DockerClient struct {
+	http *http.Client
+}
+
+// ContainerConfig is the subset of the container create request we use
+type ContainerConfig struct {
+	Image           string            `json:"Image"`
+	Cmd             []string          `json:"Cmd"`
+	Env             []string          `json:"Env,omitempty"`
+	User            string            `json:"User,omitempty"`
+	NetworkDisabled bool              `json:"NetworkDisabled,omitempty"`
+	Labels          map[string]string `json:"Labels,omitempty"`
+	HostConfig      HostConfig        `json:"HostConfig"`
+}
+
+// HostConfig holds the binds, resource limits and security options of a container
+type HostConfig struct {
+	Binds          []string          `json:"Binds,omitempty"`
+	NetworkMode    string            `json:"NetworkMode,omitempty"`
+	ReadonlyRootfs bool              `json:"ReadonlyRootfs,omitempty"`
+	Tmpfs          map[string]string `json:"Tmpfs,omitempty"`
+	CapDrop        []string          `json:"CapDrop,omitempty"`
+	SecurityOpt    []string          `json:"SecurityOpt,omitempty"`
+	// cpu quota in units of 1e-9 cpus
+	NanoCpus int64 `json:"NanoCpus,omitempty"`
+	// memory limit in bytes
+	Memory int64 `json:"Memory,omitempty"`
+}
+
+// Container is an entry of the containers list
+type Container struct {
+	Id     string            `json:"Id"`
+	Names  []string          `json:"Names"`
+	Labels map[string]string `json:"Labels"`
+	State  string            `json:"State"`
+}
+
+// ContainerState is the state of a container as returned by inspect
+type ContainerState struct {
+	Status    string `json:"Status"`
+	Running   bool   `json:"Running"`
+	OOMKilled bool   `json:"OOMKilled"`
+	ExitCode  int64  `json:"ExitCode"`
+}
+
+// NewDockerClient returns a client of the docker daemon listening at socketPath
+func NewDockerClient(socketPath string) *DockerClient {
+	if socketPath == "" {
+		socketPath = DefaultDockerSocket
+	}
+	transport := &http.Transport{
+		DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+			var dialer net.Dialer
+			return dialer.DialContext(ctx, "unix", socketPath)
+		},
+	}
+	return &DockerClient{http: &http.Client{Transport: transport}}
+}
+
+func (c *DockerClient) do(ctx context.Context, method, path string, query url.Values, body any, out any) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	target := "http://docker" + path
+	if len(query) > 0 {
+		target += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, method, target, reader)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return ErrNotFound
+	}
+	if resp.StatusCode >= 300 {
+		var apiErr struct {
+			Message string `json:"message"`
+		}
+		json.NewDecoder(resp.Body).Decode(&apiErr)
+		return fmt.Errorf("docker %s %s failed with status %v: %s", method, path, resp.StatusCode, apiErr.Message)
+	}
+
+	switch out := out.(type) {
+	case nil:
+		return nil
+	case *[]byte:
+		*out, err = io.ReadAll(resp.Body)
+		return err
+	default:
+		return json.NewDecoder(resp.Body).Decode(out)
+	}
+}
+
+// ListContainers returns all the containers, running or not, with the provided labels
+func (c *DockerClient) ListContainers(ctx context.Context, labels map[string]string) ([]Container, error) {
+	var labelFilters []string
+	for key, value := range labels {
+		labelFilters = append(labelFilters, key+"="+value)
+	}
+	filters, err := json.Marshal(map[string][]string{"label": labelFilters})
+	if err != nil {
+		return nil, err
+	}
+
+	var containers []Container
+	err = c.do(ctx, http.MethodGet, "/containers/json", url.Values{"all": {"1"}, "filters": {string(filters)}}, nil, &containers)
+	return containers, err
+}
+
+// CreateContainer creates a container with the provided name and returns its id
+func (c *DockerClient) CreateContainer(ctx context.Context, name string, config ContainerConfig) (string, error) {
+	var created struct {
+		Id string `json:"Id"`
+	}
+	err := c.do(ctx, http.MethodPost, "/containers/create", url.Values{"name": {name}}, config, &created)
+	return created.Id, err
+}
+
+// StartContainer starts a created container
+func (c *DockerClient) StartContainer(ctx context.Context, id string) error {
+	return c.do(ctx, http.MethodPost, "/containers/"+id+"/start", nil, nil, nil)
+}
+
+// WaitContainer blocks until the container exits and returns its exit code
+func (c *DockerClient) WaitContainer(ctx context.Context, id string) (int64, error) {
+	var result struct {
+		StatusCode int64 `json:"StatusCode"`
+		Error      *struct {
+			Message string `json:"Message"`
+		} `json:"Error"`
+	}
+	if err := c.do(ctx, http.MethodPost, "/containers/"+id+"/wait", nil, nil, &result); err != nil {
+		return 0, err
+	}
+	if result.Error != nil && result.Error.Message != "" {
+		return result.StatusCode, errors.New(result.Error.Message)
+	}
+	return result.StatusCode, nil
+}
+
+// InspectContainer returns the state of the container with the exact id or name
+func (c *DockerClient) InspectContainer(ctx context.Context, id string) (ContainerState, error) {
+	var result struct {
+		State ContainerState `json:"State"`
+	}
+	err := c.do(ctx, http.MethodGet, "/containers/"+id+"/json", nil, nil, &result)
+	return result.State, err
+}
+
+// ContainerLogs returns stdout and stderr of the container
+func (c *DockerClient) ContainerLogs(ctx context.Context, id string) (string, error) {
+	var raw []byte
+	if err := c.do(ctx, http.MethodGet, "/containers/"+id+"/logs", url.Values{"stdout": {"1"}, "stderr": {"1"}}, nil, &raw); err != nil {
+		return "", err
+	}
+	return demuxLogs(raw), nil
+}
+
+// StopContainer stops the container, killing it after timeout
+func (c *DockerClient) StopContainer(ctx context.Context, id string, timeout time.Duration) error {
+	return c.do(ctx, http.MethodPost, "/containers/"+id+"/stop", url.Values{"t": {strconv.Itoa(int(timeout.Seconds()))}}, nil, nil)
+}
+
+// RemoveContainer removes the container, even if it is running
+func (c *DockerClient) RemoveContainer(ctx context.Context, id string) error {
+	return c.do(ctx, http.MethodDelete, "/containers/"+id, url.Values{"force": {"1"}}, nil, nil)
+}
+
+// demuxLogs joins the frames of a multiplexed log stream, used by containers without a tty.
+// Each frame has an 8 bytes header with the stream type and the size of the payload
+func demuxLogs(raw []byte) string {
+	var out bytes.Buffer
+	for len(raw) > 0 {
+		if len(raw) < 8 || raw[0] > 2 {
+			// not multiplexed, it is the raw output
+			out.Write(raw)
+			break
+		}
+		size := int(binary.BigEndian.Uint32(raw[4:8]))
+		raw = raw[8:]
+		if size > len(raw) {
+			size = len(raw)
+		}
+		out.Write(raw[:size])
+		raw = raw[size:]
+	}
+	return out.String()
+}
@@ -0,0 +1,444 @@
+package vm
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/janction/videoUpscaler/db"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeContainer struct {
+	id     string
+	name   string
+	config ContainerConfig
+	state  ContainerState
+}
+
+// fakeDocker is a minimal Docker Engine API served over a unix socket
+type fakeDocker struct {
+	mu         sync.Mutex
+	containers map[string]*fakeContainer
+	nextId     int
+	stopped    []string
+	// called when a container starts, returns the exit code and the logs
+	run func(c *fakeContainer) (int64, string)
+	// if set, wait blocks until the request is cancelled
+	hang bool
+	logs map[string]string
+	// local images by reference, and the images the registry can pull
+	images   map[string]Image
+	registry map[string]Image
+	pulled   []string
+	// endpoints that answer with an internal error, like "create", "wait" or "logs"
+	failing map[string]bool
+}
+
+// testImage is a reference pinned by digest
+const testImage = "rodrigoa77/upscaler-cpu@sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+func newFakeDocker(t *testing.T) (*fakeDocker, string) {
+	f := &fakeDocker{containers: make(map[string]*fakeContainer), logs: make(map[string]string), registry: make(map[string]Image), failing: make(map[string]bool)}
+	f.images = map[string]Image{testImage: {Id: "sha256:local", RepoDigests: []string{testImage}}}
+	socket := filepath.Join(t.TempDir(), "docker.sock")
+	listener, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /containers/json", f.failable("list", f.list))
+	mux.HandleFunc("POST /containers/create", f.failable("create", f.create))
+	mux.HandleFunc("POST /containers/{id}/start", f.failable("start", f.start))
+	mux.HandleFunc("POST /containers/{id}/wait", f.failable("wait", f.wait))
+	mux.HandleFunc("POST /containers/{id}/stop", f.failable("stop", f.stop))
+	mux.HandleFunc("GET /containers/{id}/json", f.failable("inspect", f.inspect))
+	mux.HandleFunc("GET /containers/{id}/logs", f.failable("logs", f.containerLogs))
+	mux.HandleFunc("DELETE /containers/{id}", f.failable("remove", f.remove))
+	mux.HandleFunc("GET /images/", f.inspectImage)
+	mux.HandleFunc("POST /images/create", f.pullImage)
+
+	server := &http.Server{Handler: mux}
+	go server.Serve(listener)
+	t.Cleanup(func() { server.Close() })
+	return f, socket
+}
+
+// failable answers with an internal error while the endpoint is failing
+func (f *fakeDocker) failable(endpoint string, handler http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		failing := f.failing[endpoint]
+		f.mu.Unlock()
+		if failing {
+			w.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(w).Encode(map[string]string{"message": endpoint + " failed"})
+			return
+		}
+		handler(w, r)
+	}
+}
+
+// find returns the container by exact id or name
+func (f *fakeDocker) find(idOrName string) *fakeContainer {
+	for _, c := range f.containers {
+		if c.id == idOrName || c.name == idOrName {
+			return c
+		}
+	}
+	return nil
+}
+
+func (f *fakeDocker) list(w http.ResponseWriter, r *http.Request) {
+	var filters map[string][]string
+	json.Unmarshal([]byte(r.URL.Query().Get("filters")), &filters)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	result := []Container{}
+	for _, c := range f.containers {
+		matches := true
+		for _, label := range filters["label"] {
+			key, value, _ := strings.Cut(label, "=")
+			if c.config.Labels[key] != value {
+				matches = false
+			}
+		}
+		if matches {
+			result = append(result, Container{Id: c.id, Names: []string{"/" + c.name}, Labels: c.config.Labels, State: c.state.Status})
+		}
+	}
+	json.NewEncoder(w).Encode(result)
+}
+
+func (f *fakeDocker) create(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	name := r.URL.Query().Get("name")
+	if f.find(name) != nil {
+		w.WriteHeader(http.StatusConflict)
+		json.NewEncoder(w).Encode(map[string]string{"message": "name in use"})
+		return
+	}
+	var config ContainerConfig
+	json.NewDecoder(r.Body).Decode(&config)
+	f.nextId++
+	c := &fakeContainer{id: fmt.Sprintf("id%v", f.nextId), name: name, config: config, state: ContainerState{Status: "created"}}
+	f.containers[c.id] = c
+	json.NewEncoder(w).Encode(map[string]string{"Id": c.id})
+}
+
+func (f *fakeDocker) start(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	c := f.find(r.PathValue("id"))
+	if c == nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	c.state = ContainerState{Status: "running", Running: true}
+	if f.run != nil && !f.hang {
+		code, logs := f.run(c)
+		f.logs[c.id] = logs
+		c.state = ContainerState{Status: "exited", ExitCode: code, OOMKilled: c.state.OOMKilled}
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (f *fakeDocker) wait(w http.ResponseWriter, r *http.Request) {
+	if f.hang {
+		<-r.Context().Done()
+		return
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	c := f.find(r.PathValue("id"))
+	if c == nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	json.NewEncoder(w).Encode(map[string]int64{"StatusCode": c.state.ExitCode})
+}
+
+func (f *fakeDocker) stop(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	c := f.find(r.PathValue("id"))
+	if c == nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	f.stopped = append(f.stopped, c.name)
+	c.state = ContainerState{Status: "exited", ExitCode: 137}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (f *fakeDocker) inspect(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	c := f.find(r.PathValue("id"))
+	if c == nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	json.NewEncoder(w).Encode(map[string]ContainerState{"State": c.state})
+}
+
+func (f *fakeDocker) containerLogs(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	c := f.find(r.PathValue("id"))
+	if c == nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	w.Write(multiplex(1, f.logs[c.id]))
+}
+
+func (f *fakeDocker) remove(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	c := f.find(r.PathValue("id"))
+	if c == nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	delete(f.containers, c.id)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (f *fakeDocker) inspectImage(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	ref := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/images/"), "/json")
+	image, found := f.images[ref]
+	if !found {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	json.NewEncoder(w).Encode(image)
+}
+
+func (f *fakeDocker) pullImage(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	ref := r.URL.Query().Get("fromImage")
+	f.pulled = append(f.pulled, ref)
+	image, found := f.registry[ref]
+	if !found {
+		fmt.Fprintln(w, `{"status":"Pulling"}`)
+		fmt.Fprintln(w, `{"error":"manifest unknown"}`)
+		return
+	}
+	f.images[ref] = image
+	fmt.Fprintln(w, `{"status":"Downloaded newer image"}`)
+}
+
+func multiplex(stream byte, payload string) []byte {
+	header := make([]byte, 8)
+	header[0] = stream
+	binary.BigEndian.PutUint32(header[4:], uint32(len(payload)))
+	return append(header, payload...)
+}
+
+// writeFrames simulates the upscaler writing the frames of the range into the bound work dir
+func writeFrames(t *testing.T, c *fakeContainer) string {
+	hostPath := filepath.Dir(strings.TrimSuffix(c.config.HostConfig.Binds[1], ":"+containerOutputDir+":rw"))
+	var start, end int64
+	fmt.Sscanf(c.config.Labels[LabelFrames], "%d-%d", &start, &end)
+
+	var logs strings.Builder
+	for frame := start; frame <= end; frame++ {
+		require.NoError(t, os.MkdirAll(filepath.Join(hostPath, "output"), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(hostPath, "output", FormatFrameFilename(int(frame))), []byte("png"), 0o644))
+		fmt.Fprintf(&logs, "FRAME_DONE %v 3\n", frame)
+	}
+	return logs.String()
+}
+
+// --- Test for DockerRenderer ---
+func TestDockerRendererRender(t *testing.T) {
+	// 1. Setup
+	fake, socket := newFakeDocker(t)
+	database, err := db.Init(t.TempDir())
+	require.NoError(t, err)
+	var configs []ContainerConfig
+	fake.run = func(c *fakeContainer) (int64, string) {
+		configs = append(configs, c.config)
+		return 0, writeFrames(t, c)
+	}
+	renderer := NewDockerRenderer(socket, DockerLimits{CPUs: 1.5, MemoryMB: 512}, DefaultSandbox())
+	job := RenderJob{TaskId: "1", ThreadId: "10", Cid: "cid", Path: t.TempDir(), Image: testImage}
+
+	// 2. Execute the function under test
+	var rendered []int64
+	err = Render(context.Background(), renderer, job, []int64{0, 1, 2, 3, 4}, 2, DefaultRetryPolicy(), database, func(frameNumber int64) {
+		rendered = append(rendered, frameNumber)
+	})
+
+	// 3. Assert one container per range, with labels and limits, removed once done
+	require.NoError(t, err)
+	require.Equal(t, []int64{0, 1, 2, 3, 4}, rendered)
+	require.Len(t, configs, 3)
+	require.Equal(t, map[string]string{LabelTask: "1", LabelThread: "10", LabelFrames: "2-3"}, configs[1].Labels)
+	require.Equal(t, int64(1.5e9), configs[0].HostConfig.NanoCpus)
+	require.Equal(t, int64(512*1024*1024), configs[0].HostConfig.Memory)
+	require.Empty(t, fake.containers)
+
+	// 4. Assert the container is sandboxed, with a read-only input and a writable output
+	host := configs[0].HostConfig
+	require.Equal(t, []string{
+		filepath.Join(job.Path, "cid") + ":/input/cid:ro",
+		filepath.Join(job.Path, "output") + ":/output:rw",
+	}, host.Binds)
+	require.Equal(t, []string{"-i", "/input/cid", "-o", "/output"}, configs[0].Cmd[:4])
+	require.True(t, configs[0].NetworkDisabled)
+	require.Equal(t, "none", host.NetworkMode)
+	require.True(t, host.ReadonlyRootfs)
+	require.Equal(t, []string{"ALL"}, host.CapDrop)
+	require.Contains(t, host.SecurityOpt, "no-new-privileges")
+	require.NotEmpty(t, configs[0].User)
+	require.NotEqual(t, "0", strings.Split(configs[0].User, ":")[0])
+}
+
+func TestDockerRendererExitCodes(t *testing.T) {
+	// 1. Setup
+	fake, socket := newFakeDocker(t)
+	database, err := db.Init(t.TempDir())
+	require.NoError(t, err)
+	renderer := NewDockerRenderer(socket, DockerLimits{}, DefaultSandbox())
+	job := RenderJob{TaskId: "1", ThreadId: "10", Cid: "cid", Path: t.TempDir(), Image: testImage}
+
+	cases := []struct {
+		code   int64
+		oom    bool
+		reason ExitReason
+	}{
+		{code: 1, reason: ExitFailed},
+		{code: 137, oom: true, reason: ExitOOMKilled},
+		{code: 143, reason: ExitKilled},
+	}
+	for _, c := range cases {
+		fake.run = func(container *fakeContainer) (int64, string) {
+			container.state.OOMKilled = c.oom
+			return c.code, ""
+		}
+
+		// 2. Execute the function under test
+		err := renderer.RenderRange(context.Background(), job, FrameRange{Start: 0, End: 0}, database)
+
+		// 3. Assert
+		var exitErr *ContainerExitError
+		require.True(t, errors.As(err, &exitErr))
+		require.Equal(t, c.code, exitErr.Code)
+		require.Equal(t, c.reason, exitErr.Reason)
+	}
+}
+
+func TestDockerRendererFrameTimeout(t *testing.T) {
+	// 1. Setup
+	fake, socket := newFakeDocker(t)
+	fake.hang = true
+	database, err := db.Init(t.TempDir())
+	require.NoError(t, err)
+	renderer := NewDockerRenderer(socket, DockerLimits{FrameTimeout: 50 * time.Millisecond}, DefaultSandbox())
+	job := RenderJob{TaskId: "1", ThreadId: "10", Cid: "cid", Path: t.TempDir(), Image: testImage}
+
+	// 2. Execute the function under test
+	err = renderer.RenderRange(context.Background(), job, FrameRange{Start: 0, End: 1}, database)
+
+	// 3. Assert the container was stopped
+	var exitErr *ContainerExitError
+	require.True(t, errors.As(err, &exitErr))
+	require.Equal(t, ExitTimeout, exitErr.Reason)
+	require.Equal(t, []string{ContainerName("10")}, fake.stopped)
+}
+
+func TestDockerRendererMatchesExactThread(t *testing.T) {
+	// 1. Setup, thread 1 must not match the container of thread 12
+	fake, socket := newFakeDocker(t)
+	fake.containers["a"] = &fakeContainer{id: "a", name: ContainerName("12"), config: ContainerConfig{Labels: map[string]string{LabelThread: "12"}}, state: ContainerState{Status: "running", Running: true}}
+	fake.containers["b"] = &fakeContainer{id: "b", name: ContainerName("1"), config: ContainerConfig{Labels: map[string]string{LabelThread: "1"}}, state: ContainerState{Status: "exited"}}
+	renderer := NewDockerRenderer(socket, DockerLimits{}, DefaultSandbox())
+	ctx := context.Background()
+
+	// 2. Assert
+	require.False(t, renderer.IsRunning(ctx, "1"))
+	require.True(t, renderer.IsRunning(ctx, "12"))
+	exited, err := renderer.IsExited("1")
+	require.NoError(t, err)
+	require.True(t, exited)
+
+	require.NoError(t, renderer.Cleanup(ctx, "1"))
+	require.Len(t, fake.containers, 1)
+	require.NotNil(t, fake.containers["a"])
+}
+
+// --- Test for EnsureImage ---
+func TestDockerRendererPinnedImage(t *testing.T) {
+	// 1. Setup
+	fake, socket := newFakeDocker(t)
+	database, err := db.Init(t.TempDir())
+	require.NoError(t, err)
+	renderer := NewDockerRenderer(socket, DockerLimits{}, DefaultSandbox())
+	job := RenderJob{TaskId: "1", ThreadId: "10", Cid: "cid", Path: t.TempDir()}
+	ctx := context.Background()
+
+	// 2. images without digest are refused
+	job.Image = "rodrigoa77/upscaler-cpu:latest"
+	require.ErrorContains(t, renderer.RenderRange(ctx, job, FrameRange{Start: 0, End: 0}, database), "not pinned by digest")
+
+	// 3. a local image with another digest is refused
+	other := "rodrigoa77/upscaler-cpu@sha256:" + strings.Repeat("a", 64)
+	fake.images[other] = Image{Id: "sha256:local", RepoDigests: []string{testImage}}
+	job.Image = other
+	require.ErrorContains(t, renderer.RenderRange(ctx, job, FrameRange{Start: 0, End: 0}, database), "doesn't match digest")
+
+	// 4. missing images are pulled by digest
+	missing := "rodrigoa77/upscaler-cpu@sha256:" + strings.Repeat("b", 64)
+	fake.registry[missing] = Image{Id: "sha256:pulled", RepoDigests: []string{missing}}
+	require.NoError(t, renderer.Client.EnsureImage(ctx, missing))
+	require.Equal(t, []string{missing}, fake.pulled)
+
+	// 5. pull errors in the progress stream are reported
+	require.ErrorContains(t, renderer.Client.EnsureImage(ctx, "rodrigoa77/upscaler-cpu@sha256:"+strings.Repeat("c", 64)), "manifest unknown")
+	require.Empty(t, fake.containers)
+}
+
+// --- Test for NewSandbox ---
+func TestNewSandbox(t *testing.T) {
+	// 1. root is rejected
+	_, err := NewSandbox("0:0", "")
+	require.Error(t, err)
+
+	// 2. seccomp profile is loaded and validated
+	profilePath := filepath.Join(t.TempDir(), "seccomp.json")
+	require.NoError(t, os.WriteFile(profilePath, []byte(`{"defaultAction":"SCMP_ACT_ERRNO"}`), 0o644))
+	sandbox, err := NewSandbox("1000:1000", profilePath)
+	require.NoError(t, err)
+	require.Equal(t, "1000:1000", sandbox.User)
+
+	config := ContainerConfig{}
+	require.NoError(t, sandbox.apply(&config, RenderJob{Cid: "cid", Path: t.TempDir(), Image: testImage}))
+	require.Contains(t, config.HostConfig.SecurityOpt, `seccomp={"defaultAction":"SCMP_ACT_ERRNO"}`)
+
+	require.NoError(t, os.WriteFile(profilePath, []byte("not json"), 0o644))
+	_, err = NewSandbox("1000:1000", profilePath)
+	require.Error(t, err)
+}
+
+// --- Test for demuxLogs ---
+func TestDemuxLogs(t *testing.T) {
+	raw := append(multiplex(1, "FRAME_DONE 1 2\n"), multiplex(2, "warning\n")...)
+	require.Equal(t, "FRAME_DONE 1 2\nwarning\n", demuxLogs(raw))
+	require.Equal(t, "plain output", demuxLogs([]byte("plain output")))
+}
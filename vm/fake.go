@@ -0,0 +1,123 @@
+package vm
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/janction/videoUpscaler/db"
+	"golang.org/x/image/tiff"
+)
+
+// FakeRenderer writes synthetic PNG frames instead of upscaling the input. The pixels only depend on
+// the cid, the options and the frame number, so every worker produces the same frames. Used for end to end tests
+type FakeRenderer struct {
+	Width  int
+	Height int
+	// time spent on each frame, to simulate work in progress
+	Delay   time.Duration
+	running *runningThreads
+}
+
+// NewFakeRenderer returns a fake renderer of 64x36 frames, multiplied by the scale, without delay
+func NewFakeRenderer() *FakeRenderer {
+	return &FakeRenderer{Width: 64, Height: 36, running: newRunningThreads()}
+}
+
+// RenderRange writes the synthetic frames of the range. The input is not read. WebP frames are not supported,
+// since there is no WebP encoder without ffmpeg
+func (r *FakeRenderer) RenderRange(ctx context.Context, job RenderJob, frameRange FrameRange, db *db.DB) error {
+	id, cid, path := job.ThreadId, job.Cid, job.Path
+	format := job.Options.GetFormat()
+	if format == FormatWebP {
+		return startError{fmt.Errorf("format %s is not supported by the fake renderer", format)}
+	}
+	// every format gets the same pixels
+	seed := job.Options
+	seed.Format = ""
+
+	ctx, done := r.running.start(ctx, id)
+	defer done()
+
+	for frame := frameRange.Start; frame <= frameRange.End; frame++ {
+		if r.Delay > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(r.Delay):
+			}
+		} else if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		scale := int(job.Options.getScale())
+		img := FakeFrame(cid+fmt.Sprint(seed), frame, r.Width*scale, r.Height*scale)
+		err := writeFrame(filepath.Join(path, "output"), format.FrameFilename(frame), func(tmpPath string) error {
+			file, err := os.Create(tmpPath)
+			if err != nil {
+				return err
+			}
+			if err := encodeFakeFrame(file, img, format); err != nil {
+				file.Close()
+				return err
+			}
+			return file.Close()
+		})
+		if err != nil {
+			return err
+		}
+		db.AddLogEntry(id, fmt.Sprintf("Successfully rendered frame %v in %v seconds.", frame, int(r.Delay.Seconds())), time.Now().Unix(), 1)
+		db.AddRenderDuration(id, int(frame), int(r.Delay.Seconds()))
+	}
+	return nil
+}
+
+// encodeFakeFrame writes the frame as PNG, or as 16 bit TIFF like the tiff16 format of the upscalers
+func encodeFakeFrame(w io.Writer, img *image.RGBA, format OutputFormat) error {
+	if format != FormatTIFF16 {
+		return png.Encode(w, img)
+	}
+	wide := image.NewRGBA64(img.Bounds())
+	draw.Draw(wide, wide.Bounds(), img, img.Bounds().Min, draw.Src)
+	return tiff.Encode(w, wide, &tiff.Options{Compression: tiff.Deflate})
+}
+
+// FakeFrame returns the synthetic frame the fake renderer writes for the cid and frame number
+func FakeFrame(cid string, frameNumber int64, width, height int) *image.RGBA {
+	seed := make([]byte, 8)
+	binary.BigEndian.PutUint64(seed, uint64(frameNumber))
+	sum := sha256.Sum256(append([]byte(cid), seed...))
+
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.Set(x, y, color.RGBA{R: sum[0] + uint8(x), G: sum[1] + uint8(y), B: sum[2] ^ uint8(x*y), A: 255})
+		}
+	}
+	return img
+}
+
+// IsRunning returns true while frames of the thread are being written
+func (r *FakeRenderer) IsRunning(ctx context.Context, id string) bool {
+	return r.running.isRunning(id)
+}
+
+// Cancel stops writing frames of the thread
+func (r *FakeRenderer) Cancel(ctx context.Context, id string) error {
+	r.running.cancel(id)
+	return nil
+}
+
+// Cleanup does nothing, the fake renderer leaves no resources behind
+func (r *FakeRenderer) Cleanup(ctx context.Context, id string) error {
+	return nil
+}
@@ -0,0 +1,77 @@
+package vm
+
+import (
+	"fmt"
+	"slices"
+)
+
+// OutputFormat is the image format of the upscaled frames
+type OutputFormat string
+
+// output formats. All of them are lossless, so the pixel hash of a frame doesn't depend on the format
+const (
+	FormatPNG OutputFormat = "png"
+	// lossless WebP
+	FormatWebP OutputFormat = "webp"
+	// TIFF with 16 bits per channel, deflate compressed
+	FormatTIFF16 OutputFormat = "tiff16"
+)
+
+// DefaultFormat is the format of tasks that don't select one
+const DefaultFormat = FormatPNG
+
+var formats = []OutputFormat{FormatPNG, FormatWebP, FormatTIFF16}
+
+// Validate makes sure the format is supported. An empty format is the default format
+func (f OutputFormat) Validate() error {
+	if f != "" && !slices.Contains(formats, f) {
+		return fmt.Errorf("output format %s doesn't exist", f)
+	}
+	return nil
+}
+
+// Ext returns the file extension of the format, without the dot
+func (f OutputFormat) Ext() string {
+	switch f {
+	case FormatWebP:
+		return "webp"
+	case FormatTIFF16:
+		return "tiff"
+	default:
+		return "png"
+	}
+}
+
+// FramePattern returns the name of the frames of the format, with the frame number as the only verb
+func (f OutputFormat) FramePattern() string {
+	return "frame_%06d." + f.Ext()
+}
+
+// FrameFilename returns the name of the frame in the format
+func (f OutputFormat) FrameFilename(frameNumber int64) string {
+	return fmt.Sprintf(f.FramePattern(), frameNumber)
+}
+
+// codecArgs returns the ffmpeg codec arguments of the format. PNG is the default codec of .png files
+func (f OutputFormat) codecArgs() []string {
+	switch f {
+	case FormatWebP:
+		return []string{"-c:v", "libwebp", "-lossless", "1"}
+	case FormatTIFF16:
+		return []string{"-c:v", "tiff", "-pix_fmt", "rgb48le", "-compression_algo", "deflate"}
+	default:
+		return nil
+	}
+}
+
+// encodeArgs returns the ffmpeg output arguments that write a single frame in the format, whatever the extension of the output
+func (f OutputFormat) encodeArgs() []string {
+	switch f {
+	case FormatWebP:
+		return append(f.codecArgs(), "-f", "webp")
+	case FormatTIFF16:
+		return append(f.codecArgs(), "-f", "image2", "-update", "1")
+	default:
+		return []string{"-c:v", "png", "-f", "image2", "-update", "1"}
+	}
+}
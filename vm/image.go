@@ -0,0 +1,91 @@
+package vm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+var digestRegexp = regexp.MustCompile(`^sha256:[a-f0-9]{64}$`)
+
+// ImageDigest returns the digest of an image reference pinned by digest, like repository@sha256:<hex>.
+// References without a digest, like repository:tag, are rejected
+func ImageDigest(ref string) (string, error) {
+	name, digest, found := strings.Cut(ref, "@")
+	if !found || name == "" || strings.ContainsAny(name, " \t\n") {
+		return "", fmt.Errorf("image %s is not pinned by digest", ref)
+	}
+	if !digestRegexp.MatchString(digest) {
+		return "", fmt.Errorf("image %s has an invalid digest", ref)
+	}
+	return digest, nil
+}
+
+// Image is the subset of an image inspect we use
+type Image struct {
+	Id          string   `json:"Id"`
+	RepoDigests []string `json:"RepoDigests"`
+}
+
+// InspectImage returns the local image with the provided reference
+func (c *DockerClient) InspectImage(ctx context.Context, ref string) (Image, error) {
+	var image Image
+	err := c.do(ctx, http.MethodGet, "/images/"+ref+"/json", nil, nil, &image)
+	return image, err
+}
+
+// PullImage pulls the image with the provided reference and waits until it is downloaded
+func (c *DockerClient) PullImage(ctx context.Context, ref string) error {
+	var progress []byte
+	if err := c.do(ctx, http.MethodPost, "/images/create", url.Values{"fromImage": {ref}}, nil, &progress); err != nil {
+		return err
+	}
+
+	// pull errors are reported in the progress stream, not with the status code
+	decoder := json.NewDecoder(strings.NewReader(string(progress)))
+	for {
+		var message struct {
+			Error string `json:"error"`
+		}
+		if err := decoder.Decode(&message); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		if message.Error != "" {
+			return fmt.Errorf("unable to pull image %s: %s", ref, message.Error)
+		}
+	}
+}
+
+// EnsureImage makes sure the pinned image is available locally with the expected digest, pulling it if missing
+func (c *DockerClient) EnsureImage(ctx context.Context, ref string) error {
+	digest, err := ImageDigest(ref)
+	if err != nil {
+		return err
+	}
+
+	image, err := c.InspectImage(ctx, ref)
+	if errors.Is(err, ErrNotFound) {
+		if err := c.PullImage(ctx, ref); err != nil {
+			return err
+		}
+		image, err = c.InspectImage(ctx, ref)
+	}
+	if err != nil {
+		return err
+	}
+
+	if !slices.ContainsFunc(image.RepoDigests, func(repoDigest string) bool { return strings.HasSuffix(repoDigest, "@"+digest) }) {
+		return fmt.Errorf("local image %s doesn't match digest %s", image.Id, digest)
+	}
+	return nil
+}
@@ -0,0 +1,205 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"time"
+
+	"github.com/janction/videoUpscaler/db"
+	"github.com/janction/videoUpscaler/videoUpscalerLogger"
+)
+
+// LocalRenderer upscales frames running ffmpeg and waifu2x-converter-cpp directly on the host,
+// for workers without docker
+type LocalRenderer struct {
+	FFmpegPath  string
+	Waifu2xPath string
+	// dir with a subdir per waifu2x model. If empty, the waifu2x default model dir is used
+	ModelsPath string
+	running    *runningThreads
+}
+
+// NewLocalRenderer returns a local renderer using the binaries found in the PATH
+func NewLocalRenderer() *LocalRenderer {
+	return &LocalRenderer{FFmpegPath: "ffmpeg", Waifu2xPath: "waifu2x-converter-cpp", running: newRunningThreads()}
+}
+
+// RenderRange extracts the range of frames with ffmpeg and upscales them one by one with waifu2x.
+// The ffmpeg backends resize the range with ffmpeg alone. Other backends are only available with docker
+func (r *LocalRenderer) RenderRange(ctx context.Context, job RenderJob, frameRange FrameRange, db *db.DB) error {
+	id, path := job.ThreadId, job.Path
+	backend, err := job.Options.GetBackend()
+	if err != nil {
+		return startError{err}
+	}
+	if !backend.FFmpeg && backend.Name != BackendWaifu2x {
+		return startError{fmt.Errorf("backend %s is not supported by the local renderer", backend.Name)}
+	}
+
+	ctx, done := r.running.start(ctx, id)
+	defer done()
+
+	started := time.Now().Unix()
+	db.AddLogEntry(id, fmt.Sprintf("Started upscaler frames %v to %v...", frameRange.Start, frameRange.End), started, 0)
+
+	tmpDir := r.tmpDir(id)
+	framesIn := filepath.Join(tmpDir, "frames_in")
+	framesOut := filepath.Join(tmpDir, "frames_out")
+	defer os.RemoveAll(tmpDir)
+	for _, dir := range []string{framesIn, framesOut} {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return err
+		}
+	}
+
+	if backend.FFmpeg {
+		return r.resizeRange(ctx, job, backend, frameRange, tmpDir, db)
+	}
+
+	extractArgs := append([]string{"-hide_banner", "-loglevel", "error", "-y"}, job.decodeArgs(path, frameRange)...)
+	extractArgs = append(extractArgs, "-start_number", strconv.FormatInt(frameRange.Start, 10), filepath.Join(framesIn, framePattern))
+	extractCmd := exec.CommandContext(ctx, r.FFmpegPath, extractArgs...)
+	videoUpscalerLogger.Logger.Info("Starting ffmpeg: %s", extractCmd.String())
+	if output, err := extractCmd.CombinedOutput(); err != nil {
+		db.AddLogEntry(id, fmt.Sprintf("Error extracting frames %v to %v. %s", frameRange.Start, frameRange.End, err.Error()), started, 2)
+		return fmt.Errorf("failed to extract frames: %w. %s", err, string(output))
+	}
+
+	outputPath := filepath.Join(path, "output")
+	format := job.Options.GetFormat()
+	for frame := frameRange.Start; frame <= frameRange.End; frame++ {
+		frameStarted := time.Now()
+		name := fmt.Sprintf(framePattern, frame)
+		upscaled := filepath.Join(framesOut, name)
+
+		upscaleCmd := exec.CommandContext(ctx, r.Waifu2xPath, r.waifu2xArgs(filepath.Join(framesIn, name), upscaled, job.Options)...)
+		if output, err := upscaleCmd.CombinedOutput(); err != nil {
+			db.AddLogEntry(id, fmt.Sprintf("Error while upscaler frame %v. %s", frame, err.Error()), time.Now().Unix(), 2)
+			return fmt.Errorf("failed to upscale frame %v: %w. %s", frame, err, string(output))
+		}
+
+		if err := writeFrame(outputPath, format.FrameFilename(frame), func(tmpPath string) error { return r.encodeFrame(ctx, upscaled, tmpPath, format) }); err != nil {
+			return err
+		}
+
+		duration := int(time.Since(frameStarted).Seconds())
+		db.AddLogEntry(id, fmt.Sprintf("Successfully rendered frame %v in %v seconds.", frame, duration), time.Now().Unix(), 1)
+		db.AddRenderDuration(id, int(frame), duration)
+	}
+	return nil
+}
+
+// resizeRange runs the ffmpeg arguments of the backend on the host, then moves the frames to the output
+func (r *LocalRenderer) resizeRange(ctx context.Context, job RenderJob, backend Backend, frameRange FrameRange, tmpDir string, db *db.DB) error {
+	started := time.Now()
+	framesOut := filepath.Join(tmpDir, "frames_out")
+	args, err := backend.ExpandArgs(job, job.Path, framesOut, frameRange)
+	if err != nil {
+		return err
+	}
+
+	resizeCmd := exec.CommandContext(ctx, r.FFmpegPath, args...)
+	videoUpscalerLogger.Logger.Info("Starting ffmpeg: %s", resizeCmd.String())
+	if output, err := resizeCmd.CombinedOutput(); err != nil {
+		db.AddLogEntry(job.ThreadId, fmt.Sprintf("Error resizing frames %v to %v. %s", frameRange.Start, frameRange.End, err.Error()), time.Now().Unix(), 2)
+		return fmt.Errorf("failed to resize frames: %w. %s", err, string(output))
+	}
+
+	// ffmpeg doesn't report each frame, so every frame of the range takes the same time
+	duration := int(time.Since(started).Seconds()) / int(frameRange.End-frameRange.Start+1)
+	outputPath := filepath.Join(job.Path, "output")
+	format := job.Options.GetFormat()
+	for frame := frameRange.Start; frame <= frameRange.End; frame++ {
+		resized := filepath.Join(framesOut, backend.outputFilename(format, frame))
+		if _, err := os.Stat(resized); err != nil {
+			// frames past the end of the video
+			continue
+		}
+		if err := writeFrame(outputPath, format.FrameFilename(frame), func(tmpPath string) error { return copyFile(resized, tmpPath) }); err != nil {
+			return err
+		}
+		db.AddLogEntry(job.ThreadId, fmt.Sprintf("Successfully rendered frame %v in %v seconds.", frame, duration), time.Now().Unix(), 1)
+		db.AddRenderDuration(job.ThreadId, int(frame), duration)
+	}
+	return nil
+}
+
+// encodeFrame writes the upscaled PNG frame in the output format. PNG frames are copied as is
+func (r *LocalRenderer) encodeFrame(ctx context.Context, upscaled, output string, format OutputFormat) error {
+	if format == FormatPNG {
+		return copyFile(upscaled, output)
+	}
+	args := append([]string{"-hide_banner", "-loglevel", "error", "-y", "-i", upscaled}, format.encodeArgs()...)
+	encodeCmd := exec.CommandContext(ctx, r.FFmpegPath, append(args, output)...)
+	if output, err := encodeCmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("failed to encode frame as %s: %w. %s", format, err, string(output))
+	}
+	return nil
+}
+
+// waifu2xArgs mirrors the options the upscaler-cpu image uses
+func (r *LocalRenderer) waifu2xArgs(input, output string, options UpscaleOptions) []string {
+	args := []string{"-i", input, "-o", output, "--scale-ratio", strconv.Itoa(int(options.getScale())), "--png-compression", "0", "--image-quality", "100"}
+	if options.Denoise {
+		args = append(args, "--noise-level", strconv.Itoa(int(options.NoiseLevel)), "-m", "noise_scale")
+	} else {
+		args = append(args, "-m", "scale")
+	}
+	if options.Deterministic {
+		args = append(args, "-j", "1")
+	} else {
+		args = append(args, "-j", strconv.Itoa(runtime.NumCPU()))
+	}
+	if r.ModelsPath != "" {
+		model := options.Model
+		if model == "" {
+			model = backends[BackendWaifu2x].DefaultModel
+		}
+		args = append(args, "--model-dir", filepath.Join(r.ModelsPath, model))
+	}
+	return args
+}
+
+func (r *LocalRenderer) tmpDir(id string) string {
+	return filepath.Join(os.TempDir(), "upscaler-local"+id)
+}
+
+// IsRunning returns true while a range of the thread is being upscaled
+func (r *LocalRenderer) IsRunning(ctx context.Context, id string) bool {
+	return r.running.isRunning(id)
+}
+
+// Cancel kills the ffmpeg or waifu2x process of the thread
+func (r *LocalRenderer) Cancel(ctx context.Context, id string) error {
+	r.running.cancel(id)
+	return nil
+}
+
+// Cleanup removes the extracted frames left by a previous run of the thread
+func (r *LocalRenderer) Cleanup(ctx context.Context, id string) error {
+	return os.RemoveAll(r.tmpDir(id))
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
@@ -0,0 +1,43 @@
+package vm
+
+import "fmt"
+
+// UpscaleOptions are the upscaler settings of a render job
+type UpscaleOptions struct {
+	Scale   int32
+	Denoise bool
+	// 0 to 3, only used with Denoise
+	NoiseLevel int32
+	// model of the backend. Empty uses the default model of the backend
+	Model string
+	// single threaded upscale, so every worker gets the same pixels
+	Deterministic bool
+	// upscaling backend. Empty uses DefaultBackend
+	Backend string
+	// format of the upscaled frames. Empty uses DefaultFormat
+	Format OutputFormat
+}
+
+func (o UpscaleOptions) getScale() int32 {
+	if o.Scale < 1 {
+		return 2
+	}
+	return o.Scale
+}
+
+// GetBackend returns the backend of the options
+func (o UpscaleOptions) GetBackend() (Backend, error) {
+	backend, found := GetBackend(o.Backend)
+	if !found {
+		return backend, fmt.Errorf("backend %s doesn't exist", o.Backend)
+	}
+	return backend, nil
+}
+
+// GetFormat returns the output format of the options
+func (o UpscaleOptions) GetFormat() OutputFormat {
+	if o.Format == "" {
+		return DefaultFormat
+	}
+	return o.Format
+}
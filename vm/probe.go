@@ -0,0 +1,101 @@
+package vm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strconv"
+)
+
+// Media describes a video file as reported by ffprobe
+type Media struct {
+	FrameCount int64
+	// frame rate of the first video stream as a rational, like 30000/1001
+	FrameRate    string
+	Width        int32
+	Height       int32
+	Codec        string
+	DurationMs   int64
+	AudioStreams []AudioStream
+}
+
+// AudioStream is an audio stream of a video file
+type AudioStream struct {
+	Index      int32
+	Codec      string
+	Channels   int32
+	SampleRate int32
+	Language   string
+}
+
+// output of ffprobe -show_streams -show_format -of json
+type ffprobeOutput struct {
+	Streams []struct {
+		Index         int32             `json:"index"`
+		CodecType     string            `json:"codec_type"`
+		CodecName     string            `json:"codec_name"`
+		Width         int32             `json:"width"`
+		Height        int32             `json:"height"`
+		RFrameRate    string            `json:"r_frame_rate"`
+		NbReadPackets string            `json:"nb_read_packets"`
+		Channels      int32             `json:"channels"`
+		SampleRate    string            `json:"sample_rate"`
+		Tags          map[string]string `json:"tags"`
+	} `json:"streams"`
+	Format struct {
+		Duration string `json:"duration"`
+	} `json:"format"`
+}
+
+// ProbeMedia runs ffprobe on the file. Frames are counted from the packets of the first video stream,
+// so the count doesn't depend on the metadata of the container
+func ProbeMedia(ctx context.Context, path string) (Media, error) {
+	output, err := exec.CommandContext(ctx, "ffprobe", "-v", "error", "-count_packets", "-show_streams", "-show_format", "-of", "json", path).Output()
+	if err != nil {
+		return Media{}, fmt.Errorf("failed to probe %s: %w", path, err)
+	}
+	return parseProbe(output)
+}
+
+// parseProbe reads the json output of ffprobe
+func parseProbe(output []byte) (Media, error) {
+	var probe ffprobeOutput
+	if err := json.Unmarshal(output, &probe); err != nil {
+		return Media{}, fmt.Errorf("invalid ffprobe output: %w", err)
+	}
+
+	var media Media
+	video := false
+	for _, stream := range probe.Streams {
+		switch {
+		case stream.CodecType == "video" && !video:
+			video = true
+			frames, err := strconv.ParseInt(stream.NbReadPackets, 10, 64)
+			if err != nil {
+				return Media{}, fmt.Errorf("invalid frame count %s: %w", stream.NbReadPackets, err)
+			}
+			media.FrameCount = frames
+			media.FrameRate = stream.RFrameRate
+			media.Width = stream.Width
+			media.Height = stream.Height
+			media.Codec = stream.CodecName
+		case stream.CodecType == "audio":
+			// a missing sample rate is reported as 0
+			sampleRate, _ := strconv.ParseInt(stream.SampleRate, 10, 32)
+			media.AudioStreams = append(media.AudioStreams, AudioStream{Index: stream.Index, Codec: stream.CodecName, Channels: stream.Channels, SampleRate: int32(sampleRate), Language: stream.Tags["language"]})
+		}
+	}
+	if !video {
+		return Media{}, fmt.Errorf("no video stream found")
+	}
+
+	if probe.Format.Duration != "" {
+		duration, err := strconv.ParseFloat(probe.Format.Duration, 64)
+		if err != nil {
+			return Media{}, fmt.Errorf("invalid duration %s: %w", probe.Format.Duration, err)
+		}
+		media.DurationMs = int64(duration * 1000)
+	}
+	return media, nil
+}
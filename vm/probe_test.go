@@ -0,0 +1,32 @@
+package vm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// --- Test for ProbeMedia ---
+func TestParseProbe(t *testing.T) {
+	// 1. Setup
+	output := `{
+		"streams": [
+			{"index": 0, "codec_type": "video", "codec_name": "h264", "width": 1920, "height": 1080, "r_frame_rate": "30000/1001", "nb_read_packets": "1440"},
+			{"index": 1, "codec_type": "audio", "codec_name": "aac", "channels": 2, "sample_rate": "48000", "tags": {"language": "eng"}},
+			{"index": 2, "codec_type": "subtitle", "codec_name": "subrip"},
+			{"index": 3, "codec_type": "video", "codec_name": "mjpeg", "width": 320, "height": 240, "r_frame_rate": "90000/1", "nb_read_packets": "1"}
+		],
+		"format": {"duration": "48.048000"}
+	}`
+
+	// 2. the first video stream is the one upscaled
+	media, err := parseProbe([]byte(output))
+	require.NoError(t, err)
+	require.Equal(t, Media{FrameCount: 1440, FrameRate: "30000/1001", Width: 1920, Height: 1080, Codec: "h264", DurationMs: 48048, AudioStreams: []AudioStream{{Index: 1, Codec: "aac", Channels: 2, SampleRate: 48000, Language: "eng"}}}, media)
+
+	// 3. files without video are rejected
+	_, err = parseProbe([]byte(`{"streams": [{"index": 0, "codec_type": "audio", "codec_name": "mp3"}], "format": {}}`))
+	require.Error(t, err)
+	_, err = parseProbe([]byte("not json"))
+	require.Error(t, err)
+}
@@ -0,0 +1,201 @@
+package vm
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/janction/videoUpscaler/db"
+	"github.com/janction/videoUpscaler/videoUpscalerLogger"
+)
+
+// RenderJob identifies the input of a thread to upscale
+type RenderJob struct {
+	TaskId   string
+	ThreadId string
+	Cid      string
+	// work dir of the thread, with the input at Path/Cid. Frames are written to Path/output
+	Path string
+	// upscaler image pinned by digest. Only used by renderers running containers
+	Image   string
+	Options UpscaleOptions
+	// frames of an image sequence input, downloaded to Path/Cid named with FormatInputFilename. Nil if the input is a video file
+	Sequence *ImageSequence
+}
+
+// input returns the path of the video file, or the image2 pattern of the sequence frames, with the input at dir/Cid
+func (j RenderJob) input(dir string) string {
+	if j.Sequence != nil {
+		return filepath.Join(dir, j.Cid, j.Sequence.InputPattern())
+	}
+	return filepath.Join(dir, j.Cid)
+}
+
+// decodeArgs returns the ffmpeg input arguments that decode only the range of frames of the input at dir
+func (j RenderJob) decodeArgs(dir string, frameRange FrameRange) []string {
+	if j.Sequence != nil {
+		return []string{"-start_number", strconv.FormatInt(frameRange.Start, 10), "-i", j.input(dir),
+			"-frames:v", strconv.FormatInt(frameRange.End-frameRange.Start+1, 10)}
+	}
+	return []string{"-i", j.input(dir), "-vf", fmt.Sprintf(`select=between(n\,%d\,%d)`, frameRange.Start, frameRange.End), "-vsync", "vfr"}
+}
+
+// Renderer upscales frames of a thread input into path/output, named with the FrameFilename of the output format
+type Renderer interface {
+	// RenderRange upscales the inclusive range of frames of the job input
+	RenderRange(ctx context.Context, job RenderJob, frameRange FrameRange, db *db.DB) error
+	// IsRunning returns true if the renderer is still working on the thread
+	IsRunning(ctx context.Context, id string) bool
+	// Cancel stops any work in progress of the thread
+	Cancel(ctx context.Context, id string) error
+	// Cleanup releases any resource left by previous work of the thread
+	Cleanup(ctx context.Context, id string) error
+}
+
+// NewRenderer returns the renderer with the given name: docker, local or fake. Defaults to docker,
+// using the daemon at dockerSocket with the provided limits and sandbox
+func NewRenderer(name string, dockerSocket string, limits DockerLimits, sandbox Sandbox) (Renderer, error) {
+	switch name {
+	case "", "docker":
+		return NewDockerRenderer(dockerSocket, limits, sandbox), nil
+	case "local":
+		return NewLocalRenderer(), nil
+	case "fake":
+		return NewFakeRenderer(), nil
+	default:
+		return nil, fmt.Errorf("unknown renderer %s", name)
+	}
+}
+
+// Render upscales the frames with the renderer, in ranges of up to batchSize consecutive frames.
+// onRendered is called for each frame found at the output, so the caller can checkpoint it.
+// Frames still missing after a range is rendered are retried with the backoff of the policy. Once a range
+// runs out of attempts, Render stops and returns a *RenderError with the reason of the last failure
+func Render(ctx context.Context, renderer Renderer, job RenderJob, frames []int64, batchSize int, policy RetryPolicy, db *db.DB, onRendered func(frameNumber int64)) error {
+	for _, frameRange := range SplitFrameRanges(frames, batchSize) {
+		pending := []FrameRange{frameRange}
+		for attempt := 1; ; attempt++ {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+
+			var lastErr error
+			var missing []int64
+			for _, pendingRange := range pending {
+				videoUpscalerLogger.Logger.Info("Upscaler frames %v to %v", pendingRange.Start, pendingRange.End)
+				if err := renderer.RenderRange(ctx, job, pendingRange, db); err != nil {
+					// frames completed before the error are still checkpointed
+					videoUpscalerLogger.Logger.Error("unable to upscale frames %v to %v: %s", pendingRange.Start, pendingRange.End, err.Error())
+					lastErr = err
+				}
+				missing = append(missing, checkRenderedFrames(job, pendingRange, db, onRendered)...)
+			}
+			if len(missing) == 0 {
+				break
+			}
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+
+			reason := ClassifyFailure(lastErr)
+			if attempt >= policy.MaxAttempts {
+				db.AddLogEntry(job.ThreadId, fmt.Sprintf("Giving up %v frames after %v attempts: %s", len(missing), attempt, reason), time.Now().Unix(), 2)
+				return &RenderError{Reason: reason, Frames: missing, Err: lastErr}
+			}
+			db.AddLogEntry(job.ThreadId, fmt.Sprintf("Retrying %v frames in %v, attempt %v failed: %s", len(missing), policy.backoff(attempt), attempt, reason), time.Now().Unix(), 2)
+			if err := policy.wait(ctx, attempt); err != nil {
+				return err
+			}
+			pending = SplitFrameRanges(missing, batchSize)
+		}
+	}
+	return nil
+}
+
+// checkRenderedFrames calls onRendered for the frames of the range found at the output, and returns the missing ones
+func checkRenderedFrames(job RenderJob, frameRange FrameRange, db *db.DB, onRendered func(frameNumber int64)) []int64 {
+	var missing []int64
+	format := job.Options.GetFormat()
+	for frame := frameRange.Start; frame <= frameRange.End; frame++ {
+		if _, err := os.Stat(filepath.Join(job.Path, "output", format.FrameFilename(frame))); err != nil {
+			db.AddLogEntry(job.ThreadId, fmt.Sprintf("Error while upscaler frame %v. file is not there", frame), time.Now().Unix(), 2)
+			missing = append(missing, frame)
+			continue
+		}
+		if onRendered != nil {
+			onRendered(frame)
+		}
+	}
+	return missing
+}
+
+// runningThreads keeps the cancel functions of the threads a process based renderer is working on
+type runningThreads struct {
+	mu   sync.Mutex
+	runs map[string]*run
+}
+
+// run is one call working on a thread. A restarted thread gets a new run, so the previous one can't remove it
+type run struct {
+	cancel context.CancelFunc
+}
+
+func newRunningThreads() *runningThreads {
+	return &runningThreads{runs: make(map[string]*run)}
+}
+
+// start registers the thread as running, cancelling any previous run of the thread still going.
+// The returned done function must be called when work finishes
+func (r *runningThreads) start(ctx context.Context, id string) (context.Context, func()) {
+	ctx, cancel := context.WithCancel(ctx)
+	current := &run{cancel: cancel}
+
+	r.mu.Lock()
+	if previous, found := r.runs[id]; found {
+		previous.cancel()
+	}
+	r.runs[id] = current
+	r.mu.Unlock()
+
+	return ctx, func() {
+		cancel()
+		r.mu.Lock()
+		if r.runs[id] == current {
+			delete(r.runs, id)
+		}
+		r.mu.Unlock()
+	}
+}
+
+func (r *runningThreads) isRunning(id string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	_, found := r.runs[id]
+	return found
+}
+
+func (r *runningThreads) cancel(id string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if run, found := r.runs[id]; found {
+		run.cancel()
+	}
+}
+
+// writeFrame writes a frame into the output dir with a temporary name first, so a frame only gets
+// its final name once it is completely written
+func writeFrame(outputPath string, name string, write func(tmpPath string) error) error {
+	if err := os.MkdirAll(outputPath, 0o755); err != nil {
+		return err
+	}
+	tmpPath := filepath.Join(outputPath, "."+name+".tmp")
+	if err := write(tmpPath); err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+	return os.Rename(tmpPath, filepath.Join(outputPath, name))
+}
@@ -0,0 +1,119 @@
+package vm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// FailureReason classifies why frames couldn't be rendered. Values match the ThreadFailureReason
+// names the CLI accepts
+type FailureReason string
+
+// reasons of a render failure
+const (
+	// the input couldn't be decoded, the upscaler exited with an error
+	FailureInputDecode FailureReason = "input-decode"
+	// the upscaler couldn't be started, like a missing image or a docker error
+	FailureContainerStart FailureReason = "container-start"
+	// the upscaler took longer than the frame timeout
+	FailureTimeout FailureReason = "timeout"
+	// the upscaler ran out of memory or was killed
+	FailureResourceLimit FailureReason = "resource-limit"
+	// the upscaler finished but some frames are not at the output
+	FailureMissingOutput FailureReason = "missing-output"
+	// the input doesn't match the media info of the task, so it can't be rendered by any worker
+	FailureMediaMismatch FailureReason = "media-mismatch"
+)
+
+// RenderError is returned by Render once the frames are still missing after all the attempts
+type RenderError struct {
+	Reason FailureReason
+	// frames that couldn't be rendered
+	Frames []int64
+	Err    error
+}
+
+func (e *RenderError) Error() string {
+	if e.Err == nil {
+		return fmt.Sprintf("%v frames failed to render: %s", len(e.Frames), e.Reason)
+	}
+	return fmt.Sprintf("%v frames failed to render: %s. %s", len(e.Frames), e.Reason, e.Err.Error())
+}
+
+func (e *RenderError) Unwrap() error {
+	return e.Err
+}
+
+// startError marks errors that happened before the upscaler started
+type startError struct {
+	err error
+}
+
+func (e startError) Error() string {
+	return e.err.Error()
+}
+
+func (e startError) Unwrap() error {
+	return e.err
+}
+
+// ClassifyFailure returns the reason of an error of RenderRange. A nil error means the renderer
+// finished without writing some of the frames
+func ClassifyFailure(err error) FailureReason {
+	var exitErr *ContainerExitError
+	var start startError
+	switch {
+	case err == nil:
+		return FailureMissingOutput
+	case errors.As(err, &start):
+		return FailureContainerStart
+	case errors.Is(err, context.DeadlineExceeded):
+		return FailureTimeout
+	case errors.As(err, &exitErr):
+		switch exitErr.Reason {
+		case ExitTimeout:
+			return FailureTimeout
+		case ExitOOMKilled, ExitKilled:
+			return FailureResourceLimit
+		}
+	}
+	return FailureInputDecode
+}
+
+// RetryPolicy bounds how many times a range of frames is rendered before giving up the thread
+type RetryPolicy struct {
+	// renders of a range, including the first one. 0 or 1 never retries
+	MaxAttempts int
+	// wait before the first retry, doubled on each retry up to MaxBackoff
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryPolicy renders a range up to 3 times, waiting 10 seconds and then 20 seconds between attempts
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{MaxAttempts: 3, Backoff: 10 * time.Second, MaxBackoff: 2 * time.Minute}
+}
+
+// backoff returns the wait before the retry that follows the attempt
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	wait := p.Backoff
+	for i := 1; i < attempt && wait < p.MaxBackoff; i++ {
+		wait *= 2
+	}
+	if p.MaxBackoff > 0 && wait > p.MaxBackoff {
+		wait = p.MaxBackoff
+	}
+	return wait
+}
+
+// wait blocks for the backoff of the attempt, or until ctx is done
+func (p RetryPolicy) wait(ctx context.Context, attempt int) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(p.backoff(attempt)):
+		return nil
+	}
+}
@@ -0,0 +1,104 @@
+package vm
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/janction/videoUpscaler/db"
+	"github.com/stretchr/testify/require"
+)
+
+// flakyRenderer fails the first calls, then renders with the fake renderer
+type flakyRenderer struct {
+	*FakeRenderer
+	failures int
+	err      error
+	calls    []FrameRange
+}
+
+func (r *flakyRenderer) RenderRange(ctx context.Context, job RenderJob, frameRange FrameRange, db *db.DB) error {
+	r.calls = append(r.calls, frameRange)
+	if len(r.calls) <= r.failures {
+		return r.err
+	}
+	return r.FakeRenderer.RenderRange(ctx, job, frameRange, db)
+}
+
+// --- Test for Render retries ---
+func TestRenderRetriesFailedRanges(t *testing.T) {
+	// 1. Setup
+	database, err := db.Init(t.TempDir())
+	require.NoError(t, err)
+	renderer := &flakyRenderer{FakeRenderer: NewFakeRenderer(), failures: 1, err: startError{errors.New("daemon is down")}}
+	policy := RetryPolicy{MaxAttempts: 3, Backoff: time.Millisecond}
+
+	// 2. Execute the function under test
+	var rendered []int64
+	err = Render(context.Background(), renderer, RenderJob{ThreadId: "1", Cid: "cid", Path: t.TempDir()}, []int64{0, 1, 2}, 3, policy, database, func(frameNumber int64) {
+		rendered = append(rendered, frameNumber)
+	})
+
+	// 3. Assert the range was rendered on the second attempt
+	require.NoError(t, err)
+	require.Equal(t, []int64{0, 1, 2}, rendered)
+	require.Equal(t, []FrameRange{{Start: 0, End: 2}, {Start: 0, End: 2}}, renderer.calls)
+}
+
+func TestRenderGivesUpAfterMaxAttempts(t *testing.T) {
+	// 1. Setup
+	database, err := db.Init(t.TempDir())
+	require.NoError(t, err)
+	renderer := &flakyRenderer{FakeRenderer: NewFakeRenderer(), failures: 10, err: &ContainerExitError{Code: 1, Reason: ExitFailed}}
+	policy := RetryPolicy{MaxAttempts: 2, Backoff: time.Millisecond}
+
+	// 2. Execute the function under test
+	err = Render(context.Background(), renderer, RenderJob{ThreadId: "1", Cid: "cid", Path: t.TempDir()}, []int64{0, 1}, 2, policy, database, nil)
+
+	// 3. Assert no more than MaxAttempts renders happened
+	var renderErr *RenderError
+	require.True(t, errors.As(err, &renderErr))
+	require.Equal(t, FailureInputDecode, renderErr.Reason)
+	require.Equal(t, []int64{0, 1}, renderErr.Frames)
+	require.Len(t, renderer.calls, 2)
+}
+
+func TestClassifyFailure(t *testing.T) {
+	require.Equal(t, FailureMissingOutput, ClassifyFailure(nil))
+	require.Equal(t, FailureContainerStart, ClassifyFailure(startError{errors.New("no such image")}))
+	require.Equal(t, FailureTimeout, ClassifyFailure(&ContainerExitError{Code: -1, Reason: ExitTimeout}))
+	require.Equal(t, FailureResourceLimit, ClassifyFailure(&ContainerExitError{Code: 137, Reason: ExitOOMKilled}))
+	require.Equal(t, FailureInputDecode, ClassifyFailure(&ContainerExitError{Code: 1, Reason: ExitFailed}))
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 5, Backoff: 10 * time.Second, MaxBackoff: 30 * time.Second}
+	require.Equal(t, 10*time.Second, policy.backoff(1))
+	require.Equal(t, 20*time.Second, policy.backoff(2))
+	require.Equal(t, 30*time.Second, policy.backoff(3))
+}
+
+// --- Test for restarted runs of a thread ---
+func TestRunningThreadsRestart(t *testing.T) {
+	// 1. Setup
+	running := newRunningThreads()
+	first, firstDone := running.start(context.Background(), "1")
+
+	// 2. Execute the function under test
+	second, secondDone := running.start(context.Background(), "1")
+
+	// 3. Assert the results
+	// the previous run is cancelled when the thread is started again
+	require.Error(t, first.Err())
+	require.NoError(t, second.Err())
+
+	// the first run finishing doesn't remove the second one
+	firstDone()
+	require.True(t, running.isRunning("1"))
+	running.cancel("1")
+	require.Error(t, second.Err())
+
+	secondDone()
+	require.False(t, running.isRunning("1"))
+}
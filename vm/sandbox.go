@@ -0,0 +1,97 @@
+package vm
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// mount points of the input and output inside the upscaler container
+const (
+	containerInputDir  = "/input"
+	containerOutputDir = "/output"
+)
+
+// nobody is the user the container runs as when the worker itself runs as root
+const nobody = "65534:65534"
+
+// Sandbox restricts what the upscaler container can do, since it processes videos from untrusted requesters.
+// Containers always run without network, with a read-only root, without capabilities and without
+// privilege escalation
+type Sandbox struct {
+	// uid:gid the container runs as. Never root
+	User string
+	// seccomp profile in JSON. If empty, the docker default profile is used
+	SeccompProfile string
+}
+
+// NewSandbox returns the sandbox for the provided user and seccomp profile file. If the user is empty,
+// the container runs as the user of this process, or as nobody if this process runs as root
+func NewSandbox(user string, seccompProfilePath string) (Sandbox, error) {
+	sandbox := Sandbox{User: user}
+	if sandbox.User == "" {
+		sandbox.User = defaultSandboxUser()
+	}
+	if uid, _, _ := strings.Cut(sandbox.User, ":"); uid == "0" || uid == "root" {
+		return sandbox, fmt.Errorf("upscaler container can't run as root")
+	}
+
+	if seccompProfilePath != "" {
+		profile, err := os.ReadFile(seccompProfilePath)
+		if err != nil {
+			return sandbox, fmt.Errorf("unable to read seccomp profile: %w", err)
+		}
+		if !json.Valid(profile) {
+			return sandbox, fmt.Errorf("seccomp profile %s is not valid json", seccompProfilePath)
+		}
+		sandbox.SeccompProfile = string(profile)
+	}
+	return sandbox, nil
+}
+
+// DefaultSandbox returns the sandbox with the user of this process and the docker default seccomp profile
+func DefaultSandbox() Sandbox {
+	return Sandbox{User: defaultSandboxUser()}
+}
+
+func defaultSandboxUser() string {
+	if os.Getuid() <= 0 {
+		return nobody
+	}
+	return fmt.Sprintf("%v:%v", os.Getuid(), os.Getgid())
+}
+
+// apply hardens the container config and mounts the input read-only and the output writable
+func (s Sandbox) apply(config *ContainerConfig, job RenderJob) error {
+	outputPath := filepath.Join(job.Path, "output")
+	if err := os.MkdirAll(outputPath, 0o755); err != nil {
+		return err
+	}
+	if s.User == nobody {
+		// the container user doesn't own the output dir
+		if err := os.Chmod(outputPath, 0o777); err != nil {
+			return err
+		}
+	}
+
+	config.User = s.User
+	config.NetworkDisabled = true
+	// the upscaler writes temporary frames and caches to /tmp, the only writable path besides the output
+	config.Env = append(config.Env, "HOME=/tmp")
+
+	config.HostConfig.Binds = []string{
+		fmt.Sprintf("%s:%s:ro", filepath.Join(job.Path, job.Cid), filepath.Join(containerInputDir, job.Cid)),
+		fmt.Sprintf("%s:%s:rw", outputPath, containerOutputDir),
+	}
+	config.HostConfig.NetworkMode = "none"
+	config.HostConfig.ReadonlyRootfs = true
+	config.HostConfig.Tmpfs = map[string]string{"/tmp": "rw,nosuid,nodev"}
+	config.HostConfig.CapDrop = []string{"ALL"}
+	config.HostConfig.SecurityOpt = []string{"no-new-privileges"}
+	if s.SeccompProfile != "" {
+		config.HostConfig.SecurityOpt = append(config.HostConfig.SecurityOpt, "seccomp="+s.SeccompProfile)
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package vm
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// sequenceExtensions are the image formats accepted as frames of an image sequence
+var sequenceExtensions = []string{".exr", ".png", ".tif", ".tiff", ".dpx", ".jpg", ".jpeg"}
+
+// sequenceName splits a frame of a sequence like shot010.1001.exr into shot010., 1001 and .exr
+var sequenceName = regexp.MustCompile(`^(.*?)(\d+)(\.[A-Za-z]+)$`)
+
+// ImageSequence is an input made of one image per frame, like the shot010.1001.exr to shot010.1100.exr
+// files of a directory. Frames are counted from 0, like the frames of a video file
+type ImageSequence struct {
+	// files sorted by the number in their name. Frame n of the task is Files[n]
+	Files []string
+	// extension of the files, like .exr
+	Ext string
+}
+
+// ParseImageSequence sorts the image files of a sequence directory by the number in their names. All the
+// images must share the same name apart from the number and the numbers must be consecutive, so a missing
+// file doesn't shift the frames after it. Files that are not images, like a readme, are ignored
+func ParseImageSequence(names []string) (ImageSequence, error) {
+	type sequenceFile struct {
+		name   string
+		number int64
+	}
+	var files []sequenceFile
+	var prefix, ext string
+	for _, name := range names {
+		if !slices.Contains(sequenceExtensions, strings.ToLower(filepath.Ext(name))) {
+			continue
+		}
+		parts := sequenceName.FindStringSubmatch(name)
+		if parts == nil {
+			return ImageSequence{}, fmt.Errorf("image %s has no frame number", name)
+		}
+		if len(files) == 0 {
+			prefix, ext = parts[1], parts[3]
+		} else if parts[1] != prefix || parts[3] != ext {
+			return ImageSequence{}, fmt.Errorf("image %s is not part of the sequence %s#%s", name, prefix, ext)
+		}
+		number, err := strconv.ParseInt(parts[2], 10, 64)
+		if err != nil {
+			return ImageSequence{}, fmt.Errorf("invalid frame number of image %s: %w", name, err)
+		}
+		files = append(files, sequenceFile{name: name, number: number})
+	}
+	if len(files) == 0 {
+		return ImageSequence{}, fmt.Errorf("no images found in the sequence")
+	}
+
+	sort.Slice(files, func(i, j int) bool { return files[i].number < files[j].number })
+	sequence := ImageSequence{Ext: strings.ToLower(ext)}
+	for i, file := range files {
+		if i > 0 && file.number != files[i-1].number+1 {
+			if file.number == files[i-1].number {
+				return ImageSequence{}, fmt.Errorf("images %s and %s have the same frame number", files[i-1].name, file.name)
+			}
+			return ImageSequence{}, fmt.Errorf("sequence is missing the images between %s and %s", files[i-1].name, file.name)
+		}
+		sequence.Files = append(sequence.Files, file.name)
+	}
+	return sequence, nil
+}
+
+// File returns the name of the image of the frame
+func (s ImageSequence) File(frameNumber int64) (string, error) {
+	if frameNumber < 0 || frameNumber >= int64(len(s.Files)) {
+		return "", fmt.Errorf("frame %v is out of the %v images of the sequence", frameNumber, len(s.Files))
+	}
+	return s.Files[frameNumber], nil
+}
+
+// FormatInputFilename returns the name a frame of the sequence is downloaded with, so renderers can read the
+// frames of a thread with a single pattern, see InputPattern
+func (s ImageSequence) FormatInputFilename(frameNumber int64) string {
+	return fmt.Sprintf(s.InputPattern(), frameNumber)
+}
+
+// InputPattern returns the ffmpeg image2 pattern of the downloaded frames, like frame_%06d.exr
+func (s ImageSequence) InputPattern() string {
+	return "frame_%06d" + s.Ext
+}
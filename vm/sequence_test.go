@@ -0,0 +1,41 @@
+package vm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// --- Test for ImageSequence ---
+func TestParseImageSequence(t *testing.T) {
+	// 1. Setup
+	names := []string{"shot010.1002.exr", "README.txt", "shot010.1000.exr", "shot010.1001.exr"}
+
+	// 2. images are sorted by number and other files are ignored
+	sequence, err := ParseImageSequence(names)
+	require.NoError(t, err)
+	require.Equal(t, []string{"shot010.1000.exr", "shot010.1001.exr", "shot010.1002.exr"}, sequence.Files)
+	require.Equal(t, ".exr", sequence.Ext)
+
+	// 3. frames are counted from 0 and downloaded with a name the renderers can read with a pattern
+	file, err := sequence.File(2)
+	require.NoError(t, err)
+	require.Equal(t, "shot010.1002.exr", file)
+	_, err = sequence.File(3)
+	require.Error(t, err)
+	require.Equal(t, "frame_000002.exr", sequence.FormatInputFilename(2))
+
+	// 4. gaps, duplicated numbers, mixed sequences and empty directories are rejected
+	invalid := [][]string{
+		{"shot.1000.exr", "shot.1002.exr"},
+		{"shot.1000.exr", "shot.01000.exr"},
+		{"shot.1000.exr", "plate.1001.exr"},
+		{"shot.1000.exr", "shot.1001.png"},
+		{"shot.1000.png", "thumbnail.png"},
+		{"README.txt"},
+	}
+	for _, names := range invalid {
+		_, err := ParseImageSequence(names)
+		require.Error(t, err, names)
+	}
+}